@@ -28,6 +28,8 @@ import (
 	v910 "github.com/neutron-org/neutron/v11/app/upgrades/v9.1.0"
 	"github.com/neutron-org/neutron/v11/x/coinfactory"
 	dynamicfeestypes "github.com/neutron-org/neutron/v11/x/dynamicfees/types"
+	gmpkeeper "github.com/neutron-org/neutron/v11/x/gmp/keeper"
+	gmptypes "github.com/neutron-org/neutron/v11/x/gmp/types"
 	stateverifier "github.com/neutron-org/neutron/v11/x/state-verifier"
 	svkeeper "github.com/neutron-org/neutron/v11/x/state-verifier/keeper"
 	stateverifiertypes "github.com/neutron-org/neutron/v11/x/state-verifier/types"
@@ -186,6 +188,7 @@ import (
 	"github.com/neutron-org/neutron/v11/x/feerefunder"
 	feekeeper "github.com/neutron-org/neutron/v11/x/feerefunder/keeper"
 	ibchooks "github.com/neutron-org/neutron/v11/x/ibc-hooks"
+	ibchookskeeper "github.com/neutron-org/neutron/v11/x/ibc-hooks/keeper"
 	ibchookstypes "github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
 	"github.com/neutron-org/neutron/v11/x/interchainqueries"
 	interchainqueriesmodulekeeper "github.com/neutron-org/neutron/v11/x/interchainqueries/keeper"
@@ -291,6 +294,7 @@ var (
 		oracle.AppModuleBasic{},
 		marketmap.AppModuleBasic{},
 		dynamicfees.AppModuleBasic{},
+		gmpmiddleware.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 	)
 
@@ -307,6 +311,7 @@ var (
 		tokenfactorytypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
 		coinfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 		crontypes.ModuleName:                    nil,
+		contractmanagermoduletypes.ModuleName:   nil,
 		dextypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
 		oracletypes.ModuleName:                  nil,
 		marketmaptypes.ModuleName:               nil,
@@ -371,6 +376,7 @@ type App struct {
 	FeeGrantKeeper      feegrantkeeper.Keeper
 	FeeMarkerKeeper     *feemarketkeeper.Keeper
 	DynamicFeesKeeper   *dynamicfeeskeeper.Keeper
+	GmpKeeper           *gmpkeeper.Keeper
 	FeeKeeper           *feekeeper.Keeper
 	StakingKeeper       *stakingkeeper.Keeper
 	TokenFactoryKeeper  *tokenfactorykeeper.Keeper
@@ -387,6 +393,7 @@ type App struct {
 
 	TransferStack           *ibchooks.IBCMiddleware
 	Ics20WasmHooks          *ibchooks.WasmHooks
+	IBCHooksKeeper          *ibchookskeeper.Keeper
 	RateLimitingICS4Wrapper *ibcratelimit.ICS4Wrapper
 	HooksICS4Wrapper        ibchooks.ICS4Middleware
 
@@ -479,7 +486,7 @@ func New(
 		tokenfactorytypes.StoreKey, coinfactorytypes.StoreKey, pfmtypes.StoreKey,
 		crontypes.StoreKey, ibchookstypes.StoreKey, consensusparamtypes.StoreKey, crisistypes.StoreKey, dextypes.StoreKey,
 		oracletypes.StoreKey, marketmaptypes.StoreKey, feemarkettypes.StoreKey, dynamicfeestypes.StoreKey, globalfeetypes.StoreKey, stakingtypes.StoreKey,
-		ibcratelimittypes.ModuleName, stateverifiertypes.StoreKey,
+		ibcratelimittypes.ModuleName, stateverifiertypes.StoreKey, gmptypes.StoreKey,
 		govtypes.StoreKey, minttypes.StoreKey, distributiontypes.StoreKey,
 	)
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, dextypes.TStoreKey)
@@ -591,6 +598,8 @@ func New(
 
 	app.DynamicFeesKeeper = dynamicfeeskeeper.NewKeeper(appCodec, keys[dynamicfeestypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
+	app.GmpKeeper = gmpkeeper.NewKeeper(appCodec, keys[gmptypes.StoreKey], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
 	app.FeeMarkerKeeper = feemarketkeeper.NewKeeper(
 		appCodec,
 		keys[feemarkettypes.StoreKey],
@@ -616,6 +625,7 @@ func New(
 		memKeys[feetypes.MemStoreKey],
 		app.IBCKeeper.ChannelKeeper,
 		app.BankKeeper,
+		app.DynamicFeesKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	feeModule := feerefunder.NewAppModule(appCodec, *app.FeeKeeper, app.AccountKeeper, app.BankKeeper)
@@ -625,6 +635,7 @@ func New(
 		keys[contractmanagermoduletypes.StoreKey],
 		keys[contractmanagermoduletypes.MemStoreKey],
 		&app.WasmKeeper,
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -646,9 +657,11 @@ func New(
 		app.AccountKeeper,
 		&app.BankKeeper,
 		&app.WasmKeeper,
+		app.TokenFactoryKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.CoinfactoryKeeper = &coinfactoryKeeper
+	app.TokenFactoryKeeper.SetCoinfactoryKeeper(app.CoinfactoryKeeper)
 
 	app.WireICS20PreWasmKeeper(appCodec)
 	app.PFMModule = packetforward.NewAppModule(app.PFMKeeper, app.GetSubspace(pfmtypes.ModuleName))
@@ -753,6 +766,10 @@ func New(
 
 	app.MarketMapKeeper.SetHooks(app.OracleKeeper.Hooks())
 
+	app.RateLimitingICS4Wrapper.IbcratelimitKeeper.SetOracleKeeper(app.OracleKeeper)
+	app.DynamicFeesKeeper.SetOracleKeeper(app.OracleKeeper)
+	app.DynamicFeesKeeper.SetDexKeeper(app.DexKeeper)
+
 	app.CronKeeper = *cronkeeper.NewKeeper(
 		appCodec,
 		keys[crontypes.StoreKey],
@@ -772,6 +789,8 @@ func New(
 		&app.DexKeeper,
 		app.OracleKeeper,
 		app.MarketMapKeeper,
+		app.IBCHooksKeeper,
+		app.CoinfactoryKeeper,
 	), wasmOpts...)
 
 	queryPlugins := wasmkeeper.WithQueryPlugins(
@@ -829,7 +848,7 @@ func New(
 	interchainTxsModule := interchaintxs.NewAppModule(appCodec, app.InterchainTxsKeeper, app.AccountKeeper, app.BankKeeper)
 	contractManagerModule := contractmanager.NewAppModule(appCodec, app.ContractManagerKeeper)
 	ibcRateLimitmodule := ibcratelimit.NewAppModule(appCodec, app.RateLimitingICS4Wrapper.IbcratelimitKeeper, app.RateLimitingICS4Wrapper)
-	ibcHooksModule := ibchooks.NewAppModule(app.AccountKeeper)
+	ibcHooksModule := ibchooks.NewAppModule(app.AccountKeeper, app.IBCHooksKeeper)
 
 	transferModule := transferSudo.NewAppModule(app.TransferKeeper)
 	app.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(app.WasmKeeper)
@@ -891,6 +910,7 @@ func New(
 		globalfee.NewAppModule(app.GlobalFeeKeeper, app.GetSubspace(globalfee.ModuleName), app.AppCodec(), app.keys[globalfee.ModuleName]),
 		feemarket.NewAppModule(appCodec, *app.FeeMarkerKeeper),
 		dynamicfees.NewAppModule(appCodec, *app.DynamicFeesKeeper),
+		gmpmiddleware.NewAppModule(appCodec, *app.GmpKeeper),
 		dexModule,
 		marketmapModule,
 		oracleModule,
@@ -982,6 +1002,7 @@ func New(
 		globalfee.ModuleName,
 		feemarkettypes.ModuleName,
 		dextypes.ModuleName,
+		dynamicfeestypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		stateverifiertypes.ModuleName,
 	)
@@ -1023,6 +1044,7 @@ func New(
 		marketmaptypes.ModuleName,
 		dextypes.ModuleName,
 		dynamicfeestypes.ModuleName,
+		gmptypes.ModuleName,
 		crisistypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		stateverifiertypes.ModuleName,
@@ -1628,7 +1650,14 @@ func (app *App) WireICS20PreWasmKeeper(
 		&wasmHooks,
 	)
 
-	ibcratelimitKeeper := ibcratelimitkeeper.NewKeeper(appCodec, app.keys[ibcratelimittypes.ModuleName], authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	ibcratelimitKeeper := ibcratelimitkeeper.NewKeeper(
+		appCodec,
+		app.keys[ibcratelimittypes.ModuleName],
+		app.BankKeeper,
+		nil, // oracle keeper is set later, right after its creation
+		contractmanager.NewSudoLimitWrapper(app.ContractManagerKeeper, &app.WasmKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	// ChannelKeeper wrapper for rate limiting SendPacket(). The wasmKeeper needs to be added after it's created
 	rateLimitingICS4Wrapper := ibcratelimit.NewICS4Middleware(
 		app.HooksICS4Wrapper,
@@ -1657,6 +1686,20 @@ func (app *App) WireICS20PreWasmKeeper(
 
 	app.PFMKeeper.SetTransferKeeper(app.TransferKeeper.Keeper)
 
+	// The ibc-hooks keeper keeps the packets whose acknowledgement is postponed by the wasm hooks contracts
+	// and the contracts registered for the callbacks of the sent packets
+	ibcHooksKeeper := ibchookskeeper.NewKeeper(
+		appCodec,
+		app.keys[ibchookstypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.BankKeeper,
+		app.TransferKeeper.Keeper,
+		app.RateLimitingICS4Wrapper,
+		contractmanager.NewSudoLimitWrapper(app.ContractManagerKeeper, &app.WasmKeeper),
+	)
+	app.IBCHooksKeeper = &ibcHooksKeeper
+	wasmHooks.IbcHooksKeeper = app.IBCHooksKeeper
+
 	// Packet Forward Middleware
 	// Initialize packet forward middleware router
 	var ibcStack ibcporttypes.IBCModule = packetforward.NewIBCMiddleware(
@@ -1671,7 +1714,11 @@ func (app *App) WireICS20PreWasmKeeper(
 		pfmkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
 
-	ibcStack = gmpmiddleware.NewIBCMiddleware(ibcStack)
+	ibcStack = gmpmiddleware.NewIBCMiddleware(
+		ibcStack,
+		app.GmpKeeper,
+		contractmanager.NewSudoLimitWrapper(app.ContractManagerKeeper, &app.WasmKeeper),
+	)
 	// RateLimiting IBC Middleware
 	rateLimitingTransferModule := ibcratelimit.NewIBCModule(ibcStack, app.RateLimitingICS4Wrapper)
 
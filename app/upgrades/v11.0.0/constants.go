@@ -26,6 +26,7 @@ var Upgrade = upgrades.Upgrade{
 			"gov",
 			"mint",
 			"distribution",
+			"gmp",
 		},
 		Deleted: Deleted,
 	},
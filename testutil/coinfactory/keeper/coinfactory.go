@@ -44,6 +44,7 @@ func CoinFactoryKeeper(
 		accountKeeper,
 		bankKeeper,
 		contractKeeper,
+		nil,
 		testutil.TestOwnerAddress,
 	)
 
@@ -21,7 +21,7 @@ import (
 	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
 )
 
-func ContractManagerKeeper(t testing.TB, wasmKeeper types.WasmKeeper) (*keeper.Keeper, sdk.Context) {
+func ContractManagerKeeper(t testing.TB, wasmKeeper types.WasmKeeper, bankKeeper types.BankKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -39,6 +39,7 @@ func ContractManagerKeeper(t testing.TB, wasmKeeper types.WasmKeeper) (*keeper.K
 		storeKey,
 		memStoreKey,
 		wasmKeeper,
+		bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -21,6 +21,10 @@ import (
 )
 
 func FeeKeeper(t testing.TB, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper) (*keeper.Keeper, sdk.Context) {
+	return FeeKeeperWithDynamicFees(t, channelKeeper, bankKeeper, nil)
+}
+
+func FeeKeeperWithDynamicFees(t testing.TB, channelKeeper types.ChannelKeeper, bankKeeper types.BankKeeper, dynamicfeesKeeper types.DynamicFeesKeeper) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -39,6 +43,7 @@ func FeeKeeper(t testing.TB, channelKeeper types.ChannelKeeper, bankKeeper types
 		memStoreKey,
 		channelKeeper,
 		bankKeeper,
+		dynamicfeesKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
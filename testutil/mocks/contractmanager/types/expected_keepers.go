@@ -81,6 +81,71 @@ func (mr *MockWasmKeeperMockRecorder) Sudo(ctx, contractAddress, msg interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sudo", reflect.TypeOf((*MockWasmKeeper)(nil).Sudo), ctx, contractAddress, msg)
 }
 
+// MockBankKeeper is a mock of BankKeeper interface.
+type MockBankKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockBankKeeperMockRecorder
+}
+
+// MockBankKeeperMockRecorder is the mock recorder for MockBankKeeper.
+type MockBankKeeperMockRecorder struct {
+	mock *MockBankKeeper
+}
+
+// NewMockBankKeeper creates a new mock instance.
+func NewMockBankKeeper(ctrl *gomock.Controller) *MockBankKeeper {
+	mock := &MockBankKeeper{ctrl: ctrl}
+	mock.recorder = &MockBankKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
+	return m.recorder
+}
+
+// SendCoinsFromAccountToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr types0.AccAddress, recipientModule string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromAccountToModule", ctx, senderAddr, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromAccountToModule indicates an expected call of SendCoinsFromAccountToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromAccountToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromAccountToModule), ctx, senderAddr, recipientModule, amt)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types0.AccAddress, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
+// SendCoinsFromModuleToModule mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types0.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToModule", ctx, senderModule, recipientModule, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToModule indicates an expected call of SendCoinsFromModuleToModule.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
+}
+
 // MockContractManagerKeeper is a mock of ContractManagerKeeper interface.
 type MockContractManagerKeeper struct {
 	ctrl     *gomock.Controller
@@ -105,17 +170,29 @@ func (m *MockContractManagerKeeper) EXPECT() *MockContractManagerKeeperMockRecor
 }
 
 // AddContractFailure mocks base method.
-func (m *MockContractManagerKeeper) AddContractFailure(ctx context.Context, address string, sudoPayload []byte, errMsg string) types1.Failure {
+func (m *MockContractManagerKeeper) AddContractFailure(ctx context.Context, address string, sudoPayload []byte, errMsg string, gasUsed uint64) types1.Failure {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddContractFailure", ctx, address, sudoPayload, errMsg)
+	ret := m.ctrl.Call(m, "AddContractFailure", ctx, address, sudoPayload, errMsg, gasUsed)
 	ret0, _ := ret[0].(types1.Failure)
 	return ret0
 }
 
 // AddContractFailure indicates an expected call of AddContractFailure.
-func (mr *MockContractManagerKeeperMockRecorder) AddContractFailure(ctx, address, sudoPayload, errMsg interface{}) *gomock.Call {
+func (mr *MockContractManagerKeeperMockRecorder) AddContractFailure(ctx, address, sudoPayload, errMsg, gasUsed interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContractFailure", reflect.TypeOf((*MockContractManagerKeeper)(nil).AddContractFailure), ctx, address, sudoPayload, errMsg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddContractFailure", reflect.TypeOf((*MockContractManagerKeeper)(nil).AddContractFailure), ctx, address, sudoPayload, errMsg, gasUsed)
+}
+
+// ChargeSudoGas mocks base method.
+func (m *MockContractManagerKeeper) ChargeSudoGas(ctx context.Context, contractAddress types0.AccAddress, gasUsed uint64, held types0.Coin) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ChargeSudoGas", ctx, contractAddress, gasUsed, held)
+}
+
+// ChargeSudoGas indicates an expected call of ChargeSudoGas.
+func (mr *MockContractManagerKeeperMockRecorder) ChargeSudoGas(ctx, contractAddress, gasUsed, held interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeSudoGas", reflect.TypeOf((*MockContractManagerKeeper)(nil).ChargeSudoGas), ctx, contractAddress, gasUsed, held)
 }
 
 // GetParams mocks base method.
@@ -131,3 +208,31 @@ func (mr *MockContractManagerKeeperMockRecorder) GetParams(ctx interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockContractManagerKeeper)(nil).GetParams), ctx)
 }
+
+// GetSudoCallGasLimit mocks base method.
+func (m *MockContractManagerKeeper) GetSudoCallGasLimit(ctx context.Context, contractAddress types0.AccAddress) uint64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSudoCallGasLimit", ctx, contractAddress)
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// GetSudoCallGasLimit indicates an expected call of GetSudoCallGasLimit.
+func (mr *MockContractManagerKeeperMockRecorder) GetSudoCallGasLimit(ctx, contractAddress interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSudoCallGasLimit", reflect.TypeOf((*MockContractManagerKeeper)(nil).GetSudoCallGasLimit), ctx, contractAddress)
+}
+
+// ReserveSudoGas mocks base method.
+func (m *MockContractManagerKeeper) ReserveSudoGas(ctx context.Context, contractAddress types0.AccAddress, gasLimit uint64) types0.Coin {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveSudoGas", ctx, contractAddress, gasLimit)
+	ret0, _ := ret[0].(types0.Coin)
+	return ret0
+}
+
+// ReserveSudoGas indicates an expected call of ReserveSudoGas.
+func (mr *MockContractManagerKeeperMockRecorder) ReserveSudoGas(ctx, contractAddress, gasLimit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveSudoGas", reflect.TypeOf((*MockContractManagerKeeper)(nil).ReserveSudoGas), ctx, contractAddress, gasLimit)
+}
@@ -73,6 +73,20 @@ func (m *MockBankKeeper) EXPECT() *MockBankKeeperMockRecorder {
 	return m.recorder
 }
 
+// BlockedAddr mocks base method.
+func (m *MockBankKeeper) BlockedAddr(addr types.AccAddress) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockedAddr", addr)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// BlockedAddr indicates an expected call of BlockedAddr.
+func (mr *MockBankKeeperMockRecorder) BlockedAddr(addr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockedAddr", reflect.TypeOf((*MockBankKeeper)(nil).BlockedAddr), addr)
+}
+
 // HasBalance mocks base method.
 func (m *MockBankKeeper) HasBalance(ctx context.Context, addr types.AccAddress, amt types.Coin) bool {
 	m.ctrl.T.Helper()
@@ -129,6 +143,44 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// MockDynamicFeesKeeper is a mock of DynamicFeesKeeper interface.
+type MockDynamicFeesKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDynamicFeesKeeperMockRecorder
+}
+
+// MockDynamicFeesKeeperMockRecorder is the mock recorder for MockDynamicFeesKeeper.
+type MockDynamicFeesKeeperMockRecorder struct {
+	mock *MockDynamicFeesKeeper
+}
+
+// NewMockDynamicFeesKeeper creates a new mock instance.
+func NewMockDynamicFeesKeeper(ctrl *gomock.Controller) *MockDynamicFeesKeeper {
+	mock := &MockDynamicFeesKeeper{ctrl: ctrl}
+	mock.recorder = &MockDynamicFeesKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDynamicFeesKeeper) EXPECT() *MockDynamicFeesKeeperMockRecorder {
+	return m.recorder
+}
+
+// ConvertToDenom mocks base method.
+func (m *MockDynamicFeesKeeper) ConvertToDenom(ctx types.Context, fromCoin types.DecCoin, toDenom string) (types.DecCoin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConvertToDenom", ctx, fromCoin, toDenom)
+	ret0, _ := ret[0].(types.DecCoin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConvertToDenom indicates an expected call of ConvertToDenom.
+func (mr *MockDynamicFeesKeeperMockRecorder) ConvertToDenom(ctx, fromCoin, toDenom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConvertToDenom", reflect.TypeOf((*MockDynamicFeesKeeper)(nil).ConvertToDenom), ctx, fromCoin, toDenom)
+}
+
 // MockChannelKeeper is a mock of ChannelKeeper interface.
 type MockChannelKeeper struct {
 	ctrl     *gomock.Controller
@@ -29,6 +29,8 @@ type NeutronMsg struct {
 	UpdateInterchainQuery     *UpdateInterchainQuery            `json:"update_interchain_query,omitempty"`
 	RemoveInterchainQuery     *RemoveInterchainQuery            `json:"remove_interchain_query,omitempty"`
 	IBCTransfer               *transferwrappertypes.MsgTransfer `json:"ibc_transfer,omitempty"`
+	/// Contracts can send several ICS-20 transfers at once, each as a separate packet, paying the fee once.
+	BatchIBCTransfer *transferwrappertypes.MsgBatchTransfer `json:"batch_ibc_transfer,omitempty"`
 	// Token factory types
 	/// Contracts can create denoms, namespaced under the contract's address.
 	/// A contract may create any number of independent sub-denoms.
@@ -59,6 +61,16 @@ type NeutronMsg struct {
 	/// A contract that has failed acknowledgement can resubmit it
 	ResubmitFailure *ResubmitFailure `json:"resubmit_failure,omitempty"`
 
+	// Coinfactory types
+	/// Contracts can grant the roles over the coinfactory denoms they are the admins of
+	CoinfactoryGrantRole *CoinfactoryGrantRole `json:"coinfactory_grant_role,omitempty"`
+	/// Contracts can revoke the roles over the coinfactory denoms they are the admins of
+	CoinfactoryRevokeRole *CoinfactoryRevokeRole `json:"coinfactory_revoke_role,omitempty"`
+
+	// IBC hooks types
+	/// A contract invoked by a wasm-hooked transfer that has postponed the acknowledgement can write it
+	WriteAsyncAck *WriteAsyncAck `json:"write_async_ack,omitempty"`
+
 	// dex module bindings
 	Dex *Dex `json:"dex,omitempty"`
 }
@@ -71,6 +83,7 @@ type SubmitTx struct {
 	Memo                string        `json:"memo"`
 	Timeout             uint64        `json:"timeout"`
 	Fee                 feetypes.Fee  `json:"fee"`
+	SplitOversized      bool          `json:"split_oversized,omitempty"`
 }
 
 // RegisterInterchainAccount creates account on remote chain.
@@ -79,6 +92,7 @@ type RegisterInterchainAccount struct {
 	InterchainAccountId string    `json:"interchain_account_id"`
 	RegisterFee         sdk.Coins `json:"register_fee,omitempty"`
 	Ordering            string    `json:"ordering,omitempty"`
+	AutoReopen          bool      `json:"auto_reopen,omitempty"`
 }
 
 // RegisterInterchainAccountResponse holds response for RegisterInterchainAccount.
@@ -94,6 +108,8 @@ type RegisterInterchainQuery struct {
 	TransactionsFilter string            `json:"transactions_filter"`
 	ConnectionId       string            `json:"connection_id"`
 	UpdatePeriod       uint64            `json:"update_period"`
+	RewardPerUpdate    sdk.Coins         `json:"reward_per_update,omitempty"`
+	RewardEscrow       sdk.Coins         `json:"reward_escrow,omitempty"`
 }
 
 type SubmitAdminProposal struct {
@@ -133,6 +149,8 @@ type UpdateInterchainQuery struct {
 	NewKeys               []*icqtypes.KVKey `json:"new_keys,omitempty"`
 	NewUpdatePeriod       uint64            `json:"new_update_period,omitempty"`
 	NewTransactionsFilter string            `json:"new_transactions_filter,omitempty"`
+	NewRewardPerUpdate    sdk.Coins         `json:"new_reward_per_update,omitempty"`
+	ClearRewardPerUpdate  bool              `json:"clear_reward_per_update,omitempty"`
 }
 
 type UpdateInterchainQueryResponse struct{}
@@ -226,6 +244,33 @@ type ResubmitFailureResponse struct {
 	FailureId uint64 `json:"failure_id"`
 }
 
+// CoinfactoryGrantRole grants a role over a coinfactory denom to an address.
+type CoinfactoryGrantRole struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	// Role is one of minter, burner, force_transferer, metadata_setter or hook_setter
+	Role string `json:"role"`
+	// MintAllowance limits the amount the minter can mint, unlimited if not set
+	MintAllowance *math.Int `json:"mint_allowance,omitempty"`
+}
+
+// CoinfactoryRevokeRole revokes a role over a coinfactory denom from an address.
+type CoinfactoryRevokeRole struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Role    string `json:"role"`
+}
+
+// WriteAsyncAck writes the acknowledgement of an ICS-20 transfer whose wasm hook has postponed it.
+type WriteAsyncAck struct {
+	ChannelId string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	// Result is passed to the sender in the successful acknowledgement
+	Result []byte `json:"result,omitempty"`
+	// Error writes an error acknowledgement instead and refunds the received tokens to the sender
+	Error string `json:"error,omitempty"`
+}
+
 type Dex struct {
 	Deposit                  *dextypes.MsgDeposit                  `json:"deposit"`
 	Withdrawal               *dextypes.MsgWithdrawal               `json:"withdrawal"`
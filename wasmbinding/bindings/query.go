@@ -160,6 +160,8 @@ func (rq RegisteredQuery) MarshalJSON() ([]byte, error) {
 type QueryInterchainAccountAddressResponse struct {
 	// The corresponding interchain account address on the host chain
 	InterchainAccountAddress string `json:"interchain_account_address,omitempty"`
+	// The status of the interchain account's channel, e.g. CHANNEL_STATUS_OPEN
+	ChannelStatus string `json:"channel_status,omitempty"`
 }
 
 type QueryRegisteredQueryResultResponse struct {
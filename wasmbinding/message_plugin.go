@@ -18,6 +18,7 @@ import (
 	dexkeeper "github.com/neutron-org/neutron/v11/x/dex/keeper"
 	dextypes "github.com/neutron-org/neutron/v11/x/dex/types"
 	dexutils "github.com/neutron-org/neutron/v11/x/dex/utils"
+	ibchookskeeper "github.com/neutron-org/neutron/v11/x/ibc-hooks/keeper"
 
 	"cosmossdk.io/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -48,6 +49,9 @@ import (
 
 	tokenfactorykeeper "github.com/neutron-org/neutron/v11/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/neutron-org/neutron/v11/x/tokenfactory/types"
+
+	coinfactorykeeper "github.com/neutron-org/neutron/v11/x/coinfactory/keeper"
+	coinfactorytypes "github.com/neutron-org/neutron/v11/x/coinfactory/types"
 )
 
 func CustomMessageDecorator(
@@ -59,6 +63,8 @@ func CustomMessageDecorator(
 	cronKeeper *cronkeeper.Keeper,
 	contractmanagerKeeper *contractmanagerkeeper.Keeper,
 	dexKeeper *dexkeeper.Keeper,
+	ibcHooksKeeper *ibchookskeeper.Keeper,
+	coinfactoryKeeper *coinfactorykeeper.Keeper,
 ) func(messenger wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
@@ -74,6 +80,8 @@ func CustomMessageDecorator(
 			ContractmanagerMsgServer:   contractmanagerkeeper.NewMsgServerImpl(*contractmanagerKeeper),
 			ContractmanagerQueryServer: contractmanagerkeeper.NewQueryServerImpl(*contractmanagerKeeper),
 			DexMsgServer:               dexkeeper.NewMsgServerImpl(*dexKeeper),
+			IbcHooksKeeper:             ibcHooksKeeper,
+			CoinfactoryMsgServer:       coinfactorykeeper.NewMsgServerImpl(*coinfactoryKeeper),
 		}
 	}
 }
@@ -91,6 +99,8 @@ type CustomMessenger struct {
 	ContractmanagerMsgServer   contractmanagertypes.MsgServer
 	ContractmanagerQueryServer contractmanagertypes.QueryServer
 	DexMsgServer               dextypes.MsgServer
+	IbcHooksKeeper             *ibchookskeeper.Keeper
+	CoinfactoryMsgServer       coinfactorytypes.MsgServer
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -130,6 +140,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 	if contractMsg.IBCTransfer != nil {
 		return m.ibcTransfer(ctx, contractAddr, *contractMsg.IBCTransfer)
 	}
+	if contractMsg.BatchIBCTransfer != nil {
+		return m.batchIBCTransfer(ctx, contractAddr, *contractMsg.BatchIBCTransfer)
+	}
 
 	if contractMsg.CreateDenom != nil {
 		return m.createDenom(ctx, contractAddr, contractMsg.CreateDenom)
@@ -153,12 +166,22 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		return m.setDenomMetadata(ctx, contractAddr, contractMsg.SetDenomMetadata)
 	}
 
+	if contractMsg.CoinfactoryGrantRole != nil {
+		return m.coinfactoryGrantRole(ctx, contractAddr, contractMsg.CoinfactoryGrantRole)
+	}
+	if contractMsg.CoinfactoryRevokeRole != nil {
+		return m.coinfactoryRevokeRole(ctx, contractAddr, contractMsg.CoinfactoryRevokeRole)
+	}
+
 	if contractMsg.RemoveSchedule != nil {
 		return m.removeSchedule(ctx, contractAddr, contractMsg.RemoveSchedule)
 	}
 	if contractMsg.ResubmitFailure != nil {
 		return m.resubmitFailure(ctx, contractAddr, contractMsg.ResubmitFailure)
 	}
+	if contractMsg.WriteAsyncAck != nil {
+		return m.writeAsyncAck(ctx, contractAddr, contractMsg.WriteAsyncAck)
+	}
 	if contractMsg.Dex != nil {
 		data, messages, err := m.dispatchDexMsg(ctx, contractAddr, *(contractMsg.Dex))
 		return nil, data, messages, err
@@ -301,6 +324,42 @@ func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddre
 	return nil, [][]byte{data}, msgResponses, nil
 }
 
+func (m *CustomMessenger) batchIBCTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, batchTransferMsg transferwrappertypes.MsgBatchTransfer) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	batchTransferMsg.Sender = contractAddr.String()
+
+	response, err := m.transferKeeper.BatchTransfer(ctx, &batchTransferMsg)
+	if err != nil {
+		ctx.Logger().Debug("transferServer.BatchTransfer: failed to transfer",
+			"from_address", contractAddr.String(),
+			"msg", batchTransferMsg,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "failed to execute BatchIBCTransfer")
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		ctx.Logger().Error("json.Marshal: failed to marshal MsgBatchTransferResponse response to JSON",
+			"from_address", contractAddr.String(),
+			"msg", response,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "marshal json failed")
+	}
+
+	ctx.Logger().Debug("batchTransferMsg completed",
+		"from_address", contractAddr.String(),
+		"msg", batchTransferMsg,
+	)
+
+	anyResp, err := types.NewAnyWithValue(response)
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "failed to convert {%T} to Any", response)
+	}
+	msgResponses := [][]*types.Any{{anyResp}}
+	return nil, [][]byte{data}, msgResponses, nil
+}
+
 func (m *CustomMessenger) updateInterchainQuery(ctx sdk.Context, contractAddr sdk.AccAddress, updateQuery *bindings.UpdateInterchainQuery) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	response, err := m.performUpdateInterchainQuery(ctx, contractAddr, updateQuery)
 	if err != nil {
@@ -341,6 +400,8 @@ func (m *CustomMessenger) performUpdateInterchainQuery(ctx sdk.Context, contract
 		NewKeys:               updateQuery.NewKeys,
 		NewUpdatePeriod:       updateQuery.NewUpdatePeriod,
 		NewTransactionsFilter: updateQuery.NewTransactionsFilter,
+		NewRewardPerUpdate:    updateQuery.NewRewardPerUpdate,
+		ClearRewardPerUpdate:  updateQuery.ClearRewardPerUpdate,
 		Sender:                contractAddr.String(),
 	}
 
@@ -656,6 +717,7 @@ func (m *CustomMessenger) performSubmitTx(ctx sdk.Context, contractAddr sdk.AccA
 		InterchainAccountId: submitTx.InterchainAccountId,
 		Timeout:             submitTx.Timeout,
 		Fee:                 submitTx.Fee,
+		SplitOversized:      submitTx.SplitOversized,
 	}
 	for _, msg := range submitTx.Msgs {
 		tx.Msgs = append(tx.Msgs, &types.Any{
@@ -729,6 +791,7 @@ func (m *CustomMessenger) performRegisterInterchainAccount(ctx sdk.Context, cont
 		InterchainAccountId: reg.InterchainAccountId,
 		RegisterFee:         getRegisterFee(reg.RegisterFee),
 		Ordering:            orderValue,
+		AutoReopen:          reg.AutoReopen,
 	}
 
 	response, err := m.Ictxmsgserver.RegisterInterchainAccount(ctx, &msg)
@@ -793,6 +856,8 @@ func (m *CustomMessenger) performRegisterInterchainQuery(ctx sdk.Context, contra
 		ConnectionId:       reg.ConnectionId,
 		UpdatePeriod:       reg.UpdatePeriod,
 		Sender:             contractAddr.String(),
+		RewardPerUpdate:    reg.RewardPerUpdate,
+		RewardEscrow:       reg.RewardEscrow,
 	}
 
 	response, err := m.Icqmsgserver.RegisterInterchainQuery(ctx, &msg)
@@ -877,9 +942,92 @@ func (m *CustomMessenger) resubmitFailure(ctx sdk.Context, contractAddr sdk.AccA
 	return nil, [][]byte{data}, msgResponses, nil
 }
 
+func (m *CustomMessenger) writeAsyncAck(ctx sdk.Context, contractAddr sdk.AccAddress, writeAsyncAck *bindings.WriteAsyncAck) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	if m.IbcHooksKeeper == nil {
+		return nil, nil, nil, errors.Wrap(sdkerrors.ErrNotSupported, "async acknowledgements are not supported")
+	}
+
+	err := m.IbcHooksKeeper.WriteAsyncAcknowledgement(
+		ctx,
+		contractAddr,
+		writeAsyncAck.ChannelId,
+		writeAsyncAck.Sequence,
+		writeAsyncAck.Result,
+		writeAsyncAck.Error,
+	)
+	if err != nil {
+		ctx.Logger().Error("failed to writeAsyncAck",
+			"from_address", contractAddr.String(),
+			"channel_id", writeAsyncAck.ChannelId,
+			"sequence", writeAsyncAck.Sequence,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "failed to write async acknowledgement")
+	}
+
+	ctx.Logger().Debug("async acknowledgement written",
+		"from_address", contractAddr.String(),
+		"channel_id", writeAsyncAck.ChannelId,
+		"sequence", writeAsyncAck.Sequence,
+	)
+	return nil, nil, nil, nil
+}
+
 func getRegisterFee(fee sdk.Coins) sdk.Coins {
 	if fee == nil {
 		return make(sdk.Coins, 0)
 	}
 	return fee
 }
+
+func (m *CustomMessenger) coinfactoryGrantRole(ctx sdk.Context, contractAddr sdk.AccAddress, grantRole *bindings.CoinfactoryGrantRole) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	role, err := coinfactorytypes.ParseRole(grantRole.Role)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to parse coinfactory role")
+	}
+
+	msg := coinfactorytypes.NewMsgGrantRole(contractAddr.String(), grantRole.Denom, grantRole.Address, role, grantRole.MintAllowance)
+	if err := msg.Validate(); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to validate coinfactory grant role message")
+	}
+
+	_, err = m.CoinfactoryMsgServer.GrantRole(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error("failed to grant coinfactory role",
+			"from_address", contractAddr.String(),
+			"denom", grantRole.Denom,
+			"address", grantRole.Address,
+			"role", grantRole.Role,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "failed to grant coinfactory role")
+	}
+
+	return nil, nil, nil, nil
+}
+
+func (m *CustomMessenger) coinfactoryRevokeRole(ctx sdk.Context, contractAddr sdk.AccAddress, revokeRole *bindings.CoinfactoryRevokeRole) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	role, err := coinfactorytypes.ParseRole(revokeRole.Role)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to parse coinfactory role")
+	}
+
+	msg := coinfactorytypes.NewMsgRevokeRole(contractAddr.String(), revokeRole.Denom, revokeRole.Address, role)
+	if err := msg.Validate(); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "failed to validate coinfactory revoke role message")
+	}
+
+	_, err = m.CoinfactoryMsgServer.RevokeRole(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error("failed to revoke coinfactory role",
+			"from_address", contractAddr.String(),
+			"denom", revokeRole.Denom,
+			"address", revokeRole.Address,
+			"role", revokeRole.Role,
+			"error", err,
+		)
+		return nil, nil, nil, errors.Wrap(err, "failed to revoke coinfactory role")
+	}
+
+	return nil, nil, nil, nil
+}
@@ -61,7 +61,20 @@ func (qp *QueryPlugin) GetInterchainAccountAddress(ctx sdk.Context, req *binding
 		return nil, err
 	}
 
-	return &bindings.QueryInterchainAccountAddressResponse{InterchainAccountAddress: grpcResp.GetInterchainAccountAddress()}, nil
+	resp := &bindings.QueryInterchainAccountAddressResponse{InterchainAccountAddress: grpcResp.GetInterchainAccountAddress()}
+
+	// channels of the interchain accounts registered before the channels tracking was introduced
+	// have no status
+	statusResp, err := qp.icaControllerKeeper.InterchainAccountStatus(ctx, &icatypes.QueryInterchainAccountStatusRequest{
+		OwnerAddress:        req.OwnerAddress,
+		InterchainAccountId: req.InterchainAccountID,
+		ConnectionId:        req.ConnectionID,
+	})
+	if err == nil {
+		resp.ChannelStatus = statusResp.Channel.Status.String()
+	}
+
+	return resp, nil
 }
 
 func (qp *QueryPlugin) GetRegisteredInterchainQueries(ctx sdk.Context, query *bindings.QueryRegisteredQueriesRequest) (*bindings.QueryRegisteredQueriesResponse, error) {
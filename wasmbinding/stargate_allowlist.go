@@ -52,6 +52,9 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.coinfactory.v1beta1.Query/DenomsFromCreator":      func() proto.Message { return &coinfactorytypes.QueryDenomsFromCreatorResponse{} },
 		"/neutron.coinfactory.v1beta1.Query/BeforeSendHookAddress":  func() proto.Message { return &coinfactorytypes.QueryBeforeSendHookAddressResponse{} },
 		"/neutron.coinfactory.v1beta1.Query/FullDenom":              func() proto.Message { return &coinfactorytypes.QueryFullDenomResponse{} },
+		"/neutron.coinfactory.v1beta1.Query/DenomRoles":             func() proto.Message { return &coinfactorytypes.QueryDenomRolesResponse{} },
+		"/neutron.coinfactory.v1beta1.Query/FrozenAddresses":        func() proto.Message { return &coinfactorytypes.QueryFrozenAddressesResponse{} },
+		"/neutron.coinfactory.v1beta1.Query/DenomLink":              func() proto.Message { return &coinfactorytypes.QueryDenomLinkResponse{} },
 
 		// interchain accounts
 		"/ibc.applications.interchain_accounts.controller.v1.Query/InterchainAccount": func() proto.Message { return &icacontrollertypes.QueryInterchainAccountResponse{} },
@@ -73,6 +76,8 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		// interchaintxs
 		"/neutron.interchaintxs.v1.Query/Params":                   func() proto.Message { return &interchaintxstypes.QueryParamsResponse{} },
 		"/neutron.interchaintxs.v1.Query/InterchainAccountAddress": func() proto.Message { return &interchaintxstypes.QueryInterchainAccountAddressResponse{} },
+		"/neutron.interchaintxs.v1.Query/InterchainAccountStatus":  func() proto.Message { return &interchaintxstypes.QueryInterchainAccountStatusResponse{} },
+		"/neutron.interchaintxs.v1.Query/HostAllowMessages":        func() proto.Message { return &interchaintxstypes.QueryHostAllowMessagesResponse{} },
 
 		// cron
 		"/neutron.cron.Query/Params": func() proto.Message { return &crontypes.QueryParamsResponse{} },
@@ -83,6 +88,8 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.interchainqueries.Query/RegisteredQuery":   func() proto.Message { return &interchainqueriestypes.QueryRegisteredQueryResponse{} },
 		"/neutron.interchainqueries.Query/QueryResult":       func() proto.Message { return &interchainqueriestypes.QueryRegisteredQueryResultResponse{} },
 		"/neutron.interchainqueries.Query/LastRemoteHeight":  func() proto.Message { return &interchainqueriestypes.QueryLastRemoteHeightResponse{} },
+		"/neutron.interchainqueries.Query/RelayerStats":      func() proto.Message { return &interchainqueriestypes.QueryRelayerStatsResponse{} },
+		"/neutron.interchainqueries.Query/AllRelayerStats":   func() proto.Message { return &interchainqueriestypes.QueryAllRelayerStatsResponse{} },
 
 		// dex
 		"/neutron.dex.Query/Params":                            func() proto.Message { return &dextypes.QueryParamsResponse{} },
@@ -559,7 +559,7 @@ func (suite *CustomMessengerTestSuite) TestResubmitFailureAck() {
 	require.NoError(suite.T(), err)
 
 	failureID := suite.neutron.ContractManagerKeeper.GetNextFailureIDKey(suite.ctx, suite.contractAddress.String())
-	suite.neutron.ContractManagerKeeper.AddContractFailure(suite.ctx, suite.contractAddress.String(), payload, "test error")
+	suite.neutron.ContractManagerKeeper.AddContractFailure(suite.ctx, suite.contractAddress.String(), payload, "test error", 0)
 
 	// Craft message
 	msg := bindings.NeutronMsg{
@@ -585,7 +585,7 @@ func (suite *CustomMessengerTestSuite) TestResubmitFailureTimeout() {
 	require.NoError(suite.T(), err)
 
 	failureID := suite.neutron.ContractManagerKeeper.GetNextFailureIDKey(suite.ctx, suite.contractAddress.String())
-	suite.neutron.ContractManagerKeeper.AddContractFailure(suite.ctx, suite.contractAddress.String(), payload, "test error")
+	suite.neutron.ContractManagerKeeper.AddContractFailure(suite.ctx, suite.contractAddress.String(), payload, "test error", 0)
 
 	// Craft message
 	msg := bindings.NeutronMsg{
@@ -613,7 +613,7 @@ func (suite *CustomMessengerTestSuite) TestResubmitFailureFromDifferentContract(
 	failureID := suite.neutron.ContractManagerKeeper.GetNextFailureIDKey(suite.ctx, testutil.TestOwnerAddress)
 	payload, err := contractmanagerkeeper.PrepareSudoCallbackMessage(packet, &ack)
 	require.NoError(suite.T(), err)
-	suite.neutron.ContractManagerKeeper.AddContractFailure(suite.ctx, testutil.TestOwnerAddress, payload, "test error")
+	suite.neutron.ContractManagerKeeper.AddContractFailure(suite.ctx, testutil.TestOwnerAddress, payload, "test error", 0)
 
 	// Craft message
 	msg := bindings.NeutronMsg{
@@ -4,10 +4,12 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	coinfactorykeeper "github.com/neutron-org/neutron/v11/x/coinfactory/keeper"
 	contractmanagerkeeper "github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
 	cronkeeper "github.com/neutron-org/neutron/v11/x/cron/keeper"
 	dexkeeper "github.com/neutron-org/neutron/v11/x/dex/keeper"
 	feerefunderkeeper "github.com/neutron-org/neutron/v11/x/feerefunder/keeper"
+	ibchookskeeper "github.com/neutron-org/neutron/v11/x/ibc-hooks/keeper"
 
 	marketmapkeeper "github.com/skip-mev/slinky/x/marketmap/keeper"
 	oraclekeeper "github.com/skip-mev/slinky/x/oracle/keeper"
@@ -31,6 +33,8 @@ func RegisterCustomPlugins(
 	dexKeeper *dexkeeper.Keeper,
 	oracleKeeper *oraclekeeper.Keeper,
 	markemapKeeper *marketmapkeeper.Keeper,
+	ibcHooksKeeper *ibchookskeeper.Keeper,
+	coinfactoryKeeper *coinfactorykeeper.Keeper,
 ) []wasmkeeper.Option {
 	wasmQueryPlugin := NewQueryPlugin(ictxKeeper, icqKeeper, feeRefunderKeeper, tfk, contractmanagerKeeper, dexKeeper, oracleKeeper, markemapKeeper)
 
@@ -38,7 +42,7 @@ func RegisterCustomPlugins(
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messagePluginOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, bank, tfk, cronKeeper, contractmanagerKeeper, dexKeeper),
+		CustomMessageDecorator(ictxKeeper, icqKeeper, transfer, bank, tfk, cronKeeper, contractmanagerKeeper, dexKeeper, ibcHooksKeeper, coinfactoryKeeper),
 	)
 
 	return []wasmkeeper.Option{
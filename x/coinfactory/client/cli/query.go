@@ -28,6 +28,10 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
 		GetCmdBeforeSendHook(),
+		GetCmdDenomMintLimits(),
+		GetCmdDenomRoles(),
+		GetCmdFrozenAddresses(),
+		GetCmdDenomLink(),
 	)
 
 	return cmd
@@ -160,3 +164,147 @@ func GetCmdBeforeSendHook() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomMintLimits returns the max supply and the mint schedule for a queried denom
+func GetCmdDenomMintLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-limits [denom] [flags]",
+		Short: "Get the max supply, the mint schedule and the mintable amount for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			creator, subdenom, err := types.DeconstructDenom(denom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomMintLimits(cmd.Context(), &types.QueryDenomMintLimitsRequest{
+				Creator:  creator,
+				Subdenom: subdenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomRoles returns the roles granted over a queried denom
+func GetCmdDenomRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-roles [denom] [flags]",
+		Short: "Get the roles granted over a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			creator, subdenom, err := types.DeconstructDenom(denom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomRoles(cmd.Context(), &types.QueryDenomRolesRequest{
+				Creator:  creator,
+				Subdenom: subdenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAddresses returns the addresses frozen for a queried denom and whether its transfers are paused
+func GetCmdFrozenAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-addresses [denom] [flags]",
+		Short: "Get the addresses frozen for a specific denom and whether its transfers are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			creator, subdenom, err := types.DeconstructDenom(denom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FrozenAddresses(cmd.Context(), &types.QueryFrozenAddressesRequest{
+				Creator:    creator,
+				Subdenom:   subdenom,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomLink returns the link between a migrated tokenfactory denom and its coinfactory successor
+func GetCmdDenomLink() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-link [denom] [flags]",
+		Short: "Get the link between a migrated tokenfactory denom and its coinfactory successor by either denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomLink(cmd.Context(), &types.QueryDenomLinkRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"cosmossdk.io/math"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 
@@ -35,6 +38,16 @@ func GetTxCmd() *cobra.Command {
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHook(),
 		NewSetDenomMetadataCmd(),
+		NewSetMintLimitsCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetFrozenCmd("freeze", true),
+		NewSetFrozenCmd("unfreeze", false),
+		NewSetPausedCmd("pause", true),
+		NewSetPausedCmd("unpause", false),
+		NewMigrateTokenfactoryDenomCmd(),
+		NewSetConversionDirectionsCmd(),
+		NewConvertCmd(),
 	)
 
 	return cmd
@@ -278,3 +291,380 @@ func NewSetBeforeSendHook() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagMaxSupply      = "max-supply"
+	FlagMintSchedule   = "mint-schedule"
+	FlagMintAllowance  = "mint-allowance"
+	FlagToCoinfactory  = "to-coinfactory"
+	FlagToTokenfactory = "to-tokenfactory"
+)
+
+// NewSetMintLimitsCmd broadcast MsgSetMintLimits
+func NewSetMintLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-limits [denom] [flags]",
+		Short: "Sets the max supply and the mint schedule for a factory-created denom. Must have admin authority to do so.",
+		Long: `Sets the max supply and the mint schedule for a factory-created denom. Must have admin authority to do so.
+The max supply can only be lowered once set, and the mint schedule can be set only once.
+The mint schedule is a comma-separated list of amount@unlock_time entries with RFC3339 unlock times, e.g.
+--mint-schedule 1000000@2027-01-01T00:00:00Z,1000000@2028-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var maxSupply *math.Int
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if maxSupplyStr != "" {
+				amount, ok := math.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid max supply: %s", maxSupplyStr)
+				}
+				maxSupply = &amount
+			}
+
+			mintScheduleStr, err := cmd.Flags().GetString(FlagMintSchedule)
+			if err != nil {
+				return err
+			}
+			mintSchedule, err := parseMintSchedule(mintScheduleStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintLimits(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+				mintSchedule,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMaxSupply, "", "Max supply of the denom")
+	cmd.Flags().String(FlagMintSchedule, "", "Mint schedule of the denom as comma-separated amount@unlock_time entries")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [address] [role] [flags]",
+		Short: "Grants a role over a factory-created denom to an address. Must have admin authority to do so.",
+		Long: `Grants a role over a factory-created denom to an address. Must have admin authority to do so.
+The role is one of minter, burner, force_transferer, metadata_setter or hook_setter.
+Minters can be limited with --mint-allowance, granting the role again overwrites the allowance.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			var mintAllowance *math.Int
+			mintAllowanceStr, err := cmd.Flags().GetString(FlagMintAllowance)
+			if err != nil {
+				return err
+			}
+			if mintAllowanceStr != "" {
+				amount, ok := math.NewIntFromString(mintAllowanceStr)
+				if !ok {
+					return fmt.Errorf("invalid mint allowance: %s", mintAllowanceStr)
+				}
+				mintAllowance = &amount
+			}
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				role,
+				mintAllowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintAllowance, "", "Amount the minter is allowed to mint, unlimited if not set")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [address] [role] [flags]",
+		Short: "Revokes a role over a factory-created denom from an address. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role, err := types.ParseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				role,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetFrozenCmd broadcast MsgSetFrozen to freeze or unfreeze an address
+func NewSetFrozenCmd(use string, frozen bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [denom] [address] [flags]",
+		Short: fmt.Sprintf("Sets the frozen status of an address for a factory-created denom to %t. Must have admin authority to do so.", frozen),
+		Long: fmt.Sprintf(`Sets the frozen status of an address for a factory-created denom to %t. Must have admin authority to do so.
+Frozen addresses can neither send nor receive the denom, while the admin can still mint, burn and force transfer it.`, frozen),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFrozen(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				frozen,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetPausedCmd broadcast MsgSetPaused to pause or resume the transfers of a denom
+func NewSetPausedCmd(use string, paused bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [denom] [flags]",
+		Short: fmt.Sprintf("Sets the paused status of a factory-created denom to %t. Must have admin authority to do so.", paused),
+		Long: fmt.Sprintf(`Sets the paused status of a factory-created denom to %t. Must have admin authority to do so.
+Nobody can transfer a paused denom, while the admin can still mint, burn and force transfer it.`, paused),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPaused(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				paused,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMigrateTokenfactoryDenomCmd broadcast MsgMigrateTokenfactoryDenom
+func NewMigrateTokenfactoryDenomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-tokenfactory-denom [tokenfactory-denom] [subdenom] [flags]",
+		Short: "Migrates a tokenfactory denom to a new coinfactory denom. Must have admin authority over the tokenfactory denom to do so.",
+		Long: `Migrates a tokenfactory denom to a new coinfactory denom. Must have admin authority over the tokenfactory denom to do so.
+The bank metadata and the before send hook of the tokenfactory denom are copied to the new denom, and the holders
+can convert the tokens 1:1 with the convert command in the directions enabled with --to-coinfactory and --to-tokenfactory.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toCoinfactory, err := cmd.Flags().GetBool(FlagToCoinfactory)
+			if err != nil {
+				return err
+			}
+			toTokenfactory, err := cmd.Flags().GetBool(FlagToTokenfactory)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMigrateTokenfactoryDenom(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				toCoinfactory,
+				toTokenfactory,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagToCoinfactory, true, "Allow converting the tokenfactory denom to the coinfactory denom")
+	cmd.Flags().Bool(FlagToTokenfactory, false, "Allow converting the coinfactory denom back to the tokenfactory denom")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetConversionDirectionsCmd broadcast MsgSetConversionDirections
+func NewSetConversionDirectionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-conversion-directions [denom] [flags]",
+		Short: "Enables or disables the conversions of a migrated coinfactory denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			toCoinfactory, err := cmd.Flags().GetBool(FlagToCoinfactory)
+			if err != nil {
+				return err
+			}
+			toTokenfactory, err := cmd.Flags().GetBool(FlagToTokenfactory)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetConversionDirections(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				toCoinfactory,
+				toTokenfactory,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagToCoinfactory, false, "Allow converting the tokenfactory denom to the coinfactory denom")
+	cmd.Flags().Bool(FlagToTokenfactory, false, "Allow converting the coinfactory denom back to the tokenfactory denom")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewConvertCmd broadcast MsgConvert
+func NewConvertCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert [amount] [flags]",
+		Short: "Converts a migrated tokenfactory denom to its coinfactory denom or back 1:1",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgConvert(
+				clientCtx.GetFromAddress().String(),
+				amount,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMintSchedule parses comma-separated amount@unlock_time entries
+func parseMintSchedule(schedule string) ([]types.MintScheduleEntry, error) {
+	if schedule == "" {
+		return nil, nil
+	}
+
+	var entries []types.MintScheduleEntry
+	for _, entry := range strings.Split(schedule, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "@")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid mint schedule entry %q, expected amount@unlock_time", entry)
+		}
+		amount, ok := math.NewIntFromString(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid mint schedule amount: %s", parts[0])
+		}
+		unlockTime, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid mint schedule unlock time: %w", err)
+		}
+		entries = append(entries, types.MintScheduleEntry{UnlockTime: unlockTime, Amount: amount})
+	}
+	return entries, nil
+}
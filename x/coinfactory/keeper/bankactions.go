@@ -28,16 +28,45 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return status.Errorf(codes.Internal, "minting to IBC escrow accounts is forbidden")
 	}
 
+	err = k.checkMintLimits(ctx, amount)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+	return k.bankKeeper.SendCoinsFromModuleToAccount(withoutFreeze(ctx), types.ModuleName,
 		mintToAcc,
 		sdk.NewCoins(amount))
 }
 
+// checkMintLimits ensures that minting the amount keeps the supply within the max supply and the mint schedule of the denom.
+// The supply of a migrated tokenfactory denom counts towards the limits of its successor, so the tokens minted here
+// can't be converted back to exceed the limits of the tokenfactory denom.
+func (k Keeper) checkMintLimits(ctx sdk.Context, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	newSupply := k.getLinkedSupply(ctx, amount.Denom).Add(amount.Amount)
+	if metadata.MaxSupply != nil && newSupply.GT(*metadata.MaxSupply) {
+		return types.ErrMaxSupplyExceeded.Wrapf("max supply: %s, supply after minting: %s", metadata.MaxSupply, newSupply)
+	}
+
+	if len(metadata.MintSchedule) > 0 {
+		unlocked := metadata.UnlockedSupply(ctx.BlockTime())
+		if newSupply.GT(unlocked) {
+			return types.ErrMintScheduleExceeded.Wrapf("unlocked supply: %s, supply after minting: %s", unlocked, newSupply)
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
 	// verify that denom is an x/coinfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -58,7 +87,7 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return status.Errorf(codes.Internal, "burning from IBC escrow accounts is forbidden")
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
+	err = k.bankKeeper.SendCoinsFromAccountToModule(withoutFreeze(ctx),
 		burnFromAcc,
 		types.ModuleName,
 		sdk.NewCoins(amount))
@@ -102,7 +131,8 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr, toAddr
 		return status.Errorf(codes.Internal, "force transfer to IBC escrow accounts is forbidden")
 	}
 
-	return k.bankKeeper.SendCoins(ctx, transferFromAcc, transferToAcc, sdk.NewCoins(amount))
+	// the admin can move the tokens of frozen addresses and while the denom is paused
+	return k.bankKeeper.SendCoins(withoutFreeze(ctx), transferFromAcc, transferToAcc, sdk.NewCoins(amount))
 }
 
 func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
@@ -94,8 +94,12 @@ func (h Hooks) TrackBeforeSend(ctx context.Context, from, to sdk.AccAddress, amo
 	_ = h.k.callBeforeSendListener(ctx, from, to, amount, false)
 }
 
-// BlockBeforeSend calls the before send listener contract and returns any errors
+// BlockBeforeSend checks the freeze list and the pause flag of the denoms, then calls the before send listener
+// contract and returns any errors
 func (h Hooks) BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := h.k.assertSendAllowed(ctx, from, to, amount); err != nil {
+		return err
+	}
 	return h.k.callBeforeSendListener(ctx, from, to, amount, true)
 }
 
@@ -113,6 +117,11 @@ func (k Keeper) callBeforeSendListener(ctx context.Context, from, to sdk.AccAddr
 	}()
 
 	for _, coin := range amount {
+		// only coinfactory denoms can have a hook
+		if !types.HasModuleDenomPrefix(coin.Denom) {
+			continue
+		}
+
 		contractAddr := k.GetBeforeSendHook(ctx, coin.Denom)
 		if contractAddr != "" {
 			cwAddr, err := sdk.AccAddressFromBech32(contractAddr)
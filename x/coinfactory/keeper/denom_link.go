@@ -0,0 +1,228 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
+)
+
+// setDenomLink links the tokenfactory denom to its coinfactory successor
+func (k Keeper) setDenomLink(ctx sdk.Context, link types.DenomLink) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDenomLinkKey(link.TokenfactoryDenom), k.cdc.MustMarshal(&link))
+	k.GetDenomPrefixStore(ctx, link.CoinfactoryDenom).Set([]byte(types.TokenfactoryDenomKey), []byte(link.TokenfactoryDenom))
+}
+
+// GetDenomLink returns the link of the denom, which is either the migrated tokenfactory denom or its
+// coinfactory successor
+func (k Keeper) GetDenomLink(ctx context.Context, denom string) (types.DenomLink, bool) {
+	tokenfactoryDenom := denom
+	if bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.TokenfactoryDenomKey)); bz != nil {
+		tokenfactoryDenom = string(bz)
+	}
+
+	bz := sdk.UnwrapSDKContext(ctx).KVStore(k.storeKey).Get(types.GetDenomLinkKey(tokenfactoryDenom))
+	if bz == nil {
+		return types.DenomLink{}, false
+	}
+
+	var link types.DenomLink
+	k.cdc.MustUnmarshal(bz, &link)
+	return link, true
+}
+
+// IsMigratedTokenfactoryDenom returns true if the tokenfactory denom is migrated to coinfactory. Such denoms
+// can only be minted by the conversions, so that their supply stays within the mint limits of the successors.
+func (k Keeper) IsMigratedTokenfactoryDenom(ctx context.Context, denom string) bool {
+	link, found := k.GetDenomLink(ctx, denom)
+	return found && link.TokenfactoryDenom == denom
+}
+
+// GetAllDenomLinks returns the links of all the migrated tokenfactory denoms
+func (k Keeper) GetAllDenomLinks(ctx sdk.Context) []types.DenomLink {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomLinkKey).Iterator(nil, nil)
+	defer iterator.Close() //nolint:errcheck
+
+	links := []types.DenomLink{}
+	for ; iterator.Valid(); iterator.Next() {
+		var link types.DenomLink
+		k.cdc.MustUnmarshal(iterator.Value(), &link)
+		links = append(links, link)
+	}
+	return links
+}
+
+// migrateTokenfactoryDenom creates the coinfactory successor of the tokenfactory denom administered by the sender,
+// copies the bank metadata, the mint limits and the before send hook of the tokenfactory denom to it and links both denoms
+func (k Keeper) migrateTokenfactoryDenom(ctx sdk.Context, sender, tokenfactoryDenom, subdenom string, toCoinfactory, toTokenfactory bool) (string, error) {
+	tfMetadata, err := k.tfKeeper.GetAuthorityMetadata(ctx, tokenfactoryDenom)
+	if err != nil {
+		return "", err
+	}
+
+	if sender != tfMetadata.GetAdmin() {
+		return "", types.ErrUnauthorized.Wrapf("%s is not the admin of %s", sender, tokenfactoryDenom)
+	}
+
+	if _, found := k.GetDenomLink(ctx, tokenfactoryDenom); found {
+		return "", types.ErrInvalidDenomLink.Wrapf("%s is already migrated", tokenfactoryDenom)
+	}
+
+	denom, err := k.CreateDenom(ctx, sender, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	if bankMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, tokenfactoryDenom); found {
+		metadata := migratedMetadata(bankMetadata, denom)
+		if err := metadata.Validate(); err != nil {
+			return "", types.ErrInvalidDenomLink.Wrapf("invalid metadata of the migrated denom: %s", err)
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, metadata)
+	}
+
+	// the limits of the successor apply to the combined supply of both denoms, see checkMintLimits
+	if tfMetadata.MaxSupply != nil || len(tfMetadata.MintSchedule) > 0 {
+		metadata, err := k.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return "", err
+		}
+		metadata.MaxSupply = tfMetadata.MaxSupply
+		for _, entry := range tfMetadata.MintSchedule {
+			metadata.MintSchedule = append(metadata.MintSchedule, types.MintScheduleEntry{
+				UnlockTime: entry.UnlockTime,
+				Amount:     entry.Amount,
+			})
+		}
+		if err := k.setAuthorityMetadata(ctx, denom, metadata); err != nil {
+			return "", err
+		}
+	}
+
+	// the hook must be whitelisted for coinfactory as well
+	if hook := k.tfKeeper.GetBeforeSendHook(ctx, tokenfactoryDenom); hook != "" {
+		hookAddr, err := sdk.AccAddressFromBech32(hook)
+		if err != nil {
+			return "", err
+		}
+		if err := k.AssertIsHookWhitelisted(ctx, denom, hookAddr); err != nil {
+			return "", err
+		}
+		if err := k.setBeforeSendHook(ctx, denom, hook); err != nil {
+			return "", err
+		}
+	}
+
+	k.setDenomLink(ctx, types.DenomLink{
+		TokenfactoryDenom:     tokenfactoryDenom,
+		CoinfactoryDenom:      denom,
+		ToCoinfactoryEnabled:  toCoinfactory,
+		ToTokenfactoryEnabled: toTokenfactory,
+	})
+
+	return denom, nil
+}
+
+// migratedMetadata returns the bank metadata of the tokenfactory denom with its base unit renamed to the coinfactory denom
+func migratedMetadata(metadata banktypes.Metadata, denom string) banktypes.Metadata {
+	tokenfactoryDenom := metadata.Base
+
+	units := make([]*banktypes.DenomUnit, 0, len(metadata.DenomUnits))
+	for _, unit := range metadata.DenomUnits {
+		migratedUnit := *unit
+		if migratedUnit.Denom == tokenfactoryDenom {
+			migratedUnit.Denom = denom
+		}
+		units = append(units, &migratedUnit)
+	}
+	metadata.DenomUnits = units
+	metadata.Base = denom
+
+	if metadata.Display == tokenfactoryDenom {
+		metadata.Display = denom
+	}
+	if metadata.Name == tokenfactoryDenom {
+		metadata.Name = denom
+	}
+	if metadata.Symbol == tokenfactoryDenom {
+		metadata.Symbol = denom
+	}
+
+	return metadata
+}
+
+// getLinkedSupply returns the supply of the denom together with the supply of the tokenfactory denom it succeeds
+func (k Keeper) getLinkedSupply(ctx sdk.Context, denom string) math.Int {
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if link, found := k.GetDenomLink(ctx, denom); found && link.CoinfactoryDenom == denom {
+		supply = supply.Add(k.bankKeeper.GetSupply(ctx, link.TokenfactoryDenom).Amount)
+	}
+	return supply
+}
+
+// setConversionDirections enables or disables the conversions of the migrated denom
+func (k Keeper) setConversionDirections(ctx sdk.Context, denom string, toCoinfactory, toTokenfactory bool) error {
+	link, found := k.GetDenomLink(ctx, denom)
+	if !found || link.CoinfactoryDenom != denom {
+		return types.ErrDenomNotLinked.Wrapf("denom: %s", denom)
+	}
+
+	link.ToCoinfactoryEnabled = toCoinfactory
+	link.ToTokenfactoryEnabled = toTokenfactory
+	k.setDenomLink(ctx, link)
+
+	return nil
+}
+
+// convert burns the amount of the linked denom from the sender and mints the same amount of the other denom of the
+// link to it. It returns the minted coin.
+func (k Keeper) convert(ctx sdk.Context, sender string, amount sdk.Coin) (sdk.Coin, error) {
+	link, found := k.GetDenomLink(ctx, amount.Denom)
+	if !found {
+		return sdk.Coin{}, types.ErrDenomNotLinked.Wrapf("denom: %s", amount.Denom)
+	}
+
+	senderAcc, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the frozen holders can't convert in either direction, nor can anybody while the coinfactory denom is paused
+	err = k.assertSendAllowed(ctx, senderAcc, senderAcc, sdk.NewCoins(sdk.NewCoin(link.CoinfactoryDenom, amount.Amount)))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if amount.Denom == link.TokenfactoryDenom {
+		if !link.ToCoinfactoryEnabled {
+			return sdk.Coin{}, types.ErrConversionDisabled.Wrapf("conversion of %s to %s is disabled", link.TokenfactoryDenom, link.CoinfactoryDenom)
+		}
+
+		converted := sdk.NewCoin(link.CoinfactoryDenom, amount.Amount)
+		if err := k.tfKeeper.BurnFrom(ctx, amount, sender); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.mintTo(ctx, converted, sender); err != nil {
+			return sdk.Coin{}, err
+		}
+		return converted, nil
+	}
+
+	if !link.ToTokenfactoryEnabled {
+		return sdk.Coin{}, types.ErrConversionDisabled.Wrapf("conversion of %s to %s is disabled", link.CoinfactoryDenom, link.TokenfactoryDenom)
+	}
+
+	converted := sdk.NewCoin(link.TokenfactoryDenom, amount.Amount)
+	if err := k.burnFrom(ctx, amount, sender); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.tfKeeper.MintTo(ctx, converted, sender); err != nil {
+		return sdk.Coin{}, err
+	}
+	return converted, nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
+	tokenfactorykeeper "github.com/neutron-org/neutron/v11/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/neutron-org/neutron/v11/x/tokenfactory/types"
+)
+
+// createTokenfactoryDenom creates a tokenfactory denom administered by the first test account and mints it to the holder
+func (suite *KeeperTestSuite) createTokenfactoryDenom(ctx sdk.Context, holder sdk.AccAddress, amount int64) string {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	err := app.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.NewParams(nil, 0, "", nil))
+	suite.Require().NoError(err)
+
+	tfMsgServer := tokenfactorykeeper.NewMsgServerImpl(*app.TokenFactoryKeeper)
+	res, err := tfMsgServer.CreateDenom(ctx, tokenfactorytypes.NewMsgCreateDenom(suite.TestAccs[0].String(), "legacy"))
+	suite.Require().NoError(err)
+	tfDenom := res.GetNewTokenDenom()
+
+	_, err = tfMsgServer.Mint(ctx, tokenfactorytypes.NewMsgMintTo(suite.TestAccs[0].String(), sdk.NewInt64Coin(tfDenom, amount), holder.String()))
+	suite.Require().NoError(err)
+
+	_, err = tfMsgServer.SetDenomMetadata(ctx, tokenfactorytypes.NewMsgSetDenomMetadata(suite.TestAccs[0].String(), banktypes.Metadata{
+		Description: "legacy token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: tfDenom, Exponent: 0},
+			{Denom: "legacy", Exponent: 6},
+		},
+		Base:    tfDenom,
+		Display: "legacy",
+		Name:    "Legacy",
+		Symbol:  "LGC",
+	}))
+	suite.Require().NoError(err)
+
+	return tfDenom
+}
+
+func (suite *KeeperTestSuite) TestMigrateTokenfactoryDenom() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	suite.TopUpWallet(ctx, suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress(), admin)
+	tfDenom := suite.createTokenfactoryDenom(ctx, holder, 100)
+
+	// only the admin of the tokenfactory denom can migrate it
+	_, err := suite.msgServer.MigrateTokenfactoryDenom(ctx, types.NewMsgMigrateTokenfactoryDenom(holder.String(), tfDenom, "legacy", true, false))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	res, err := suite.msgServer.MigrateTokenfactoryDenom(ctx, types.NewMsgMigrateTokenfactoryDenom(admin.String(), tfDenom, "legacy", true, false))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+
+	_, err = suite.msgServer.MigrateTokenfactoryDenom(ctx, types.NewMsgMigrateTokenfactoryDenom(admin.String(), tfDenom, "legacy2", true, false))
+	suite.Require().ErrorIs(err, types.ErrInvalidDenomLink)
+
+	// the metadata is copied with the base unit renamed
+	metadata, found := app.BankKeeper.GetDenomMetaData(ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(denom, metadata.Base)
+	suite.Require().Equal(denom, metadata.DenomUnits[0].Denom)
+	suite.Require().Equal("legacy", metadata.Display)
+	suite.Require().Equal("LGC", metadata.Symbol)
+
+	// the link can be discovered by either denom
+	expectedLink := types.DenomLink{TokenfactoryDenom: tfDenom, CoinfactoryDenom: denom, ToCoinfactoryEnabled: true}
+	for _, d := range []string{tfDenom, denom} {
+		linkRes, err := suite.queryClient.DenomLink(ctx, &types.QueryDenomLinkRequest{Denom: d})
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedLink, linkRes.Link)
+	}
+	suite.Require().Equal([]types.DenomLink{expectedLink}, app.CoinfactoryKeeper.ExportGenesis(ctx).DenomLinks)
+}
+
+func (suite *KeeperTestSuite) TestConvert() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	suite.TopUpWallet(ctx, suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress(), admin)
+	tfDenom := suite.createTokenfactoryDenom(ctx, holder, 100)
+
+	res, err := suite.msgServer.MigrateTokenfactoryDenom(ctx, types.NewMsgMigrateTokenfactoryDenom(admin.String(), tfDenom, "legacy", true, false))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+
+	convertRes, err := suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(tfDenom, 60)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 60), convertRes.Converted)
+	suite.Require().Equal(int64(40), app.BankKeeper.GetBalance(ctx, holder, tfDenom).Amount.Int64())
+	suite.Require().Equal(int64(60), app.BankKeeper.GetBalance(ctx, holder, denom).Amount.Int64())
+	suite.Require().Equal(int64(40), app.BankKeeper.GetSupply(ctx, tfDenom).Amount.Int64())
+
+	// the conversion back is disabled
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, types.ErrConversionDisabled)
+
+	// only the admin can change the directions
+	_, err = suite.msgServer.SetConversionDirections(ctx, types.NewMsgSetConversionDirections(holder.String(), denom, false, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetConversionDirections(ctx, types.NewMsgSetConversionDirections(admin.String(), denom, false, true))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(tfDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrConversionDisabled)
+
+	convertRes, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(tfDenom, 10), convertRes.Converted)
+	suite.Require().Equal(int64(50), app.BankKeeper.GetBalance(ctx, holder, tfDenom).Amount.Int64())
+	suite.Require().Equal(int64(50), app.BankKeeper.GetBalance(ctx, holder, denom).Amount.Int64())
+
+	// the frozen holders can't convert
+	_, err = suite.msgServer.SetFrozen(ctx, types.NewMsgSetFrozen(admin.String(), denom, holder.String(), true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// the denoms which aren't linked can't be converted
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin("untrn", 10)))
+	suite.Require().ErrorIs(err, types.ErrDenomNotLinked)
+}
+
+func (suite *KeeperTestSuite) TestConvertWithinMintLimits() {
+	suite.Setup()
+	ctx := suite.ChainA.GetContext()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	suite.TopUpWallet(ctx, suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress(), admin)
+	tfDenom := suite.createTokenfactoryDenom(ctx, holder, 100)
+
+	tfMsgServer := tokenfactorykeeper.NewMsgServerImpl(*app.TokenFactoryKeeper)
+	maxSupply := math.NewInt(150)
+	_, err := tfMsgServer.SetMintLimits(ctx, tokenfactorytypes.NewMsgSetMintLimits(admin.String(), tfDenom, &maxSupply, nil))
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.MigrateTokenfactoryDenom(ctx, types.NewMsgMigrateTokenfactoryDenom(admin.String(), tfDenom, "legacy", true, true))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+
+	// the limits are copied to the successor
+	metadata, err := app.CoinfactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(maxSupply, *metadata.MaxSupply)
+
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(tfDenom, 100)))
+	suite.Require().NoError(err)
+
+	// the tokenfactory admin can't mint the migrated denom once its supply has been lowered by the conversion
+	_, err = tfMsgServer.Mint(ctx, tokenfactorytypes.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(tfDenom, 30), holder.String()))
+	suite.Require().ErrorIs(err, tokenfactorytypes.ErrDenomMigrated)
+	suite.Require().True(app.BankKeeper.GetSupply(ctx, tfDenom).Amount.IsZero())
+
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(denom, 51), admin.String()))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(denom, 50), admin.String()))
+	suite.Require().NoError(err)
+
+	// the minted tokens can't be converted back past the max supply of the tokenfactory denom
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(denom, 1), admin.String()))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(holder.String(), sdk.NewInt64Coin(denom, 100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Convert(ctx, types.NewMsgConvert(admin.String(), sdk.NewInt64Coin(denom, 50)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(maxSupply, app.BankKeeper.GetSupply(ctx, tfDenom).Amount)
+	suite.Require().True(app.BankKeeper.GetSupply(ctx, denom).Amount.IsZero())
+
+	// the max supply of the successor can't be lowered below the combined supply
+	belowSupply := math.NewInt(149)
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin.String(), denom, &belowSupply, nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
+)
+
+// bypassFreezeKey is the context key set by the admin actions (mint, burn and force transfer) to move
+// the tokens regardless of the freeze list and the pause flag of the denom
+type bypassFreezeKey struct{}
+
+// withoutFreeze returns the context in which the sends aren't checked against the freeze list and the pause flag
+func withoutFreeze(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(bypassFreezeKey{}, true)
+}
+
+// setFrozen freezes or unfreezes the address for the denom
+func (k Keeper) setFrozen(ctx sdk.Context, denom, address string, frozen bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if frozen {
+		store.Set(types.GetFrozenAddressKey(address), []byte(address))
+	} else {
+		store.Delete(types.GetFrozenAddressKey(address))
+	}
+}
+
+// IsFrozen checks whether the address is frozen for the denom
+func (k Keeper) IsFrozen(ctx context.Context, denom, address string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has(types.GetFrozenAddressKey(address))
+}
+
+// GetFrozenAddressesStore returns the store of the addresses frozen for the denom
+func (k Keeper) GetFrozenAddressesStore(ctx context.Context, denom string) storetypes.KVStore {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAddressesPrefix())
+}
+
+// GetFrozenAddresses returns all the addresses frozen for the denom
+func (k Keeper) GetFrozenAddresses(ctx context.Context, denom string) []string {
+	iterator := k.GetFrozenAddressesStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close() //nolint:errcheck
+
+	addresses := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Value()))
+	}
+	return addresses
+}
+
+// setPaused pauses or resumes the transfers of the denom
+func (k Keeper) setPaused(ctx sdk.Context, denom string, paused bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if paused {
+		store.Set([]byte(types.DenomPausedKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomPausedKey))
+	}
+}
+
+// IsPaused checks whether the transfers of the denom are paused
+func (k Keeper) IsPaused(ctx context.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedKey))
+}
+
+// assertSendAllowed ensures that none of the coins is paused or frozen for the sender or the recipient,
+// unless the send is made by an admin action. The coins of other modules are skipped without any store
+// reads, so that the plain sends don't pay for the checks.
+func (k Keeper) assertSendAllowed(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if bypass, ok := ctx.Value(bypassFreezeKey{}).(bool); ok && bypass {
+		return nil
+	}
+
+	for _, coin := range amount {
+		if !types.HasModuleDenomPrefix(coin.Denom) {
+			continue
+		}
+		if k.IsPaused(ctx, coin.Denom) {
+			return types.ErrDenomPaused.Wrapf("denom: %s", coin.Denom)
+		}
+		if k.IsFrozen(ctx, coin.Denom, from.String()) {
+			return types.ErrAddressFrozen.Wrapf("sender %s is frozen for %s", from, coin.Denom)
+		}
+		if k.IsFrozen(ctx, coin.Denom, to.String()) {
+			return types.ErrAddressFrozen.Wrapf("recipient %s is frozen for %s", to, coin.Denom)
+		}
+	}
+
+	return nil
+}
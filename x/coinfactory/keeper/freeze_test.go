@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestFreeze() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	other := suite.TestAccs[2]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), holder.String()))
+	suite.Require().NoError(err)
+
+	// only the admin can freeze addresses
+	_, err = suite.msgServer.SetFrozen(ctx, types.NewMsgSetFrozen(holder.String(), suite.defaultDenom, other.String(), true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetFrozen(ctx, types.NewMsgSetFrozen(admin.String(), suite.defaultDenom, holder.String(), true))
+	suite.Require().NoError(err)
+	suite.Require().True(app.CoinfactoryKeeper.IsFrozen(ctx, suite.defaultDenom, holder.String()))
+
+	// the frozen address can neither send nor receive the denom
+	err = app.BankKeeper.SendCoins(ctx, holder, other, coins)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), other.String()))
+	suite.Require().NoError(err)
+	err = app.BankKeeper.SendCoins(ctx, other, holder, coins)
+	suite.Require().ErrorIs(err, types.ErrAddressFrozen)
+
+	// the admin actions aren't blocked
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin.String(), coins[0], holder.String(), admin.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(admin.String(), coins[0], holder.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), coins[0], holder.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(90), app.BankKeeper.GetBalance(ctx, holder, suite.defaultDenom).Amount.Int64())
+
+	creator, subdenom, err := types.DeconstructDenom(suite.defaultDenom)
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.FrozenAddresses(ctx, &types.QueryFrozenAddressesRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{holder.String()}, res.Addresses)
+	suite.Require().False(res.Paused)
+
+	genesis := app.CoinfactoryKeeper.ExportGenesis(ctx)
+	suite.Require().Equal([]string{holder.String()}, genesis.FactoryDenoms[0].FrozenAddresses)
+
+	_, err = suite.msgServer.SetFrozen(ctx, types.NewMsgSetFrozen(admin.String(), suite.defaultDenom, holder.String(), false))
+	suite.Require().NoError(err)
+	err = app.BankKeeper.SendCoins(ctx, holder, other, coins)
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.FrozenAddresses(ctx, &types.QueryFrozenAddressesRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Addresses)
+}
+
+func (suite *KeeperTestSuite) TestPause() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+	other := suite.TestAccs[2]
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMintTo(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 100), holder.String()))
+	suite.Require().NoError(err)
+
+	// only the admin can pause the denom
+	_, err = suite.msgServer.SetPaused(ctx, types.NewMsgSetPaused(holder.String(), suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.SetPaused(ctx, types.NewMsgSetPaused(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+
+	err = app.BankKeeper.SendCoins(ctx, holder, other, coins)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// the admin actions aren't blocked
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(admin.String(), coins[0], holder.String(), other.String()))
+	suite.Require().NoError(err)
+
+	creator, subdenom, err := types.DeconstructDenom(suite.defaultDenom)
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.FrozenAddresses(ctx, &types.QueryFrozenAddressesRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Paused)
+
+	_, err = suite.msgServer.SetPaused(ctx, types.NewMsgSetPaused(admin.String(), suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	err = app.BankKeeper.SendCoins(ctx, holder, other, coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestPlainSendGas() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	admin := suite.TestAccs[0]
+	holder := suite.TestAccs[1]
+
+	_, err := suite.msgServer.SetPaused(ctx, types.NewMsgSetPaused(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetFrozen(ctx, types.NewMsgSetFrozen(admin.String(), suite.defaultDenom, holder.String(), true))
+	suite.Require().NoError(err)
+
+	// the hooks don't read the store for the sends of non-coinfactory denoms
+	hooks := app.CoinfactoryKeeper.Hooks()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("untrn", 10))
+	gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	suite.Require().NoError(hooks.BlockBeforeSend(gasCtx, holder, admin, coins))
+	hooks.TrackBeforeSend(gasCtx, holder, admin, coins)
+	suite.Require().Zero(gasCtx.GasMeter().GasConsumed())
+
+	// while the sends of coinfactory denoms are still checked
+	coins = sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	suite.Require().ErrorIs(hooks.BlockBeforeSend(gasCtx, holder, admin, coins), types.ErrDenomPaused)
+	suite.Require().NotZero(gasCtx.GasMeter().GasConsumed())
+}
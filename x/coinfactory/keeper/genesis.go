@@ -44,6 +44,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 				panic(err)
 			}
 		}
+
+		for _, role := range genDenom.GetRoles() {
+			k.setDenomRole(ctx, genDenom.GetDenom(), role)
+		}
+
+		k.setPaused(ctx, genDenom.GetDenom(), genDenom.Paused)
+		for _, address := range genDenom.GetFrozenAddresses() {
+			k.setFrozen(ctx, genDenom.GetDenom(), address, true)
+		}
+	}
+
+	for _, link := range genState.GetDenomLinks() {
+		k.setDenomLink(ctx, link)
 	}
 }
 
@@ -66,11 +79,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			Denom:               denom,
 			AuthorityMetadata:   authorityMetadata,
 			HookContractAddress: contractHook,
+			Roles:               k.GetDenomRoles(ctx, denom),
+			Paused:              k.IsPaused(ctx, denom),
+			FrozenAddresses:     k.GetFrozenAddresses(ctx, denom),
 		})
 	}
 
 	return &types.GenesisState{
 		FactoryDenoms: genDenoms,
 		Params:        k.GetParams(ctx),
+		DenomLinks:    k.GetAllDenomLinks(ctx),
 	}
 }
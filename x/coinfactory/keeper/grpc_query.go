@@ -4,6 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
 )
@@ -64,3 +67,76 @@ func (k Keeper) FullDenom(_ context.Context, req *types.QueryFullDenomRequest) (
 
 	return &types.QueryFullDenomResponse{FullDenom: fullDenom}, nil
 }
+
+func (k Keeper) DenomMintLimits(ctx context.Context, req *types.QueryDenomMintLimitsRequest) (*types.QueryDenomMintLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom, err := types.GetTokenDenom(req.GetCreator(), req.GetSubdenom())
+	if err != nil {
+		return nil, err
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := k.bankKeeper.GetSupply(sdkCtx, denom)
+
+	return &types.QueryDenomMintLimitsResponse{
+		MaxSupply:    authorityMetadata.MaxSupply,
+		MintSchedule: authorityMetadata.MintSchedule,
+		Supply:       supply,
+		Mintable:     authorityMetadata.MintableAmount(supply.Amount, sdkCtx.BlockTime()),
+	}, nil
+}
+
+func (k Keeper) DenomRoles(ctx context.Context, req *types.QueryDenomRolesRequest) (*types.QueryDenomRolesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom, err := types.GetTokenDenom(req.GetCreator(), req.GetSubdenom())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomRolesResponse{Roles: k.GetDenomRoles(sdkCtx, denom)}, nil
+}
+
+func (k Keeper) FrozenAddresses(ctx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	denom, err := types.GetTokenDenom(req.GetCreator(), req.GetSubdenom())
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	pageRes, err := query.Paginate(k.GetFrozenAddressesStore(ctx, denom), req.Pagination, func(_, value []byte) error {
+		addresses = append(addresses, string(value))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAddressesResponse{
+		Addresses:  addresses,
+		Paused:     k.IsPaused(ctx, denom),
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) DenomLink(ctx context.Context, req *types.QueryDenomLinkRequest) (*types.QueryDenomLinkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	link, found := k.GetDenomLink(ctx, req.GetDenom())
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrDenomNotLinked.Wrapf("denom: %s", req.GetDenom()).Error())
+	}
+
+	return &types.QueryDenomLinkResponse{Link: link}, nil
+}
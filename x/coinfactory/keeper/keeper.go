@@ -22,6 +22,7 @@ type (
 		accountKeeper  types.AccountKeeper
 		bankKeeper     types.BankKeeper
 		contractKeeper types.ContractKeeper
+		tfKeeper       types.TokenfactoryKeeper
 		authority      string
 	}
 )
@@ -34,6 +35,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	contractKeeper types.ContractKeeper,
+	tfKeeper types.TokenfactoryKeeper,
 	authority string,
 ) Keeper {
 	sortedKnownModules := make([]string, 0, len(maccPerms))
@@ -49,6 +51,7 @@ func NewKeeper(
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
 		contractKeeper: contractKeeper,
+		tfKeeper:       tfKeeper,
 		authority:      authority,
 	}
 }
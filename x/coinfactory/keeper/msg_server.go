@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -60,13 +61,16 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Amount.Denom)
 	}
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	isAdmin, err := server.assertAdminOrRole(ctx, msg.Amount.GetDenom(), msg.Sender, types.ROLE_MINTER)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
+	// the admin mints without limits, minters spend their mint allowance
+	if !isAdmin {
+		if err := server.spendMintAllowance(ctx, msg.Sender, msg.Amount); err != nil {
+			return nil, err
+		}
 	}
 
 	if msg.MintToAddress == "" {
@@ -96,15 +100,11 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	_, err := server.assertAdminOrRole(ctx, msg.Amount.GetDenom(), msg.Sender, types.ROLE_BURNER)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
 	if msg.BurnFromAddress == "" {
 		msg.BurnFromAddress = msg.Sender
 	}
@@ -138,15 +138,11 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Amount.GetDenom())
+	_, err := server.assertAdminOrRole(ctx, msg.Amount.GetDenom(), msg.Sender, types.ROLE_FORCE_TRANSFERER)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
 	err = server.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
 	if err != nil {
 		return nil, err
@@ -208,15 +204,11 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Metadata.Base)
+	_, err = server.assertAdminOrRole(ctx, msg.Metadata.Base, msg.Sender, types.ROLE_METADATA_SETTER)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
 	server.bankKeeper.SetDenomMetaData(ctx, msg.Metadata)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -237,15 +229,11 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	_, err := server.assertAdminOrRole(ctx, msg.Denom, msg.Sender, types.ROLE_HOOK_SETTER)
 	if err != nil {
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
-		return nil, types.ErrUnauthorized
-	}
-
 	// If we are not removing a hook make sure it has been already whitelisted
 	if msg.ContractAddr != "" {
 		// msg.ContractAddr has already been validated
@@ -271,6 +259,254 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
 
+func (server msgServer) SetMintLimits(goCtx context.Context, msg *types.MsgSetMintLimits) (*types.MsgSetMintLimitsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetMintLimits")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setMintLimits(ctx, msg.Denom, msg.MaxSupply, msg.MintSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeDenom, msg.Denom)}
+	if msg.MaxSupply != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()))
+	}
+	if len(msg.MintSchedule) > 0 {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMintSchedule, types.FormatMintSchedule(msg.MintSchedule)))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.TypeMsgSetMintLimits, attributes...),
+	})
+
+	return &types.MsgSetMintLimitsResponse{}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgGrantRole")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.grantRole(ctx, msg.Denom, msg.Address, msg.Role, msg.MintAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+	}
+	if msg.MintAllowance != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMintAllowance, msg.MintAllowance.String()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.TypeMsgGrantRole, attributes...),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRevokeRole")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.revokeRole(ctx, msg.Denom, msg.Address, msg.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeRole, msg.Role.String()),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetFrozen(goCtx context.Context, msg *types.MsgSetFrozen) (*types.MsgSetFrozenResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetFrozen")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	server.setFrozen(ctx, msg.Denom, msg.Address, msg.Frozen)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetFrozen,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetFrozenResponse{}, nil
+}
+
+func (server msgServer) SetPaused(goCtx context.Context, msg *types.MsgSetPaused) (*types.MsgSetPausedResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetPaused")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	server.setPaused(ctx, msg.Denom, msg.Paused)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetPausedResponse{}, nil
+}
+
+func (server msgServer) MigrateTokenfactoryDenom(goCtx context.Context, msg *types.MsgMigrateTokenfactoryDenom) (*types.MsgMigrateTokenfactoryDenomResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgMigrateTokenfactoryDenom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	denom, err := server.migrateTokenfactoryDenom(ctx, msg.Sender, msg.TokenfactoryDenom, msg.Subdenom, msg.ToCoinfactoryEnabled, msg.ToTokenfactoryEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgMigrateDenom,
+			sdk.NewAttribute(types.AttributeCreator, msg.Sender),
+			sdk.NewAttribute(types.AttributeTokenfactoryDenom, msg.TokenfactoryDenom),
+			sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+			sdk.NewAttribute(types.AttributeToCoinfactoryEnabled, strconv.FormatBool(msg.ToCoinfactoryEnabled)),
+			sdk.NewAttribute(types.AttributeToTokenfactoryEnabled, strconv.FormatBool(msg.ToTokenfactoryEnabled)),
+		),
+	})
+
+	return &types.MsgMigrateTokenfactoryDenomResponse{
+		NewTokenDenom: denom,
+	}, nil
+}
+
+func (server msgServer) SetConversionDirections(goCtx context.Context, msg *types.MsgSetConversionDirections) (*types.MsgSetConversionDirectionsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetConversionDirections")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setConversionDirections(ctx, msg.Denom, msg.ToCoinfactoryEnabled, msg.ToTokenfactoryEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetConversion,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeToCoinfactoryEnabled, strconv.FormatBool(msg.ToCoinfactoryEnabled)),
+			sdk.NewAttribute(types.AttributeToTokenfactoryEnabled, strconv.FormatBool(msg.ToTokenfactoryEnabled)),
+		),
+	})
+
+	return &types.MsgSetConversionDirectionsResponse{}, nil
+}
+
+func (server msgServer) Convert(goCtx context.Context, msg *types.MsgConvert) (*types.MsgConvertResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgConvert")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	converted, err := server.convert(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgConvert,
+			sdk.NewAttribute(types.AttributeAddress, msg.Sender),
+			sdk.NewAttribute(types.AttributeAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeConvertedAmount, converted.String()),
+		),
+	})
+
+	return &types.MsgConvertResponse{Converted: converted}, nil
+}
+
 // UpdateParams updates the module parameters
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -840,6 +841,173 @@ func TestMsgSetBeforeSendHookValidate(t *testing.T) {
 	}
 }
 
+func TestMsgSetMintLimitsValidate(t *testing.T) {
+	k, ctx := testkeeper.CoinFactoryKeeper(t, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	maxSupply := math.NewInt(1000)
+	zero := math.ZeroInt()
+	unlockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSetMintLimits
+		expectedErr error
+	}{
+		{
+			"empty sender",
+			types.MsgSetMintLimits{
+				Sender:    "",
+				Denom:     denom,
+				MaxSupply: &maxSupply,
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"incorrect denom prefix",
+			types.MsgSetMintLimits{
+				Sender:    testutil.TestOwnerAddress,
+				Denom:     "bitcoin.coinfactory.sun",
+				MaxSupply: &maxSupply,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"no limits",
+			types.MsgSetMintLimits{
+				Sender: testutil.TestOwnerAddress,
+				Denom:  denom,
+			},
+			types.ErrInvalidMintLimits,
+		},
+		{
+			"zero max supply",
+			types.MsgSetMintLimits{
+				Sender:    testutil.TestOwnerAddress,
+				Denom:     denom,
+				MaxSupply: &zero,
+			},
+			types.ErrInvalidMintLimits,
+		},
+		{
+			"zero mint schedule amount",
+			types.MsgSetMintLimits{
+				Sender: testutil.TestOwnerAddress,
+				Denom:  denom,
+				MintSchedule: []types.MintScheduleEntry{
+					{UnlockTime: unlockTime, Amount: zero},
+				},
+			},
+			types.ErrInvalidMintLimits,
+		},
+		{
+			"unordered mint schedule",
+			types.MsgSetMintLimits{
+				Sender: testutil.TestOwnerAddress,
+				Denom:  denom,
+				MintSchedule: []types.MintScheduleEntry{
+					{UnlockTime: unlockTime, Amount: maxSupply},
+					{UnlockTime: unlockTime, Amount: maxSupply},
+				},
+			},
+			types.ErrInvalidMintLimits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.SetMintLimits(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
+func TestMsgGrantRoleValidate(t *testing.T) {
+	k, ctx := testkeeper.CoinFactoryKeeper(t, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	allowance := math.NewInt(1000)
+	negative := math.NewInt(-1)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgGrantRole
+		expectedErr error
+	}{
+		{
+			"empty sender",
+			types.MsgGrantRole{
+				Sender:  "",
+				Denom:   denom,
+				Address: testAddress,
+				Role:    types.ROLE_MINTER,
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid grantee",
+			types.MsgGrantRole{
+				Sender:  testutil.TestOwnerAddress,
+				Denom:   denom,
+				Address: "invalid",
+				Role:    types.ROLE_MINTER,
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"incorrect denom prefix",
+			types.MsgGrantRole{
+				Sender:  testutil.TestOwnerAddress,
+				Denom:   "bitcoin.coinfactory.sun",
+				Address: testAddress,
+				Role:    types.ROLE_MINTER,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"unspecified role",
+			types.MsgGrantRole{
+				Sender:  testutil.TestOwnerAddress,
+				Denom:   denom,
+				Address: testAddress,
+				Role:    types.ROLE_UNSPECIFIED,
+			},
+			types.ErrInvalidRole,
+		},
+		{
+			"mint allowance for a burner",
+			types.MsgGrantRole{
+				Sender:        testutil.TestOwnerAddress,
+				Denom:         denom,
+				Address:       testAddress,
+				Role:          types.ROLE_BURNER,
+				MintAllowance: &allowance,
+			},
+			types.ErrInvalidRole,
+		},
+		{
+			"negative mint allowance",
+			types.MsgGrantRole{
+				Sender:        testutil.TestOwnerAddress,
+				Denom:         denom,
+				Address:       testAddress,
+				Role:          types.ROLE_MINTER,
+				MintAllowance: &negative,
+			},
+			types.ErrInvalidRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.GrantRole(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
 func TestMsgUpdateParamsValidate(t *testing.T) {
 	k, ctx := testkeeper.CoinFactoryKeeper(t, nil, nil, nil)
 
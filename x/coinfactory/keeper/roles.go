@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
+)
+
+// GetDenomRole returns the role granted to the address over the denom
+func (k Keeper) GetDenomRole(ctx sdk.Context, denom string, role types.Role, address string) (types.DenomRole, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get(types.GetDenomRoleKey(role, address))
+	if bz == nil {
+		return types.DenomRole{}, false
+	}
+
+	var denomRole types.DenomRole
+	k.cdc.MustUnmarshal(bz, &denomRole)
+	return denomRole, true
+}
+
+// GetDenomRoles returns all the roles granted over the denom
+func (k Keeper) GetDenomRoles(ctx sdk.Context, denom string) []types.DenomRole {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetDenomRolesPrefix())
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close() //nolint:errcheck
+
+	roles := []types.DenomRole{}
+	for ; iterator.Valid(); iterator.Next() {
+		var denomRole types.DenomRole
+		k.cdc.MustUnmarshal(iterator.Value(), &denomRole)
+		roles = append(roles, denomRole)
+	}
+	return roles
+}
+
+// setDenomRole grants the role over the denom, overwriting the mint allowance if the role is already granted
+func (k Keeper) setDenomRole(ctx sdk.Context, denom string, denomRole types.DenomRole) {
+	bz := k.cdc.MustMarshal(&denomRole)
+	k.GetDenomPrefixStore(ctx, denom).Set(types.GetDenomRoleKey(denomRole.Role, denomRole.Address), bz)
+}
+
+// removeDenomRole revokes the role over the denom
+func (k Keeper) removeDenomRole(ctx sdk.Context, denom string, role types.Role, address string) {
+	k.GetDenomPrefixStore(ctx, denom).Delete(types.GetDenomRoleKey(role, address))
+}
+
+// removeDenomRoles revokes all the roles over the denom
+func (k Keeper) removeDenomRoles(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetDenomRolesPrefix())
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() //nolint:errcheck
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// assertAdminOrRole ensures that the sender is either the admin of the denom or has been granted the role over it.
+// It returns true if the sender is the admin.
+func (k Keeper) assertAdminOrRole(ctx sdk.Context, denom, sender string, role types.Role) (bool, error) {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return false, err
+	}
+
+	if sender == authorityMetadata.GetAdmin() {
+		return true, nil
+	}
+
+	if _, found := k.GetDenomRole(ctx, denom, role, sender); !found {
+		return false, types.ErrUnauthorized.Wrapf("%s is neither the admin of %s nor has the %s role", sender, denom, role)
+	}
+
+	return false, nil
+}
+
+// spendMintAllowance decreases the mint allowance of the minter by the amount. Minters without an allowance
+// are unlimited.
+func (k Keeper) spendMintAllowance(ctx sdk.Context, minter string, amount sdk.Coin) error {
+	denomRole, found := k.GetDenomRole(ctx, amount.Denom, types.ROLE_MINTER, minter)
+	if !found {
+		return types.ErrUnauthorized.Wrapf("%s has no %s role over %s", minter, types.ROLE_MINTER, amount.Denom)
+	}
+
+	if denomRole.MintAllowance == nil {
+		return nil
+	}
+
+	if denomRole.MintAllowance.LT(amount.Amount) {
+		return types.ErrMintAllowanceExceeded.Wrapf("mint allowance: %s, amount: %s", denomRole.MintAllowance, amount.Amount)
+	}
+
+	allowance := denomRole.MintAllowance.Sub(amount.Amount)
+	denomRole.MintAllowance = &allowance
+	k.setDenomRole(ctx, amount.Denom, denomRole)
+
+	return nil
+}
+
+// grantRole grants the role over the denom to the address
+func (k Keeper) grantRole(ctx sdk.Context, denom, address string, role types.Role, mintAllowance *math.Int) error {
+	denomRole := types.DenomRole{
+		Address:       address,
+		Role:          role,
+		MintAllowance: mintAllowance,
+	}
+	if err := denomRole.Validate(); err != nil {
+		return err
+	}
+
+	k.setDenomRole(ctx, denom, denomRole)
+	return nil
+}
+
+// revokeRole revokes the role over the denom from the address
+func (k Keeper) revokeRole(ctx sdk.Context, denom, address string, role types.Role) error {
+	if _, found := k.GetDenomRole(ctx, denom, role, address); !found {
+		return types.ErrInvalidRole.Wrapf("%s has no %s role over %s", address, role, denom)
+	}
+
+	k.removeDenomRole(ctx, denom, role, address)
+	return nil
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/coinfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestGrantRevokeRole() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	admin := suite.TestAccs[0].String()
+	grantee := suite.TestAccs[1].String()
+
+	// only the admin can grant roles
+	_, err := suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(grantee, suite.defaultDenom, grantee, types.ROLE_BURNER, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	allowance := math.NewInt(100)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, grantee, types.ROLE_MINTER, &allowance))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, grantee, types.ROLE_BURNER, nil))
+	suite.Require().NoError(err)
+
+	creator, subdenom, err := types.DeconstructDenom(suite.defaultDenom)
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.DenomRoles(ctx, &types.QueryDenomRolesRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.DenomRole{
+		{Address: grantee, Role: types.ROLE_MINTER, MintAllowance: &allowance},
+		{Address: grantee, Role: types.ROLE_BURNER},
+	}, res.Roles)
+
+	// only the admin can revoke roles
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(grantee, suite.defaultDenom, grantee, types.ROLE_BURNER))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, grantee, types.ROLE_BURNER))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, grantee, types.ROLE_BURNER))
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+
+	res, err = suite.queryClient.DenomRoles(ctx, &types.QueryDenomRolesRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomRole{{Address: grantee, Role: types.ROLE_MINTER, MintAllowance: &allowance}}, res.Roles)
+
+	// the roles are exported and imported with the genesis
+	genesis := suite.GetNeutronZoneApp(suite.ChainA).CoinfactoryKeeper.ExportGenesis(ctx)
+	suite.Require().Len(genesis.FactoryDenoms, 1)
+	suite.Require().Equal(res.Roles, genesis.FactoryDenoms[0].Roles)
+
+	// the roles are revoked when the admin changes
+	_, err = suite.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.DenomRoles(ctx, &types.QueryDenomRolesRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Roles)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(grantee, sdk.NewInt64Coin(suite.defaultDenom, 10), grantee))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
+
+func (suite *KeeperTestSuite) TestRolePermissions() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+	other := suite.TestAccs[2].String()
+
+	// grantees can't act on the denom before the role is granted
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	allowance := math.NewInt(100)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.ROLE_MINTER, &allowance))
+	suite.Require().NoError(err)
+
+	// the minter spends its mint allowance
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMintTo(minter, sdk.NewInt64Coin(suite.defaultDenom, 60), other))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 50)))
+	suite.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+
+	role, found := suite.GetNeutronZoneApp(suite.ChainA).CoinfactoryKeeper.GetDenomRole(ctx, suite.defaultDenom, types.ROLE_MINTER, minter)
+	suite.Require().True(found)
+	suite.Require().Equal(math.NewInt(40), *role.MintAllowance)
+
+	// the admin isn't limited by the allowances
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+
+	// the minter has no other permissions
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), other, minter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(minter, suite.defaultDenom, ""))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(minter, suite.defaultDenom, other, types.ROLE_MINTER, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// minters without an allowance are unlimited
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.ROLE_MINTER, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 500)))
+	suite.Require().NoError(err)
+
+	// the other roles
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.ROLE_BURNER, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurnFrom(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), other))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.ROLE_FORCE_TRANSFERER, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(ctx, types.NewMsgForceTransfer(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), other, minter))
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.GrantRole(ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, minter, types.ROLE_HOOK_SETTER, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetBeforeSendHook(ctx, types.NewMsgSetBeforeSendHook(minter, suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+	suite.Require().Equal(math.NewInt(40), bankKeeper.GetBalance(ctx, suite.TestAccs[2], suite.defaultDenom).Amount)
+	suite.Require().Equal(math.NewInt(510), bankKeeper.GetBalance(ctx, suite.TestAccs[1], suite.defaultDenom).Amount)
+
+	// the minter loses its permissions when the role is revoked
+	_, err = suite.msgServer.RevokeRole(ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, minter, types.ROLE_MINTER))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
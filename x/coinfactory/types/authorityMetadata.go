@@ -1,6 +1,12 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +17,65 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	if metadata.MaxSupply != nil && !metadata.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply must be positive, got %s", metadata.MaxSupply)
+	}
+
+	return ValidateMintSchedule(metadata.MintSchedule)
+}
+
+// ValidateMintSchedule checks that the schedule entries unlock positive amounts in the strictly increasing order of time.
+func ValidateMintSchedule(schedule []MintScheduleEntry) error {
+	for i, entry := range schedule {
+		if entry.Amount.IsNil() || !entry.Amount.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidMintLimits, "mint schedule entry %d: amount must be positive", i)
+		}
+		if i > 0 && !entry.UnlockTime.After(schedule[i-1].UnlockTime) {
+			return errorsmod.Wrapf(ErrInvalidMintLimits, "mint schedule entry %d: unlock times must be strictly increasing", i)
+		}
+	}
 	return nil
 }
+
+// UnlockedSupply returns the total supply unlocked by the mint schedule at the given time.
+func (metadata DenomAuthorityMetadata) UnlockedSupply(t time.Time) math.Int {
+	unlocked := math.ZeroInt()
+	for _, entry := range metadata.MintSchedule {
+		if entry.UnlockTime.After(t) {
+			break
+		}
+		unlocked = unlocked.Add(entry.Amount)
+	}
+	return unlocked
+}
+
+// MintableAmount returns the amount that can be minted on top of the current supply at the given time according to
+// the max supply and the mint schedule. It returns nil if the minting isn't limited.
+func (metadata DenomAuthorityMetadata) MintableAmount(supply math.Int, t time.Time) *math.Int {
+	limit := metadata.MaxSupply
+	if len(metadata.MintSchedule) > 0 {
+		unlocked := metadata.UnlockedSupply(t)
+		if limit == nil || unlocked.LT(*limit) {
+			limit = &unlocked
+		}
+	}
+	if limit == nil {
+		return nil
+	}
+
+	mintable := math.ZeroInt()
+	if limit.GT(supply) {
+		mintable = limit.Sub(supply)
+	}
+	return &mintable
+}
+
+// FormatMintSchedule formats the mint schedule as comma-separated amount@unlock_time entries.
+func FormatMintSchedule(schedule []MintScheduleEntry) string {
+	entries := make([]string, 0, len(schedule))
+	for _, entry := range schedule {
+		entries = append(entries, fmt.Sprintf("%s@%s", entry.Amount, entry.UnlockTime.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(entries, ",")
+}
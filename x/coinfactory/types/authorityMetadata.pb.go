@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -30,6 +36,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid neutron address
 	Admin string `protobuf:"bytes,1,opt,name=Admin,proto3" json:"Admin,omitempty" yaml:"admin"`
+	// Hard cap on the total supply of the denom enforced on minting. Unset for
+	// no cap. Once set, it can only be lowered.
+	MaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty" yaml:"max_supply"`
+	// Schedule unlocking the total supply of the denom over time. Empty for no
+	// schedule. Once set, it can't be changed.
+	MintSchedule []MintScheduleEntry `protobuf:"bytes,3,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule" yaml:"mint_schedule"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +84,63 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMintSchedule() []MintScheduleEntry {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
+// MintScheduleEntry unlocks the amount of the total supply of the denom at the
+// unlock time. The supply can be minted up to the sum of the unlocked amounts.
+type MintScheduleEntry struct {
+	UnlockTime time.Time             `protobuf:"bytes,1,opt,name=unlock_time,json=unlockTime,proto3,stdtime" json:"unlock_time" yaml:"unlock_time"`
+	Amount     cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+}
+
+func (m *MintScheduleEntry) Reset()         { *m = MintScheduleEntry{} }
+func (m *MintScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*MintScheduleEntry) ProtoMessage()    {}
+func (*MintScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a1ac4030abc9db6, []int{1}
+}
+func (m *MintScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintScheduleEntry.Merge(m, src)
+}
+func (m *MintScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintScheduleEntry proto.InternalMessageInfo
+
+func (m *MintScheduleEntry) GetUnlockTime() time.Time {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "neutron.coinfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*MintScheduleEntry)(nil), "neutron.coinfactory.v1beta1.MintScheduleEntry")
 }
 
 func init() {
@@ -81,22 +148,37 @@ func init() {
 }
 
 var fileDescriptor_3a1ac4030abc9db6 = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xce, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xce, 0xcf, 0xcc, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c,
-	0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x92, 0x86, 0x6a, 0xd2, 0x43, 0xd2, 0xa4, 0x07, 0xd5, 0x24, 0x25, 0x97, 0x9c, 0x5f, 0x9c, 0x9b,
-	0x5f, 0xac, 0x9f, 0x94, 0x58, 0x9c, 0x0a, 0x37, 0x09, 0xa4, 0x10, 0xa2, 0x59, 0x4a, 0x24, 0x3d,
-	0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a, 0x6e, 0x5c, 0x62, 0x2e, 0xa9, 0x79,
-	0xf9, 0xb9, 0x8e, 0xe8, 0x56, 0x0a, 0xa9, 0x71, 0xb1, 0x3a, 0xa6, 0xe4, 0x66, 0xe6, 0x49, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x3a, 0x09, 0x7c, 0xba, 0x27, 0xcf, 0x53, 0x99, 0x98, 0x9b, 0x63, 0xa5,
-	0x94, 0x08, 0x12, 0x56, 0x0a, 0x82, 0x48, 0x5b, 0xb1, 0xbc, 0x58, 0x20, 0xcf, 0xe8, 0x14, 0x70,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x66, 0xe9, 0x99, 0x25, 0x19, 0xa5,
-	0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xf7, 0xeb, 0xe6, 0x17, 0xa5, 0xc3, 0xd8, 0xfa, 0x65,
-	0x86, 0x86, 0xfa, 0x15, 0x28, 0xc1, 0x50, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xa0,
-	0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x75, 0x4d, 0x83, 0xda, 0x2a, 0x01, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x80, 0xe3, 0x16, 0x2a, 0xf5, 0xd2, 0x4a, 0xd4, 0x2a, 0x28, 0x04, 0x64, 0x57, 0x1e, 0x50,
+	0x97, 0xde, 0x29, 0xad, 0xc4, 0x50, 0x31, 0xd0, 0x08, 0x86, 0x0e, 0x95, 0x50, 0xda, 0x09, 0x06,
+	0xeb, 0xec, 0x5c, 0x1d, 0xab, 0xbe, 0x7b, 0xc6, 0x7e, 0xae, 0xe2, 0x7f, 0xd1, 0x99, 0x89, 0x1f,
+	0xc1, 0x8f, 0xc8, 0xc0, 0x50, 0x31, 0x21, 0x06, 0x83, 0x92, 0x85, 0x39, 0xbf, 0x00, 0xd9, 0x77,
+	0x81, 0xd0, 0x4a, 0xd9, 0xee, 0xde, 0x7b, 0xdf, 0x7b, 0xf7, 0x3e, 0x9b, 0x1c, 0x29, 0x51, 0x60,
+	0x06, 0x8a, 0x85, 0x10, 0xab, 0x4b, 0x1e, 0x22, 0x64, 0x25, 0xbb, 0xee, 0x05, 0x02, 0x79, 0x8f,
+	0xf1, 0x02, 0x47, 0x90, 0xc5, 0x58, 0x9e, 0x09, 0xe4, 0x43, 0x8e, 0x9c, 0xa6, 0x19, 0x20, 0xd8,
+	0xcf, 0x0c, 0x44, 0x97, 0x20, 0x6a, 0xa0, 0xae, 0x13, 0x42, 0x2e, 0x21, 0x67, 0x01, 0xcf, 0xc5,
+	0xdf, 0x4e, 0x75, 0xa1, 0x86, 0xbb, 0x4f, 0x75, 0xde, 0x6f, 0x6e, 0x4c, 0x5f, 0x4c, 0x6a, 0x37,
+	0x82, 0x08, 0x74, 0xbc, 0x3e, 0x99, 0xa8, 0x1b, 0x01, 0x44, 0x89, 0x60, 0xcd, 0x2d, 0x28, 0x2e,
+	0x19, 0xc6, 0x52, 0xe4, 0xc8, 0x65, 0xaa, 0x0b, 0xbc, 0x4f, 0x6b, 0xe4, 0xc9, 0x1b, 0xa1, 0x40,
+	0x9e, 0xdc, 0x7d, 0xaf, 0xfd, 0x82, 0x3c, 0x3c, 0x19, 0xca, 0x58, 0x75, 0xac, 0x3d, 0x6b, 0x7f,
+	0xb3, 0xff, 0x68, 0x5e, 0xb9, 0x5b, 0x25, 0x97, 0xc9, 0xb1, 0xc7, 0xeb, 0xb0, 0x37, 0xd0, 0x69,
+	0xdb, 0x27, 0x44, 0xf2, 0xb1, 0x9f, 0x17, 0x69, 0x9a, 0x94, 0x9d, 0xb5, 0xa6, 0xf8, 0xf5, 0xa4,
+	0x72, 0xad, 0x1f, 0x95, 0xfb, 0x58, 0xbf, 0x31, 0x1f, 0x5e, 0xd1, 0x18, 0x98, 0xe4, 0x38, 0xa2,
+	0xa7, 0x0a, 0xe7, 0x95, 0xbb, 0xa3, 0x3b, 0xfd, 0x03, 0xbd, 0x6f, 0x5f, 0x0e, 0x88, 0xd9, 0xe8,
+	0x54, 0xe1, 0x60, 0x53, 0xf2, 0xf1, 0x79, 0x93, 0xb1, 0x3f, 0x92, 0x6d, 0x19, 0x2b, 0xf4, 0xf3,
+	0x70, 0x24, 0x86, 0x45, 0x22, 0x3a, 0xeb, 0x7b, 0xeb, 0xfb, 0xed, 0x43, 0x4a, 0x57, 0xa8, 0xa4,
+	0x67, 0xb1, 0xc2, 0x73, 0x03, 0xbc, 0x55, 0x98, 0x95, 0xfd, 0xe7, 0x93, 0xca, 0x6d, 0xcd, 0x2b,
+	0x77, 0xd7, 0x8c, 0x5e, 0x6e, 0xe9, 0x0d, 0xb6, 0xe4, 0x12, 0x70, 0xfc, 0xe0, 0xf7, 0x67, 0xd7,
+	0xf2, 0xbe, 0x5a, 0x64, 0xe7, 0x5e, 0x1f, 0xfb, 0x03, 0x69, 0x17, 0x2a, 0x81, 0xf0, 0xca, 0xaf,
+	0x65, 0x36, 0x76, 0xda, 0x87, 0x5d, 0xaa, 0x4d, 0xd3, 0x85, 0x69, 0x7a, 0xb1, 0x30, 0xdd, 0x77,
+	0xcc, 0x60, 0x5b, 0x0f, 0x5e, 0x82, 0xbd, 0x9b, 0x9f, 0xae, 0x35, 0x20, 0x3a, 0x52, 0x03, 0xf6,
+	0x05, 0xd9, 0xe0, 0x12, 0x0a, 0x85, 0x46, 0xe4, 0xab, 0x9a, 0x5d, 0x25, 0x72, 0xdb, 0x7c, 0x92,
+	0x06, 0xba, 0x2b, 0xd1, 0xf4, 0xd2, 0xeb, 0xf4, 0xdf, 0x4d, 0xa6, 0x8e, 0x75, 0x3b, 0x75, 0xac,
+	0x5f, 0x53, 0xc7, 0xba, 0x99, 0x39, 0xad, 0xdb, 0x99, 0xd3, 0xfa, 0x3e, 0x73, 0x5a, 0xef, 0x5f,
+	0x46, 0x31, 0x8e, 0x8a, 0x80, 0x86, 0x20, 0x99, 0x91, 0x7a, 0x00, 0x59, 0xb4, 0x38, 0xb3, 0xeb,
+	0x5e, 0x8f, 0x8d, 0xff, 0xfb, 0xcd, 0xb1, 0x4c, 0x45, 0x1e, 0x6c, 0x34, 0xdb, 0x1e, 0xfd, 0x19,
+	0x00, 0x9b, 0x1e, 0x66, 0xe5, 0x0a, 0x03, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +203,48 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if that1.MaxSupply == nil {
+		if this.MaxSupply != nil {
+			return false
+		}
+	} else if !this.MaxSupply.Equal(*that1.MaxSupply) {
+		return false
+	}
+	if len(this.MintSchedule) != len(that1.MintSchedule) {
+		return false
+	}
+	for i := range this.MintSchedule {
+		if !this.MintSchedule[i].Equal(&that1.MintSchedule[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MintScheduleEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MintScheduleEntry)
+	if !ok {
+		that2, ok := that.(MintScheduleEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.UnlockTime.Equal(that1.UnlockTime) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +267,32 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -153,6 +303,47 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MintScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuthorityMetadata(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -174,6 +365,29 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnlockTime)
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
 	return n
 }
 
@@ -244,6 +458,193 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, MintScheduleEntry{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintScheduleEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintScheduleEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -14,6 +14,14 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	// cdc.RegisterConcrete(&MsgForceTransfer{}, "neutron/coinfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "neutron/coinfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "neutron/coinfactory/set-beforesend-hook", nil)
+	cdc.RegisterConcrete(&MsgSetMintLimits{}, "neutron/coinfactory/set-mint-limits", nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, "neutron/coinfactory/grant-role", nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, "neutron/coinfactory/revoke-role", nil)
+	cdc.RegisterConcrete(&MsgSetFrozen{}, "neutron/coinfactory/set-frozen", nil)
+	cdc.RegisterConcrete(&MsgSetPaused{}, "neutron/coinfactory/set-paused", nil)
+	cdc.RegisterConcrete(&MsgMigrateTokenfactoryDenom{}, "neutron/coinfactory/migrate-tokenfactory-denom", nil)
+	cdc.RegisterConcrete(&MsgSetConversionDirections{}, "neutron/coinfactory/set-conversion-directions", nil)
+	cdc.RegisterConcrete(&MsgConvert{}, "neutron/coinfactory/convert", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron/coinfactory/update-params", nil)
 }
 
@@ -26,6 +34,14 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		// &MsgForceTransfer{},
 		&MsgChangeAdmin{},
 		&MsgSetBeforeSendHook{},
+		&MsgSetMintLimits{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetFrozen{},
+		&MsgSetPaused{},
+		&MsgMigrateTokenfactoryDenom{},
+		&MsgSetConversionDirections{},
+		&MsgConvert{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	tokenfactorytypes "github.com/neutron-org/neutron/v11/x/tokenfactory/types"
+)
+
+// Validate performs the stateless validation of the denom link
+func (l DenomLink) Validate() error {
+	if _, _, err := tokenfactorytypes.DeconstructDenom(l.TokenfactoryDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomLink, "invalid tokenfactory denom (%s)", err)
+	}
+
+	if _, _, err := DeconstructDenom(l.CoinfactoryDenom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomLink, "invalid coinfactory denom (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,501 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/coinfactory/v1beta1/denom_link.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomLink links a tokenfactory denom to the coinfactory denom it has been
+// migrated to. The holders can convert the tokens 1:1 in the enabled
+// directions.
+type DenomLink struct {
+	TokenfactoryDenom string `protobuf:"bytes,1,opt,name=tokenfactory_denom,json=tokenfactoryDenom,proto3" json:"tokenfactory_denom,omitempty" yaml:"tokenfactory_denom"`
+	CoinfactoryDenom  string `protobuf:"bytes,2,opt,name=coinfactory_denom,json=coinfactoryDenom,proto3" json:"coinfactory_denom,omitempty" yaml:"coinfactory_denom"`
+	// to_coinfactory_enabled allows converting the tokenfactory denom to the
+	// coinfactory denom.
+	ToCoinfactoryEnabled bool `protobuf:"varint,3,opt,name=to_coinfactory_enabled,json=toCoinfactoryEnabled,proto3" json:"to_coinfactory_enabled,omitempty" yaml:"to_coinfactory_enabled"`
+	// to_tokenfactory_enabled allows converting the coinfactory denom back to
+	// the tokenfactory denom.
+	ToTokenfactoryEnabled bool `protobuf:"varint,4,opt,name=to_tokenfactory_enabled,json=toTokenfactoryEnabled,proto3" json:"to_tokenfactory_enabled,omitempty" yaml:"to_tokenfactory_enabled"`
+}
+
+func (m *DenomLink) Reset()         { *m = DenomLink{} }
+func (m *DenomLink) String() string { return proto.CompactTextString(m) }
+func (*DenomLink) ProtoMessage()    {}
+func (*DenomLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4cc868841fa8a6d3, []int{0}
+}
+func (m *DenomLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomLink.Merge(m, src)
+}
+func (m *DenomLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomLink proto.InternalMessageInfo
+
+func (m *DenomLink) GetTokenfactoryDenom() string {
+	if m != nil {
+		return m.TokenfactoryDenom
+	}
+	return ""
+}
+
+func (m *DenomLink) GetCoinfactoryDenom() string {
+	if m != nil {
+		return m.CoinfactoryDenom
+	}
+	return ""
+}
+
+func (m *DenomLink) GetToCoinfactoryEnabled() bool {
+	if m != nil {
+		return m.ToCoinfactoryEnabled
+	}
+	return false
+}
+
+func (m *DenomLink) GetToTokenfactoryEnabled() bool {
+	if m != nil {
+		return m.ToTokenfactoryEnabled
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*DenomLink)(nil), "neutron.coinfactory.v1beta1.DenomLink")
+}
+
+func init() {
+	proto.RegisterFile("neutron/coinfactory/v1beta1/denom_link.proto", fileDescriptor_4cc868841fa8a6d3)
+}
+
+var fileDescriptor_4cc868841fa8a6d3 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0x19, 0x3e, 0xf2, 0x45, 0x66, 0x25, 0x0d, 0x2a, 0xfe, 0x30, 0xc5, 0x59, 0xb1, 0xd0,
+	0x4e, 0x1a, 0x13, 0x17, 0x2c, 0x51, 0x17, 0x26, 0x2c, 0x4c, 0x63, 0x62, 0xc2, 0xa6, 0x69, 0x61,
+	0xac, 0x0d, 0x30, 0x87, 0xd4, 0x81, 0xc8, 0x5d, 0x78, 0x09, 0x5e, 0x8e, 0x4b, 0x96, 0xae, 0xaa,
+	0x81, 0x8d, 0xeb, 0x5e, 0x81, 0x61, 0xda, 0xea, 0x10, 0xd8, 0x9d, 0xbc, 0x7d, 0xce, 0xd3, 0x33,
+	0x79, 0xf1, 0x99, 0xe0, 0x13, 0x19, 0x81, 0x60, 0x3d, 0x08, 0xc5, 0xa3, 0xd7, 0x93, 0x10, 0xcd,
+	0xd8, 0xd4, 0xf6, 0xb9, 0xf4, 0x6c, 0xd6, 0xe7, 0x02, 0x46, 0xee, 0x30, 0x14, 0x03, 0x6b, 0x1c,
+	0x81, 0x04, 0xe3, 0x38, 0xa3, 0x2d, 0x8d, 0xb6, 0x32, 0xfa, 0xa8, 0x1a, 0x40, 0x00, 0x8a, 0x63,
+	0xab, 0x29, 0x5d, 0xa1, 0x9f, 0x45, 0x5c, 0xbe, 0x5e, 0x79, 0x3a, 0xa1, 0x18, 0x18, 0x1d, 0x6c,
+	0x48, 0x18, 0xf0, 0x7c, 0xd7, 0x55, 0x7f, 0xa8, 0xa1, 0x06, 0x6a, 0x96, 0xdb, 0xf5, 0x24, 0x36,
+	0x0f, 0x67, 0xde, 0x68, 0xd8, 0xa2, 0x9b, 0x0c, 0x75, 0x2a, 0x7a, 0xa8, 0x8c, 0xc6, 0x2d, 0xae,
+	0x68, 0x87, 0x64, 0xb2, 0xa2, 0x92, 0x9d, 0x24, 0xb1, 0x59, 0x4b, 0x65, 0x1b, 0x08, 0x75, 0x76,
+	0xb5, 0x2c, 0x55, 0x3d, 0xe0, 0x7d, 0x09, 0xae, 0x8e, 0x72, 0xe1, 0xf9, 0x43, 0xde, 0xaf, 0xfd,
+	0x6b, 0xa0, 0xe6, 0x4e, 0xfb, 0x34, 0x89, 0xcd, 0x7a, 0x7e, 0xdc, 0x36, 0x8e, 0x3a, 0x55, 0x09,
+	0x57, 0x7f, 0xf9, 0x4d, 0x1a, 0x1b, 0x5d, 0x7c, 0x20, 0xc1, 0x5d, 0x7b, 0x50, 0x6e, 0x2e, 0x29,
+	0x33, 0x4d, 0x62, 0x93, 0xfc, 0x9a, 0xb7, 0x81, 0xd4, 0xd9, 0x93, 0x70, 0xaf, 0x7d, 0xc8, 0xdc,
+	0xad, 0xd2, 0xf7, 0x9b, 0x89, 0xda, 0x77, 0xef, 0x0b, 0x82, 0xe6, 0x0b, 0x82, 0xbe, 0x16, 0x04,
+	0xbd, 0x2e, 0x49, 0x61, 0xbe, 0x24, 0x85, 0x8f, 0x25, 0x29, 0x74, 0x2f, 0x83, 0x50, 0x3e, 0x4d,
+	0x7c, 0xab, 0x07, 0x23, 0x96, 0x35, 0x77, 0x0e, 0x51, 0x90, 0xcf, 0x6c, 0x6a, 0xdb, 0xec, 0x65,
+	0xad, 0x79, 0x39, 0x1b, 0xf3, 0x67, 0xff, 0xbf, 0xaa, 0xee, 0xe2, 0x67, 0x00, 0x8d, 0x0d, 0xea,
+	0xb3, 0x1d, 0x02, 0x00, 0x00,
+}
+
+func (this *DenomLink) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomLink)
+	if !ok {
+		that2, ok := that.(DenomLink)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TokenfactoryDenom != that1.TokenfactoryDenom {
+		return false
+	}
+	if this.CoinfactoryDenom != that1.CoinfactoryDenom {
+		return false
+	}
+	if this.ToCoinfactoryEnabled != that1.ToCoinfactoryEnabled {
+		return false
+	}
+	if this.ToTokenfactoryEnabled != that1.ToTokenfactoryEnabled {
+		return false
+	}
+	return true
+}
+func (m *DenomLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToTokenfactoryEnabled {
+		i--
+		if m.ToTokenfactoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ToCoinfactoryEnabled {
+		i--
+		if m.ToCoinfactoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CoinfactoryDenom) > 0 {
+		i -= len(m.CoinfactoryDenom)
+		copy(dAtA[i:], m.CoinfactoryDenom)
+		i = encodeVarintDenomLink(dAtA, i, uint64(len(m.CoinfactoryDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenfactoryDenom) > 0 {
+		i -= len(m.TokenfactoryDenom)
+		copy(dAtA[i:], m.TokenfactoryDenom)
+		i = encodeVarintDenomLink(dAtA, i, uint64(len(m.TokenfactoryDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDenomLink(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDenomLink(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenfactoryDenom)
+	if l > 0 {
+		n += 1 + l + sovDenomLink(uint64(l))
+	}
+	l = len(m.CoinfactoryDenom)
+	if l > 0 {
+		n += 1 + l + sovDenomLink(uint64(l))
+	}
+	if m.ToCoinfactoryEnabled {
+		n += 2
+	}
+	if m.ToTokenfactoryEnabled {
+		n += 2
+	}
+	return n
+}
+
+func sovDenomLink(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDenomLink(x uint64) (n int) {
+	return sovDenomLink(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDenomLink
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenfactoryDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomLink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomLink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomLink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenfactoryDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinfactoryDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomLink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDenomLink
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDenomLink
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoinfactoryDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToCoinfactoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomLink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToCoinfactoryEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTokenfactoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDenomLink
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToTokenfactoryEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDenomLink(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDenomLink
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDenomLink(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDenomLink
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomLink
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDenomLink
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDenomLink
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDenomLink
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDenomLink
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDenomLink        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDenomLink          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDenomLink = fmt.Errorf("proto: unexpected end of group")
+)
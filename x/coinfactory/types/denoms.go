@@ -36,6 +36,11 @@ func GetTokenDenom(creator, subdenom string) (string, error) {
 	return denom, sdk.ValidateDenom(denom)
 }
 
+// HasModuleDenomPrefix cheaply checks whether the denom may be a coinfactory denom, without validating it
+func HasModuleDenomPrefix(denom string) bool {
+	return strings.HasPrefix(denom, ModuleDenomPrefix+Separator)
+}
+
 // DeconstructDenom takes a token denom string and verifies that it is a valid
 // denom of the coinfactory module, and is of the form `coinfactory + Separator + {creator} + Separator + {subdenom}`
 // If valid, it returns the creator address and subdenom
@@ -21,4 +21,14 @@ var (
 	ErrTrackBeforeSendOutOfGas      = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
 	ErrInvalidHookContractAddress   = errorsmod.Register(ModuleName, 13, "invalid hook contract address")
 	ErrBeforeSendHookNotWhitelisted = errorsmod.Register(ModuleName, 14, "beforeSendHook is not whitelisted")
+	ErrMaxSupplyExceeded            = errorsmod.Register(ModuleName, 15, "minting exceeds the max supply of the denom")
+	ErrMintScheduleExceeded         = errorsmod.Register(ModuleName, 16, "minting exceeds the supply unlocked by the mint schedule of the denom")
+	ErrInvalidMintLimits            = errorsmod.Register(ModuleName, 17, "invalid mint limits")
+	ErrMintAllowanceExceeded        = errorsmod.Register(ModuleName, 18, "minting exceeds the mint allowance of the minter")
+	ErrInvalidRole                  = errorsmod.Register(ModuleName, 19, "invalid role")
+	ErrDenomPaused                  = errorsmod.Register(ModuleName, 20, "transfers of the denom are paused")
+	ErrAddressFrozen                = errorsmod.Register(ModuleName, 21, "address is frozen for the denom")
+	ErrDenomNotLinked               = errorsmod.Register(ModuleName, 22, "denom is not linked to a migrated denom")
+	ErrConversionDisabled           = errorsmod.Register(ModuleName, 23, "conversion of the denom is disabled")
+	ErrInvalidDenomLink             = errorsmod.Register(ModuleName, 24, "invalid denom link")
 )
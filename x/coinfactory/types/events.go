@@ -14,4 +14,15 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeMaxSupply             = "max_supply"
+	AttributeMintSchedule          = "mint_schedule"
+	AttributeRole                  = "role"
+	AttributeAddress               = "address"
+	AttributeMintAllowance         = "mint_allowance"
+	AttributeFrozen                = "frozen"
+	AttributePaused                = "paused"
+	AttributeTokenfactoryDenom     = "tokenfactory_denom"
+	AttributeToCoinfactoryEnabled  = "to_coinfactory_enabled"
+	AttributeToTokenfactoryEnabled = "to_tokenfactory_enabled"
+	AttributeConvertedAmount       = "converted_amount"
 )
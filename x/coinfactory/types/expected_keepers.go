@@ -6,6 +6,8 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokenfactorytypes "github.com/neutron-org/neutron/v11/x/tokenfactory/types"
 )
 
 type BankKeeper interface {
@@ -14,6 +16,7 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 
 	HasSupply(ctx context.Context, denom string) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -39,3 +42,11 @@ type ContractKeeper interface {
 	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
 }
+
+// TokenfactoryKeeper is used to migrate the tokenfactory denoms to coinfactory and to convert them
+type TokenfactoryKeeper interface {
+	GetAuthorityMetadata(ctx sdk.Context, denom string) (tokenfactorytypes.DenomAuthorityMetadata, error)
+	GetBeforeSendHook(ctx context.Context, denom string) string
+	MintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error
+	BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error
+}
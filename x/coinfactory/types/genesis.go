@@ -44,9 +44,52 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid mint limits (%s)", err)
+		}
+
 		if _, err := sdk.AccAddressFromBech32(denom.HookContractAddress); denom.HookContractAddress != "" && err != nil {
 			return errorsmod.Wrapf(ErrInvalidHookContractAddress, "Invalid hook contract address (%s)", err)
 		}
+
+		seenRoles := map[string]bool{}
+		for _, role := range denom.GetRoles() {
+			if err := role.Validate(); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid role of denom %s: %s", denom.GetDenom(), err)
+			}
+
+			key := string(GetDenomRoleKey(role.Role, role.Address))
+			if seenRoles[key] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate role %s of %s for denom %s", role.Role, role.Address, denom.GetDenom())
+			}
+			seenRoles[key] = true
+		}
+
+		seenFrozen := map[string]bool{}
+		for _, address := range denom.GetFrozenAddresses() {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid frozen address of denom %s: %s", denom.GetDenom(), err)
+			}
+			if seenFrozen[address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate frozen address %s for denom %s", address, denom.GetDenom())
+			}
+			seenFrozen[address] = true
+		}
+	}
+
+	seenLinks := map[string]bool{}
+	for _, link := range gs.GetDenomLinks() {
+		if err := link.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "invalid denom link: %s", err)
+		}
+		if !seenDenoms[link.CoinfactoryDenom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "denom link to unknown coinfactory denom %s", link.CoinfactoryDenom)
+		}
+		if seenLinks[link.TokenfactoryDenom] || seenLinks[link.CoinfactoryDenom] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate denom link of %s", link.TokenfactoryDenom)
+		}
+		seenLinks[link.TokenfactoryDenom] = true
+		seenLinks[link.CoinfactoryDenom] = true
 	}
 
 	return nil
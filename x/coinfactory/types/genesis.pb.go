@@ -28,6 +28,8 @@ type GenesisState struct {
 	// params defines the parameters of the module.
 	Params        Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FactoryDenoms []GenesisDenom `protobuf:"bytes,2,rep,name=factory_denoms,json=factoryDenoms,proto3" json:"factory_denoms" yaml:"factory_denoms"`
+	// denom_links are the tokenfactory denoms migrated to coinfactory denoms.
+	DenomLinks []DenomLink `protobuf:"bytes,3,rep,name=denom_links,json=denomLinks,proto3" json:"denom_links" yaml:"denom_links"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +79,13 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 	return nil
 }
 
+func (m *GenesisState) GetDenomLinks() []DenomLink {
+	if m != nil {
+		return m.DenomLinks
+	}
+	return nil
+}
+
 // GenesisDenom defines a coinfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
 // denom's admin.
@@ -84,6 +93,11 @@ type GenesisDenom struct {
 	Denom               string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata   DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
 	HookContractAddress string                 `protobuf:"bytes,3,opt,name=hook_contract_address,json=hookContractAddress,proto3" json:"hook_contract_address,omitempty"`
+	Roles               []DenomRole            `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles" yaml:"roles"`
+	// paused is true if all the transfers of the denom are paused.
+	Paused bool `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// frozen_addresses can neither send nor receive the denom.
+	FrozenAddresses []string `protobuf:"bytes,6,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -140,6 +154,27 @@ func (m *GenesisDenom) GetHookContractAddress() string {
 	return ""
 }
 
+func (m *GenesisDenom) GetRoles() []DenomRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.coinfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "neutron.coinfactory.v1beta1.GenesisDenom")
@@ -150,33 +185,41 @@ func init() {
 }
 
 var fileDescriptor_9f6954d416561c6a = []byte{
-	// 408 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xce, 0xcf, 0xcc, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa, 0xd4,
-	0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x86, 0x2a, 0xd5, 0x43, 0x52, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa7, 0x0f, 0x62, 0x41, 0xb4, 0x48, 0x29, 0x60, 0x33,
-	0xbd, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0xa8, 0x94, 0x31, 0x3e, 0xfb, 0x13, 0x4b, 0x4b, 0x32,
-	0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x7d, 0x53, 0x4b, 0x12, 0x53, 0x12, 0x4b, 0x12, 0x21, 0x9a, 0x94,
-	0x76, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0xdc, 0x16, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc9, 0xc5,
-	0x06, 0x31, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5a, 0x0f, 0x9b, 0x5b, 0x03, 0xc0,
-	0x4a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a, 0x10, 0xca, 0xe7, 0xe2, 0x83, 0xca,
-	0xc7, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69, 0xea,
-	0xe1, 0xf1, 0xae, 0x1e, 0xd4, 0x76, 0x17, 0x90, 0x0e, 0x27, 0x59, 0x90, 0x81, 0x9f, 0xee, 0xc9,
-	0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x1a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81,
-	0xf0, 0xff, 0x21, 0x1c, 0x0f, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xbb, 0x9d, 0xd3,
-	0x49, 0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x2b,
-	0x23, 0x97, 0x10, 0x3c, 0x44, 0xe2, 0x73, 0xa1, 0x41, 0x22, 0xc1, 0x04, 0xf6, 0xb1, 0x31, 0x5e,
-	0xe7, 0x82, 0x2d, 0x72, 0x44, 0x0f, 0x4d, 0x27, 0x45, 0xa8, 0xc3, 0x25, 0x21, 0xd6, 0x61, 0x1a,
-	0xae, 0x14, 0x24, 0x88, 0x11, 0x07, 0x42, 0x16, 0x5c, 0xa2, 0x19, 0xf9, 0xf9, 0xd9, 0xf1, 0xc9,
-	0xf9, 0x79, 0x25, 0x45, 0x89, 0xc9, 0x25, 0xf1, 0x89, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0x12,
-	0xcc, 0x60, 0xf7, 0x83, 0x82, 0x97, 0x31, 0x48, 0x18, 0xa4, 0xc4, 0x19, 0xaa, 0xc2, 0x11, 0xa2,
-	0xc0, 0x8a, 0xe5, 0xc5, 0x02, 0x79, 0x46, 0xa7, 0x80, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0x88, 0x32, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87,
-	0x7a, 0x47, 0x37, 0xbf, 0x28, 0x1d, 0xc6, 0xd6, 0x2f, 0x33, 0x34, 0xd4, 0xaf, 0x40, 0x49, 0x29,
-	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x64, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x65, 0x00, 0x50, 0x43, 0xcd, 0x02, 0x00, 0x00,
+	// 535 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xfe, 0x13, 0x73, 0x37, 0xd8, 0xcc, 0x26, 0x42, 0x2b, 0x92, 0xe2, 0xc3, 0x68,
+	0x25, 0x48, 0xd4, 0x55, 0x42, 0xb0, 0x5b, 0x03, 0x82, 0x0b, 0x48, 0x93, 0xb9, 0x71, 0xa9, 0xdc,
+	0xc4, 0x6b, 0xa3, 0xb6, 0x71, 0x65, 0xbb, 0x13, 0xe5, 0xce, 0x9d, 0x6f, 0x00, 0x5f, 0x06, 0x69,
+	0xc7, 0x1d, 0x39, 0x55, 0xa8, 0xbd, 0x70, 0xee, 0x27, 0x40, 0xb1, 0xdd, 0xd1, 0xfd, 0x51, 0xe0,
+	0x16, 0xbd, 0xfe, 0xbd, 0xcf, 0xfb, 0xf8, 0xf1, 0x1b, 0xd0, 0x4c, 0xe8, 0x54, 0x72, 0x96, 0xf8,
+	0x21, 0x8b, 0x93, 0x53, 0x12, 0x4a, 0xc6, 0x67, 0xfe, 0x59, 0xab, 0x47, 0x25, 0x69, 0xf9, 0x7d,
+	0x9a, 0x50, 0x11, 0x0b, 0x6f, 0xc2, 0x99, 0x64, 0xb0, 0x66, 0x50, 0x6f, 0x03, 0xf5, 0x0c, 0x5a,
+	0xdd, 0xef, 0xb3, 0x3e, 0x53, 0x9c, 0x9f, 0x7e, 0xe9, 0x96, 0x6a, 0xfd, 0x36, 0xf5, 0x09, 0xe1,
+	0x64, 0x6c, 0x44, 0xab, 0xed, 0xac, 0xf9, 0x64, 0x2a, 0x07, 0x8c, 0xc7, 0x72, 0xf6, 0x9e, 0x4a,
+	0x12, 0x11, 0x49, 0x4c, 0xd3, 0xd3, 0xac, 0xa6, 0x88, 0x26, 0x6c, 0xdc, 0x1d, 0xc5, 0xc9, 0xd0,
+	0xd0, 0x4f, 0xb2, 0x68, 0xce, 0x46, 0xd4, 0x78, 0x41, 0xdf, 0xf2, 0x60, 0xfb, 0xad, 0xbe, 0xf2,
+	0x07, 0x49, 0x24, 0x85, 0x2f, 0x41, 0x59, 0x9b, 0xb5, 0xad, 0xba, 0xd5, 0xa8, 0x1c, 0xd5, 0xbc,
+	0xdb, 0x22, 0x38, 0x51, 0x48, 0x50, 0x3c, 0x9f, 0xbb, 0x39, 0x6c, 0x1a, 0x20, 0x03, 0x77, 0xcd,
+	0x79, 0x57, 0x19, 0x12, 0x76, 0xbe, 0x5e, 0x68, 0x54, 0x8e, 0x9a, 0x5e, 0x46, 0x8a, 0x9e, 0x99,
+	0xfe, 0x3a, 0xed, 0x08, 0x1e, 0xa5, 0x82, 0xab, 0xb9, 0x7b, 0x30, 0x23, 0xe3, 0xd1, 0x31, 0xba,
+	0x2a, 0x87, 0xf0, 0x8e, 0x29, 0x28, 0x58, 0xc0, 0x10, 0x54, 0xfe, 0xde, 0x5c, 0xd8, 0x05, 0x35,
+	0xed, 0x30, 0x73, 0x9a, 0xea, 0x7c, 0x17, 0x27, 0xc3, 0xa0, 0x6a, 0x46, 0x41, 0x3d, 0x6a, 0x43,
+	0x08, 0x61, 0x10, 0xad, 0x31, 0x81, 0x7e, 0x14, 0x2e, 0x13, 0x52, 0xcd, 0xf0, 0x10, 0x94, 0xd4,
+	0xb1, 0x0a, 0x68, 0x2b, 0xd8, 0x5d, 0xcd, 0xdd, 0xed, 0x0d, 0x0d, 0x84, 0xf5, 0x31, 0xfc, 0x62,
+	0x01, 0x78, 0xf9, 0x9a, 0xdd, 0xb1, 0x79, 0x4e, 0x3b, 0xaf, 0x62, 0x6d, 0xff, 0xdb, 0x65, 0xe7,
+	0xfa, 0x26, 0x04, 0x8f, 0x8d, 0xe5, 0x87, 0x7a, 0xdc, 0x4d, 0x71, 0x84, 0xf7, 0x6e, 0xec, 0x0f,
+	0x7c, 0x01, 0x0e, 0x06, 0x8c, 0x0d, 0xbb, 0x21, 0x4b, 0x24, 0x27, 0xa1, 0xec, 0x92, 0x28, 0xe2,
+	0x54, 0xa4, 0x79, 0xa5, 0xfe, 0xd3, 0x37, 0xb4, 0xf0, 0xfd, 0x14, 0x79, 0x65, 0x88, 0x8e, 0x06,
+	0x20, 0x06, 0x25, 0xb5, 0x2b, 0x76, 0xf1, 0x7f, 0x93, 0xc5, 0x6c, 0x44, 0x83, 0x7d, 0x63, 0xd3,
+	0xa4, 0xa2, 0x24, 0x10, 0xd6, 0x52, 0xb0, 0x99, 0xee, 0xd7, 0x54, 0xd0, 0xc8, 0x2e, 0xd5, 0xad,
+	0xc6, 0x9d, 0x60, 0x6f, 0x35, 0x77, 0x77, 0x34, 0xa8, 0xeb, 0x08, 0x1b, 0x00, 0xbe, 0x01, 0xbb,
+	0xa7, 0x9c, 0x7d, 0xa6, 0xc9, 0xda, 0x31, 0x15, 0x76, 0xb9, 0x5e, 0x68, 0x6c, 0x05, 0xb5, 0xd5,
+	0xdc, 0x7d, 0x60, 0x56, 0xe4, 0x1a, 0x81, 0xf0, 0x3d, 0x5d, 0xea, 0xac, 0x2b, 0xc7, 0xc5, 0xdf,
+	0xdf, 0x5d, 0x2b, 0x38, 0x39, 0x5f, 0x38, 0xd6, 0xc5, 0xc2, 0xb1, 0x7e, 0x2d, 0x1c, 0xeb, 0xeb,
+	0xd2, 0xc9, 0x5d, 0x2c, 0x9d, 0xdc, 0xcf, 0xa5, 0x93, 0xfb, 0xf8, 0xbc, 0x1f, 0xcb, 0xc1, 0xb4,
+	0xe7, 0x85, 0x6c, 0xec, 0x9b, 0x1b, 0x3e, 0x63, 0xbc, 0xbf, 0xfe, 0xf6, 0xcf, 0x5a, 0x2d, 0xff,
+	0xd3, 0x95, 0x3f, 0x49, 0xce, 0x26, 0x54, 0xf4, 0xca, 0xea, 0x17, 0x6a, 0xff, 0x19, 0x00, 0x05,
+	0x8c, 0x85, 0xbe, 0x50, 0x04, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -207,6 +250,25 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if this.HookContractAddress != that1.HookContractAddress {
 		return false
 	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(&that1.Roles[i]) {
+			return false
+		}
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -229,6 +291,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomLinks) > 0 {
+		for iNdEx := len(m.DenomLinks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomLinks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FactoryDenoms) > 0 {
 		for iNdEx := len(m.FactoryDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -276,6 +352,39 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.HookContractAddress) > 0 {
 		i -= len(m.HookContractAddress)
 		copy(dAtA[i:], m.HookContractAddress)
@@ -328,6 +437,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DenomLinks) > 0 {
+		for _, e := range m.DenomLinks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -347,6 +462,21 @@ func (m *GenesisDenom) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -452,6 +582,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomLinks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomLinks = append(m.DenomLinks, DenomLink{})
+			if err := m.DenomLinks[len(m.DenomLinks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -599,6 +763,92 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 			}
 			m.HookContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, DenomRole{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
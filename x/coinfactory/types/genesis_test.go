@@ -165,6 +165,90 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+						},
+						Roles: []types.DenomRole{
+							{Address: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2", Role: types.ROLE_MINTER},
+							{Address: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2", Role: types.ROLE_BURNER},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+						},
+						Paused:          true,
+						FrozenAddresses: []string{"neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid frozen address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+						},
+						FrozenAddresses: []string{"sfsdfsdfsdfs"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+						},
+						Roles: []types.DenomRole{
+							{Address: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2", Role: types.ROLE_MINTER},
+							{Address: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2", Role: types.ROLE_MINTER},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: bitcoin,
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+						},
+						Roles: []types.DenomRole{
+							{Address: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2", Role: types.ROLE_UNSPECIFIED},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
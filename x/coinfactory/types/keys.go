@@ -26,6 +26,7 @@ const (
 	KeySeparator    = "|"
 	prefixParamsKey = iota + 1
 	prefixEscrowAddressKey
+	prefixDenomLinkKey
 )
 
 var (
@@ -34,8 +35,13 @@ var (
 	CreatorPrefixKey               = "creator"
 	AdminPrefixKey                 = "admin"
 	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	DenomRolePrefixKey             = "roles"
+	FrozenAddressPrefixKey         = "frozen"
+	DenomPausedKey                 = "paused"
 	ParamsKey                      = []byte{prefixParamsKey}
 	EscrowAddressKey               = []byte{prefixEscrowAddressKey}
+	DenomLinkKey                   = []byte{prefixDenomLinkKey}
+	TokenfactoryDenomKey           = "tokenfactorydenom"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -44,6 +50,33 @@ func GetDenomPrefixStore(denom string) []byte {
 	return []byte(strings.Join([]string{DenomsPrefixKey, denom, ""}, KeySeparator))
 }
 
+// GetDenomRolesPrefix returns the prefix (inside the denom prefix store) where the roles granted
+// over the denom are stored
+func GetDenomRolesPrefix() []byte {
+	return []byte(strings.Join([]string{DenomRolePrefixKey, ""}, KeySeparator))
+}
+
+// GetDenomRoleKey returns the key (inside the denom prefix store) of the role granted to the address
+func GetDenomRoleKey(role Role, address string) []byte {
+	return []byte(strings.Join([]string{DenomRolePrefixKey, role.String(), address}, KeySeparator))
+}
+
+// GetFrozenAddressesPrefix returns the prefix (inside the denom prefix store) where the addresses frozen
+// for the denom are stored
+func GetFrozenAddressesPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, ""}, KeySeparator))
+}
+
+// GetFrozenAddressKey returns the key (inside the denom prefix store) marking the address as frozen
+func GetFrozenAddressKey(address string) []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, address}, KeySeparator))
+}
+
+// GetDenomLinkKey returns the store key of the link of the migrated tokenfactory denom
+func GetDenomLinkKey(tokenfactoryDenom string) []byte {
+	return append(DenomLinkKey, []byte(tokenfactoryDenom)...)
+}
+
 // GetCreatorPrefix returns the store prefix where the list of the denoms created by a specific
 // creator are stored
 func GetCreatorPrefix(creator string) []byte {
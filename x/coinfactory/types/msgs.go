@@ -6,6 +6,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	tokenfactorytypes "github.com/neutron-org/neutron/v11/x/tokenfactory/types"
 )
 
 // constants
@@ -17,6 +19,14 @@ const (
 	TypeMsgChangeAdmin       = "change_admin"
 	TypeMsgSetDenomMetadata  = "set_denom_metadata"
 	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgSetMintLimits     = "set_mint_limits"
+	TypeMsgGrantRole         = "grant_role"
+	TypeMsgRevokeRole        = "revoke_role"
+	TypeMsgSetFrozen         = "set_frozen"
+	TypeMsgSetPaused         = "set_paused"
+	TypeMsgMigrateDenom      = "migrate_tokenfactory_denom"
+	TypeMsgSetConversion     = "set_conversion_directions"
+	TypeMsgConvert           = "convert"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -317,3 +327,324 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetMintLimits{}
+
+// NewMsgSetMintLimits creates a message to set the max supply and the mint schedule of a denom
+func NewMsgSetMintLimits(sender, denom string, maxSupply *math.Int, mintSchedule []MintScheduleEntry) *MsgSetMintLimits {
+	return &MsgSetMintLimits{
+		Sender:       sender,
+		Denom:        denom,
+		MaxSupply:    maxSupply,
+		MintSchedule: mintSchedule,
+	}
+}
+
+func (m MsgSetMintLimits) Route() string { return RouterKey }
+func (m MsgSetMintLimits) Type() string  { return TypeMsgSetMintLimits }
+func (m MsgSetMintLimits) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.MaxSupply == nil && len(m.MintSchedule) == 0 {
+		return errorsmod.Wrap(ErrInvalidMintLimits, "either max supply or mint schedule must be set")
+	}
+
+	if m.MaxSupply != nil && !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply must be positive, got %s", m.MaxSupply)
+	}
+
+	return ValidateMintSchedule(m.MintSchedule)
+}
+
+func (m MsgSetMintLimits) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetMintLimits) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to grant a role over a denom
+func NewMsgGrantRole(sender, denom, address string, role Role, mintAllowance *math.Int) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:        sender,
+		Denom:         denom,
+		Address:       address,
+		Role:          role,
+		MintAllowance: mintAllowance,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRoleGrant(m.Role, m.MintAllowance)
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a role over a denom
+func NewMsgRevokeRole(sender, denom, address string, role Role) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Role:    role,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid grantee address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return ValidateRoleGrant(m.Role, nil)
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetFrozen{}
+
+// NewMsgSetFrozen creates a message to freeze or unfreeze an address for a denom
+func NewMsgSetFrozen(sender, denom, address string, frozen bool) *MsgSetFrozen {
+	return &MsgSetFrozen{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgSetFrozen) Route() string { return RouterKey }
+func (m MsgSetFrozen) Type() string  { return TypeMsgSetFrozen }
+func (m MsgSetFrozen) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid frozen address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetFrozen) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetFrozen) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPaused{}
+
+// NewMsgSetPaused creates a message to pause or resume the transfers of a denom
+func NewMsgSetPaused(sender, denom string, paused bool) *MsgSetPaused {
+	return &MsgSetPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetPaused) Route() string { return RouterKey }
+func (m MsgSetPaused) Type() string  { return TypeMsgSetPaused }
+func (m MsgSetPaused) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetPaused) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetPaused) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMigrateTokenfactoryDenom{}
+
+// NewMsgMigrateTokenfactoryDenom creates a message to migrate a tokenfactory denom to a new coinfactory denom
+func NewMsgMigrateTokenfactoryDenom(sender, tokenfactoryDenom, subdenom string, toCoinfactory, toTokenfactory bool) *MsgMigrateTokenfactoryDenom {
+	return &MsgMigrateTokenfactoryDenom{
+		Sender:                sender,
+		TokenfactoryDenom:     tokenfactoryDenom,
+		Subdenom:              subdenom,
+		ToCoinfactoryEnabled:  toCoinfactory,
+		ToTokenfactoryEnabled: toTokenfactory,
+	}
+}
+
+func (m MsgMigrateTokenfactoryDenom) Route() string { return RouterKey }
+func (m MsgMigrateTokenfactoryDenom) Type() string  { return TypeMsgMigrateDenom }
+func (m MsgMigrateTokenfactoryDenom) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = tokenfactorytypes.DeconstructDenom(m.TokenfactoryDenom)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenom, "invalid tokenfactory denom (%s)", err)
+	}
+
+	_, err = GetTokenDenom(m.Sender, m.Subdenom)
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgMigrateTokenfactoryDenom) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgMigrateTokenfactoryDenom) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetConversionDirections{}
+
+// NewMsgSetConversionDirections creates a message to enable or disable the conversions of a migrated denom
+func NewMsgSetConversionDirections(sender, denom string, toCoinfactory, toTokenfactory bool) *MsgSetConversionDirections {
+	return &MsgSetConversionDirections{
+		Sender:                sender,
+		Denom:                 denom,
+		ToCoinfactoryEnabled:  toCoinfactory,
+		ToTokenfactoryEnabled: toTokenfactory,
+	}
+}
+
+func (m MsgSetConversionDirections) Route() string { return RouterKey }
+func (m MsgSetConversionDirections) Type() string  { return TypeMsgSetConversion }
+func (m MsgSetConversionDirections) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetConversionDirections) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetConversionDirections) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgConvert{}
+
+// NewMsgConvert creates a message to convert a migrated denom to its linked denom
+func NewMsgConvert(sender string, amount sdk.Coin) *MsgConvert {
+	return &MsgConvert{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+func (m MsgConvert) Route() string { return RouterKey }
+func (m MsgConvert) Type() string  { return TypeMsgConvert }
+func (m MsgConvert) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if !m.Amount.IsValid() || m.Amount.Amount.Equal(math.ZeroInt()) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, m.Amount.String())
+	}
+
+	return nil
+}
+
+func (m MsgConvert) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgConvert) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
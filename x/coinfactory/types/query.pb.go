@@ -5,7 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -504,6 +508,437 @@ func (m *QueryFullDenomResponse) GetFullDenom() string {
 	return ""
 }
 
+// QueryDenomMintLimitsRequest defines the request structure for the
+// DenomMintLimits gRPC query.
+type QueryDenomMintLimitsRequest struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Subdenom string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty" yaml:"subdenom"`
+}
+
+func (m *QueryDenomMintLimitsRequest) Reset()         { *m = QueryDenomMintLimitsRequest{} }
+func (m *QueryDenomMintLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitsRequest) ProtoMessage()    {}
+func (*QueryDenomMintLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{10}
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitsRequest.Merge(m, src)
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitsRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomMintLimitsRequest) GetSubdenom() string {
+	if m != nil {
+		return m.Subdenom
+	}
+	return ""
+}
+
+// QueryDenomMintLimitsResponse defines the response structure for the
+// DenomMintLimits gRPC query.
+type QueryDenomMintLimitsResponse struct {
+	// max_supply is unset if the supply isn't capped
+	MaxSupply    *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty" yaml:"max_supply"`
+	MintSchedule []MintScheduleEntry    `protobuf:"bytes,2,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule" yaml:"mint_schedule"`
+	Supply       types.Coin             `protobuf:"bytes,3,opt,name=supply,proto3" json:"supply" yaml:"supply"`
+	// mintable is the amount that can be minted at the current block time,
+	// unset if the minting isn't limited
+	Mintable *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mintable,proto3,customtype=cosmossdk.io/math.Int" json:"mintable,omitempty" yaml:"mintable"`
+}
+
+func (m *QueryDenomMintLimitsResponse) Reset()         { *m = QueryDenomMintLimitsResponse{} }
+func (m *QueryDenomMintLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitsResponse) ProtoMessage()    {}
+func (*QueryDenomMintLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{11}
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitsResponse.Merge(m, src)
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitsResponse) GetMintSchedule() []MintScheduleEntry {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
+func (m *QueryDenomMintLimitsResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+// QueryDenomRolesRequest defines the request structure for the DenomRoles
+// gRPC query.
+type QueryDenomRolesRequest struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Subdenom string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty" yaml:"subdenom"`
+}
+
+func (m *QueryDenomRolesRequest) Reset()         { *m = QueryDenomRolesRequest{} }
+func (m *QueryDenomRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRolesRequest) ProtoMessage()    {}
+func (*QueryDenomRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{12}
+}
+func (m *QueryDenomRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRolesRequest.Merge(m, src)
+}
+func (m *QueryDenomRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRolesRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRolesRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomRolesRequest) GetSubdenom() string {
+	if m != nil {
+		return m.Subdenom
+	}
+	return ""
+}
+
+// QueryDenomRolesResponse defines the response structure for the DenomRoles
+// gRPC query.
+type QueryDenomRolesResponse struct {
+	Roles []DenomRole `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles" yaml:"roles"`
+}
+
+func (m *QueryDenomRolesResponse) Reset()         { *m = QueryDenomRolesResponse{} }
+func (m *QueryDenomRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRolesResponse) ProtoMessage()    {}
+func (*QueryDenomRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{13}
+}
+func (m *QueryDenomRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRolesResponse.Merge(m, src)
+}
+func (m *QueryDenomRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomRolesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomRolesResponse) GetRoles() []DenomRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// QueryFrozenAddressesRequest defines the request structure for the
+// FrozenAddresses gRPC query.
+type QueryFrozenAddressesRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Subdenom   string             `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty" yaml:"subdenom"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenAddressesRequest) Reset()         { *m = QueryFrozenAddressesRequest{} }
+func (m *QueryFrozenAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAddressesRequest) ProtoMessage()    {}
+func (*QueryFrozenAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{14}
+}
+func (m *QueryFrozenAddressesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAddressesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAddressesRequest.Merge(m, src)
+}
+func (m *QueryFrozenAddressesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAddressesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAddressesRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenAddressesRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryFrozenAddressesRequest) GetSubdenom() string {
+	if m != nil {
+		return m.Subdenom
+	}
+	return ""
+}
+
+func (m *QueryFrozenAddressesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFrozenAddressesResponse defines the response structure for the
+// FrozenAddresses gRPC query.
+type QueryFrozenAddressesResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+	Paused     bool                `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFrozenAddressesResponse) Reset()         { *m = QueryFrozenAddressesResponse{} }
+func (m *QueryFrozenAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAddressesResponse) ProtoMessage()    {}
+func (*QueryFrozenAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{15}
+}
+func (m *QueryFrozenAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAddressesResponse.Merge(m, src)
+}
+func (m *QueryFrozenAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAddressesResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAddressesResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *QueryFrozenAddressesResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryFrozenAddressesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDenomLinkRequest defines the request structure for the DenomLink gRPC
+// query.
+type QueryDenomLinkRequest struct {
+	// denom is either the migrated tokenfactory denom or its coinfactory
+	// successor.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomLinkRequest) Reset()         { *m = QueryDenomLinkRequest{} }
+func (m *QueryDenomLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomLinkRequest) ProtoMessage()    {}
+func (*QueryDenomLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{16}
+}
+func (m *QueryDenomLinkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomLinkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomLinkRequest.Merge(m, src)
+}
+func (m *QueryDenomLinkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomLinkRequest proto.InternalMessageInfo
+
+func (m *QueryDenomLinkRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomLinkResponse defines the response structure for the DenomLink
+// gRPC query.
+type QueryDenomLinkResponse struct {
+	Link DenomLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link" yaml:"link"`
+}
+
+func (m *QueryDenomLinkResponse) Reset()         { *m = QueryDenomLinkResponse{} }
+func (m *QueryDenomLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomLinkResponse) ProtoMessage()    {}
+func (*QueryDenomLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9669bd482619f7a, []int{17}
+}
+func (m *QueryDenomLinkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomLinkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomLinkResponse.Merge(m, src)
+}
+func (m *QueryDenomLinkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomLinkResponse proto.InternalMessageInfo
+
+func (m *QueryDenomLinkResponse) GetLink() DenomLink {
+	if m != nil {
+		return m.Link
+	}
+	return DenomLink{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.coinfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.coinfactory.v1beta1.QueryParamsResponse")
@@ -515,6 +950,14 @@ func init() {
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "neutron.coinfactory.v1beta1.QueryBeforeSendHookAddressResponse")
 	proto.RegisterType((*QueryFullDenomRequest)(nil), "neutron.coinfactory.v1beta1.QueryFullDenomRequest")
 	proto.RegisterType((*QueryFullDenomResponse)(nil), "neutron.coinfactory.v1beta1.QueryFullDenomResponse")
+	proto.RegisterType((*QueryDenomMintLimitsRequest)(nil), "neutron.coinfactory.v1beta1.QueryDenomMintLimitsRequest")
+	proto.RegisterType((*QueryDenomMintLimitsResponse)(nil), "neutron.coinfactory.v1beta1.QueryDenomMintLimitsResponse")
+	proto.RegisterType((*QueryDenomRolesRequest)(nil), "neutron.coinfactory.v1beta1.QueryDenomRolesRequest")
+	proto.RegisterType((*QueryDenomRolesResponse)(nil), "neutron.coinfactory.v1beta1.QueryDenomRolesResponse")
+	proto.RegisterType((*QueryFrozenAddressesRequest)(nil), "neutron.coinfactory.v1beta1.QueryFrozenAddressesRequest")
+	proto.RegisterType((*QueryFrozenAddressesResponse)(nil), "neutron.coinfactory.v1beta1.QueryFrozenAddressesResponse")
+	proto.RegisterType((*QueryDenomLinkRequest)(nil), "neutron.coinfactory.v1beta1.QueryDenomLinkRequest")
+	proto.RegisterType((*QueryDenomLinkResponse)(nil), "neutron.coinfactory.v1beta1.QueryDenomLinkResponse")
 }
 
 func init() {
@@ -522,54 +965,89 @@ func init() {
 }
 
 var fileDescriptor_d9669bd482619f7a = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0xf0, 0xfd, 0x5a, 0xe9, 0x28, 0x9a, 0x0e, 0x3f, 0x82, 0x45, 0x5b, 0x18, 0x62, 0xc4,
-	0xa8, 0x5d, 0x4b, 0x8d, 0x89, 0xf8, 0x03, 0xa9, 0x86, 0x98, 0x10, 0x0c, 0x6c, 0xe3, 0x41, 0x3d,
-	0x34, 0xd3, 0xee, 0xb4, 0x34, 0xb4, 0x3b, 0x65, 0x76, 0x96, 0xd8, 0x10, 0x2e, 0x18, 0xef, 0x26,
-	0x5e, 0xfc, 0x27, 0xbc, 0xf8, 0x57, 0xe0, 0x8d, 0xc4, 0x8b, 0xa7, 0x8d, 0x01, 0xff, 0x82, 0x7a,
-	0xf1, 0x68, 0x3a, 0x33, 0xdb, 0x02, 0x2d, 0x0b, 0x96, 0x84, 0x5b, 0x3b, 0xef, 0xbd, 0xcf, 0x8f,
-	0x79, 0xf3, 0x5e, 0x16, 0xde, 0xb0, 0xa9, 0x2b, 0x38, 0xb3, 0x8d, 0x02, 0x2b, 0xdb, 0x45, 0x52,
-	0x10, 0x8c, 0xd7, 0x8d, 0xf5, 0x54, 0x9e, 0x0a, 0x92, 0x32, 0xd6, 0x5c, 0xca, 0xeb, 0xc9, 0x1a,
-	0x67, 0x82, 0xa1, 0x31, 0x9d, 0x98, 0xdc, 0x97, 0x98, 0xd4, 0x89, 0xb1, 0xa1, 0x12, 0x2b, 0x31,
-	0x99, 0x67, 0x34, 0x7f, 0xa9, 0x92, 0xd8, 0xd5, 0x12, 0x63, 0xa5, 0x0a, 0x35, 0x48, 0xad, 0x6c,
-	0x10, 0xdb, 0x66, 0x82, 0x88, 0x32, 0xb3, 0x1d, 0x1d, 0x1d, 0xef, 0xc6, 0x5c, 0x23, 0x9c, 0x54,
-	0xfd, 0x8c, 0x74, 0x90, 0x36, 0xe2, 0x8a, 0x15, 0xc6, 0xcb, 0xa2, 0xbe, 0x48, 0x05, 0xb1, 0x88,
-	0x20, 0xaa, 0x08, 0x0f, 0x41, 0xb4, 0xdc, 0x94, 0xbd, 0x24, 0x91, 0x4c, 0xba, 0xe6, 0x52, 0x47,
-	0xe0, 0x25, 0x38, 0x78, 0xe0, 0xd4, 0xa9, 0x31, 0xdb, 0xa1, 0xe8, 0x01, 0x0c, 0x2b, 0xc6, 0x51,
-	0x30, 0x0e, 0xa6, 0x2e, 0x4c, 0x8f, 0x25, 0xbb, 0xb9, 0x54, 0x45, 0x99, 0xff, 0xb7, 0xbd, 0x44,
-	0xc8, 0xd4, 0x05, 0xf8, 0x3d, 0x80, 0x58, 0x42, 0x3e, 0xa7, 0x36, 0xab, 0xce, 0x1d, 0x56, 0xa3,
-	0x89, 0xd1, 0x6d, 0x78, 0xbe, 0xc0, 0x29, 0x11, 0x8c, 0x4b, 0x8a, 0x48, 0x06, 0x35, 0xbc, 0xc4,
-	0xa5, 0x3a, 0xa9, 0x56, 0x66, 0xb0, 0x0e, 0x60, 0xd3, 0x4f, 0x41, 0x06, 0xec, 0x77, 0xdc, 0xbc,
-	0xd5, 0x44, 0x1c, 0xed, 0x93, 0xe9, 0x83, 0x0d, 0x2f, 0x71, 0x59, 0xa5, 0xfb, 0x11, 0x6c, 0xb6,
-	0x92, 0xf0, 0x17, 0x00, 0x27, 0x03, 0x55, 0x68, 0xa3, 0x1f, 0x00, 0x44, 0xad, 0x1b, 0xcb, 0x55,
-	0x75, 0x58, 0xbb, 0x4e, 0x27, 0x03, 0x7a, 0x9b, 0xec, 0x8e, 0x9c, 0x99, 0x68, 0xde, 0x46, 0xc3,
-	0x4b, 0x5c, 0x51, 0xe2, 0x3a, 0xc1, 0xb1, 0x19, 0xed, 0xe8, 0x11, 0x5e, 0x84, 0xd7, 0xda, 0x72,
-	0x9d, 0x79, 0xce, 0xaa, 0xcf, 0x94, 0xf5, 0x9e, 0xee, 0x0b, 0x2f, 0xc0, 0xf8, 0x51, 0x70, 0xda,
-	0xf8, 0x4d, 0x18, 0x96, 0x37, 0xd5, 0xec, 0xf0, 0x7f, 0x53, 0x91, 0x4c, 0xb4, 0xe1, 0x25, 0x06,
-	0x14, 0x9c, 0x3a, 0xc7, 0xa6, 0x4e, 0xc0, 0x5b, 0x00, 0x4e, 0x48, 0xb4, 0x0c, 0x2d, 0x32, 0x4e,
-	0xb3, 0xd4, 0xb6, 0x5e, 0x30, 0xb6, 0x3a, 0x67, 0x59, 0x9c, 0x3a, 0xce, 0x19, 0x35, 0xb4, 0xa0,
-	0x5f, 0xd5, 0x11, 0x1a, 0xb4, 0xab, 0xc7, 0x70, 0xa0, 0xc0, 0x6c, 0xc1, 0x49, 0x41, 0xe4, 0x88,
-	0x65, 0xf9, 0x52, 0x46, 0x1b, 0x5e, 0x62, 0x48, 0x4b, 0xd9, 0x1f, 0xc6, 0xe6, 0x45, 0xff, 0x7f,
-	0x13, 0x09, 0xaf, 0xc3, 0x61, 0x49, 0x32, 0xef, 0x56, 0x2a, 0xf2, 0xea, 0xce, 0xc8, 0xdc, 0x4b,
-	0x38, 0x72, 0x98, 0x57, 0x1b, 0xba, 0x07, 0x61, 0xd1, 0xad, 0x54, 0x72, 0x0a, 0x4c, 0x71, 0x0f,
-	0x37, 0xbc, 0x44, 0x54, 0x81, 0xb5, 0x63, 0xd8, 0x8c, 0x14, 0xfd, 0xea, 0xe9, 0xaf, 0xfd, 0xf0,
-	0x9c, 0x04, 0x44, 0x9f, 0x01, 0x0c, 0xab, 0x31, 0x45, 0x46, 0xe0, 0x6b, 0xee, 0xdc, 0x0d, 0xb1,
-	0xbb, 0x27, 0x2f, 0x50, 0x6a, 0xf1, 0xad, 0xad, 0xef, 0xbf, 0x3e, 0xf5, 0x5d, 0x47, 0x93, 0x46,
-	0xd0, 0x86, 0x52, 0x8b, 0x02, 0xfd, 0x01, 0x70, 0xa4, 0xfb, 0x0c, 0xa1, 0xd9, 0xe3, 0x99, 0x03,
-	0xb7, 0x4b, 0xec, 0x69, 0xef, 0x00, 0xda, 0xca, 0x5b, 0x69, 0xe5, 0x15, 0xca, 0x06, 0x5a, 0x51,
-	0x13, 0x62, 0xf8, 0xc7, 0x1b, 0xfa, 0x05, 0x6c, 0x1a, 0x1b, 0x7e, 0x6f, 0x37, 0x8d, 0xce, 0x0d,
-	0x80, 0x76, 0x00, 0x8c, 0x76, 0x8c, 0x26, 0x9a, 0x39, 0xa1, 0xe8, 0x2e, 0xeb, 0x21, 0xf6, 0xb0,
-	0xa7, 0x5a, 0xed, 0x35, 0x23, 0xbd, 0x3e, 0x42, 0x33, 0x27, 0xf0, 0x9a, 0x2b, 0x72, 0x56, 0xcd,
-	0x69, 0x9f, 0x6d, 0xc3, 0xe8, 0x37, 0x80, 0xc3, 0x5d, 0x67, 0x13, 0x3d, 0x39, 0x5e, 0x5a, 0xd0,
-	0x62, 0x89, 0xcd, 0xf6, 0x5c, 0xaf, 0xed, 0xbd, 0x96, 0xf6, 0xb2, 0x68, 0xf9, 0xd4, 0xad, 0xcc,
-	0x4b, 0x9e, 0x9c, 0x43, 0x6d, 0x2b, 0xb7, 0xc2, 0xd8, 0x2a, 0xfa, 0x06, 0x60, 0xa4, 0x35, 0xb4,
-	0x68, 0xfa, 0x78, 0xa5, 0x87, 0x37, 0x4b, 0x2c, 0xfd, 0x4f, 0x35, 0xda, 0x51, 0x56, 0x3a, 0x5a,
-	0x44, 0x0b, 0xa7, 0x76, 0xd4, 0x5e, 0x20, 0x99, 0xa5, 0xed, 0xdd, 0x38, 0xd8, 0xd9, 0x8d, 0x83,
-	0x9f, 0xbb, 0x71, 0xf0, 0x71, 0x2f, 0x1e, 0xda, 0xd9, 0x8b, 0x87, 0x7e, 0xec, 0xc5, 0x43, 0x6f,
-	0xee, 0x97, 0xca, 0x62, 0xc5, 0xcd, 0x27, 0x0b, 0xac, 0xea, 0x13, 0xde, 0x61, 0xbc, 0xd4, 0x22,
-	0x5f, 0x4f, 0xa5, 0x8c, 0x77, 0x07, 0x24, 0x88, 0x7a, 0x8d, 0x3a, 0xf9, 0xb0, 0xfc, 0xf2, 0x48,
-	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x82, 0xfb, 0xc6, 0x4c, 0x09, 0x00, 0x00,
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6f, 0x1b, 0x55,
+	0x17, 0xce, 0x34, 0x6d, 0xde, 0xfa, 0xa4, 0x69, 0x9a, 0xdb, 0x24, 0x6f, 0xea, 0x06, 0xbb, 0xbd,
+	0x15, 0xfd, 0x80, 0xd6, 0x43, 0xec, 0x0a, 0xd1, 0x02, 0xfd, 0x70, 0x21, 0x14, 0xb5, 0x29, 0xe9,
+	0x18, 0x16, 0x94, 0xc5, 0xe8, 0xda, 0xbe, 0x76, 0x46, 0x99, 0x99, 0xeb, 0xce, 0x8c, 0x4b, 0x4d,
+	0xe9, 0xa6, 0x88, 0x3d, 0x12, 0x9b, 0xfe, 0x01, 0x96, 0xec, 0xf8, 0x09, 0x08, 0xca, 0xae, 0x82,
+	0x0d, 0x42, 0xc8, 0x82, 0x16, 0x89, 0xbd, 0xd9, 0xb0, 0x44, 0x73, 0xef, 0x19, 0x8f, 0x63, 0x1b,
+	0xdb, 0x71, 0x50, 0x76, 0x9e, 0x7b, 0xce, 0x79, 0xce, 0x79, 0xce, 0xb9, 0x1f, 0x8f, 0x0c, 0xa7,
+	0x5c, 0x5e, 0x0f, 0x3c, 0xe1, 0xea, 0x25, 0x61, 0xb9, 0x15, 0x56, 0x0a, 0x84, 0xd7, 0xd0, 0xef,
+	0xad, 0x14, 0x79, 0xc0, 0x56, 0xf4, 0xbb, 0x75, 0xee, 0x35, 0x32, 0x35, 0x4f, 0x04, 0x82, 0x1c,
+	0x45, 0xc7, 0x4c, 0x87, 0x63, 0x06, 0x1d, 0x93, 0x2f, 0x95, 0x84, 0xef, 0x08, 0x5f, 0x2f, 0x32,
+	0x9f, 0xab, 0xa8, 0x36, 0x46, 0x8d, 0x55, 0x2d, 0x97, 0x05, 0x96, 0x70, 0x15, 0x50, 0x32, 0xd5,
+	0xe9, 0x1b, 0x79, 0x85, 0xa0, 0x68, 0x3f, 0xa2, 0xec, 0xa6, 0xfc, 0xd2, 0xd5, 0x07, 0x9a, 0xe6,
+	0xab, 0xa2, 0x2a, 0xd4, 0x7a, 0xf8, 0x0b, 0x57, 0x97, 0xab, 0x42, 0x54, 0x6d, 0xae, 0xb3, 0x9a,
+	0xa5, 0x33, 0xd7, 0x15, 0x81, 0xcc, 0x16, 0xc5, 0x1c, 0xeb, 0x47, 0xb0, 0xc6, 0x3c, 0xe6, 0x44,
+	0x1e, 0xb9, 0x41, 0x2d, 0x60, 0xf5, 0x60, 0x43, 0x78, 0x56, 0xd0, 0x58, 0xe3, 0x01, 0x2b, 0xb3,
+	0x80, 0x61, 0xd0, 0xd9, 0x41, 0x41, 0x65, 0xee, 0x0a, 0xc7, 0xb4, 0x2d, 0x77, 0x13, 0xbd, 0x07,
+	0x76, 0xd9, 0x13, 0x36, 0xc7, 0x5a, 0xe8, 0x3c, 0x90, 0xdb, 0x61, 0xfb, 0xd6, 0x65, 0x81, 0x06,
+	0xbf, 0x5b, 0xe7, 0x7e, 0x40, 0xd7, 0xe1, 0xf0, 0x96, 0x55, 0xbf, 0x26, 0x5c, 0x9f, 0x93, 0x0b,
+	0x30, 0xa5, 0x88, 0x2c, 0x69, 0xc7, 0xb4, 0xd3, 0xd3, 0xd9, 0xa3, 0x99, 0x7e, 0x33, 0x52, 0x41,
+	0xf9, 0xbd, 0x4f, 0x9a, 0xe9, 0x09, 0x03, 0x03, 0xe8, 0x67, 0x1a, 0x50, 0x09, 0xf9, 0x56, 0x58,
+	0xea, 0xd5, 0x6e, 0x92, 0x98, 0x98, 0x9c, 0x85, 0xff, 0x95, 0x3c, 0xce, 0x02, 0xe1, 0xc9, 0x14,
+	0x89, 0x3c, 0x69, 0x35, 0xd3, 0x07, 0x1b, 0xcc, 0xb1, 0x2f, 0x52, 0x34, 0x50, 0x23, 0x72, 0x21,
+	0x3a, 0xec, 0xf7, 0xeb, 0x45, 0x49, 0x7e, 0x69, 0x8f, 0x74, 0x3f, 0xdc, 0x6a, 0xa6, 0x67, 0x95,
+	0x7b, 0x64, 0xa1, 0x46, 0xdb, 0x89, 0x7e, 0xad, 0xc1, 0x89, 0x81, 0x55, 0x20, 0xd1, 0xcf, 0x35,
+	0x20, 0xed, 0x41, 0x98, 0x0e, 0x9a, 0x91, 0x75, 0x2e, 0x33, 0x60, 0x67, 0x66, 0xfa, 0x23, 0xe7,
+	0x8f, 0x87, 0xdd, 0x68, 0x35, 0xd3, 0x47, 0x54, 0x71, 0xbd, 0xe0, 0xd4, 0x98, 0xeb, 0x19, 0x3d,
+	0x5d, 0x83, 0x17, 0xe2, 0x72, 0xfd, 0x55, 0x4f, 0x38, 0xd7, 0x14, 0xf5, 0xb1, 0xfa, 0x45, 0x6f,
+	0x40, 0xea, 0xdf, 0xe0, 0x90, 0xf8, 0x19, 0x98, 0x92, 0x9d, 0x0a, 0x27, 0x3c, 0x79, 0x3a, 0x91,
+	0x9f, 0x6b, 0x35, 0xd3, 0x33, 0x0a, 0x4e, 0xad, 0x53, 0x03, 0x1d, 0xe8, 0x23, 0x0d, 0x8e, 0x4b,
+	0xb4, 0x3c, 0xaf, 0x08, 0x8f, 0x17, 0xb8, 0x5b, 0xbe, 0x2e, 0xc4, 0xe6, 0xd5, 0x72, 0xd9, 0xe3,
+	0xbe, 0xbf, 0x4b, 0x03, 0x2d, 0x01, 0x1d, 0x54, 0x03, 0xb2, 0x7a, 0x13, 0x66, 0x4a, 0xc2, 0x0d,
+	0x3c, 0x56, 0x0a, 0x4c, 0x56, 0x2e, 0x47, 0xa5, 0x2c, 0xb5, 0x9a, 0xe9, 0x79, 0x2c, 0xa5, 0xd3,
+	0x4c, 0x8d, 0x03, 0xd1, 0x77, 0x88, 0x44, 0xef, 0xc1, 0x82, 0x4c, 0xb2, 0x5a, 0xb7, 0x6d, 0xd9,
+	0xba, 0x5d, 0x22, 0x77, 0x0b, 0x16, 0xbb, 0xf3, 0x22, 0xa1, 0xf3, 0x00, 0x95, 0xba, 0x6d, 0x9b,
+	0x0a, 0x4c, 0xe5, 0x5e, 0x68, 0x35, 0xd3, 0x73, 0x0a, 0x2c, 0xb6, 0x51, 0x23, 0x51, 0x89, 0xa2,
+	0xe9, 0xa7, 0x70, 0x34, 0x1e, 0xff, 0x9a, 0xe5, 0x06, 0x37, 0x2d, 0xc7, 0x0a, 0x76, 0x6b, 0x54,
+	0x8f, 0x27, 0x61, 0xb9, 0x7f, 0x7a, 0x24, 0x65, 0x02, 0x38, 0xec, 0xbe, 0xe9, 0xd7, 0x6b, 0x35,
+	0xbb, 0x81, 0x25, 0x5c, 0x79, 0xd2, 0x4c, 0x6b, 0xbf, 0x34, 0xd3, 0x0b, 0xea, 0x5a, 0xf6, 0xcb,
+	0x9b, 0x19, 0x4b, 0xe8, 0x0e, 0x0b, 0x36, 0x32, 0xef, 0xba, 0x41, 0xcc, 0x38, 0x0e, 0xa4, 0x3f,
+	0x7e, 0x73, 0x0e, 0x94, 0x77, 0xe8, 0x62, 0x24, 0x1c, 0x76, 0xbf, 0x20, 0x2d, 0xe4, 0x2e, 0xcc,
+	0x38, 0x96, 0x1b, 0x98, 0x7e, 0x69, 0x83, 0x97, 0xeb, 0x36, 0x5f, 0xda, 0x73, 0x6c, 0xf2, 0xf4,
+	0x74, 0x36, 0x33, 0xf0, 0x3c, 0x87, 0x85, 0x16, 0x30, 0xe0, 0x6d, 0x37, 0xf0, 0x1a, 0xf9, 0x65,
+	0x3c, 0xca, 0xb8, 0x75, 0xb6, 0x40, 0x52, 0xe3, 0x80, 0xd3, 0x11, 0x40, 0xae, 0xc3, 0x14, 0xf2,
+	0x99, 0x94, 0x77, 0xc7, 0x91, 0x0c, 0x96, 0x16, 0x3e, 0x46, 0xed, 0x1c, 0xd7, 0x84, 0xe5, 0xe6,
+	0x17, 0x10, 0x76, 0x26, 0x6a, 0xa1, 0x64, 0x63, 0x60, 0x3c, 0xb9, 0x03, 0xfb, 0x43, 0x64, 0x56,
+	0xb4, 0xf9, 0xd2, 0x5e, 0xd9, 0x9b, 0x4b, 0xc3, 0x7a, 0x33, 0x1b, 0x17, 0x18, 0x86, 0x75, 0x77,
+	0xa6, 0x8d, 0x47, 0x3f, 0x86, 0xc5, 0x78, 0x32, 0x46, 0xf8, 0x3a, 0xec, 0xd2, 0x9e, 0x70, 0xe0,
+	0xff, 0x3d, 0x89, 0x71, 0x37, 0x18, 0xb0, 0x4f, 0xbe, 0x53, 0xf2, 0x22, 0x9a, 0xce, 0x9e, 0x1c,
+	0x7e, 0xe9, 0x86, 0xf1, 0xf9, 0x79, 0xec, 0xe2, 0x01, 0x95, 0x54, 0x42, 0x50, 0x43, 0x41, 0xd1,
+	0x6f, 0x35, 0x3c, 0x01, 0xab, 0x9e, 0xf8, 0x84, 0xbb, 0x78, 0x4d, 0xec, 0x16, 0x5b, 0xb2, 0x0a,
+	0x10, 0x8b, 0x13, 0xdc, 0x10, 0x27, 0xb7, 0x6c, 0x08, 0xa5, 0x7f, 0x22, 0x56, 0xeb, 0xac, 0xca,
+	0xb1, 0x34, 0xa3, 0x23, 0x92, 0x7e, 0xa7, 0xc1, 0x72, 0x7f, 0x1a, 0xd8, 0xbb, 0x2c, 0x24, 0x58,
+	0xb4, 0x88, 0x17, 0xf9, 0x7c, 0xab, 0x99, 0x3e, 0x84, 0x6f, 0x4f, 0x64, 0xa2, 0x46, 0xec, 0x16,
+	0xde, 0xfc, 0x35, 0x56, 0xf7, 0x79, 0x59, 0x72, 0xd9, 0xdf, 0x79, 0xf3, 0xab, 0x75, 0x6a, 0xa0,
+	0x03, 0x79, 0xa7, 0x0f, 0x8f, 0x53, 0x43, 0x79, 0xa8, 0xda, 0xb6, 0x10, 0xb9, 0x8c, 0x17, 0xab,
+	0x1c, 0xdf, 0x4d, 0xcb, 0xdd, 0x8c, 0x06, 0x71, 0x12, 0xf6, 0x75, 0x5e, 0x6d, 0x87, 0xe2, 0x81,
+	0x62, 0x53, 0x95, 0x99, 0x5a, 0xb0, 0xd8, 0x0d, 0x80, 0x2d, 0x78, 0x0f, 0xf6, 0x86, 0x72, 0x08,
+	0x9f, 0xec, 0x11, 0x76, 0x4f, 0x18, 0x9d, 0x3f, 0x8c, 0xbb, 0x67, 0x5a, 0x25, 0x0b, 0x11, 0xa8,
+	0x21, 0x81, 0xb2, 0x7f, 0x1e, 0x84, 0x7d, 0x32, 0x17, 0x79, 0xac, 0xc1, 0x94, 0xd2, 0x38, 0x44,
+	0x1f, 0x88, 0xdb, 0x2b, 0xac, 0x92, 0xaf, 0x8c, 0x1e, 0xa0, 0x88, 0xd0, 0x97, 0x1f, 0xfd, 0xf4,
+	0xc7, 0x97, 0x7b, 0x5e, 0x24, 0x27, 0xf4, 0x41, 0x92, 0x4e, 0xa9, 0x2c, 0xf2, 0xb7, 0x06, 0x8b,
+	0xfd, 0x05, 0x08, 0xb9, 0x3c, 0x3c, 0xf3, 0x40, 0x69, 0x96, 0xbc, 0x32, 0x3e, 0x00, 0x52, 0xf9,
+	0x48, 0x52, 0xf9, 0x80, 0x14, 0xf4, 0xa1, 0x5a, 0xd6, 0xd7, 0xa3, 0xe5, 0x07, 0x78, 0xdc, 0x1e,
+	0xea, 0x0f, 0xa2, 0x83, 0xf4, 0x50, 0xef, 0x95, 0x4f, 0xe4, 0xa9, 0x06, 0x73, 0x3d, 0xba, 0x86,
+	0x5c, 0x1c, 0xb1, 0xe8, 0x3e, 0xda, 0x2a, 0xf9, 0xfa, 0x58, 0xb1, 0xc8, 0x35, 0x2f, 0xb9, 0xbe,
+	0x41, 0x2e, 0x8e, 0xc0, 0xd5, 0xac, 0x78, 0xc2, 0x31, 0x91, 0x67, 0x4c, 0x98, 0xfc, 0xa5, 0xc1,
+	0x42, 0x5f, 0x61, 0x43, 0x2e, 0x0d, 0x2f, 0x6d, 0x90, 0x2a, 0x4b, 0x5e, 0x1e, 0x3b, 0x1e, 0xe9,
+	0x7d, 0x28, 0xe9, 0x15, 0xc8, 0xed, 0x1d, 0x8f, 0xb2, 0x28, 0xf3, 0x98, 0x3e, 0x77, 0xcb, 0xe6,
+	0x86, 0x10, 0x9b, 0xe4, 0x07, 0x0d, 0x12, 0x6d, 0xc5, 0x43, 0xb2, 0xc3, 0x2b, 0xed, 0x96, 0x65,
+	0xc9, 0xdc, 0xb6, 0x62, 0x90, 0x51, 0x41, 0x32, 0x5a, 0x23, 0x37, 0x76, 0xcc, 0x28, 0x56, 0x5f,
+	0xe4, 0x57, 0x0d, 0x66, 0xbb, 0xe4, 0x0e, 0x79, 0x6d, 0xc4, 0x6d, 0xd5, 0x23, 0xd0, 0x92, 0x17,
+	0xc6, 0x88, 0x44, 0x76, 0xef, 0x4b, 0x76, 0xb7, 0xc8, 0xcd, 0x1d, 0xb3, 0x93, 0x72, 0xc7, 0x56,
+	0x54, 0xbe, 0xd7, 0x00, 0xe2, 0xa7, 0x9b, 0xe4, 0x46, 0xac, 0xaf, 0x53, 0x61, 0x24, 0xcf, 0x6f,
+	0x2f, 0x08, 0xf9, 0xdc, 0x92, 0x7c, 0xae, 0x93, 0xd5, 0x1d, 0xf3, 0x91, 0xca, 0x80, 0xfc, 0xae,
+	0xc1, 0x6c, 0xd7, 0x6b, 0x3a, 0xca, 0xa0, 0xfa, 0xeb, 0x88, 0xe4, 0x85, 0x31, 0x22, 0xff, 0xf3,
+	0x83, 0x55, 0x91, 0x19, 0xcc, 0xf8, 0x85, 0xff, 0x4a, 0x83, 0x44, 0xfb, 0xa9, 0x1b, 0xe5, 0x60,
+	0x75, 0x3f, 0xcb, 0xc9, 0xdc, 0xb6, 0x62, 0x90, 0x91, 0x2e, 0x19, 0x9d, 0x21, 0xa7, 0xf4, 0xd1,
+	0xfe, 0xc1, 0xc8, 0xaf, 0x3f, 0x79, 0x96, 0xd2, 0x9e, 0x3e, 0x4b, 0x69, 0xbf, 0x3d, 0x4b, 0x69,
+	0x5f, 0x3c, 0x4f, 0x4d, 0x3c, 0x7d, 0x9e, 0x9a, 0xf8, 0xf9, 0x79, 0x6a, 0xe2, 0xce, 0xab, 0x55,
+	0x2b, 0xd8, 0xa8, 0x17, 0x33, 0x25, 0xe1, 0x44, 0x60, 0xe7, 0x84, 0x57, 0x6d, 0x03, 0xdf, 0x5b,
+	0x59, 0xd1, 0xef, 0x6f, 0x81, 0x0f, 0x1a, 0x35, 0xee, 0x17, 0xa7, 0xe4, 0x7f, 0x1d, 0xb9, 0x7f,
+	0x06, 0x00, 0xec, 0x08, 0xe2, 0xc1, 0x7c, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -599,6 +1077,18 @@ type QueryClient interface {
 	// FullDenom defines a gRPC query method for getting full denom name
 	// from the creator and subdenom strings.
 	FullDenom(ctx context.Context, in *QueryFullDenomRequest, opts ...grpc.CallOption) (*QueryFullDenomResponse, error)
+	// DenomMintLimits defines a gRPC query method for fetching the max supply
+	// and the mint schedule of a denom along with its current supply.
+	DenomMintLimits(ctx context.Context, in *QueryDenomMintLimitsRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitsResponse, error)
+	// DenomRoles defines a gRPC query method for fetching the roles granted
+	// over a denom.
+	DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error)
+	// FrozenAddresses defines a gRPC query method for fetching the addresses
+	// frozen for a denom and whether its transfers are paused.
+	FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error)
+	// DenomLink defines a gRPC query method for fetching the link between a
+	// migrated tokenfactory denom and its coinfactory successor by either denom.
+	DenomLink(ctx context.Context, in *QueryDenomLinkRequest, opts ...grpc.CallOption) (*QueryDenomLinkResponse, error)
 }
 
 type queryClient struct {
@@ -654,12 +1144,48 @@ func (c *queryClient) FullDenom(ctx context.Context, in *QueryFullDenomRequest,
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the coinfactory module's
-	// parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DenomAuthorityMetadata defines a gRPC query method for fetching
+func (c *queryClient) DenomMintLimits(ctx context.Context, in *QueryDenomMintLimitsRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitsResponse, error) {
+	out := new(QueryDenomMintLimitsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.coinfactory.v1beta1.Query/DenomMintLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomRoles(ctx context.Context, in *QueryDenomRolesRequest, opts ...grpc.CallOption) (*QueryDenomRolesResponse, error) {
+	out := new(QueryDenomRolesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.coinfactory.v1beta1.Query/DenomRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAddresses(ctx context.Context, in *QueryFrozenAddressesRequest, opts ...grpc.CallOption) (*QueryFrozenAddressesResponse, error) {
+	out := new(QueryFrozenAddressesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.coinfactory.v1beta1.Query/FrozenAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomLink(ctx context.Context, in *QueryDenomLinkRequest, opts ...grpc.CallOption) (*QueryDenomLinkResponse, error) {
+	out := new(QueryDenomLinkResponse)
+	err := c.cc.Invoke(ctx, "/neutron.coinfactory.v1beta1.Query/DenomLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the coinfactory module's
+	// parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DenomAuthorityMetadata defines a gRPC query method for fetching
 	// DenomAuthorityMetadata for a particular denom.
 	DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error)
 	// DenomsFromCreator defines a gRPC query method for fetching all
@@ -671,6 +1197,18 @@ type QueryServer interface {
 	// FullDenom defines a gRPC query method for getting full denom name
 	// from the creator and subdenom strings.
 	FullDenom(context.Context, *QueryFullDenomRequest) (*QueryFullDenomResponse, error)
+	// DenomMintLimits defines a gRPC query method for fetching the max supply
+	// and the mint schedule of a denom along with its current supply.
+	DenomMintLimits(context.Context, *QueryDenomMintLimitsRequest) (*QueryDenomMintLimitsResponse, error)
+	// DenomRoles defines a gRPC query method for fetching the roles granted
+	// over a denom.
+	DenomRoles(context.Context, *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error)
+	// FrozenAddresses defines a gRPC query method for fetching the addresses
+	// frozen for a denom and whether its transfers are paused.
+	FrozenAddresses(context.Context, *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error)
+	// DenomLink defines a gRPC query method for fetching the link between a
+	// migrated tokenfactory denom and its coinfactory successor by either denom.
+	DenomLink(context.Context, *QueryDenomLinkRequest) (*QueryDenomLinkResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -692,6 +1230,18 @@ func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req
 func (*UnimplementedQueryServer) FullDenom(ctx context.Context, req *QueryFullDenomRequest) (*QueryFullDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FullDenom not implemented")
 }
+func (*UnimplementedQueryServer) DenomMintLimits(ctx context.Context, req *QueryDenomMintLimitsRequest) (*QueryDenomMintLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintLimits not implemented")
+}
+func (*UnimplementedQueryServer) DenomRoles(ctx context.Context, req *QueryDenomRolesRequest) (*QueryDenomRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomRoles not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAddresses(ctx context.Context, req *QueryFrozenAddressesRequest) (*QueryFrozenAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAddresses not implemented")
+}
+func (*UnimplementedQueryServer) DenomLink(ctx context.Context, req *QueryDenomLinkRequest) (*QueryDenomLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomLink not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -787,6 +1337,79 @@ func _Query_FullDenom_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomMintLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.coinfactory.v1beta1.Query/DenomMintLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintLimits(ctx, req.(*QueryDenomMintLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.coinfactory.v1beta1.Query/DenomRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomRoles(ctx, req.(*QueryDenomRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.coinfactory.v1beta1.Query/FrozenAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAddresses(ctx, req.(*QueryFrozenAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.coinfactory.v1beta1.Query/DenomLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomLink(ctx, req.(*QueryDenomLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.coinfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -811,6 +1434,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FullDenom",
 			Handler:    _Query_FullDenom_Handler,
 		},
+		{
+			MethodName: "DenomMintLimits",
+			Handler:    _Query_DenomMintLimits_Handler,
+		},
+		{
+			MethodName: "DenomRoles",
+			Handler:    _Query_DenomRoles_Handler,
+		},
+		{
+			MethodName: "FrozenAddresses",
+			Handler:    _Query_FrozenAddresses_Handler,
+		},
+		{
+			MethodName: "DenomLink",
+			Handler:    _Query_DenomLink_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/coinfactory/v1beta1/query.proto",
@@ -1138,133 +1777,395 @@ func (m *QueryFullDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomMintLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryDenomMintLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomMintLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Subdenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomMintLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDenomMintLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomMintLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Mintable != nil {
+		{
+			size := m.Mintable.Size()
+			i -= size
+			if _, err := m.Mintable.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Subdenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDenomRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFullDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAddressesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomLinkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomLinkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomLinkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomLinkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomLinkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomLinkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	l = len(m.Subdenom)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -1272,26 +2173,1139 @@ func (m *QueryFullDenomRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryFullDenomResponse) Size() (n int) {
+func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.FullDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFullDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFullDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FullDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Mintable != nil {
+		l = m.Mintable.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomLinkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomLinkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Link.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFullDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFullDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFullDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryFullDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFullDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFullDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FullDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1314,12 +3328,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1341,7 +3419,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMintLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1364,17 +3442,120 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMintLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMintLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, MintScheduleEntry{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1384,22 +3565,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.Mintable = &v
+			if err := m.Mintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1424,7 +3608,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1447,10 +3631,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1538,7 +3722,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1561,15 +3745,15 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1596,7 +3780,8 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Roles = append(m.Roles, DenomRole{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1621,7 +3806,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1644,10 +3829,10 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1682,61 +3867,43 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1746,23 +3913,27 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1785,7 +3956,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1808,15 +3979,15 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1844,13 +4015,13 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1860,79 +4031,17 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subdenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Paused = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1942,23 +4051,27 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddr = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1981,7 +4094,7 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFullDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomLinkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,47 +4117,15 @@ func (m *QueryFullDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFullDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomLinkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFullDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomLinkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2072,7 +4153,7 @@ func (m *QueryFullDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2095,7 +4176,7 @@ func (m *QueryFullDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFullDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomLinkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,17 +4199,17 @@ func (m *QueryFullDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFullDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomLinkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFullDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomLinkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2138,23 +4219,24 @@ func (m *QueryFullDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FullDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// roleNames are the short names of the roles used by the CLI and the wasm bindings
+var roleNames = map[string]Role{
+	"minter":           ROLE_MINTER,
+	"burner":           ROLE_BURNER,
+	"force_transferer": ROLE_FORCE_TRANSFERER,
+	"metadata_setter":  ROLE_METADATA_SETTER,
+	"hook_setter":      ROLE_HOOK_SETTER,
+}
+
+// ParseRole returns the role by its short name, e.g. minter, or by its proto name, e.g. ROLE_MINTER
+func ParseRole(name string) (Role, error) {
+	if role, ok := roleNames[strings.ToLower(name)]; ok {
+		return role, nil
+	}
+	if role, ok := Role_value[strings.ToUpper(name)]; ok && Role(role).IsValid() {
+		return Role(role), nil
+	}
+	return ROLE_UNSPECIFIED, errorsmod.Wrapf(ErrInvalidRole, "unknown role: %s", name)
+}
+
+// IsValid checks whether the role can be granted
+func (r Role) IsValid() bool {
+	_, ok := Role_name[int32(r)]
+	return ok && r != ROLE_UNSPECIFIED
+}
+
+// Validate performs the stateless validation of the granted role
+func (r DenomRole) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid address (%s)", err)
+	}
+
+	return ValidateRoleGrant(r.Role, r.MintAllowance)
+}
+
+// ValidateRoleGrant checks that the role can be granted and that the mint allowance is only set for the minter role
+func ValidateRoleGrant(role Role, mintAllowance *math.Int) error {
+	if !role.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid role: %s", role)
+	}
+	if mintAllowance == nil {
+		return nil
+	}
+	if role != ROLE_MINTER {
+		return errorsmod.Wrapf(ErrInvalidRole, "mint allowance can only be set for the %s role", ROLE_MINTER)
+	}
+	if mintAllowance.IsNil() || mintAllowance.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid mint allowance: %s", mintAllowance)
+	}
+	return nil
+}
@@ -0,0 +1,507 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/coinfactory/v1beta1/roles.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role defines a permission over a coinfactory denom that the admin of the
+// denom can grant to other addresses.
+type Role int32
+
+const (
+	ROLE_UNSPECIFIED Role = 0
+	// mints the denom, within the mint allowance if it is set
+	ROLE_MINTER Role = 1
+	// burns the denom
+	ROLE_BURNER Role = 2
+	// force transfers the denom
+	ROLE_FORCE_TRANSFERER Role = 3
+	// sets the bank metadata of the denom
+	ROLE_METADATA_SETTER Role = 4
+	// sets the before send hook of the denom
+	ROLE_HOOK_SETTER Role = 5
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_MINTER",
+	2: "ROLE_BURNER",
+	3: "ROLE_FORCE_TRANSFERER",
+	4: "ROLE_METADATA_SETTER",
+	5: "ROLE_HOOK_SETTER",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":      0,
+	"ROLE_MINTER":           1,
+	"ROLE_BURNER":           2,
+	"ROLE_FORCE_TRANSFERER": 3,
+	"ROLE_METADATA_SETTER":  4,
+	"ROLE_HOOK_SETTER":      5,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8470c247fdea41ae, []int{0}
+}
+
+// DenomRole is a role over a coinfactory denom granted to an address.
+type DenomRole struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Role    Role   `protobuf:"varint,2,opt,name=role,proto3,enum=neutron.coinfactory.v1beta1.Role" json:"role,omitempty" yaml:"role"`
+	// mint_allowance is the amount the minter can still mint, unset for no
+	// limit. Only used by the minter role.
+	MintAllowance *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=mint_allowance,json=mintAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"mint_allowance,omitempty" yaml:"mint_allowance"`
+}
+
+func (m *DenomRole) Reset()         { *m = DenomRole{} }
+func (m *DenomRole) String() string { return proto.CompactTextString(m) }
+func (*DenomRole) ProtoMessage()    {}
+func (*DenomRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8470c247fdea41ae, []int{0}
+}
+func (m *DenomRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRole.Merge(m, src)
+}
+func (m *DenomRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRole proto.InternalMessageInfo
+
+func (m *DenomRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DenomRole) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("neutron.coinfactory.v1beta1.Role", Role_name, Role_value)
+	proto.RegisterType((*DenomRole)(nil), "neutron.coinfactory.v1beta1.DenomRole")
+}
+
+func init() {
+	proto.RegisterFile("neutron/coinfactory/v1beta1/roles.proto", fileDescriptor_8470c247fdea41ae)
+}
+
+var fileDescriptor_8470c247fdea41ae = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x41, 0x6e, 0xd3, 0x40,
+	0x14, 0xf5, 0xb4, 0x06, 0xd4, 0xa9, 0x48, 0x2d, 0x2b, 0x91, 0xd2, 0x20, 0xd9, 0xc5, 0x1b, 0x2a,
+	0x44, 0x6d, 0x05, 0x24, 0x16, 0xdd, 0x39, 0xcd, 0x44, 0x58, 0x40, 0x52, 0x4d, 0xdc, 0x0d, 0x1b,
+	0xcb, 0x71, 0x86, 0x34, 0xaa, 0x3d, 0xbf, 0xb2, 0xa7, 0x85, 0xdc, 0x80, 0x1d, 0x1c, 0x01, 0x89,
+	0x2b, 0x70, 0x88, 0x2e, 0x2b, 0x56, 0x88, 0x85, 0x85, 0x92, 0x0d, 0xeb, 0x88, 0x03, 0x20, 0x7b,
+	0xec, 0x8a, 0x6e, 0xba, 0xfb, 0xf3, 0xfe, 0x7b, 0xef, 0xff, 0x37, 0x33, 0xf8, 0x09, 0x67, 0x17,
+	0x22, 0x05, 0xee, 0x44, 0x30, 0xe7, 0xef, 0xc3, 0x48, 0x40, 0xba, 0x70, 0x2e, 0xbb, 0x13, 0x26,
+	0xc2, 0xae, 0x93, 0x42, 0xcc, 0x32, 0xfb, 0x3c, 0x05, 0x01, 0xfa, 0xa3, 0x8a, 0x68, 0xff, 0x47,
+	0xb4, 0x2b, 0x62, 0x67, 0x37, 0x82, 0x2c, 0x81, 0x2c, 0x28, 0xa9, 0x8e, 0x3c, 0x48, 0x5d, 0xa7,
+	0x39, 0x83, 0x19, 0x48, 0xbc, 0xa8, 0x24, 0x6a, 0xfd, 0x45, 0x78, 0xab, 0xcf, 0x38, 0x24, 0x14,
+	0x62, 0xa6, 0x3f, 0xc3, 0x0f, 0xc2, 0xe9, 0x34, 0x65, 0x59, 0xd6, 0x46, 0x7b, 0x68, 0x7f, 0xab,
+	0xa7, 0xaf, 0x73, 0xb3, 0xb1, 0x08, 0x93, 0xf8, 0xd0, 0xaa, 0x1a, 0x16, 0xad, 0x29, 0xfa, 0x00,
+	0xab, 0xc5, 0x62, 0xed, 0x8d, 0x3d, 0xb4, 0xdf, 0x78, 0xfe, 0xd8, 0xbe, 0x63, 0x31, 0xbb, 0xb0,
+	0xef, 0xed, 0xac, 0x73, 0x73, 0x5b, 0xba, 0x15, 0x42, 0x8b, 0x96, 0x7a, 0xfd, 0x0c, 0x37, 0x92,
+	0x39, 0x17, 0x41, 0x18, 0xc7, 0xf0, 0x21, 0xe4, 0x11, 0x6b, 0x6f, 0x96, 0xc3, 0xfb, 0x57, 0xb9,
+	0x89, 0x7e, 0xe5, 0x66, 0x4b, 0xe6, 0xc8, 0xa6, 0x67, 0xf6, 0x1c, 0x9c, 0x24, 0x14, 0xa7, 0xb6,
+	0xc7, 0xc5, 0x3a, 0x37, 0x5b, 0xd2, 0xeb, 0xb6, 0xd8, 0xfa, 0xf1, 0xfd, 0x00, 0x57, 0xc9, 0x3d,
+	0x2e, 0xe8, 0xc3, 0xa2, 0xed, 0xd6, 0xdd, 0x43, 0xf5, 0xcf, 0x57, 0x13, 0x3d, 0xfd, 0x8c, 0xb0,
+	0x5a, 0x26, 0x6e, 0x62, 0x8d, 0x8e, 0xde, 0x90, 0xe0, 0x64, 0x38, 0x3e, 0x26, 0x47, 0xde, 0xc0,
+	0x23, 0x7d, 0x4d, 0xd1, 0x77, 0xf0, 0x76, 0x89, 0xbe, 0xf5, 0x86, 0x3e, 0xa1, 0x1a, 0xba, 0x01,
+	0x7a, 0x27, 0x74, 0x48, 0xa8, 0xb6, 0xa1, 0xef, 0xe2, 0x56, 0x09, 0x0c, 0x46, 0xf4, 0x88, 0x04,
+	0x3e, 0x75, 0x87, 0xe3, 0x01, 0xa1, 0x84, 0x6a, 0x9b, 0x7a, 0x1b, 0x37, 0xa5, 0x98, 0xf8, 0x6e,
+	0xdf, 0xf5, 0xdd, 0x60, 0x4c, 0xfc, 0xc2, 0x45, 0xbd, 0x19, 0xf6, 0x6a, 0x34, 0x7a, 0x5d, 0xa3,
+	0xf7, 0x3a, 0xea, 0xa7, 0x6f, 0x86, 0xd2, 0x3b, 0xbe, 0x5a, 0x1a, 0xe8, 0x7a, 0x69, 0xa0, 0xdf,
+	0x4b, 0x03, 0x7d, 0x59, 0x19, 0xca, 0xf5, 0xca, 0x50, 0x7e, 0xae, 0x0c, 0xe5, 0xdd, 0xcb, 0xd9,
+	0x5c, 0x9c, 0x5e, 0x4c, 0xec, 0x08, 0x12, 0xa7, 0xba, 0xe2, 0x03, 0x48, 0x67, 0x75, 0xed, 0x5c,
+	0x76, 0xbb, 0xce, 0xc7, 0x5b, 0xdf, 0x46, 0x2c, 0xce, 0x59, 0x36, 0xb9, 0x5f, 0xbe, 0xf0, 0x8b,
+	0x7f, 0x03, 0x00, 0xf0, 0x81, 0xcf, 0x23, 0x5a, 0x02, 0x00, 0x00,
+}
+
+func (this *DenomRole) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomRole)
+	if !ok {
+		that2, ok := that.(DenomRole)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if that1.MintAllowance == nil {
+		if this.MintAllowance != nil {
+			return false
+		}
+	} else if !this.MintAllowance.Equal(*that1.MintAllowance) {
+		return false
+	}
+	return true
+}
+func (m *DenomRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintAllowance != nil {
+		{
+			size := m.MintAllowance.Size()
+			i -= size
+			if _, err := m.MintAllowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintRoles(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintRoles(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRoles(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoles(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoles(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRoles(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRoles(uint64(m.Role))
+	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 1 + l + sovRoles(uint64(l))
+	}
+	return n
+}
+
+func sovRoles(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoles(x uint64) (n int) {
+	return sovRoles(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoles
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoles
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoles
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoles
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MintAllowance = &v
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoles(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoles
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoles(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoles
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoles
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoles
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoles
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoles
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoles
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoles        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoles          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoles = fmt.Errorf("proto: unexpected end of group")
+)
@@ -25,6 +25,9 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdFailures())
 	cmd.AddCommand(CmdFailureDetails())
+	cmd.AddCommand(CmdFailuresCount())
+	cmd.AddCommand(CmdRetryPolicy())
+	cmd.AddCommand(CmdSudoGasLimit())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
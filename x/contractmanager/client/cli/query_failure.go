@@ -15,6 +15,18 @@ import (
 	contractmanagertypes "github.com/neutron-org/neutron/v11/x/contractmanager/types"
 )
 
+const (
+	FlagFailureKind = "kind"
+	FlagChannelID   = "channel-id"
+	FlagSequence    = "sequence"
+	FlagQueryID     = "query-id"
+	FlagMinHeight   = "min-height"
+	FlagMaxHeight   = "max-height"
+	FlagMinGasUsed  = "min-gas-used"
+
+	failureKindPrefix = "FAILURE_KIND_"
+)
+
 func CmdFailures() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "failures [address]",
@@ -39,6 +51,9 @@ func CmdFailures() *cobra.Command {
 				Address:    address,
 				Pagination: pageReq,
 			}
+			if err := readFailuresFilterFlags(cmd, params); err != nil {
+				return err
+			}
 
 			res, err := queryClient.Failures(cmd.Context(), params)
 			if err != nil {
@@ -49,12 +64,59 @@ func CmdFailures() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagFailureKind, "", "filter failures by kind, e.g. ica_ack, ica_timeout, transfer_ack, icq_result")
+	cmd.Flags().String(FlagChannelID, "", "filter failures by channel ID")
+	cmd.Flags().Uint64(FlagSequence, 0, "filter failures by packet sequence")
+	cmd.Flags().Uint64(FlagQueryID, 0, "filter failures by interchain query ID")
+	cmd.Flags().Uint64(FlagMinHeight, 0, "filter out failures created before the height")
+	cmd.Flags().Uint64(FlagMaxHeight, 0, "filter out failures created after the height")
+	cmd.Flags().Uint64(FlagMinGasUsed, 0, "filter out failures which sudo calls used less gas")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// readFailuresFilterFlags sets the failure filters of the request from the command flags.
+func readFailuresFilterFlags(cmd *cobra.Command, req *contractmanagertypes.QueryFailuresRequest) error {
+	kind, err := cmd.Flags().GetString(FlagFailureKind)
+	if err != nil {
+		return err
+	}
+	if kind != "" {
+		name := strings.ToUpper(kind)
+		if !strings.HasPrefix(name, failureKindPrefix) {
+			name = failureKindPrefix + name
+		}
+		value, ok := contractmanagertypes.FailureKind_value[name]
+		if !ok {
+			return fmt.Errorf("unknown failure kind %s", kind)
+		}
+		req.Kind = contractmanagertypes.FailureKind(value)
+	}
+
+	if req.ChannelId, err = cmd.Flags().GetString(FlagChannelID); err != nil {
+		return err
+	}
+	if req.Sequence, err = cmd.Flags().GetUint64(FlagSequence); err != nil {
+		return err
+	}
+	if req.QueryId, err = cmd.Flags().GetUint64(FlagQueryID); err != nil {
+		return err
+	}
+	if req.MinHeight, err = cmd.Flags().GetUint64(FlagMinHeight); err != nil {
+		return err
+	}
+	if req.MaxHeight, err = cmd.Flags().GetUint64(FlagMaxHeight); err != nil {
+		return err
+	}
+	if req.MinGasUsed, err = cmd.Flags().GetUint64(FlagMinGasUsed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // CmdFailureDetails returns the command handler for the failure's detailed error querying.
 func CmdFailureDetails() *cobra.Command {
 	cmd := &cobra.Command{
@@ -112,3 +174,30 @@ func CmdFailureDetails() *cobra.Command {
 
 	return cmd
 }
+
+// CmdFailuresCount returns the command handler for the contract's failures count querying.
+func CmdFailuresCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "failures-count [address]",
+		Short: "shows the number of failures of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := contractmanagertypes.NewQueryClient(clientCtx)
+			res, err := queryClient.AddressFailuresCount(cmd.Context(), &contractmanagertypes.QueryAddressFailuresCountRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
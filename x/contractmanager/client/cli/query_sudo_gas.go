@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	contractmanagertypes "github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+// CmdSudoGasLimit returns the command handler for the contract's sudo call gas limit querying.
+func CmdSudoGasLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sudo-gas-limit [address]",
+		Short: "shows the effective sudo call gas limit of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := contractmanagertypes.NewQueryClient(clientCtx)
+			res, err := queryClient.SudoGasLimit(cmd.Context(), &contractmanagertypes.QuerySudoGasLimitRequest{Address: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,9 +9,19 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	for _, elem := range genState.SudoGasGrants {
+		k.SaveSudoGasGrant(ctx, elem)
+	}
+	for _, elem := range genState.SudoGasPrepayments {
+		k.SaveSudoGasPrepayment(ctx, elem)
+	}
+	// Set all the retry policies
+	for _, elem := range genState.RetryPolicies {
+		k.SaveRetryPolicy(ctx, elem)
+	}
 	// Set all the failure
 	for _, elem := range genState.FailuresList {
-		k.AddContractFailure(ctx, elem.Address, elem.SudoPayload, elem.Error)
+		k.ImportFailure(ctx, elem)
 	}
 	// this line is used by starport scaffolding # genesis/module/init
 	err := k.SetParams(ctx, genState.Params)
@@ -26,6 +36,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.FailuresList = k.GetAllFailures(ctx)
+	genesis.RetryPolicies = k.GetAllRetryPolicies(ctx)
+	genesis.SudoGasGrants = k.GetAllSudoGasGrants(ctx)
+	genesis.SudoGasPrepayments = k.GetAllSudoGasPrepayments(ctx)
 
 	return genesis
 }
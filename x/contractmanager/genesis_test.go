@@ -49,7 +49,7 @@ func TestGenesis(t *testing.T) {
 		},
 	}
 
-	k, ctx := keepertest.ContractManagerKeeper(t, nil)
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
 	contractmanager.InitGenesis(ctx, *k, genesisState)
 	got := contractmanager.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
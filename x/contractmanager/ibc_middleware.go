@@ -26,15 +26,18 @@ func NewSudoLimitWrapper(contractManager contractmanagertypes.ContractManagerKee
 	}
 }
 
-// Sudo calls underlying Sudo handlers with a limited amount of gas
+// Sudo calls underlying Sudo handlers with a limited amount of gas, which is the contract's effective sudo call gas limit
 // in case of `out of gas` panic it converts the panic into an error and stops `out of gas` panic propagation
 // if error happens during the Sudo call, we store the data that raised the error, and return the error
 func (k SudoLimitWrapper) Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) (resp []byte, err error) {
 	c := sdk.UnwrapSDKContext(ctx)
 
-	cacheCtx, writeFn := createCachedContext(c, k.contractManager.GetParams(ctx).SudoCallGasLimit)
+	gasLimit := k.contractManager.GetSudoCallGasLimit(ctx, contractAddress)
+	// the fee for the prepaid gas is held before the call, so that the contract can't withdraw it during the call
+	heldFee := k.contractManager.ReserveSudoGas(ctx, contractAddress, gasLimit)
+	cacheCtx, writeFn := createCachedContext(c, gasLimit)
 	func() {
-		defer outOfGasRecovery(cacheCtx.GasMeter(), &err)
+		defer contractmanagerkeeper.OutOfGasRecovery(cacheCtx.GasMeter(), &err)
 		// Actually we have only one kind of error returned from acknowledgement
 		// maybe later we'll retrieve actual errors from events
 		resp, err = k.WasmKeeper.Sudo(cacheCtx, contractAddress, msg)
@@ -45,6 +48,7 @@ func (k SudoLimitWrapper) Sudo(ctx context.Context, contractAddress sdk.AccAddre
 			contractAddress.String(),
 			msg,
 			contractmanagerkeeper.RedactError(err).Error(),
+			cacheCtx.GasMeter().GasConsumedToLimit(),
 		)
 		c.EventManager().EmitEvents(sdk.Events{
 			sdk.NewEvent(
@@ -58,6 +62,7 @@ func (k SudoLimitWrapper) Sudo(ctx context.Context, contractAddress sdk.AccAddre
 		writeFn()
 	}
 
+	k.contractManager.ChargeSudoGas(ctx, contractAddress, cacheCtx.GasMeter().GasConsumedToLimit(), heldFee)
 	c.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "consume gas from cached context")
 	return resp, err
 }
@@ -66,21 +71,6 @@ func (k SudoLimitWrapper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", contractmanagertypes.ModuleName))
 }
 
-// outOfGasRecovery converts `out of gas` panic into an error
-// leaving unprocessed any other kinds of panics
-func outOfGasRecovery(
-	gasMeter types.GasMeter,
-	err *error,
-) {
-	if r := recover(); r != nil {
-		_, ok := r.(types.ErrorOutOfGas)
-		if !ok || !gasMeter.IsOutOfGas() {
-			panic(r)
-		}
-		*err = contractmanagertypes.ErrSudoOutOfGas
-	}
-}
-
 // createCachedContext creates a cached context with a limited gas meter.
 func createCachedContext(ctx sdk.Context, gasLimit uint64) (sdk.Context, func()) {
 	cacheCtx, writeFn := ctx.CacheContext()
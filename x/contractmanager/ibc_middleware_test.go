@@ -40,7 +40,9 @@ func TestSudo(t *testing.T) {
 
 	//  success during Sudo
 	ctx := infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
-	cmKeeper.EXPECT().GetParams(ctx).Return(types.Params{SudoCallGasLimit: 10000})
+	cmKeeper.EXPECT().GetSudoCallGasLimit(ctx, contractAddress).Return(uint64(10000))
+	cmKeeper.EXPECT().ReserveSudoGas(ctx, contractAddress, uint64(10000)).Return(sdk.Coin{})
+	cmKeeper.EXPECT().ChargeSudoGas(ctx, contractAddress, gomock.Any(), sdk.Coin{})
 	wmKeeper.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddress, msg).Do(func(cachedCtx sdk.Context, _ sdk.AccAddress, _ []byte) {
 		st := cachedCtx.KVStore(storeKey)
 		st.Set(ShouldBeWrittenKey("sudo"), ShouldBeWritten)
@@ -51,8 +53,10 @@ func TestSudo(t *testing.T) {
 
 	//  error during Sudo
 	ctx = infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
-	cmKeeper.EXPECT().GetParams(ctx).Return(types.Params{SudoCallGasLimit: 10000})
-	cmKeeper.EXPECT().AddContractFailure(ctx, contractAddress.String(), msg, contractmanagerkeeper.RedactError(wasmtypes.ErrExecuteFailed).Error())
+	cmKeeper.EXPECT().GetSudoCallGasLimit(ctx, contractAddress).Return(uint64(10000))
+	cmKeeper.EXPECT().ReserveSudoGas(ctx, contractAddress, uint64(10000)).Return(sdk.Coin{})
+	cmKeeper.EXPECT().ChargeSudoGas(ctx, contractAddress, gomock.Any(), sdk.Coin{})
+	cmKeeper.EXPECT().AddContractFailure(ctx, contractAddress.String(), msg, contractmanagerkeeper.RedactError(wasmtypes.ErrExecuteFailed).Error(), gomock.Any())
 	wmKeeper.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddress, msg).Do(func(cachedCtx sdk.Context, _ sdk.AccAddress, _ []byte) {
 		st := cachedCtx.KVStore(storeKey)
 		st.Set(ShouldNotBeWrittenKey, ShouldNotBeWritten)
@@ -63,13 +67,15 @@ func TestSudo(t *testing.T) {
 
 	// ou of gas during Sudo
 	ctx = infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
-	cmKeeper.EXPECT().GetParams(ctx).Return(types.Params{SudoCallGasLimit: 10000})
-	cmKeeper.EXPECT().AddContractFailure(ctx, contractAddress.String(), msg, contractmanagerkeeper.RedactError(types.ErrSudoOutOfGas).Error())
+	cmKeeper.EXPECT().GetSudoCallGasLimit(ctx, contractAddress).Return(uint64(10000))
+	cmKeeper.EXPECT().ReserveSudoGas(ctx, contractAddress, uint64(10000)).Return(sdk.Coin{})
+	cmKeeper.EXPECT().AddContractFailure(ctx, contractAddress.String(), msg, contractmanagerkeeper.RedactError(types.ErrSudoOutOfGas).Error(), uint64(10000))
 	wmKeeper.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddress, msg).Do(func(cachedCtx sdk.Context, _ sdk.AccAddress, _ []byte) {
 		st := cachedCtx.KVStore(storeKey)
 		st.Set(ShouldNotBeWrittenKey, ShouldNotBeWritten)
 		cachedCtx.GasMeter().ConsumeGas(10001, "heavy calculations")
 	})
+	cmKeeper.EXPECT().ChargeSudoGas(ctx, contractAddress, uint64(10000), sdk.Coin{})
 	_, err = middleware.Sudo(ctx, contractAddress, msg)
 	require.ErrorContains(t, err, types.ErrSudoOutOfGas.Error())
 	require.Nil(t, st.Get(ShouldNotBeWrittenKey))
@@ -7,6 +7,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,23 +24,85 @@ import (
 // Sudo method:
 // https://github.com/neutron-org/neutron/blob/eb8b5ae50907439ff9af0527a42ef0cb448a78b5/x/contractmanager/ibc_middleware.go#L42.
 // Another good way could be passing here some constant value.
-func (k Keeper) AddContractFailure(ctx context.Context, address string, sudoPayload []byte, errMsg string) types.Failure {
+//
+// The kind of the failure and the channel, packet sequence or interchain query ID it's related to
+// are decoded from the sudo payload and stored along with the gas used by the failed sudo call.
+func (k Keeper) AddContractFailure(ctx context.Context, address string, sudoPayload []byte, errMsg string, gasUsed uint64) types.Failure {
 	c := sdk.UnwrapSDKContext(ctx)
 
 	failure := types.Failure{
-		Address:     address,
-		SudoPayload: sudoPayload,
-		Error:       errMsg,
+		Address:       address,
+		SudoPayload:   sudoPayload,
+		Error:         errMsg,
+		CreatedHeight: uint64(c.BlockHeight()), //nolint:gosec
+		CreatedAt:     c.BlockTime(),
+		GasUsed:       gasUsed,
 	}
+	failure.SetSudoPayloadMetadata()
 	nextFailureID := k.GetNextFailureIDKey(ctx, failure.GetAddress())
 	failure.Id = nextFailureID
 
-	store := c.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&failure)
-	store.Set(types.GetFailureKey(failure.GetAddress(), nextFailureID), bz)
+	k.pruneFailuresOverLimit(c, address)
+	k.scheduleFailureRetry(c, &failure)
+	k.storeNewFailure(c, failure)
 	return failure
 }
 
+// ImportFailure stores the failure as is. It's used to import failures from genesis.
+func (k Keeper) ImportFailure(ctx sdk.Context, failure types.Failure) {
+	if failure.CreatedAt.IsZero() {
+		failure.CreatedHeight = uint64(ctx.BlockHeight()) //nolint:gosec
+		failure.CreatedAt = ctx.BlockTime()
+	}
+
+	if failure.NextRetryHeight != 0 {
+		ctx.KVStore(k.storeKey).Set(
+			types.GetFailureRetryQueueKey(failure.NextRetryHeight, failure.Address, failure.Id),
+			[]byte(failure.Address),
+		)
+	}
+	k.storeNewFailure(ctx, failure)
+}
+
+func (k Keeper) setFailure(ctx sdk.Context, failure types.Failure) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&failure)
+	store.Set(types.GetFailureKey(failure.GetAddress(), failure.Id), bz)
+}
+
+// storeNewFailure saves the failure, adds it to the expiry queue and increases the failures count
+// of the contract.
+func (k Keeper) storeNewFailure(ctx sdk.Context, failure types.Failure) {
+	k.setFailure(ctx, failure)
+	ctx.KVStore(k.storeKey).Set(
+		types.GetFailureExpiryQueueKey(failure.CreatedAt, failure.Address, failure.Id),
+		[]byte(failure.Address),
+	)
+	k.setFailuresCount(ctx, failure.Address, k.GetFailuresCount(ctx, failure.Address)+1)
+}
+
+// GetFailuresCount returns the number of stored failures of the contract.
+func (k Keeper) GetFailuresCount(ctx context.Context, address string) uint64 {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	bz := c.KVStore(k.storeKey).Get(types.GetFailuresCountKey(address))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setFailuresCount(ctx sdk.Context, address string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.GetFailuresCountKey(address))
+		return
+	}
+
+	store.Set(types.GetFailuresCountKey(address), sdk.Uint64ToBigEndian(count))
+}
+
 func (k Keeper) GetNextFailureIDKey(ctx context.Context, address string) uint64 {
 	c := sdk.UnwrapSDKContext(ctx)
 
@@ -105,10 +168,128 @@ func (k Keeper) resubmitFailure(ctx sdk.Context, contractAddr sdk.AccAddress, fa
 	return nil
 }
 
-func (k Keeper) removeFailure(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) {
+// removeFailure removes the failure along with its indexes. Returns false if there is no such
+// failure.
+func (k Keeper) removeFailure(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64) bool {
 	store := ctx.KVStore(k.storeKey)
 	failureKey := types.GetFailureKey(contractAddr.String(), id)
+
+	bz := store.Get(failureKey)
+	if bz == nil {
+		return false
+	}
+
+	var failure types.Failure
+	k.cdc.MustUnmarshal(bz, &failure)
+	k.unscheduleFailureRetry(ctx, &failure)
+	store.Delete(types.GetFailureExpiryQueueKey(failure.CreatedAt, failure.Address, failure.Id))
+	k.setFailuresCount(ctx, failure.Address, k.GetFailuresCount(ctx, failure.Address)-1)
+
 	store.Delete(failureKey)
+	return true
+}
+
+// pruneFailure removes the failure and emits an event with the reason of the removal.
+func (k Keeper) pruneFailure(ctx sdk.Context, contractAddr sdk.AccAddress, id uint64, reason string) {
+	if !k.removeFailure(ctx, contractAddr, id) {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFailurePruned,
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, contractAddr.String()),
+		sdk.NewAttribute(types.AttributeKeySudoFailureID, fmt.Sprintf("%d", id)),
+		sdk.NewAttribute(types.AttributeKeyPruneReason, reason),
+	))
+}
+
+// pruneFailuresOverLimit prunes the oldest failures of the contract to make room for a new one if
+// the contract reached the failures limit.
+func (k Keeper) pruneFailuresOverLimit(ctx sdk.Context, address string) {
+	maxFailures := k.GetParams(ctx).MaxFailuresPerContract
+	if maxFailures == 0 || k.GetFailuresCount(ctx, address) < maxFailures {
+		return
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		k.Logger(ctx).Error("pruneFailuresOverLimit: failed to parse contract address", "address", address, "error", err)
+		return
+	}
+
+	excess := k.GetFailuresCount(ctx, address) - maxFailures + 1
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFailureKeyPrefix(address))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	ids := make([]uint64, 0, excess)
+	for ; iterator.Valid() && uint64(len(ids)) < excess; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close() //nolint:errcheck
+
+	for _, id := range ids {
+		k.pruneFailure(ctx, contractAddr, id, types.PruneReasonLimitExceeded)
+	}
+}
+
+// PruneExpiredFailures removes the failures which retention period is over. At most
+// MaxFailuresPrunedPerBlock failures are removed, the rest are removed in the next blocks.
+func (k Keeper) PruneExpiredFailures(ctx sdk.Context) {
+	retentionPeriod := k.GetParams(ctx).FailureRetentionPeriod
+	if retentionPeriod == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.FailureExpiryQueueKey,
+		types.GetFailureExpiryQueueTimePrefix(ctx.BlockTime().Add(-retentionPeriod)),
+	)
+
+	type expiredFailure struct {
+		key     []byte
+		address string
+		id      uint64
+	}
+	var expired []expiredFailure
+	for ; iterator.Valid() && len(expired) < types.MaxFailuresPrunedPerBlock; iterator.Next() {
+		key := iterator.Key()
+		expired = append(expired, expiredFailure{
+			key:     key,
+			address: string(iterator.Value()),
+			id:      sdk.BigEndianToUint64(key[len(key)-8:]),
+		})
+	}
+	iterator.Close() //nolint:errcheck
+
+	for _, failure := range expired {
+		// the queue entry is removed even if the failure can't be, so that it's not stuck in the queue
+		store.Delete(failure.key)
+
+		contractAddr, err := sdk.AccAddressFromBech32(failure.address)
+		if err != nil {
+			k.Logger(ctx).Error("PruneExpiredFailures: failed to parse contract address", "address", failure.address, "error", err)
+			continue
+		}
+
+		k.pruneFailure(ctx, contractAddr, failure.id, types.PruneReasonExpired)
+	}
+}
+
+// OutOfGasRecovery converts `out of gas` panic into an error
+// leaving unprocessed any other kinds of panics
+func OutOfGasRecovery(
+	gasMeter storetypes.GasMeter,
+	err *error,
+) {
+	if r := recover(); r != nil {
+		_, ok := r.(storetypes.ErrorOutOfGas)
+		if !ok || !gasMeter.IsOutOfGas() {
+			panic(r)
+		}
+		*err = types.ErrSudoOutOfGas
+	}
 }
 
 // RedactError removes non-determenistic details from the error returning just codespace and core
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/neutron-org/neutron/v11/testutil/common/nullify"
 
@@ -54,7 +55,8 @@ func createNFailure(k *keeper.Keeper, ctx sdk.Context, addresses, failures int)
 			}
 			items[i][c].SudoPayload = sudo
 			items[i][c].Error = "test error"
-			k.AddContractFailure(ctx, items[i][c].Address, sudo, "test error")
+			items[i][c].PortId = p.SourcePort
+			k.AddContractFailure(ctx, items[i][c].Address, sudo, "test error", 0)
 		}
 	}
 	return items
@@ -75,7 +77,7 @@ func flattenFailures(items [][]types.Failure) []types.Failure {
 }
 
 func TestGetAllFailures(t *testing.T) {
-	k, ctx := keepertest.ContractManagerKeeper(t, nil)
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
 	items := createNFailure(k, ctx, 10, 4)
 	flattenItems := flattenFailures(items)
 
@@ -90,10 +92,10 @@ func TestGetAllFailures(t *testing.T) {
 func TestAddGetFailure(t *testing.T) {
 	// test adding and getting failure
 	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
-	k, ctx := keepertest.ContractManagerKeeper(t, nil)
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
 	failureID := k.GetNextFailureIDKey(ctx, contractAddress.String())
 	sudoPayload := []byte("payload")
-	k.AddContractFailure(ctx, contractAddress.String(), sudoPayload, "test error")
+	k.AddContractFailure(ctx, contractAddress.String(), sudoPayload, "test error", 0)
 	failure, err := k.GetFailure(ctx, contractAddress, failureID)
 	require.NoError(t, err)
 	require.Equal(t, failureID, failure.Id)
@@ -114,7 +116,7 @@ func TestResubmitFailure(t *testing.T) {
 	defer ctrl.Finish()
 
 	wk := mock_types.NewMockWasmKeeper(ctrl)
-	k, ctx := keepertest.ContractManagerKeeper(t, wk)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, nil)
 
 	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
 	data := []byte("Result")
@@ -130,7 +132,7 @@ func TestResubmitFailure(t *testing.T) {
 	failureID := k.GetNextFailureIDKey(ctx, contractAddr.String())
 	payload, err := keeper.PrepareSudoCallbackMessage(packet, &ack)
 	require.NoError(t, err)
-	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error")
+	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error", 0)
 
 	// success response
 	xSuc := types.MessageSudoCallback{Response: &types.ResponseSudoPayload{
@@ -170,7 +172,7 @@ func TestResubmitFailure(t *testing.T) {
 	failureID2 := k.GetNextFailureIDKey(ctx, contractAddr.String())
 	payload, err = keeper.PrepareSudoCallbackMessage(packet, &ack)
 	require.NoError(t, err)
-	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error")
+	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error", 0)
 
 	wk.EXPECT().Sudo(ctx, contractAddr, msgSuc).Return(nil, fmt.Errorf("failed to sudo"))
 	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
@@ -192,7 +194,7 @@ func TestResubmitFailure(t *testing.T) {
 	failureID3 := k.GetNextFailureIDKey(ctx, contractAddr.String())
 	payload, err = keeper.PrepareSudoCallbackMessage(packet, &ackError)
 	require.NoError(t, err)
-	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error")
+	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error", 0)
 
 	wk.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddr, msgErr).Return([]byte{}, nil)
 	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
@@ -212,7 +214,7 @@ func TestResubmitFailure(t *testing.T) {
 	failureID4 := k.GetNextFailureIDKey(ctx, contractAddr.String())
 	payload, err = keeper.PrepareSudoCallbackMessage(packet, &ackError)
 	require.NoError(t, err)
-	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error")
+	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error", 0)
 
 	wk.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddr, msgErr).Return(nil, fmt.Errorf("failed to sudo"))
 	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
@@ -234,7 +236,7 @@ func TestResubmitFailure(t *testing.T) {
 	failureID5 := k.GetNextFailureIDKey(ctx, contractAddr.String())
 	payload, err = keeper.PrepareSudoCallbackMessage(packet, nil)
 	require.NoError(t, err)
-	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error")
+	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error", 0)
 
 	wk.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddr, msgTimeout).Return([]byte{}, nil)
 	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
@@ -254,7 +256,7 @@ func TestResubmitFailure(t *testing.T) {
 	failureID6 := k.GetNextFailureIDKey(ctx, contractAddr.String())
 	payload, err = keeper.PrepareSudoCallbackMessage(packet, nil)
 	require.NoError(t, err)
-	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error")
+	k.AddContractFailure(ctx, contractAddr.String(), payload, "test error", 0)
 
 	wk.EXPECT().Sudo(gomock.AssignableToTypeOf(ctx), contractAddr, msgTimeout).Return(nil, fmt.Errorf("failed to sudo"))
 	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
@@ -271,3 +273,57 @@ func TestResubmitFailure(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, failureAfter6.Id, failure6.Id)
 }
+
+func TestPruneFailures(t *testing.T) {
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(startTime)
+
+	params := types.DefaultParams()
+	params.FailureRetentionPeriod = time.Hour
+	params.MaxFailuresPerContract = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		k.AddContractFailure(ctx, contractAddress.String(), []byte("payload"), "test error", 0)
+	}
+	require.Equal(t, uint64(3), k.GetFailuresCount(ctx, contractAddress.String()))
+
+	failure, err := k.GetFailure(ctx, contractAddress, 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), failure.CreatedHeight)
+	require.Equal(t, startTime.Add(time.Minute), failure.CreatedAt)
+
+	// the oldest failure is pruned once the limit is reached
+	ctx = ctx.WithBlockTime(startTime.Add(3 * time.Minute))
+	k.AddContractFailure(ctx, contractAddress.String(), []byte("payload"), "test error", 0)
+	require.Equal(t, uint64(3), k.GetFailuresCount(ctx, contractAddress.String()))
+	_, err = k.GetFailure(ctx, contractAddress, 0)
+	require.Error(t, err)
+
+	resp, err := k.AddressFailuresCount(ctx, &types.QueryAddressFailuresCountRequest{Address: contractAddress.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Count)
+
+	// nothing is expired yet
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	k.PruneExpiredFailures(ctx)
+	require.Equal(t, uint64(3), k.GetFailuresCount(ctx, contractAddress.String()))
+
+	// the failures created more than an hour ago are pruned
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour + 2*time.Minute + time.Second))
+	k.PruneExpiredFailures(ctx)
+	require.Equal(t, uint64(1), k.GetFailuresCount(ctx, contractAddress.String()))
+	_, err = k.GetFailure(ctx, contractAddress, 3)
+	require.NoError(t, err)
+
+	prunedEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeFailurePruned {
+			prunedEvents++
+		}
+	}
+	require.Equal(t, 3, prunedEvents)
+}
@@ -43,14 +43,21 @@ func (k Keeper) AddressFailures(c context.Context, req *types.QueryFailuresReque
 		failureStore = prefix.NewStore(store, types.ContractFailuresKey)
 	}
 
-	pageRes, err := query.Paginate(failureStore, req.Pagination, func(_, value []byte) error {
+	pageRes, err := query.FilteredPaginate(failureStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var failure types.Failure
 		if err := k.cdc.Unmarshal(value, &failure); err != nil {
-			return err
+			return false, err
 		}
 
-		failures = append(failures, failure)
-		return nil
+		// skip the failures which don't satisfy the filters of the request
+		if !req.Matches(failure) {
+			return false, nil
+		}
+
+		if accumulate {
+			failures = append(failures, failure)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -76,3 +83,15 @@ func (k Keeper) AddressFailure(c context.Context, req *types.QueryFailureRequest
 
 	return &types.QueryFailureResponse{Failure: *resp}, nil
 }
+
+func (k Keeper) AddressFailuresCount(c context.Context, req *types.QueryAddressFailuresCountRequest) (*types.QueryAddressFailuresCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request field must not be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	return &types.QueryAddressFailuresCountResponse{Count: k.GetFailuresCount(c, req.Address)}, nil
+}
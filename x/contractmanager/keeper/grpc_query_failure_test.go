@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 
+	"github.com/neutron-org/neutron/v11/testutil"
 	"github.com/neutron-org/neutron/v11/testutil/common/nullify"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,7 +23,7 @@ import (
 var _ = strconv.IntSize
 
 func TestFailureQuerySingle(t *testing.T) {
-	k, ctx := keepertest.ContractManagerKeeper(t, nil)
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
 	msgs := createNFailure(k, ctx, 2, 2)
 	for _, tc := range []struct {
 		desc     string
@@ -77,7 +80,7 @@ func TestFailureQuerySingle(t *testing.T) {
 }
 
 func TestFailureQueryPaginated(t *testing.T) {
-	k, ctx := keepertest.ContractManagerKeeper(t, nil)
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
 	msgs := createNFailure(k, ctx, 5, 3)
 	flattenItems := flattenFailures(msgs)
 
@@ -135,3 +138,107 @@ func TestFailureQueryPaginated(t *testing.T) {
 		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
 	})
 }
+
+func TestFailureQueryFilters(t *testing.T) {
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
+	contractAddress := testutil.TestOwnerAddress
+
+	icaPacket := channeltypes.Packet{Sequence: 1, SourcePort: "icacontroller-" + contractAddress + ".ica0", SourceChannel: "channel-1"}
+	transferPacket := channeltypes.Packet{Sequence: 2, SourcePort: "transfer", SourceChannel: "channel-0"}
+
+	icaAck, err := keeper.PrepareSudoCallbackMessage(icaPacket, &channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Result{Result: []byte("result")}})
+	require.NoError(t, err)
+	icaTimeout, err := keeper.PrepareSudoCallbackMessage(icaPacket, nil)
+	require.NoError(t, err)
+	transferTimeout, err := keeper.PrepareSudoCallbackMessage(transferPacket, nil)
+	require.NoError(t, err)
+	kvQueryResultMsg := types.MessageKVQueryResult{}
+	kvQueryResultMsg.KVQueryResult.QueryID = 7
+	kvQueryResult, err := json.Marshal(kvQueryResultMsg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	k.AddContractFailure(ctx, contractAddress, icaAck, "test error", 100)
+	k.AddContractFailure(ctx, contractAddress, icaTimeout, "test error", 200)
+	ctx = ctx.WithBlockHeight(20)
+	k.AddContractFailure(ctx, contractAddress, transferTimeout, "test error", 300)
+	k.AddContractFailure(ctx, contractAddress, kvQueryResult, "test error", 400)
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryFailuresRequest
+		ids     []uint64
+	}{
+		{
+			desc:    "NoFilters",
+			request: &types.QueryFailuresRequest{Address: contractAddress},
+			ids:     []uint64{0, 1, 2, 3},
+		},
+		{
+			desc:    "Kind",
+			request: &types.QueryFailuresRequest{Kind: types.FailureKind_FAILURE_KIND_ICA_TIMEOUT},
+			ids:     []uint64{1},
+		},
+		{
+			desc:    "Channel",
+			request: &types.QueryFailuresRequest{Address: contractAddress, ChannelId: "channel-1"},
+			ids:     []uint64{0, 1},
+		},
+		{
+			desc:    "ChannelAndSequence",
+			request: &types.QueryFailuresRequest{ChannelId: "channel-0", Sequence: 2},
+			ids:     []uint64{2},
+		},
+		{
+			desc:    "QueryID",
+			request: &types.QueryFailuresRequest{Address: contractAddress, QueryId: 7},
+			ids:     []uint64{3},
+		},
+		{
+			desc:    "Height",
+			request: &types.QueryFailuresRequest{MinHeight: 11, MaxHeight: 20},
+			ids:     []uint64{2, 3},
+		},
+		{
+			desc:    "GasUsed",
+			request: &types.QueryFailuresRequest{Address: contractAddress, MinGasUsed: 200, MaxHeight: 10},
+			ids:     []uint64{1},
+		},
+		{
+			desc:    "NoMatches",
+			request: &types.QueryFailuresRequest{Kind: types.FailureKind_FAILURE_KIND_TRANSFER_ACK},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := k.Failures(ctx, tc.request)
+			require.NoError(t, err)
+			ids := make([]uint64, 0, len(response.Failures))
+			for _, failure := range response.Failures {
+				ids = append(ids, failure.Id)
+			}
+			require.ElementsMatch(t, tc.ids, ids)
+			require.Equal(t, uint64(len(tc.ids)), response.Pagination.Total)
+		})
+	}
+
+	// the pagination only counts the failures matching the filters
+	response, err := k.Failures(ctx, &types.QueryFailuresRequest{
+		ChannelId:  "channel-1",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Failures, 1)
+	require.Equal(t, uint64(0), response.Failures[0].Id)
+	require.Equal(t, uint64(2), response.Pagination.Total)
+	require.NotNil(t, response.Pagination.NextKey)
+
+	response, err = k.Failures(ctx, &types.QueryFailuresRequest{
+		ChannelId:  "channel-1",
+		Pagination: &query.PageRequest{Key: response.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Failures, 1)
+	require.Equal(t, uint64(1), response.Failures[0].Id)
+	require.Equal(t, types.FailureKind_FAILURE_KIND_ICA_TIMEOUT, response.Failures[0].Kind)
+	require.Equal(t, uint64(200), response.Failures[0].GasUsed)
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func TestParamsQuery(t *testing.T) {
-	keeper, ctx := testkeeper.ContractManagerKeeper(t, nil)
+	keeper, ctx := testkeeper.ContractManagerKeeper(t, nil, nil)
 	params := types.DefaultParams()
 	err := keeper.SetParams(ctx, params)
 	require.NoError(t, err)
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+func (k Keeper) RetryPolicy(c context.Context, req *types.QueryRetryPolicyRequest) (*types.QueryRetryPolicyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request field must not be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	policy, found := k.GetRetryPolicy(c, req.Address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no retry policy found for contract %s", req.Address)
+	}
+
+	return &types.QueryRetryPolicyResponse{RetryPolicy: policy}, nil
+}
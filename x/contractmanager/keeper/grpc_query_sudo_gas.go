@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+func (k Keeper) SudoGasLimit(c context.Context, req *types.QuerySudoGasLimitRequest) (*types.QuerySudoGasLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request field must not be empty")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+
+	resp := &types.QuerySudoGasLimitResponse{GasLimit: k.GetSudoCallGasLimit(c, addr)}
+	if grant, found := k.GetSudoGasGrant(c, req.Address); found {
+		resp.Grant = &grant
+	}
+	if prepayment, found := k.GetSudoGasPrepayment(c, req.Address); found {
+		resp.Prepayment = &prepayment
+	}
+
+	return resp, nil
+}
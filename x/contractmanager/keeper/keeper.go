@@ -17,6 +17,7 @@ type (
 		storeKey   storetypes.StoreKey
 		memKey     storetypes.StoreKey
 		wasmKeeper types.WasmKeeper
+		bankKeeper types.BankKeeper
 		authority  string
 	}
 )
@@ -26,6 +27,7 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	wasmKeeper types.WasmKeeper,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) *Keeper {
 	return &Keeper{
@@ -33,6 +35,7 @@ func NewKeeper(
 		storeKey:   storeKey,
 		memKey:     memKey,
 		wasmKeeper: wasmKeeper,
+		bankKeeper: bankKeeper,
 		authority:  authority,
 	}
 }
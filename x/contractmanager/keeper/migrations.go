@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/neutron-org/neutron/v11/x/contractmanager/migrations/v2"
+	v3 "github.com/neutron-org/neutron/v11/x/contractmanager/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.cdc, m.keeper.storeKey)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -69,3 +70,144 @@ func (k Keeper) ResubmitFailure(goCtx context.Context, req *types.MsgResubmitFai
 
 	return &types.MsgResubmitFailureResponse{}, nil
 }
+
+// SetRetryPolicy sets the automatic retry policy of the contract's failures
+func (k Keeper) SetRetryPolicy(goCtx context.Context, req *types.MsgSetRetryPolicy) (*types.MsgSetRetryPolicyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetRetryPolicy")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "sender in set retry policy request is not in correct address format")
+	}
+
+	if !k.wasmKeeper.HasContractInfo(ctx, sender) {
+		return nil, errors.Wrap(types.ErrNotContractRetryPolicy, "sender in set retry policy request is not a smart contract")
+	}
+
+	k.SaveRetryPolicy(ctx, req.RetryPolicy())
+
+	return &types.MsgSetRetryPolicyResponse{}, nil
+}
+
+// RemoveRetryPolicy removes the automatic retry policy of the contract's failures
+func (k Keeper) RemoveRetryPolicy(goCtx context.Context, req *types.MsgRemoveRetryPolicy) (*types.MsgRemoveRetryPolicyResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveRetryPolicy")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetRetryPolicy(ctx, req.Sender); !found {
+		return nil, errors.Wrapf(types.ErrRetryPolicyNotFound, "no retry policy found for contract %s", req.Sender)
+	}
+
+	k.DeleteRetryPolicy(ctx, req.Sender)
+
+	return &types.MsgRemoveRetryPolicyResponse{}, nil
+}
+
+// SetSudoGasGrant grants a sudo call gas limit to the contract or revokes the grant
+func (k Keeper) SetSudoGasGrant(goCtx context.Context, req *types.MsgSetSudoGasGrant) (*types.MsgSetSudoGasGrantResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetSudoGasGrant")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.GasLimit == 0 {
+		k.DeleteSudoGasGrant(ctx, req.Address)
+	} else {
+		k.SaveSudoGasGrant(ctx, types.SudoGasGrant{Address: req.Address, GasLimit: req.GasLimit})
+	}
+
+	return &types.MsgSetSudoGasGrantResponse{}, nil
+}
+
+// PrepaySudoGas sets the prepaid sudo call gas limit of the contract and tops up its prepaid balance
+func (k Keeper) PrepaySudoGas(goCtx context.Context, req *types.MsgPrepaySudoGas) (*types.MsgPrepaySudoGasResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPrepaySudoGas")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "sender in prepay sudo gas request is not in correct address format")
+	}
+
+	if !k.wasmKeeper.HasContractInfo(ctx, sender) {
+		return nil, errors.Wrap(types.ErrNotContractSudoGas, "sender in prepay sudo gas request is not a smart contract")
+	}
+
+	params := k.GetParams(ctx)
+	if !params.SudoGasPrepaymentsEnabled() {
+		return nil, types.ErrSudoGasPrepaymentDisabled
+	}
+
+	if req.GasLimit > params.MaxPrepaidSudoCallGasLimit {
+		return nil, errors.Wrapf(types.ErrInvalidSudoGasLimit, "gas limit %d exceeds the maximum prepaid gas limit %d", req.GasLimit, params.MaxPrepaidSudoCallGasLimit)
+	}
+
+	if req.Amount.Denom != params.SudoGasPrice.Denom {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "prepayment must be in %s, got %s", params.SudoGasPrice.Denom, req.Amount.Denom)
+	}
+
+	prepayment, found := k.GetSudoGasPrepayment(ctx, req.Sender)
+	if !found {
+		prepayment = types.SudoGasPrepayment{
+			Address: req.Sender,
+			Balance: sdk.NewCoin(params.SudoGasPrice.Denom, math.ZeroInt()),
+		}
+	}
+
+	if req.Amount.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(req.Amount)); err != nil {
+			return nil, errors.Wrap(err, "failed to escrow sudo gas prepayment")
+		}
+	}
+
+	prepayment.GasLimit = req.GasLimit
+	prepayment.Balance = prepayment.Balance.Add(req.Amount)
+	k.SaveSudoGasPrepayment(ctx, prepayment)
+
+	return &types.MsgPrepaySudoGasResponse{}, nil
+}
+
+// WithdrawSudoGasPrepayment refunds the remaining prepaid balance to the contract and removes its
+// prepaid sudo call gas limit
+func (k Keeper) WithdrawSudoGasPrepayment(goCtx context.Context, req *types.MsgWithdrawSudoGasPrepayment) (*types.MsgWithdrawSudoGasPrepaymentResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgWithdrawSudoGasPrepayment")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "sender in withdraw sudo gas prepayment request is not in correct address format")
+	}
+
+	prepayment, found := k.GetSudoGasPrepayment(ctx, req.Sender)
+	if !found {
+		return nil, errors.Wrapf(types.ErrSudoGasPrepaymentNotFound, "no sudo gas prepayment found for contract %s", req.Sender)
+	}
+
+	if prepayment.Balance.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(prepayment.Balance)); err != nil {
+			return nil, errors.Wrap(err, "failed to refund sudo gas prepayment")
+		}
+	}
+
+	k.DeleteSudoGasPrepayment(ctx, req.Sender)
+
+	return &types.MsgWithdrawSudoGasPrepaymentResponse{}, nil
+}
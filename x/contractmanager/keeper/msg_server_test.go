@@ -10,7 +10,7 @@ import (
 )
 
 func TestMsgUpdateParamsValidate(t *testing.T) {
-	k, ctx := keeper.ContractManagerKeeper(t, nil)
+	k, ctx := keeper.ContractManagerKeeper(t, nil, nil)
 
 	tests := []struct {
 		name        string
@@ -10,7 +10,7 @@ import (
 )
 
 func TestGetParams(t *testing.T) {
-	k, ctx := testkeeper.ContractManagerKeeper(t, nil)
+	k, ctx := testkeeper.ContractManagerKeeper(t, nil, nil)
 	params := types.DefaultParams()
 
 	err := k.SetParams(ctx, params)
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+// GetRetryPolicy returns the retry policy of the contract.
+func (k Keeper) GetRetryPolicy(ctx context.Context, address string) (types.RetryPolicy, bool) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	bz := c.KVStore(k.storeKey).Get(types.GetRetryPolicyKey(address))
+	if bz == nil {
+		return types.RetryPolicy{}, false
+	}
+
+	var policy types.RetryPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// SaveRetryPolicy saves the retry policy of the contract.
+func (k Keeper) SaveRetryPolicy(ctx context.Context, policy types.RetryPolicy) {
+	c := sdk.UnwrapSDKContext(ctx)
+	c.KVStore(k.storeKey).Set(types.GetRetryPolicyKey(policy.Address), k.cdc.MustMarshal(&policy))
+}
+
+// DeleteRetryPolicy removes the retry policy of the contract. Already scheduled retry attempts are
+// dropped when they are due.
+func (k Keeper) DeleteRetryPolicy(ctx context.Context, address string) {
+	c := sdk.UnwrapSDKContext(ctx)
+	c.KVStore(k.storeKey).Delete(types.GetRetryPolicyKey(address))
+}
+
+// GetAllRetryPolicies returns all retry policies
+func (k Keeper) GetAllRetryPolicies(ctx context.Context) (list []types.RetryPolicy) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(c.KVStore(k.storeKey), types.RetryPoliciesKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RetryPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// scheduleFailureRetry schedules the next automatic retry attempt of the failure according to the
+// contract's retry policy. The failure is moved to the dead-letter state if all the attempts are
+// made. Failures of contracts without a retry policy are left untouched.
+func (k Keeper) scheduleFailureRetry(ctx sdk.Context, failure *types.Failure) {
+	failure.NextRetryHeight = 0
+
+	policy, found := k.GetRetryPolicy(ctx, failure.Address)
+	if !found {
+		return
+	}
+
+	if failure.Attempts >= policy.MaxAttempts {
+		failure.DeadLetter = true
+		return
+	}
+
+	failure.NextRetryHeight = uint64(ctx.BlockHeight()) + policy.BackoffBlocks //nolint:gosec
+	ctx.KVStore(k.storeKey).Set(
+		types.GetFailureRetryQueueKey(failure.NextRetryHeight, failure.Address, failure.Id),
+		[]byte(failure.Address),
+	)
+}
+
+// unscheduleFailureRetry removes the scheduled automatic retry attempt of the failure if any.
+func (k Keeper) unscheduleFailureRetry(ctx sdk.Context, failure *types.Failure) {
+	if failure.NextRetryHeight == 0 {
+		return
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.GetFailureRetryQueueKey(failure.NextRetryHeight, failure.Address, failure.Id))
+	failure.NextRetryHeight = 0
+}
+
+// RetryFailures makes the automatic retry attempts scheduled up to the current height. At most
+// MaxFailureRetriesPerBlock attempts with the total gas limit of at most the params' max retry gas
+// per block are made, the rest are made in the next blocks.
+func (k Keeper) RetryFailures(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(
+		types.FailureRetryQueueKey,
+		types.GetFailureRetryQueueHeightPrefix(uint64(ctx.BlockHeight())+1), //nolint:gosec
+	)
+
+	type scheduledRetry struct {
+		address string
+		id      uint64
+	}
+	var retries []scheduledRetry
+	for ; iterator.Valid() && len(retries) < types.MaxFailureRetriesPerBlock; iterator.Next() {
+		key := iterator.Key()
+		retries = append(retries, scheduledRetry{
+			address: string(iterator.Value()),
+			id:      sdk.BigEndianToUint64(key[len(key)-8:]),
+		})
+	}
+	iterator.Close() //nolint:errcheck
+
+	params := k.GetParams(ctx)
+	gasBudget := params.RetryGasPerBlock()
+	for _, retry := range retries {
+		gasLimit, made := k.retryFailure(ctx, params, retry.address, retry.id, gasBudget)
+		if !made {
+			break
+		}
+		gasBudget -= gasLimit
+	}
+}
+
+// retryFailure makes an automatic retry attempt of the failure with the gas limit increased
+// according to the contract's retry policy and capped by the params. A successful attempt removes
+// the failure, otherwise the next attempt is scheduled. The attempt is not made if its gas limit
+// exceeds the gas budget, the gas limit of the attempt is returned otherwise.
+func (k Keeper) retryFailure(ctx sdk.Context, params types.Params, address string, id, gasBudget uint64) (uint64, bool) {
+	contractAddr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		k.Logger(ctx).Error("retryFailure: failed to parse contract address", "address", address, "error", err)
+		return 0, true
+	}
+
+	failure, err := k.GetFailure(ctx, contractAddr, id)
+	if err != nil {
+		k.Logger(ctx).Error("retryFailure: scheduled failure not found", "address", address, "failure_id", id, "error", err)
+		return 0, true
+	}
+
+	policy, found := k.GetRetryPolicy(ctx, address)
+	if !found {
+		// the policy has been removed since the attempt was scheduled
+		k.unscheduleFailureRetry(ctx, failure)
+		k.setFailure(ctx, *failure)
+		return 0, true
+	}
+
+	sudoCallGasLimit := k.GetSudoCallGasLimit(ctx, contractAddr)
+	// the contracts which have prepaid a higher sudo call gas limit get at least it, but no attempt
+	// exceeds the gas per block, so that every attempt can eventually be made
+	gasLimit := min(
+		policy.AttemptGasLimit(sudoCallGasLimit, failure.Attempts+1),
+		max(params.RetryAttemptGasLimit(), sudoCallGasLimit),
+		params.RetryGasPerBlock(),
+	)
+	if gasLimit > gasBudget {
+		return 0, false
+	}
+
+	k.unscheduleFailureRetry(ctx, failure)
+	failure.Attempts++
+
+	// the gas added by the retry policy is not charged from the prepaid balance
+	chargedGasLimit := min(gasLimit, sudoCallGasLimit)
+	heldFee := k.ReserveSudoGas(ctx, contractAddr, chargedGasLimit)
+	cacheCtx, writeFn := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	func() {
+		defer OutOfGasRecovery(cacheCtx.GasMeter(), &err)
+		_, err = k.wasmKeeper.Sudo(cacheCtx, contractAddr, failure.SudoPayload)
+	}()
+	gasUsed := cacheCtx.GasMeter().GasConsumedToLimit()
+	k.ChargeSudoGas(ctx, contractAddr, min(gasUsed, chargedGasLimit), heldFee)
+
+	if err == nil {
+		writeFn()
+		k.removeFailure(ctx, contractAddr, failure.Id)
+	} else {
+		failure.Error = RedactError(err).Error()
+		failure.GasUsed = gasUsed
+		k.scheduleFailureRetry(ctx, failure)
+		k.setFailure(ctx, *failure)
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(wasmtypes.AttributeKeyContractAddr, address),
+		sdk.NewAttribute(types.AttributeKeySudoFailureID, fmt.Sprintf("%d", failure.Id)),
+		sdk.NewAttribute(types.AttributeKeyRetryAttempt, fmt.Sprintf("%d", failure.Attempts)),
+		sdk.NewAttribute(types.AttributeKeyRetrySuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(types.AttributeKeyDeadLetter, strconv.FormatBool(failure.DeadLetter)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeySudoError, failure.Error))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFailureRetry, attributes...))
+
+	return gasLimit, true
+}
@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/testutil"
+	keepertest "github.com/neutron-org/neutron/v11/testutil/contractmanager/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/contractmanager/types"
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+func TestSetRetryPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wk := mock_types.NewMockWasmKeeper(ctrl)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, nil)
+
+	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	msg := types.MsgSetRetryPolicy{
+		Sender:            contractAddr.String(),
+		MaxAttempts:       3,
+		BackoffBlocks:     5,
+		GasLimitIncrement: 1000,
+	}
+
+	// invalid policy
+	invalidMsg := msg
+	invalidMsg.MaxAttempts = types.MaxRetryAttempts + 1
+	_, err := k.SetRetryPolicy(ctx, &invalidMsg)
+	require.ErrorIs(t, err, types.ErrInvalidRetryPolicy)
+
+	invalidMsg = msg
+	invalidMsg.BackoffBlocks = 0
+	_, err = k.SetRetryPolicy(ctx, &invalidMsg)
+	require.ErrorIs(t, err, types.ErrInvalidRetryPolicy)
+
+	// not a contract
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(false)
+	_, err = k.SetRetryPolicy(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrNotContractRetryPolicy)
+
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
+	_, err = k.SetRetryPolicy(ctx, &msg)
+	require.NoError(t, err)
+
+	resp, err := k.RetryPolicy(ctx, &types.QueryRetryPolicyRequest{Address: contractAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, msg.RetryPolicy(), resp.RetryPolicy)
+
+	_, err = k.RemoveRetryPolicy(ctx, &types.MsgRemoveRetryPolicy{Sender: contractAddr.String()})
+	require.NoError(t, err)
+	_, found := k.GetRetryPolicy(ctx, contractAddr.String())
+	require.False(t, found)
+
+	_, err = k.RemoveRetryPolicy(ctx, &types.MsgRemoveRetryPolicy{Sender: contractAddr.String()})
+	require.ErrorIs(t, err, types.ErrRetryPolicyNotFound)
+}
+
+func TestRetryFailures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wk := mock_types.NewMockWasmKeeper(ctrl)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, nil)
+	ctx = ctx.WithBlockHeight(10)
+
+	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	policy := types.RetryPolicy{
+		Address:           contractAddr.String(),
+		MaxAttempts:       2,
+		BackoffBlocks:     2,
+		GasLimitIncrement: 1000,
+	}
+	k.SaveRetryPolicy(ctx, policy)
+	sudoCallGasLimit := k.GetParams(ctx).SudoCallGasLimit
+
+	failure := k.AddContractFailure(ctx, contractAddr.String(), []byte("payload"), "test error", 0)
+	require.Equal(t, uint64(12), failure.NextRetryHeight)
+
+	// the retry attempt is not due yet
+	k.RetryFailures(ctx.WithBlockHeight(11))
+
+	// the first attempt fails
+	wk.EXPECT().Sudo(gomock.Any(), contractAddr, []byte("payload")).DoAndReturn(
+		func(ctx context.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+			require.Equal(t, sudoCallGasLimit+1000, sdk.UnwrapSDKContext(ctx).GasMeter().Limit())
+			return nil, fmt.Errorf("failed to sudo")
+		})
+	k.RetryFailures(ctx.WithBlockHeight(12))
+
+	stored, err := k.GetFailure(ctx, contractAddr, failure.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), stored.Attempts)
+	require.Equal(t, uint64(14), stored.NextRetryHeight)
+	require.False(t, stored.DeadLetter)
+
+	// the last attempt fails and the failure is moved to the dead-letter state
+	wk.EXPECT().Sudo(gomock.Any(), contractAddr, []byte("payload")).DoAndReturn(
+		func(ctx context.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+			require.Equal(t, sudoCallGasLimit+2000, sdk.UnwrapSDKContext(ctx).GasMeter().Limit())
+			return nil, fmt.Errorf("failed to sudo")
+		})
+	k.RetryFailures(ctx.WithBlockHeight(14))
+
+	stored, err = k.GetFailure(ctx, contractAddr, failure.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), stored.Attempts)
+	require.Equal(t, uint64(0), stored.NextRetryHeight)
+	require.True(t, stored.DeadLetter)
+
+	// nothing is retried anymore
+	k.RetryFailures(ctx.WithBlockHeight(16))
+
+	// a successful attempt removes the failure
+	failure = k.AddContractFailure(ctx, contractAddr.String(), []byte("payload2"), "test error", 0)
+	wk.EXPECT().Sudo(gomock.Any(), contractAddr, []byte("payload2")).Return(nil, nil)
+	k.RetryFailures(ctx.WithBlockHeight(12))
+
+	_, err = k.GetFailure(ctx, contractAddr, failure.Id)
+	require.ErrorContains(t, err, "key not found")
+
+	// scheduled attempts are dropped once the policy is removed
+	failure = k.AddContractFailure(ctx, contractAddr.String(), []byte("payload3"), "test error", 0)
+	k.DeleteRetryPolicy(ctx, contractAddr.String())
+	k.RetryFailures(ctx.WithBlockHeight(12))
+
+	stored, err = k.GetFailure(ctx, contractAddr, failure.Id)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), stored.Attempts)
+	require.Equal(t, uint64(0), stored.NextRetryHeight)
+}
+
+func TestRetryFailuresGasLimits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wk := mock_types.NewMockWasmKeeper(ctrl)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, nil)
+	ctx = ctx.WithBlockHeight(10)
+
+	params := k.GetParams(ctx)
+	params.MaxRetryAttemptGasLimit = params.SudoCallGasLimit + 500
+	params.MaxRetryGasPerBlock = 2*params.MaxRetryAttemptGasLimit + 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	k.SaveRetryPolicy(ctx, types.RetryPolicy{
+		Address:           contractAddr.String(),
+		MaxAttempts:       2,
+		BackoffBlocks:     2,
+		GasLimitIncrement: 1000,
+	})
+
+	failures := make([]types.Failure, 0, 3)
+	for i := 0; i < 3; i++ {
+		failures = append(failures, k.AddContractFailure(ctx, contractAddr.String(), []byte("payload"), "test error", 0))
+	}
+
+	// the increased gas limit of the attempts is capped and only two attempts fit into the block
+	wk.EXPECT().Sudo(gomock.Any(), contractAddr, []byte("payload")).DoAndReturn(
+		func(ctx context.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+			require.Equal(t, params.MaxRetryAttemptGasLimit, sdk.UnwrapSDKContext(ctx).GasMeter().Limit())
+			return nil, fmt.Errorf("failed to sudo: %s", "non-deterministic details")
+		}).Times(2)
+	retryCtx := ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	k.RetryFailures(retryCtx)
+
+	for i, failure := range failures {
+		stored, err := k.GetFailure(ctx, contractAddr, failure.Id)
+		require.NoError(t, err)
+		if i < 2 {
+			require.Equal(t, uint32(1), stored.Attempts)
+			require.Equal(t, uint64(14), stored.NextRetryHeight)
+		} else {
+			require.Equal(t, uint32(0), stored.Attempts)
+			require.Equal(t, uint64(12), stored.NextRetryHeight)
+		}
+	}
+
+	// the events carry the redacted error only
+	for _, event := range retryCtx.EventManager().Events() {
+		if event.Type != types.EventTypeFailureRetry {
+			continue
+		}
+		sudoError, found := event.GetAttribute(types.AttributeKeySudoError)
+		require.True(t, found)
+		require.NotContains(t, sudoError.Value, "non-deterministic details")
+	}
+
+	// the attempt left over is made in the next block
+	wk.EXPECT().Sudo(gomock.Any(), contractAddr, []byte("payload")).Return(nil, nil)
+	k.RetryFailures(ctx.WithBlockHeight(13))
+	_, err := k.GetFailure(ctx, contractAddr, failures[2].Id)
+	require.ErrorContains(t, err, "key not found")
+}
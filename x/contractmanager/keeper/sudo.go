@@ -22,11 +22,19 @@ func (k Keeper) HasContractInfo(ctx context.Context, contractAddress sdk.AccAddr
 }
 
 func PrepareSudoCallbackMessage(request channeltypes.Packet, ack *channeltypes.Acknowledgement) ([]byte, error) {
+	return PrepareSudoCallbackMessageWithMsgResponses(request, ack, nil)
+}
+
+// PrepareSudoCallbackMessageWithMsgResponses does the same as PrepareSudoCallbackMessage, but also
+// passes the decoded responses of the executed messages to the contract on a successful
+// acknowledgement.
+func PrepareSudoCallbackMessageWithMsgResponses(request channeltypes.Packet, ack *channeltypes.Acknowledgement, msgResponses []types.MsgResponse) ([]byte, error) {
 	m := types.MessageSudoCallback{}
 	if ack != nil && ack.GetError() == "" { //nolint:gocritic //
 		m.Response = &types.ResponseSudoPayload{
-			Data:    ack.GetResult(),
-			Request: request,
+			Data:         ack.GetResult(),
+			Request:      request,
+			MsgResponses: msgResponses,
 		}
 	} else if ack != nil {
 		m.Error = &types.ErrorSudoPayload{
@@ -43,6 +51,14 @@ func PrepareSudoCallbackMessage(request channeltypes.Packet, ack *channeltypes.A
 	return data, nil
 }
 
+func PrepareBatchResultCallbackMessage(result types.BatchResultPayload) ([]byte, error) {
+	m, err := json.Marshal(types.MessageSudoCallback{BatchResult: &result})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MessageSudoCallback: %v", err)
+	}
+	return m, nil
+}
+
 func PrepareOpenAckCallbackMessage(details types.OpenAckDetails) ([]byte, error) {
 	x := types.MessageOnChanOpenAck{
 		OpenAck: details,
@@ -54,6 +70,28 @@ func PrepareOpenAckCallbackMessage(details types.OpenAckDetails) ([]byte, error)
 	return m, nil
 }
 
+func PrepareChanCloseCallbackMessage(details types.ChanCloseDetails) ([]byte, error) {
+	x := types.MessageOnChanClose{
+		ChanClose: details,
+	}
+	m, err := json.Marshal(x)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MessageOnChanClose: %v", err)
+	}
+	return m, nil
+}
+
+func PrepareTransferReceivedCallbackMessage(details types.TransferReceivedDetails) ([]byte, error) {
+	x := types.MessageTransferReceived{
+		TransferReceived: details,
+	}
+	m, err := json.Marshal(x)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal MessageTransferReceived: %v", err)
+	}
+	return m, nil
+}
+
 // SudoTxQueryResult is used to pass a tx query result to the contract that registered the query
 // to:
 //  1. check whether the transaction actually satisfies the initial query arguments;
@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+// GetSudoGasGrant returns the sudo call gas limit granted to the contract by the governance.
+func (k Keeper) GetSudoGasGrant(ctx context.Context, address string) (types.SudoGasGrant, bool) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	bz := c.KVStore(k.storeKey).Get(types.GetSudoGasGrantKey(address))
+	if bz == nil {
+		return types.SudoGasGrant{}, false
+	}
+
+	var grant types.SudoGasGrant
+	k.cdc.MustUnmarshal(bz, &grant)
+	return grant, true
+}
+
+// SaveSudoGasGrant saves the sudo call gas limit granted to the contract.
+func (k Keeper) SaveSudoGasGrant(ctx context.Context, grant types.SudoGasGrant) {
+	c := sdk.UnwrapSDKContext(ctx)
+	c.KVStore(k.storeKey).Set(types.GetSudoGasGrantKey(grant.Address), k.cdc.MustMarshal(&grant))
+}
+
+// DeleteSudoGasGrant revokes the sudo call gas limit granted to the contract.
+func (k Keeper) DeleteSudoGasGrant(ctx context.Context, address string) {
+	c := sdk.UnwrapSDKContext(ctx)
+	c.KVStore(k.storeKey).Delete(types.GetSudoGasGrantKey(address))
+}
+
+// GetAllSudoGasGrants returns all sudo call gas limits granted by the governance
+func (k Keeper) GetAllSudoGasGrants(ctx context.Context) (list []types.SudoGasGrant) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(c.KVStore(k.storeKey), types.SudoGasGrantsKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SudoGasGrant
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// GetSudoGasPrepayment returns the sudo call gas prepayment of the contract.
+func (k Keeper) GetSudoGasPrepayment(ctx context.Context, address string) (types.SudoGasPrepayment, bool) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	bz := c.KVStore(k.storeKey).Get(types.GetSudoGasPrepaymentKey(address))
+	if bz == nil {
+		return types.SudoGasPrepayment{}, false
+	}
+
+	var prepayment types.SudoGasPrepayment
+	k.cdc.MustUnmarshal(bz, &prepayment)
+	return prepayment, true
+}
+
+// SaveSudoGasPrepayment saves the sudo call gas prepayment of the contract.
+func (k Keeper) SaveSudoGasPrepayment(ctx context.Context, prepayment types.SudoGasPrepayment) {
+	c := sdk.UnwrapSDKContext(ctx)
+	c.KVStore(k.storeKey).Set(types.GetSudoGasPrepaymentKey(prepayment.Address), k.cdc.MustMarshal(&prepayment))
+}
+
+// DeleteSudoGasPrepayment removes the sudo call gas prepayment of the contract.
+func (k Keeper) DeleteSudoGasPrepayment(ctx context.Context, address string) {
+	c := sdk.UnwrapSDKContext(ctx)
+	c.KVStore(k.storeKey).Delete(types.GetSudoGasPrepaymentKey(address))
+}
+
+// GetAllSudoGasPrepayments returns all sudo call gas prepayments
+func (k Keeper) GetAllSudoGasPrepayments(ctx context.Context) (list []types.SudoGasPrepayment) {
+	c := sdk.UnwrapSDKContext(ctx)
+
+	store := prefix.NewStore(c.KVStore(k.storeKey), types.SudoGasPrepaymentsKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.SudoGasPrepayment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return list
+}
+
+// GetSudoCallGasLimit returns the effective gas limit of the contract's sudo calls. It's the
+// highest of the module-wide limit, the limit granted by the governance and the prepaid limit
+// covered by the contract's prepaid balance.
+func (k Keeper) GetSudoCallGasLimit(ctx context.Context, contractAddress sdk.AccAddress) uint64 {
+	params := k.GetParams(ctx)
+	gasLimit := k.getFreeSudoCallGasLimit(ctx, params, contractAddress.String())
+
+	if prepayment, found := k.GetSudoGasPrepayment(ctx, contractAddress.String()); found {
+		gasLimit = max(gasLimit, prepaidSudoCallGasLimit(params, gasLimit, prepayment))
+	}
+
+	return gasLimit
+}
+
+// ReserveSudoGas holds the fee for the gas of the contract's sudo call above the module-wide and
+// granted limits up to the gas limit of the call. The fee is taken out of the prepaid balance before
+// the call, so that the contract can't withdraw it during the call. The held fee is settled by
+// ChargeSudoGas after the call.
+func (k Keeper) ReserveSudoGas(ctx context.Context, contractAddress sdk.AccAddress, gasLimit uint64) sdk.Coin {
+	params := k.GetParams(ctx)
+	freeGasLimit := k.getFreeSudoCallGasLimit(ctx, params, contractAddress.String())
+	if gasLimit <= freeGasLimit || !params.SudoGasPrepaymentsEnabled() {
+		return sdk.Coin{}
+	}
+
+	prepayment, found := k.GetSudoGasPrepayment(ctx, contractAddress.String())
+	if !found || prepayment.Balance.Denom != params.SudoGasPrice.Denom {
+		return sdk.Coin{}
+	}
+
+	fee := math.MinInt(sudoGasFee(params, gasLimit-freeGasLimit), prepayment.Balance.Amount)
+	if !fee.IsPositive() {
+		return sdk.Coin{}
+	}
+
+	held := sdk.NewCoin(prepayment.Balance.Denom, fee)
+	prepayment.Balance = prepayment.Balance.Sub(held)
+	k.SaveSudoGasPrepayment(ctx, prepayment)
+	return held
+}
+
+// ChargeSudoGas charges the fee held by ReserveSudoGas for the gas used by a sudo call above the
+// module-wide and granted limits. The charged fees are sent to the fee collector, the rest of the held
+// fee is returned to the prepaid balance, or to the contract if it has withdrawn the balance during the call.
+func (k Keeper) ChargeSudoGas(ctx context.Context, contractAddress sdk.AccAddress, gasUsed uint64, held sdk.Coin) {
+	if held.IsNil() || !held.IsPositive() {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	freeGasLimit := k.getFreeSudoCallGasLimit(ctx, params, contractAddress.String())
+	fee := math.ZeroInt()
+	if gasUsed > freeGasLimit && params.SudoGasPrepaymentsEnabled() {
+		fee = math.MinInt(sudoGasFee(params, gasUsed-freeGasLimit), held.Amount)
+	}
+
+	if fee.IsPositive() {
+		feeCoins := sdk.NewCoins(sdk.NewCoin(held.Denom, fee))
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, feeCoins); err != nil {
+			k.Logger(sdk.UnwrapSDKContext(ctx)).Error("ChargeSudoGas: failed to send fees to the fee collector", "error", err, "address", contractAddress.String())
+			fee = math.ZeroInt()
+		}
+	}
+
+	k.releaseSudoGas(ctx, contractAddress, held.SubAmount(fee))
+}
+
+// releaseSudoGas returns the unused part of the held fee to the prepaid balance of the contract. If the
+// contract has withdrawn the prepaid balance since the fee was held, the fee is sent to the contract.
+func (k Keeper) releaseSudoGas(ctx context.Context, contractAddress sdk.AccAddress, unused sdk.Coin) {
+	if !unused.IsPositive() {
+		return
+	}
+
+	prepayment, found := k.GetSudoGasPrepayment(ctx, contractAddress.String())
+	if found && prepayment.Balance.Denom == unused.Denom {
+		prepayment.Balance = prepayment.Balance.Add(unused)
+		k.SaveSudoGasPrepayment(ctx, prepayment)
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddress, sdk.NewCoins(unused)); err != nil {
+		k.Logger(sdk.UnwrapSDKContext(ctx)).Error("releaseSudoGas: failed to refund the held sudo gas fee", "error", err, "address", contractAddress.String())
+	}
+}
+
+// getFreeSudoCallGasLimit returns the gas limit of the contract's sudo calls which is not charged
+// from the prepaid balance.
+func (k Keeper) getFreeSudoCallGasLimit(ctx context.Context, params types.Params, address string) uint64 {
+	gasLimit := params.SudoCallGasLimit
+	if grant, found := k.GetSudoGasGrant(ctx, address); found {
+		gasLimit = max(gasLimit, grant.GasLimit)
+	}
+
+	return gasLimit
+}
+
+// prepaidSudoCallGasLimit returns the highest gas limit up to the requested one such that the gas
+// above the free limit is covered by the prepaid balance.
+func prepaidSudoCallGasLimit(params types.Params, freeGasLimit uint64, prepayment types.SudoGasPrepayment) uint64 {
+	if !params.SudoGasPrepaymentsEnabled() ||
+		prepayment.Balance.Denom != params.SudoGasPrice.Denom ||
+		prepayment.GasLimit <= freeGasLimit {
+		return 0
+	}
+
+	requestedGas := min(prepayment.GasLimit, params.MaxPrepaidSudoCallGasLimit)
+	if requestedGas <= freeGasLimit {
+		return 0
+	}
+
+	affordableGas := math.LegacyNewDecFromInt(prepayment.Balance.Amount).Quo(params.SudoGasPrice.Amount).TruncateInt()
+	if affordableGas.IsUint64() && affordableGas.Uint64() < requestedGas-freeGasLimit {
+		return freeGasLimit + affordableGas.Uint64()
+	}
+
+	return requestedGas
+}
+
+// sudoGasFee returns the fee for the gas at the sudo gas price.
+func sudoGasFee(params types.Params, gas uint64) math.Int {
+	return params.SudoGasPrice.Amount.MulInt(math.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
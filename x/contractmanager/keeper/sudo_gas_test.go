@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/testutil"
+	keepertest "github.com/neutron-org/neutron/v11/testutil/contractmanager/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/contractmanager/types"
+	"github.com/neutron-org/neutron/v11/x/contractmanager"
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+func TestSudoGasGrant(t *testing.T) {
+	k, ctx := keepertest.ContractManagerKeeper(t, nil, nil)
+
+	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	require.Equal(t, types.DefaultSudoCallGasLimit, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	_, err := k.SetSudoGasGrant(ctx, &types.MsgSetSudoGasGrant{
+		Authority: contractAddr.String(),
+		Address:   contractAddr.String(),
+		GasLimit:  2_000_000,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = k.SetSudoGasGrant(ctx, &types.MsgSetSudoGasGrant{
+		Authority: k.GetAuthority(),
+		Address:   contractAddr.String(),
+		GasLimit:  2_000_000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2_000_000), k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	resp, err := k.SudoGasLimit(ctx, &types.QuerySudoGasLimitRequest{Address: contractAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2_000_000), resp.GasLimit)
+	require.Equal(t, &types.SudoGasGrant{Address: contractAddr.String(), GasLimit: 2_000_000}, resp.Grant)
+	require.Nil(t, resp.Prepayment)
+
+	// a grant below the module-wide limit doesn't lower it
+	_, err = k.SetSudoGasGrant(ctx, &types.MsgSetSudoGasGrant{
+		Authority: k.GetAuthority(),
+		Address:   contractAddr.String(),
+		GasLimit:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSudoCallGasLimit, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	// zero gas limit revokes the grant
+	_, err = k.SetSudoGasGrant(ctx, &types.MsgSetSudoGasGrant{
+		Authority: k.GetAuthority(),
+		Address:   contractAddr.String(),
+	})
+	require.NoError(t, err)
+	_, found := k.GetSudoGasGrant(ctx, contractAddr.String())
+	require.False(t, found)
+}
+
+func TestSudoGasPrepayment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wk := mock_types.NewMockWasmKeeper(ctrl)
+	bk := mock_types.NewMockBankKeeper(ctrl)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, bk)
+
+	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	msg := types.MsgPrepaySudoGas{
+		Sender:   contractAddr.String(),
+		GasLimit: 2_000_000,
+		Amount:   sdk.NewCoin("untrn", math.NewInt(5000)),
+	}
+
+	// prepayments are disabled by default
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
+	_, err := k.PrepaySudoGas(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrSudoGasPrepaymentDisabled)
+
+	params := types.DefaultParams()
+	params.MaxPrepaidSudoCallGasLimit = 3_000_000
+	sudoGasPrice := sdk.NewDecCoinFromDec("untrn", math.LegacyNewDecWithPrec(1, 2))
+	params.SudoGasPrice = &sudoGasPrice
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// too high gas limit
+	tooHighMsg := msg
+	tooHighMsg.GasLimit = 3_000_001
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
+	_, err = k.PrepaySudoGas(ctx, &tooHighMsg)
+	require.ErrorIs(t, err, types.ErrInvalidSudoGasLimit)
+
+	// wrong denom
+	wrongDenomMsg := msg
+	wrongDenomMsg.Amount = sdk.NewCoin("uatom", math.NewInt(5000))
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
+	_, err = k.PrepaySudoGas(ctx, &wrongDenomMsg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
+	bk.EXPECT().SendCoinsFromAccountToModule(ctx, contractAddr, types.ModuleName, sdk.NewCoins(msg.Amount)).Return(nil)
+	_, err = k.PrepaySudoGas(ctx, &msg)
+	require.NoError(t, err)
+
+	// the balance covers 500_000 gas above the module-wide limit
+	require.Equal(t, types.DefaultSudoCallGasLimit+500_000, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	// the fee for the whole prepaid gas is held during the call
+	held := k.ReserveSudoGas(ctx, contractAddr, types.DefaultSudoCallGasLimit+500_000)
+	require.Equal(t, sdk.NewCoin("untrn", math.NewInt(5000)), held)
+	require.Equal(t, types.DefaultSudoCallGasLimit, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	// the gas within the module-wide limit is not charged
+	k.ChargeSudoGas(ctx, contractAddr, types.DefaultSudoCallGasLimit, held)
+	require.Equal(t, types.DefaultSudoCallGasLimit+500_000, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	held = k.ReserveSudoGas(ctx, contractAddr, types.DefaultSudoCallGasLimit+500_000)
+	bk.EXPECT().SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(2000)))).Return(nil)
+	k.ChargeSudoGas(ctx, contractAddr, types.DefaultSudoCallGasLimit+200_000, held)
+
+	prepayment, found := k.GetSudoGasPrepayment(ctx, contractAddr.String())
+	require.True(t, found)
+	require.Equal(t, types.SudoGasPrepayment{
+		Address:  contractAddr.String(),
+		GasLimit: 2_000_000,
+		Balance:  sdk.NewCoin("untrn", math.NewInt(3000)),
+	}, prepayment)
+	require.Equal(t, types.DefaultSudoCallGasLimit+300_000, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	bk.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, contractAddr, sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(3000)))).Return(nil)
+	_, err = k.WithdrawSudoGasPrepayment(ctx, &types.MsgWithdrawSudoGasPrepayment{Sender: contractAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultSudoCallGasLimit, k.GetSudoCallGasLimit(ctx, contractAddr))
+
+	_, err = k.WithdrawSudoGasPrepayment(ctx, &types.MsgWithdrawSudoGasPrepayment{Sender: contractAddr.String()})
+	require.ErrorIs(t, err, types.ErrSudoGasPrepaymentNotFound)
+}
+
+func TestSudoGasPrepaymentWithdrawnDuringSudo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wk := mock_types.NewMockWasmKeeper(ctrl)
+	bk := mock_types.NewMockBankKeeper(ctrl)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, bk)
+
+	params := types.DefaultParams()
+	params.MaxPrepaidSudoCallGasLimit = 3_000_000
+	sudoGasPrice := sdk.NewDecCoinFromDec("untrn", math.LegacyNewDecWithPrec(1, 2))
+	params.SudoGasPrice = &sudoGasPrice
+	require.NoError(t, k.SetParams(ctx, params))
+
+	contractAddr := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	prepaid := sdk.NewCoin("untrn", math.NewInt(5000))
+	wk.EXPECT().HasContractInfo(ctx, contractAddr).Return(true)
+	bk.EXPECT().SendCoinsFromAccountToModule(ctx, contractAddr, types.ModuleName, sdk.NewCoins(prepaid)).Return(nil)
+	_, err := k.PrepaySudoGas(ctx, &types.MsgPrepaySudoGas{Sender: contractAddr.String(), GasLimit: 2_000_000, Amount: prepaid})
+	require.NoError(t, err)
+
+	// the contract withdraws its prepayment during the sudo call which uses the prepaid gas
+	wk.EXPECT().Sudo(gomock.Any(), contractAddr, []byte("sudo")).DoAndReturn(func(cachedCtx context.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+		sdk.UnwrapSDKContext(cachedCtx).GasMeter().ConsumeGas(types.DefaultSudoCallGasLimit+200_000, "sudo")
+		_, err := k.WithdrawSudoGasPrepayment(cachedCtx, &types.MsgWithdrawSudoGasPrepayment{Sender: contractAddr.String()})
+		return nil, err
+	})
+
+	// the used gas is still charged from the held fee, only the rest is refunded to the contract
+	bk.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(2000)))).Return(nil)
+	bk.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, contractAddr, sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(3000)))).Return(nil)
+	_, err = contractmanager.NewSudoLimitWrapper(k, wk).Sudo(ctx, contractAddr, []byte("sudo"))
+	require.NoError(t, err)
+
+	_, found := k.GetSudoGasPrepayment(ctx, contractAddr.String())
+	require.False(t, found)
+}
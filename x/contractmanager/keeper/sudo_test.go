@@ -26,7 +26,7 @@ func TestSudoTxQueryResult(t *testing.T) {
 	defer ctrl.Finish()
 	wk := mock_types.NewMockWasmKeeper(ctrl)
 
-	k, ctx := keepertest.ContractManagerKeeper(t, wk)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, nil)
 	address := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
 
 	sudoTxQueryResultMsg := types.MessageTxQueryResult{}
@@ -68,7 +68,7 @@ func TestSudoKvQueryResult(t *testing.T) {
 	defer ctrl.Finish()
 	wk := mock_types.NewMockWasmKeeper(ctrl)
 
-	k, ctx := keepertest.ContractManagerKeeper(t, wk)
+	k, ctx := keepertest.ContractManagerKeeper(t, wk, nil)
 	address := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
 
 	sudoTxQueryResultMsg := types.MessageKVQueryResult{}
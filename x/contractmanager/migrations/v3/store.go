@@ -0,0 +1,62 @@
+package v3
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration sets the creation height and time of the existing failures to the current ones,
+// decodes the kind and the related channel, packet sequence or query ID of the existing failures
+// from their sudo payloads, adds the failures to the expiry queue and counts the failures of each
+// contract
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateFailures(ctx, cdc, storeKey)
+}
+
+func migrateFailures(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating failures...")
+
+	// fetch list of all failures
+	failures := make([]types.Failure, 0)
+	iteratorStore := prefix.NewStore(ctx.KVStore(storeKey), types.ContractFailuresKey)
+	iterator := storetypes.KVStorePrefixIterator(iteratorStore, []byte{})
+
+	for ; iterator.Valid(); iterator.Next() {
+		var failure types.Failure
+		cdc.MustUnmarshal(iterator.Value(), &failure)
+		failures = append(failures, failure)
+	}
+
+	err := iterator.Close()
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	counts := make(map[string]uint64)
+	for _, failure := range failures {
+		failure.CreatedHeight = uint64(ctx.BlockHeight()) //nolint:gosec
+		failure.CreatedAt = ctx.BlockTime()
+		failure.SetSudoPayloadMetadata()
+		store.Set(types.GetFailureKey(failure.Address, failure.Id), cdc.MustMarshal(&failure))
+		store.Set(types.GetFailureExpiryQueueKey(failure.CreatedAt, failure.Address, failure.Id), []byte(failure.Address))
+		counts[failure.Address]++
+	}
+
+	// failures are iterated in the order of addresses, so the counts are set deterministically
+	for _, failure := range failures {
+		if count, ok := counts[failure.Address]; ok {
+			store.Set(types.GetFailuresCountKey(failure.Address), sdk.Uint64ToBigEndian(count))
+			delete(counts, failure.Address)
+		}
+	}
+
+	ctx.Logger().Info("Finished migrating failures")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v11/testutil"
+	v3 "github.com/neutron-org/neutron/v11/x/contractmanager/migrations/v3"
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+type V3ContractManagerMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V3ContractManagerMigrationTestSuite))
+}
+
+func (suite *V3ContractManagerMigrationTestSuite) TestFailuresUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	addressOne := testutil.TestOwnerAddress
+	addressTwo := "neutron1fxudpred77a0grgh69u0j7y84yks5ev4n5050z45kecz792jnd6scqu98z"
+
+	// Write old state
+	store := ctx.KVStore(storeKey)
+	var i uint64
+	for i = 0; i < 3; i++ {
+		addr := addressOne
+		if i == 2 {
+			addr = addressTwo
+		}
+		failure := types.Failure{
+			Address:     addr,
+			Id:          i,
+			SudoPayload: []byte("payload"),
+		}
+		bz := cdc.MustMarshal(&failure)
+		store.Set(types.GetFailureKey(failure.Address, failure.Id), bz)
+	}
+
+	// Run migration
+	suite.NoError(v3.MigrateStore(ctx, cdc, storeKey))
+
+	for _, failure := range app.ContractManagerKeeper.GetAllFailures(ctx) {
+		suite.Require().Equal(uint64(ctx.BlockHeight()), failure.CreatedHeight) //nolint:gosec
+		suite.Require().True(ctx.BlockTime().Equal(failure.CreatedAt))
+		suite.Require().True(store.Has(types.GetFailureExpiryQueueKey(failure.CreatedAt, failure.Address, failure.Id)))
+	}
+
+	suite.Require().Equal(uint64(2), app.ContractManagerKeeper.GetFailuresCount(ctx, addressOne))
+	suite.Require().Equal(uint64(1), app.ContractManagerKeeper.GetFailuresCount(ctx, addressTwo))
+}
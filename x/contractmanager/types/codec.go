@@ -10,6 +10,11 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron.contractmanager.v1.MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgResubmitFailure{}, "neutron.contractmanager.v1.MsgResubmitFailure", nil)
+	cdc.RegisterConcrete(&MsgSetRetryPolicy{}, "neutron.contractmanager.v1.MsgSetRetryPolicy", nil)
+	cdc.RegisterConcrete(&MsgRemoveRetryPolicy{}, "neutron.contractmanager.v1.MsgRemoveRetryPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetSudoGasGrant{}, "neutron.contractmanager.v1.MsgSetSudoGasGrant", nil)
+	cdc.RegisterConcrete(&MsgPrepaySudoGas{}, "neutron.contractmanager.v1.MsgPrepaySudoGas", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSudoGasPrepayment{}, "neutron.contractmanager.v1.MsgWithdrawSudoGasPrepayment", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -17,6 +22,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgResubmitFailure{},
+		&MsgSetRetryPolicy{},
+		&MsgRemoveRetryPolicy{},
+		&MsgSetSudoGasGrant{},
+		&MsgPrepaySudoGas{},
+		&MsgWithdrawSudoGasPrepayment{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
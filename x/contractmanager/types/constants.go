@@ -1,3 +1,17 @@
 package types
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
+
+const (
+	// MaxRetryAttempts is the maximum number of automatic retry attempts a retry policy can set.
+	MaxRetryAttempts = 10
+	// MaxRetryGasLimitIncrement is the maximum amount of gas a retry policy can add to the sudo
+	// call gas limit with each retry attempt.
+	MaxRetryGasLimitIncrement = uint64(1_000_000)
+	// MaxFailureRetriesPerBlock is the maximum number of automatic retry attempts made in a block.
+	// Attempts exceeding the limit are postponed to the next blocks.
+	MaxFailureRetriesPerBlock = 50
+	// MaxFailuresPrunedPerBlock is the maximum number of expired failures pruned in a block.
+	// Failures exceeding the limit are pruned in the next blocks.
+	MaxFailuresPrunedPerBlock = 100
+)
@@ -10,4 +10,11 @@ var (
 	ErrFailedToResubmitFailure    = errors.Register(ModuleName, 1102, "failed to resubmit failure")
 	ErrSudoOutOfGas               = errors.Register(ModuleName, 1103, "sudo handling went beyond the gas limit allowed by the module")
 	ErrNotContractResubmission    = errors.Register(ModuleName, 1104, "failures resubmission is only allowed to be called by a smart contract")
+	ErrNotContractRetryPolicy     = errors.Register(ModuleName, 1105, "retry policy is only allowed to be managed by a smart contract")
+	ErrInvalidRetryPolicy         = errors.Register(ModuleName, 1106, "invalid retry policy")
+	ErrRetryPolicyNotFound        = errors.Register(ModuleName, 1107, "retry policy not found")
+	ErrSudoGasPrepaymentDisabled  = errors.Register(ModuleName, 1108, "sudo gas prepayments are disabled")
+	ErrInvalidSudoGasLimit        = errors.Register(ModuleName, 1109, "invalid sudo gas limit")
+	ErrSudoGasPrepaymentNotFound  = errors.Register(ModuleName, 1110, "sudo gas prepayment not found")
+	ErrNotContractSudoGas         = errors.Register(ModuleName, 1111, "sudo gas prepayment is only allowed to be managed by a smart contract")
 )
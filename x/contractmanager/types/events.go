@@ -7,4 +7,25 @@ const (
 	// AttributeKeySudoFailureID indicates attribute containing ID of the failure related to an
 	// error Sudo call.
 	AttributeKeySudoFailureID = "failure_id"
+
+	// EventTypeFailureRetry is emitted on every automatic retry attempt of a failure.
+	EventTypeFailureRetry = "failure_retry"
+	// AttributeKeyRetryAttempt indicates an attribute containing the number of the retry attempt.
+	AttributeKeyRetryAttempt = "attempt"
+	// AttributeKeyRetrySuccess indicates an attribute containing whether the retry attempt succeeded.
+	AttributeKeyRetrySuccess = "success"
+	// AttributeKeyDeadLetter indicates an attribute containing whether the failure is moved to the
+	// dead-letter state after the retry attempt.
+	AttributeKeyDeadLetter = "dead_letter"
+
+	// EventTypeFailurePruned is emitted when a failure is pruned.
+	EventTypeFailurePruned = "failure_pruned"
+	// AttributeKeyPruneReason indicates an attribute containing the reason the failure is pruned.
+	AttributeKeyPruneReason = "reason"
+
+	// PruneReasonExpired means that the failure is pruned since its retention period is over.
+	PruneReasonExpired = "expired"
+	// PruneReasonLimitExceeded means that the failure is pruned to store a newer failure of the
+	// contract which reached the failures limit.
+	PruneReasonLimitExceeded = "limit_exceeded"
 )
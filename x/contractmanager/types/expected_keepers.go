@@ -14,7 +14,17 @@ type WasmKeeper interface {
 	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 }
 
+// BankKeeper defines the expected interface needed to escrow sudo gas prepayments.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
 type ContractManagerKeeper interface {
-	AddContractFailure(ctx context.Context, address string, sudoPayload []byte, errMsg string) Failure
+	AddContractFailure(ctx context.Context, address string, sudoPayload []byte, errMsg string, gasUsed uint64) Failure
 	GetParams(ctx context.Context) (params Params)
+	GetSudoCallGasLimit(ctx context.Context, contractAddress sdk.AccAddress) uint64
+	ReserveSudoGas(ctx context.Context, contractAddress sdk.AccAddress, gasLimit uint64) sdk.Coin
+	ChargeSudoGas(ctx context.Context, contractAddress sdk.AccAddress, gasUsed uint64, held sdk.Coin)
 }
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
+
+// sudoPayload is a union of all the sudo messages a failure can be created for.
+type sudoPayload struct {
+	MessageSudoCallback
+	MessageTxQueryResult
+	MessageKVQueryResult
+	MessageOnChanOpenAck
+	MessageOnChanClose
+	MessageTransferReceived
+}
+
+// SetSudoPayloadMetadata decodes the failure's sudo payload and sets the kind of the failure and
+// the channel, packet sequence or interchain query ID the failure is related to. The metadata is
+// left unset if the payload is not a known sudo message.
+func (m *Failure) SetSudoPayloadMetadata() {
+	var payload sudoPayload
+	if err := json.Unmarshal(m.SudoPayload, &payload); err != nil {
+		return
+	}
+
+	switch {
+	case payload.Response != nil:
+		m.setPacketMetadata(payload.Response.Request, FailureKind_FAILURE_KIND_ICA_ACK, FailureKind_FAILURE_KIND_TRANSFER_ACK)
+	case payload.Error != nil:
+		m.setPacketMetadata(payload.Error.Request, FailureKind_FAILURE_KIND_ICA_ACK, FailureKind_FAILURE_KIND_TRANSFER_ACK)
+	case payload.Timeout != nil:
+		m.setPacketMetadata(payload.Timeout.Request, FailureKind_FAILURE_KIND_ICA_TIMEOUT, FailureKind_FAILURE_KIND_TRANSFER_TIMEOUT)
+	case payload.BatchResult != nil:
+		m.Kind = FailureKind_FAILURE_KIND_ICA_BATCH_RESULT
+		m.PortId = payload.BatchResult.PortID
+		m.ChannelId = payload.BatchResult.ChannelID
+	case payload.OpenAck.PortID != "":
+		m.Kind = FailureKind_FAILURE_KIND_ICA_CHANNEL
+		m.PortId = payload.OpenAck.PortID
+		m.ChannelId = payload.OpenAck.ChannelID
+	case payload.ChanClose.PortID != "":
+		m.Kind = FailureKind_FAILURE_KIND_ICA_CHANNEL
+		m.PortId = payload.ChanClose.PortID
+		m.ChannelId = payload.ChanClose.ChannelID
+	case payload.TransferReceived.ChannelID != "":
+		m.Kind = FailureKind_FAILURE_KIND_TRANSFER_RECEIVED
+		m.PortId = payload.TransferReceived.PortID
+		m.ChannelId = payload.TransferReceived.ChannelID
+		m.Sequence = payload.TransferReceived.Sequence
+	case payload.TxQueryResult.QueryID != 0:
+		m.Kind = FailureKind_FAILURE_KIND_ICQ_RESULT
+		m.QueryId = payload.TxQueryResult.QueryID
+	case payload.KVQueryResult.QueryID != 0:
+		m.Kind = FailureKind_FAILURE_KIND_ICQ_RESULT
+		m.QueryId = payload.KVQueryResult.QueryID
+	}
+}
+
+// setPacketMetadata sets the packet details of the failure and picks its kind by the source port
+// of the packet.
+func (m *Failure) setPacketMetadata(packet channeltypes.Packet, icaKind, transferKind FailureKind) {
+	switch {
+	case strings.HasPrefix(packet.SourcePort, icatypes.ControllerPortPrefix):
+		m.Kind = icaKind
+	case packet.SourcePort == transfertypes.PortID:
+		m.Kind = transferKind
+	}
+	m.PortId = packet.SourcePort
+	m.ChannelId = packet.SourceChannel
+	m.Sequence = packet.Sequence
+}
+
+// Matches returns true if the failure passes all the filters set in the request.
+func (m *QueryFailuresRequest) Matches(failure Failure) bool {
+	switch {
+	case m.Kind != FailureKind_FAILURE_KIND_UNSPECIFIED && failure.Kind != m.Kind:
+		return false
+	case m.ChannelId != "" && failure.ChannelId != m.ChannelId:
+		return false
+	case m.Sequence != 0 && failure.Sequence != m.Sequence:
+		return false
+	case m.QueryId != 0 && failure.QueryId != m.QueryId:
+		return false
+	case m.MinHeight != 0 && failure.CreatedHeight < m.MinHeight:
+		return false
+	case m.MaxHeight != 0 && failure.CreatedHeight > m.MaxHeight:
+		return false
+	case m.MinGasUsed != 0 && failure.GasUsed < m.MinGasUsed:
+		return false
+	}
+
+	return true
+}
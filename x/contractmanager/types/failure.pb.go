@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +28,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FailureKind describes the origin of a failed sudo call.
+type FailureKind int32
+
+const (
+	// The origin of the failure is unknown
+	FailureKind_FAILURE_KIND_UNSPECIFIED FailureKind = 0
+	// Acknowledgement of an interchain transaction packet
+	FailureKind_FAILURE_KIND_ICA_ACK FailureKind = 1
+	// Timeout of an interchain transaction packet
+	FailureKind_FAILURE_KIND_ICA_TIMEOUT FailureKind = 2
+	// Result of an interchain transaction split into several packets
+	FailureKind_FAILURE_KIND_ICA_BATCH_RESULT FailureKind = 3
+	// Opening or closing of an interchain account channel
+	FailureKind_FAILURE_KIND_ICA_CHANNEL FailureKind = 4
+	// Acknowledgement of an ICS-20 transfer packet
+	FailureKind_FAILURE_KIND_TRANSFER_ACK FailureKind = 5
+	// Timeout of an ICS-20 transfer packet
+	FailureKind_FAILURE_KIND_TRANSFER_TIMEOUT FailureKind = 6
+	// Result of an interchain query
+	FailureKind_FAILURE_KIND_ICQ_RESULT FailureKind = 7
+	// ICS-20 transfer received by a contract
+	FailureKind_FAILURE_KIND_TRANSFER_RECEIVED FailureKind = 8
+)
+
+var FailureKind_name = map[int32]string{
+	0: "FAILURE_KIND_UNSPECIFIED",
+	1: "FAILURE_KIND_ICA_ACK",
+	2: "FAILURE_KIND_ICA_TIMEOUT",
+	3: "FAILURE_KIND_ICA_BATCH_RESULT",
+	4: "FAILURE_KIND_ICA_CHANNEL",
+	5: "FAILURE_KIND_TRANSFER_ACK",
+	6: "FAILURE_KIND_TRANSFER_TIMEOUT",
+	7: "FAILURE_KIND_ICQ_RESULT",
+	8: "FAILURE_KIND_TRANSFER_RECEIVED",
+}
+
+var FailureKind_value = map[string]int32{
+	"FAILURE_KIND_UNSPECIFIED":       0,
+	"FAILURE_KIND_ICA_ACK":           1,
+	"FAILURE_KIND_ICA_TIMEOUT":       2,
+	"FAILURE_KIND_ICA_BATCH_RESULT":  3,
+	"FAILURE_KIND_ICA_CHANNEL":       4,
+	"FAILURE_KIND_TRANSFER_ACK":      5,
+	"FAILURE_KIND_TRANSFER_TIMEOUT":  6,
+	"FAILURE_KIND_ICQ_RESULT":        7,
+	"FAILURE_KIND_TRANSFER_RECEIVED": 8,
+}
+
+func (x FailureKind) String() string {
+	return proto.EnumName(FailureKind_name, int32(x))
+}
+
+func (FailureKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fba0c26e85dad46e, []int{0}
+}
+
 // Failure message contains information about ACK failures and can be used to
 // replay ACK in case of requirement.
 // Note that Failure means that sudo handler to cosmwasm contract failed for
@@ -36,6 +97,30 @@ type Failure struct {
 	SudoPayload []byte `protobuf:"bytes,3,opt,name=sudo_payload,json=sudoPayload,proto3" json:"sudo_payload,omitempty"`
 	// Redacted error response of the sudo call. Full error is emitted as an event
 	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Number of automatic retry attempts made for the failure
+	Attempts uint32 `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Height of the block at which the next automatic retry attempt is made. Zero
+	// if no retry attempt is scheduled
+	NextRetryHeight uint64 `protobuf:"varint,6,opt,name=next_retry_height,json=nextRetryHeight,proto3" json:"next_retry_height,omitempty"`
+	// Whether all the automatic retry attempts are exhausted. A dead-letter
+	// failure can only be resubmitted manually
+	DeadLetter bool `protobuf:"varint,7,opt,name=dead_letter,json=deadLetter,proto3" json:"dead_letter,omitempty"`
+	// Height of the block the failure was created at
+	CreatedHeight uint64 `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// Time of the block the failure was created at
+	CreatedAt time.Time `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// Origin of the failed sudo call
+	Kind FailureKind `protobuf:"varint,10,opt,name=kind,proto3,enum=neutron.contractmanager.FailureKind" json:"kind,omitempty"`
+	// Source port of the packet or the channel the failure is related to, if any
+	PortId string `protobuf:"bytes,11,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// Source channel of the packet or the channel the failure is related to, if any
+	ChannelId string `protobuf:"bytes,12,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Sequence of the packet the failure is related to, if any
+	Sequence uint64 `protobuf:"varint,13,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// ID of the interchain query the failure is related to, if any
+	QueryId uint64 `protobuf:"varint,14,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// Amount of gas used by the failed sudo call
+	GasUsed uint64 `protobuf:"varint,15,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *Failure) Reset()         { *m = Failure{} }
@@ -99,7 +184,85 @@ func (m *Failure) GetError() string {
 	return ""
 }
 
+func (m *Failure) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Failure) GetNextRetryHeight() uint64 {
+	if m != nil {
+		return m.NextRetryHeight
+	}
+	return 0
+}
+
+func (m *Failure) GetDeadLetter() bool {
+	if m != nil {
+		return m.DeadLetter
+	}
+	return false
+}
+
+func (m *Failure) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Failure) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Failure) GetKind() FailureKind {
+	if m != nil {
+		return m.Kind
+	}
+	return FailureKind_FAILURE_KIND_UNSPECIFIED
+}
+
+func (m *Failure) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *Failure) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *Failure) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Failure) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *Failure) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("neutron.contractmanager.FailureKind", FailureKind_name, FailureKind_value)
 	proto.RegisterType((*Failure)(nil), "neutron.contractmanager.Failure")
 }
 
@@ -108,23 +271,48 @@ func init() {
 }
 
 var fileDescriptor_fba0c26e85dad46e = []byte{
-	// 249 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0xd2, 0x4f, 0x4b, 0xcc, 0xcc, 0x29, 0x2d, 0x4a, 0xd5, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x2a, 0xd3, 0x43, 0x53, 0x26, 0xa5, 0x98, 0x99, 0x94, 0xac, 0x9f, 0x9c,
-	0x5f, 0x94, 0xaa, 0x9f, 0x9c, 0x91, 0x98, 0x97, 0x97, 0x9a, 0xa3, 0x5f, 0x66, 0x08, 0x63, 0x42,
-	0xf4, 0x2a, 0xe5, 0x70, 0xb1, 0xbb, 0x41, 0x0c, 0x13, 0x92, 0xe0, 0x62, 0x4f, 0x4c, 0x49, 0x29,
-	0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0x82, 0x71, 0x85, 0xf8, 0xb8, 0x98,
-	0x32, 0x53, 0x24, 0x98, 0x14, 0x18, 0x35, 0x58, 0x82, 0x98, 0x32, 0x53, 0x84, 0x14, 0xb9, 0x78,
-	0x8a, 0x4b, 0x53, 0xf2, 0xe3, 0x0b, 0x12, 0x2b, 0x73, 0xf2, 0x13, 0x53, 0x24, 0x98, 0x15, 0x18,
-	0x35, 0x78, 0x82, 0xb8, 0x41, 0x62, 0x01, 0x10, 0x21, 0x21, 0x11, 0x2e, 0xd6, 0xd4, 0xa2, 0xa2,
-	0xfc, 0x22, 0x09, 0x16, 0xb0, 0x51, 0x10, 0x8e, 0x53, 0xc8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x43, 0xbd, 0xa3, 0x9b, 0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x19, 0x1a, 0xea, 0x57, 0x60, 0x84,
-	0x43, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x2b, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x0d, 0x9d, 0x75, 0xda, 0x2f, 0x01, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdf, 0x4e, 0xdb, 0x3a,
+	0x1c, 0x6e, 0x4a, 0xe9, 0x1f, 0x17, 0x4a, 0x8f, 0x85, 0x84, 0xe9, 0x39, 0xb4, 0x05, 0x1d, 0xa4,
+	0x0a, 0xe9, 0x24, 0x82, 0x73, 0x33, 0xed, 0x2e, 0x84, 0x54, 0x44, 0x74, 0x1d, 0x0b, 0xe9, 0x2e,
+	0x76, 0x13, 0xb9, 0xb1, 0x49, 0xa3, 0xb5, 0x71, 0x71, 0x1c, 0x44, 0x1f, 0x60, 0xf7, 0xbc, 0xc5,
+	0x5e, 0x85, 0x4b, 0x2e, 0x77, 0xb5, 0x4d, 0xf0, 0x22, 0x53, 0x9c, 0x04, 0x0d, 0xca, 0xee, 0xfc,
+	0xfd, 0xf9, 0x7d, 0x76, 0x3e, 0x5b, 0x01, 0xfb, 0x21, 0x8d, 0x05, 0x67, 0xa1, 0xe6, 0xb1, 0x50,
+	0x70, 0xec, 0x89, 0x19, 0x0e, 0xb1, 0x4f, 0xb9, 0x76, 0x89, 0x83, 0x69, 0xcc, 0xa9, 0x3a, 0xe7,
+	0x4c, 0x30, 0xb8, 0x95, 0xd9, 0xd4, 0x17, 0xb6, 0xd6, 0xa6, 0xcf, 0x7c, 0x26, 0x3d, 0x5a, 0xb2,
+	0x4a, 0xed, 0xad, 0x8e, 0xcf, 0x98, 0x3f, 0xa5, 0x9a, 0x44, 0xe3, 0xf8, 0x52, 0x13, 0xc1, 0x8c,
+	0x46, 0x02, 0xcf, 0xe6, 0x99, 0x61, 0x37, 0x18, 0x7b, 0x9a, 0xc7, 0x38, 0xd5, 0xbc, 0x09, 0x0e,
+	0x43, 0x3a, 0xd5, 0xae, 0x0f, 0xf3, 0x65, 0x6a, 0xd9, 0xfb, 0x52, 0x02, 0x95, 0x7e, 0x7a, 0x08,
+	0x88, 0x40, 0x05, 0x13, 0xc2, 0x69, 0x14, 0x21, 0xa5, 0xab, 0xf4, 0x6a, 0x76, 0x0e, 0x61, 0x03,
+	0x14, 0x03, 0x82, 0x8a, 0x5d, 0xa5, 0x57, 0xb2, 0x8b, 0x01, 0x81, 0xbb, 0x60, 0x2d, 0x8a, 0x09,
+	0x73, 0xe7, 0x78, 0x31, 0x65, 0x98, 0xa0, 0x95, 0xae, 0xd2, 0x5b, 0xb3, 0xeb, 0x09, 0x77, 0x9e,
+	0x52, 0x70, 0x13, 0xac, 0x52, 0xce, 0x19, 0x47, 0x25, 0x19, 0x95, 0x02, 0xd8, 0x02, 0x55, 0x2c,
+	0x04, 0x9d, 0xcd, 0x45, 0x84, 0x56, 0xbb, 0x4a, 0x6f, 0xdd, 0x7e, 0xc2, 0xf0, 0x00, 0xfc, 0x15,
+	0xd2, 0x1b, 0xe1, 0x72, 0x2a, 0xf8, 0xc2, 0x9d, 0xd0, 0xc0, 0x9f, 0x08, 0x54, 0x96, 0x7b, 0x6e,
+	0x24, 0x82, 0x9d, 0xf0, 0xa7, 0x92, 0x86, 0x1d, 0x50, 0x27, 0x14, 0x13, 0x77, 0x4a, 0x85, 0xa0,
+	0x1c, 0x55, 0xba, 0x4a, 0xaf, 0x6a, 0x83, 0x84, 0x1a, 0x48, 0x06, 0xee, 0x83, 0x86, 0xc7, 0x29,
+	0x16, 0x94, 0xe4, 0x49, 0x55, 0x99, 0xb4, 0x9e, 0xb1, 0x59, 0x8e, 0x01, 0x40, 0x6e, 0xc3, 0x02,
+	0xd5, 0xba, 0x4a, 0xaf, 0x7e, 0xd4, 0x52, 0xd3, 0x5e, 0xd5, 0xbc, 0x57, 0xd5, 0xc9, 0x7b, 0x3d,
+	0xae, 0xde, 0x7d, 0xef, 0x14, 0x6e, 0x7f, 0x74, 0x14, 0xbb, 0x96, 0xcd, 0xe9, 0x02, 0xbe, 0x01,
+	0xa5, 0xcf, 0x41, 0x48, 0x10, 0xe8, 0x2a, 0xbd, 0xc6, 0xd1, 0xbf, 0xea, 0x1f, 0x6e, 0x51, 0xcd,
+	0x7a, 0x3e, 0x0b, 0x42, 0x62, 0xcb, 0x09, 0xb8, 0x05, 0x2a, 0x73, 0xc6, 0x85, 0x1b, 0x10, 0x54,
+	0x97, 0x35, 0x95, 0x13, 0x68, 0x11, 0xb8, 0x03, 0x40, 0x76, 0x4f, 0x89, 0xb6, 0x26, 0xb5, 0x5a,
+	0xc6, 0x58, 0x24, 0xa9, 0x31, 0xa2, 0x57, 0x31, 0x0d, 0x3d, 0x8a, 0xd6, 0xe5, 0x77, 0x3d, 0x61,
+	0xb8, 0x0d, 0xaa, 0x57, 0x31, 0xe5, 0x8b, 0x64, 0xb0, 0x21, 0xb5, 0x8a, 0xc4, 0x16, 0x49, 0x24,
+	0x1f, 0x47, 0x6e, 0x1c, 0x51, 0x82, 0x36, 0x52, 0xc9, 0xc7, 0xd1, 0x28, 0xa2, 0xe4, 0xe0, 0x6b,
+	0x11, 0xd4, 0x7f, 0x3b, 0x1f, 0xfc, 0x07, 0xa0, 0xbe, 0x6e, 0x0d, 0x46, 0xb6, 0xe9, 0x9e, 0x59,
+	0xc3, 0x13, 0x77, 0x34, 0xbc, 0x38, 0x37, 0x0d, 0xab, 0x6f, 0x99, 0x27, 0xcd, 0x02, 0x44, 0x60,
+	0xf3, 0x99, 0x6a, 0x19, 0xba, 0xab, 0x1b, 0x67, 0x4d, 0x65, 0x69, 0x2e, 0x51, 0x1c, 0xeb, 0x9d,
+	0xf9, 0x7e, 0xe4, 0x34, 0x8b, 0x70, 0x17, 0xec, 0x2c, 0xa9, 0xc7, 0xba, 0x63, 0x9c, 0xba, 0xb6,
+	0x79, 0x31, 0x1a, 0x38, 0xcd, 0x95, 0x57, 0x03, 0x8c, 0x53, 0x7d, 0x38, 0x34, 0x07, 0xcd, 0x12,
+	0xdc, 0x01, 0xdb, 0xcf, 0x54, 0xc7, 0xd6, 0x87, 0x17, 0x7d, 0xd3, 0x96, 0xbb, 0xaf, 0x2e, 0xe5,
+	0x3f, 0xc9, 0xf9, 0x11, 0xca, 0xf0, 0x6f, 0xb0, 0xf5, 0x22, 0xff, 0x43, 0xbe, 0x79, 0x05, 0xee,
+	0x81, 0xf6, 0xeb, 0xf3, 0xb6, 0x69, 0x98, 0xd6, 0x47, 0xf3, 0xa4, 0x59, 0x3d, 0x76, 0xee, 0x1e,
+	0xda, 0xca, 0xfd, 0x43, 0x5b, 0xf9, 0xf9, 0xd0, 0x56, 0x6e, 0x1f, 0xdb, 0x85, 0xfb, 0xc7, 0x76,
+	0xe1, 0xdb, 0x63, 0xbb, 0xf0, 0xe9, 0xad, 0x1f, 0x88, 0x49, 0x3c, 0x56, 0x3d, 0x36, 0xd3, 0xb2,
+	0x37, 0xf0, 0x1f, 0xe3, 0x7e, 0xbe, 0xd6, 0xae, 0x0f, 0x0f, 0xb5, 0x9b, 0xa5, 0x5f, 0x80, 0x58,
+	0xcc, 0x69, 0x34, 0x2e, 0xcb, 0xc7, 0xf6, 0xff, 0xaf, 0x01, 0x00, 0xac, 0xd1, 0xad, 0x92, 0x2a,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Failure) Marshal() (dAtA []byte, err error) {
@@ -147,6 +335,73 @@ func (m *Failure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFailure(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x50
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintFailure(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.CreatedHeight != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DeadLetter {
+		i--
+		if m.DeadLetter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NextRetryHeight != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.NextRetryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintFailure(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -208,6 +463,40 @@ func (m *Failure) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovFailure(uint64(l))
 	}
+	if m.Attempts != 0 {
+		n += 1 + sovFailure(uint64(m.Attempts))
+	}
+	if m.NextRetryHeight != 0 {
+		n += 1 + sovFailure(uint64(m.NextRetryHeight))
+	}
+	if m.DeadLetter {
+		n += 2
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovFailure(uint64(m.CreatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovFailure(uint64(l))
+	if m.Kind != 0 {
+		n += 1 + sovFailure(uint64(m.Kind))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFailure(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovFailure(uint64(m.Sequence))
+	}
+	if m.QueryId != 0 {
+		n += 1 + sovFailure(uint64(m.QueryId))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFailure(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -363,6 +652,256 @@ func (m *Failure) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryHeight", wireType)
+			}
+			m.NextRetryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRetryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLetter", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLetter = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= FailureKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFailure
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFailure
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFailure
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFailure(dAtA[iNdEx:])
@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
+)
+
+func TestFailure_SetSudoPayloadMetadata(t *testing.T) {
+	icaPacket := channeltypes.Packet{
+		Sequence:      5,
+		SourcePort:    "icacontroller-neutron1contract.ica0",
+		SourceChannel: "channel-1",
+	}
+	transferPacket := channeltypes.Packet{
+		Sequence:      7,
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+	}
+
+	kvQueryResult := types.MessageKVQueryResult{}
+	kvQueryResult.KVQueryResult.QueryID = 3
+	txQueryResult := types.MessageTxQueryResult{}
+	txQueryResult.TxQueryResult.QueryID = 4
+
+	for _, tc := range []struct {
+		desc     string
+		payload  any
+		expected types.Failure
+	}{
+		{
+			desc:     "ica ack",
+			payload:  types.MessageSudoCallback{Response: &types.ResponseSudoPayload{Request: icaPacket}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICA_ACK, PortId: icaPacket.SourcePort, ChannelId: "channel-1", Sequence: 5},
+		},
+		{
+			desc:     "ica error ack",
+			payload:  types.MessageSudoCallback{Error: &types.ErrorSudoPayload{Request: icaPacket, Details: "error"}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICA_ACK, PortId: icaPacket.SourcePort, ChannelId: "channel-1", Sequence: 5},
+		},
+		{
+			desc:     "ica timeout",
+			payload:  types.MessageSudoCallback{Timeout: &types.TimeoutPayload{Request: icaPacket}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICA_TIMEOUT, PortId: icaPacket.SourcePort, ChannelId: "channel-1", Sequence: 5},
+		},
+		{
+			desc:     "transfer ack",
+			payload:  types.MessageSudoCallback{Response: &types.ResponseSudoPayload{Request: transferPacket}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_TRANSFER_ACK, PortId: "transfer", ChannelId: "channel-0", Sequence: 7},
+		},
+		{
+			desc:     "transfer timeout",
+			payload:  types.MessageSudoCallback{Timeout: &types.TimeoutPayload{Request: transferPacket}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_TRANSFER_TIMEOUT, PortId: "transfer", ChannelId: "channel-0", Sequence: 7},
+		},
+		{
+			desc:     "batch result",
+			payload:  types.MessageSudoCallback{BatchResult: &types.BatchResultPayload{BatchID: 1, PortID: icaPacket.SourcePort, ChannelID: "channel-1"}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICA_BATCH_RESULT, PortId: icaPacket.SourcePort, ChannelId: "channel-1"},
+		},
+		{
+			desc:     "open ack",
+			payload:  types.MessageOnChanOpenAck{OpenAck: types.OpenAckDetails{PortID: icaPacket.SourcePort, ChannelID: "channel-1"}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICA_CHANNEL, PortId: icaPacket.SourcePort, ChannelId: "channel-1"},
+		},
+		{
+			desc:     "chan close",
+			payload:  types.MessageOnChanClose{ChanClose: types.ChanCloseDetails{PortID: icaPacket.SourcePort, ChannelID: "channel-1"}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICA_CHANNEL, PortId: icaPacket.SourcePort, ChannelId: "channel-1"},
+		},
+		{
+			desc:     "transfer received",
+			payload:  types.MessageTransferReceived{TransferReceived: types.TransferReceivedDetails{PortID: "transfer", ChannelID: "channel-0", SourcePort: "transfer", SourceChannel: "channel-9", Sequence: 3}},
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_TRANSFER_RECEIVED, PortId: "transfer", ChannelId: "channel-0", Sequence: 3},
+		},
+		{
+			desc:     "kv query result",
+			payload:  kvQueryResult,
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICQ_RESULT, QueryId: 3},
+		},
+		{
+			desc:     "tx query result",
+			payload:  txQueryResult,
+			expected: types.Failure{Kind: types.FailureKind_FAILURE_KIND_ICQ_RESULT, QueryId: 4},
+		},
+		{
+			desc:     "unknown payload",
+			payload:  map[string]string{"unknown": "payload"},
+			expected: types.Failure{},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			payload, err := json.Marshal(tc.payload)
+			require.NoError(t, err)
+
+			failure := types.Failure{SudoPayload: payload}
+			failure.SetSudoPayloadMetadata()
+
+			tc.expected.SudoPayload = payload
+			require.Equal(t, tc.expected, failure)
+		})
+	}
+
+	failure := types.Failure{SudoPayload: []byte("not a json")}
+	failure.SetSudoPayloadMetadata()
+	require.Equal(t, types.FailureKind_FAILURE_KIND_UNSPECIFIED, failure.Kind)
+}
@@ -10,8 +10,11 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		FailuresList: []Failure{},
-		Params:       DefaultParams(),
+		FailuresList:       []Failure{},
+		RetryPolicies:      []RetryPolicy{},
+		SudoGasGrants:      []SudoGasGrant{},
+		SudoGasPrepayments: []SudoGasPrepayment{},
+		Params:             DefaultParams(),
 	}
 }
 
@@ -29,5 +32,41 @@ func (gs GenesisState) Validate() error {
 		failureIndexMap[index] = struct{}{}
 	}
 
+	retryPolicyIndexMap := make(map[string]struct{})
+	for _, elem := range gs.RetryPolicies {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := retryPolicyIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for retry policy")
+		}
+		retryPolicyIndexMap[elem.Address] = struct{}{}
+	}
+
+	sudoGasGrantIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SudoGasGrants {
+		if elem.GasLimit == 0 {
+			return fmt.Errorf("zero gas limit of sudo gas grant for %s", elem.Address)
+		}
+
+		if _, ok := sudoGasGrantIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for sudo gas grant")
+		}
+		sudoGasGrantIndexMap[elem.Address] = struct{}{}
+	}
+
+	sudoGasPrepaymentIndexMap := make(map[string]struct{})
+	for _, elem := range gs.SudoGasPrepayments {
+		if err := elem.Balance.Validate(); err != nil {
+			return fmt.Errorf("invalid sudo gas prepayment balance of %s: %w", elem.Address, err)
+		}
+
+		if _, ok := sudoGasPrepaymentIndexMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for sudo gas prepayment")
+		}
+		sudoGasPrepaymentIndexMap[elem.Address] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
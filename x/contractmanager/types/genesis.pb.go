@@ -28,6 +28,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// List of the contract failures
 	FailuresList []Failure `protobuf:"bytes,2,rep,name=failures_list,json=failuresList,proto3" json:"failures_list"`
+	// List of the contract retry policies
+	RetryPolicies []RetryPolicy `protobuf:"bytes,3,rep,name=retry_policies,json=retryPolicies,proto3" json:"retry_policies"`
+	// List of the sudo call gas limits granted by the governance
+	SudoGasGrants []SudoGasGrant `protobuf:"bytes,4,rep,name=sudo_gas_grants,json=sudoGasGrants,proto3" json:"sudo_gas_grants"`
+	// List of the sudo call gas prepayments of the contracts
+	SudoGasPrepayments []SudoGasPrepayment `protobuf:"bytes,5,rep,name=sudo_gas_prepayments,json=sudoGasPrepayments,proto3" json:"sudo_gas_prepayments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +83,27 @@ func (m *GenesisState) GetFailuresList() []Failure {
 	return nil
 }
 
+func (m *GenesisState) GetRetryPolicies() []RetryPolicy {
+	if m != nil {
+		return m.RetryPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSudoGasGrants() []SudoGasGrant {
+	if m != nil {
+		return m.SudoGasGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSudoGasPrepayments() []SudoGasPrepayment {
+	if m != nil {
+		return m.SudoGasPrepayments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.contractmanager.GenesisState")
 }
@@ -86,23 +113,31 @@ func init() {
 }
 
 var fileDescriptor_cf4a1534315a7490 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0xd2, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x87, 0x2a, 0xd3, 0x43, 0x53, 0x26, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56,
-	0xa3, 0x0f, 0x62, 0x41, 0x94, 0x4b, 0xe1, 0x34, 0x35, 0x2d, 0x31, 0x33, 0xa7, 0xb4, 0x28, 0x15,
-	0xaa, 0x4c, 0x05, 0x97, 0xb2, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xdd, 0x4a, 0xb3, 0x18, 0xb9,
-	0x78, 0xdc, 0x21, 0xae, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe5, 0x62, 0x83, 0x28, 0x90,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x3a, 0xbd, 0x00, 0xb0, 0x32, 0x27,
-	0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0x9a, 0x84, 0xbc, 0xb9, 0x78, 0xa1, 0xce, 0x28, 0x8e,
-	0xcf, 0xc9, 0x2c, 0x2e, 0x91, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc0, 0x69, 0x8a, 0x1b,
-	0x44, 0x35, 0xd4, 0x18, 0x1e, 0x98, 0x66, 0x9f, 0xcc, 0xe2, 0x12, 0xa7, 0x90, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xb2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x87, 0x9a, 0xac, 0x9b, 0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x19, 0x1a, 0xea,
-	0x57, 0x60, 0xf8, 0xbc, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x73, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x01, 0x5a, 0xdf, 0xfd, 0x9e, 0x01, 0x00, 0x00,
+	// 369 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6a, 0xea, 0x40,
+	0x14, 0x86, 0x93, 0xab, 0xd7, 0x45, 0xd4, 0x7b, 0x21, 0x08, 0x37, 0xb8, 0x88, 0x72, 0xd1, 0x22,
+	0x42, 0x13, 0xb4, 0xbb, 0x42, 0x37, 0x2e, 0xea, 0xa2, 0x5d, 0x58, 0xed, 0xaa, 0x9b, 0x30, 0xc6,
+	0xe9, 0x74, 0xc0, 0x64, 0xc2, 0x9c, 0x49, 0x69, 0xde, 0xa2, 0x4f, 0xd1, 0x67, 0x71, 0xe9, 0xb2,
+	0xab, 0x52, 0xf4, 0x45, 0x4a, 0x26, 0x13, 0x11, 0xcb, 0xd0, 0xdd, 0x70, 0xe6, 0x3b, 0xdf, 0xe1,
+	0x3f, 0x1c, 0xab, 0x1f, 0xe3, 0x54, 0x70, 0x16, 0xfb, 0x21, 0x8b, 0x05, 0x47, 0xa1, 0x88, 0x50,
+	0x8c, 0x08, 0xe6, 0x3e, 0xc1, 0x31, 0x06, 0x0a, 0x5e, 0xc2, 0x99, 0x60, 0xf6, 0x3f, 0x85, 0x79,
+	0x27, 0x58, 0xbb, 0x45, 0x18, 0x61, 0x92, 0xf1, 0xf3, 0x57, 0x81, 0xb7, 0xb5, 0xd6, 0x47, 0x44,
+	0xd7, 0x29, 0xc7, 0x0a, 0xeb, 0xe9, 0xb0, 0x04, 0x71, 0x14, 0xa9, 0xd9, 0xed, 0xa1, 0x8e, 0xe2,
+	0x58, 0xf0, 0x2c, 0x48, 0xd8, 0x9a, 0x86, 0x99, 0x62, 0xcf, 0x74, 0x2c, 0xa4, 0x2b, 0x16, 0x10,
+	0xa4, 0x9c, 0xff, 0xdf, 0x2a, 0x56, 0x63, 0x5a, 0x24, 0x5c, 0x08, 0x24, 0xb0, 0x7d, 0x65, 0xd5,
+	0x8a, 0xa1, 0x8e, 0xd9, 0x35, 0x07, 0xf5, 0x71, 0xc7, 0xd3, 0x24, 0xf6, 0x66, 0x12, 0x9b, 0x54,
+	0x37, 0x1f, 0x1d, 0x63, 0xae, 0x9a, 0xec, 0x1b, 0xab, 0xa9, 0xa2, 0x41, 0xb0, 0xa6, 0x20, 0x9c,
+	0x5f, 0xdd, 0xca, 0xa0, 0x3e, 0xee, 0x6a, 0x2d, 0xd7, 0x05, 0xad, 0x34, 0x8d, 0xb2, 0xf9, 0x96,
+	0x82, 0xb0, 0xef, 0xac, 0x3f, 0x47, 0xd1, 0x28, 0x06, 0xa7, 0x22, 0x6d, 0x3d, 0xad, 0x6d, 0x9e,
+	0xe3, 0x33, 0xb9, 0x08, 0x65, 0x6c, 0xf2, 0x43, 0x89, 0x62, 0xb0, 0x17, 0xd6, 0xdf, 0x72, 0x03,
+	0x01, 0xe1, 0x28, 0x16, 0xe0, 0x54, 0xa5, 0xb3, 0xaf, 0x75, 0x2e, 0xd2, 0x15, 0x9b, 0x22, 0x98,
+	0xe6, 0x74, 0x29, 0x85, 0xa3, 0x1a, 0xd8, 0x4b, 0xab, 0x75, 0x90, 0x26, 0x1c, 0x27, 0x28, 0x8b,
+	0x70, 0x6e, 0xfe, 0x2d, 0xcd, 0xc3, 0x9f, 0xcc, 0xb3, 0x43, 0x8b, 0xd2, 0xdb, 0x70, 0xfa, 0x01,
+	0x93, 0xfb, 0xcd, 0xce, 0x35, 0xb7, 0x3b, 0xd7, 0xfc, 0xdc, 0xb9, 0xe6, 0xeb, 0xde, 0x35, 0xb6,
+	0x7b, 0xd7, 0x78, 0xdf, 0xbb, 0xc6, 0xc3, 0x25, 0xa1, 0xe2, 0x29, 0x5d, 0x7a, 0x21, 0x8b, 0x7c,
+	0x35, 0xe9, 0x9c, 0x71, 0x52, 0xbe, 0xfd, 0xe7, 0xd1, 0xc8, 0x7f, 0xf9, 0x76, 0x07, 0x22, 0x4b,
+	0x30, 0x2c, 0x6b, 0xf2, 0x0a, 0x2e, 0xbe, 0x06, 0x00, 0x36, 0x67, 0xf3, 0x0e, 0xfe, 0x02, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +160,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SudoGasPrepayments) > 0 {
+		for iNdEx := len(m.SudoGasPrepayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SudoGasPrepayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SudoGasGrants) > 0 {
+		for iNdEx := len(m.SudoGasGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SudoGasGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RetryPolicies) > 0 {
+		for iNdEx := len(m.RetryPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FailuresList) > 0 {
 		for iNdEx := len(m.FailuresList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +254,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RetryPolicies) > 0 {
+		for _, e := range m.RetryPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SudoGasGrants) > 0 {
+		for _, e := range m.SudoGasGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SudoGasPrepayments) > 0 {
+		for _, e := range m.SudoGasPrepayments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +377,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryPolicies = append(m.RetryPolicies, RetryPolicy{})
+			if err := m.RetryPolicies[len(m.RetryPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoGasGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoGasGrants = append(m.SudoGasGrants, SudoGasGrant{})
+			if err := m.SudoGasGrants[len(m.SudoGasGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoGasPrepayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SudoGasPrepayments = append(m.SudoGasPrepayments, SudoGasPrepayment{})
+			if err := m.SudoGasPrepayments[len(m.SudoGasPrepayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -59,6 +59,57 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "retry attempt gas limit above retry gas per block",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MaxRetryAttemptGasLimit: 2_000_000,
+					MaxRetryGasPerBlock:     1_000_000,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid retry policy",
+			genState: &types.GenesisState{
+				RetryPolicies: []types.RetryPolicy{
+					{
+						Address:       "address1",
+						MaxAttempts:   0,
+						BackoffBlocks: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero sudo gas grant",
+			genState: &types.GenesisState{
+				SudoGasGrants: []types.SudoGasGrant{
+					{
+						Address:  "address1",
+						GasLimit: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated sudo gas grant",
+			genState: &types.GenesisState{
+				SudoGasGrants: []types.SudoGasGrant{
+					{
+						Address:  "address1",
+						GasLimit: 2_000_000,
+					},
+					{
+						Address:  "address1",
+						GasLimit: 3_000_000,
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
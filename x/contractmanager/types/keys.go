@@ -1,6 +1,10 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// ModuleName defines the module name
@@ -19,11 +23,23 @@ const (
 const (
 	prefixContractFailures = iota + 1
 	prefixParamsKey
+	prefixRetryPolicies
+	prefixFailureRetryQueue
+	prefixSudoGasGrants
+	prefixSudoGasPrepayments
+	prefixFailureExpiryQueue
+	prefixFailuresCount
 )
 
 var (
-	ContractFailuresKey = []byte{prefixContractFailures}
-	ParamsKey           = []byte{prefixParamsKey}
+	ContractFailuresKey   = []byte{prefixContractFailures}
+	ParamsKey             = []byte{prefixParamsKey}
+	RetryPoliciesKey      = []byte{prefixRetryPolicies}
+	FailureRetryQueueKey  = []byte{prefixFailureRetryQueue}
+	SudoGasGrantsKey      = []byte{prefixSudoGasGrants}
+	SudoGasPrepaymentsKey = []byte{prefixSudoGasPrepayments}
+	FailureExpiryQueueKey = []byte{prefixFailureExpiryQueue}
+	FailuresCountKey      = []byte{prefixFailuresCount}
 )
 
 // GetFailureKeyPrefix returns the store key for the failures of the specific address
@@ -43,3 +59,71 @@ func GetFailureKey(
 	key := GetFailureKeyPrefix(address)
 	return append(key, sdk.Uint64ToBigEndian(offset)...)
 }
+
+// GetRetryPolicyKey returns the store key to retrieve a RetryPolicy of the contract
+func GetRetryPolicyKey(
+	address string,
+) []byte {
+	return append(RetryPoliciesKey, []byte(address)...)
+}
+
+// GetFailureRetryQueueHeightPrefix returns the store key prefix of the failure retry attempts
+// scheduled at the given height
+func GetFailureRetryQueueHeightPrefix(
+	height uint64,
+) []byte {
+	return append(FailureRetryQueueKey, sdk.Uint64ToBigEndian(height)...)
+}
+
+// GetFailureRetryQueueKey returns the store key of a failure retry attempt scheduled at the given
+// height
+func GetFailureRetryQueueKey(
+	height uint64,
+	address string,
+	offset uint64,
+) []byte {
+	key := GetFailureRetryQueueHeightPrefix(height)
+	key = append(key, GetFailureKey(address, offset)[len(ContractFailuresKey):]...)
+	return key
+}
+
+// GetSudoGasGrantKey returns the store key to retrieve a SudoGasGrant of the contract
+func GetSudoGasGrantKey(
+	address string,
+) []byte {
+	return append(SudoGasGrantsKey, []byte(address)...)
+}
+
+// GetSudoGasPrepaymentKey returns the store key to retrieve a SudoGasPrepayment of the contract
+func GetSudoGasPrepaymentKey(
+	address string,
+) []byte {
+	return append(SudoGasPrepaymentsKey, []byte(address)...)
+}
+
+// GetFailureExpiryQueueTimePrefix returns the store key prefix of the failures created at the given
+// time
+func GetFailureExpiryQueueTimePrefix(
+	createdAt time.Time,
+) []byte {
+	return append(FailureExpiryQueueKey, sdk.FormatTimeBytes(createdAt)...)
+}
+
+// GetFailureExpiryQueueKey returns the store key of a failure in the queue of failures ordered by
+// creation time
+func GetFailureExpiryQueueKey(
+	createdAt time.Time,
+	address string,
+	offset uint64,
+) []byte {
+	key := GetFailureExpiryQueueTimePrefix(createdAt)
+	key = append(key, GetFailureKey(address, offset)[len(ContractFailuresKey):]...)
+	return key
+}
+
+// GetFailuresCountKey returns the store key to retrieve the number of failures of the contract
+func GetFailuresCountKey(
+	address string,
+) []byte {
+	return append(FailuresCountKey, []byte(address)...)
+}
@@ -1,13 +1,23 @@
 package types
 
 import (
+	"fmt"
+
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
-const DefaultSudoCallGasLimit = uint64(1_000_000)
+const (
+	DefaultSudoCallGasLimit = uint64(1_000_000)
+	// DefaultMaxRetryAttemptGasLimit is the gas limit of an automatic retry attempt used if the
+	// params don't set one.
+	DefaultMaxRetryAttemptGasLimit = uint64(10_000_000)
+	// DefaultMaxRetryGasPerBlock is the total gas limit of the automatic retry attempts of a block
+	// used if the params don't set one.
+	DefaultMaxRetryGasPerBlock = uint64(50_000_000)
+)
 
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
@@ -33,9 +43,54 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if p.FailureRetentionPeriod < 0 {
+		return fmt.Errorf("failure retention period must not be negative: %s", p.FailureRetentionPeriod)
+	}
+
+	if p.RetryAttemptGasLimit() > p.RetryGasPerBlock() {
+		return fmt.Errorf("max retry attempt gas limit %d must not exceed max retry gas per block %d", p.RetryAttemptGasLimit(), p.RetryGasPerBlock())
+	}
+
+	if p.SudoGasPrice == nil {
+		return nil
+	}
+
+	if p.SudoGasPrice.Amount.IsNil() {
+		return fmt.Errorf("invalid sudo gas price: amount is not set")
+	}
+
+	if err := p.SudoGasPrice.Validate(); err != nil {
+		return fmt.Errorf("invalid sudo gas price: %w", err)
+	}
+
 	return nil
 }
 
+// SudoGasPrepaymentsEnabled returns whether contracts can pay for sudo call gas limits above the
+// module-wide one.
+func (p Params) SudoGasPrepaymentsEnabled() bool {
+	return p.MaxPrepaidSudoCallGasLimit > 0 &&
+		p.SudoGasPrice != nil &&
+		!p.SudoGasPrice.Amount.IsNil() &&
+		p.SudoGasPrice.IsPositive()
+}
+
+// RetryAttemptGasLimit returns the maximum gas limit of an automatic retry attempt.
+func (p Params) RetryAttemptGasLimit() uint64 {
+	if p.MaxRetryAttemptGasLimit == 0 {
+		return DefaultMaxRetryAttemptGasLimit
+	}
+	return p.MaxRetryAttemptGasLimit
+}
+
+// RetryGasPerBlock returns the maximum total gas limit of the automatic retry attempts of a block.
+func (p Params) RetryGasPerBlock() uint64 {
+	if p.MaxRetryGasPerBlock == 0 {
+		return DefaultMaxRetryGasPerBlock
+	}
+	return p.MaxRetryGasPerBlock
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -5,17 +5,22 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +31,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	SudoCallGasLimit uint64 `protobuf:"varint,1,opt,name=sudo_call_gas_limit,json=sudoCallGasLimit,proto3" json:"sudo_call_gas_limit,omitempty"`
+	// Maximum sudo call gas limit a contract can pay for. Zero disables the gas
+	// prepayments
+	MaxPrepaidSudoCallGasLimit uint64 `protobuf:"varint,2,opt,name=max_prepaid_sudo_call_gas_limit,json=maxPrepaidSudoCallGasLimit,proto3" json:"max_prepaid_sudo_call_gas_limit,omitempty"`
+	// Price of a gas unit of sudo calls charged from the prepaid balances. Unset
+	// price disables the gas prepayments
+	SudoGasPrice *types.DecCoin `protobuf:"bytes,3,opt,name=sudo_gas_price,json=sudoGasPrice,proto3" json:"sudo_gas_price,omitempty"`
+	// Period after which failures are pruned. Zero keeps failures forever
+	FailureRetentionPeriod time.Duration `protobuf:"bytes,4,opt,name=failure_retention_period,json=failureRetentionPeriod,proto3,stdduration" json:"failure_retention_period"`
+	// Maximum number of failures stored per contract. Once the limit is reached,
+	// the oldest failure of the contract is pruned to store a new one. Zero
+	// means no limit
+	MaxFailuresPerContract uint64 `protobuf:"varint,5,opt,name=max_failures_per_contract,json=maxFailuresPerContract,proto3" json:"max_failures_per_contract,omitempty"`
+	// Maximum gas limit of an automatic retry attempt of a failure, which caps
+	// the gas limit increments of the retry policies. Zero means the default
+	// limit
+	MaxRetryAttemptGasLimit uint64 `protobuf:"varint,6,opt,name=max_retry_attempt_gas_limit,json=maxRetryAttemptGasLimit,proto3" json:"max_retry_attempt_gas_limit,omitempty"`
+	// Maximum total gas limit of the automatic retry attempts made in a block.
+	// The attempts exceeding it are made in the next blocks. Zero means the
+	// default limit
+	MaxRetryGasPerBlock uint64 `protobuf:"varint,7,opt,name=max_retry_gas_per_block,json=maxRetryGasPerBlock,proto3" json:"max_retry_gas_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -67,6 +92,48 @@ func (m *Params) GetSudoCallGasLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxPrepaidSudoCallGasLimit() uint64 {
+	if m != nil {
+		return m.MaxPrepaidSudoCallGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetSudoGasPrice() *types.DecCoin {
+	if m != nil {
+		return m.SudoGasPrice
+	}
+	return nil
+}
+
+func (m *Params) GetFailureRetentionPeriod() time.Duration {
+	if m != nil {
+		return m.FailureRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxFailuresPerContract() uint64 {
+	if m != nil {
+		return m.MaxFailuresPerContract
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetryAttemptGasLimit() uint64 {
+	if m != nil {
+		return m.MaxRetryAttemptGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRetryGasPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRetryGasPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.contractmanager.Params")
 }
@@ -76,20 +143,36 @@ func init() {
 }
 
 var fileDescriptor_121b05e48c7a8737 = []byte{
-	// 205 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0xd2, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xaa, 0xd2, 0x43, 0x53, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa3,
-	0x0f, 0x62, 0x41, 0x94, 0x2b, 0xd9, 0x72, 0xb1, 0x05, 0x80, 0xb5, 0x0b, 0xe9, 0x72, 0x09, 0x17,
-	0x97, 0xa6, 0xe4, 0xc7, 0x27, 0x27, 0xe6, 0xe4, 0xc4, 0xa7, 0x27, 0x16, 0xc7, 0xe7, 0x64, 0xe6,
-	0x66, 0x96, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0xb0, 0x04, 0x09, 0x80, 0xa4, 0x9c, 0x13, 0x73, 0x72,
-	0xdc, 0x13, 0x8b, 0x7d, 0x40, 0xe2, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x85, 0x9c, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x55, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92,
-	0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x49, 0xba, 0xf9, 0x45, 0xe9, 0x30, 0xb6, 0x7e, 0x99, 0xa1,
-	0xa1, 0x7e, 0x05, 0x86, 0x57, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x6e, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x46, 0xe2, 0xd1, 0x17, 0xf2, 0x00, 0x00, 0x00,
+	// 459 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd4, 0x30,
+	0x1c, 0xc5, 0x13, 0x7a, 0x1c, 0x28, 0x20, 0x84, 0x52, 0xd4, 0x4b, 0x0f, 0x94, 0xab, 0x10, 0x43,
+	0x97, 0xda, 0x3a, 0x60, 0xa1, 0x62, 0xe1, 0xae, 0xa2, 0x0b, 0x43, 0x14, 0x98, 0x90, 0x90, 0xf5,
+	0x8f, 0xcf, 0x0d, 0x16, 0x71, 0x1c, 0xd9, 0x4e, 0x95, 0x7e, 0x0b, 0xc6, 0x8e, 0x7c, 0x9c, 0x8e,
+	0x1d, 0x18, 0x98, 0x00, 0xdd, 0x7d, 0x11, 0x64, 0xc7, 0x01, 0x74, 0xb0, 0xfd, 0xe3, 0xff, 0xfb,
+	0xbd, 0xe8, 0x3d, 0x3b, 0x7a, 0x52, 0xb3, 0xd6, 0x28, 0x59, 0x63, 0x2a, 0x6b, 0xa3, 0x80, 0x1a,
+	0x01, 0x35, 0x94, 0x4c, 0xe1, 0x06, 0x14, 0x08, 0x8d, 0x1a, 0x25, 0x8d, 0x8c, 0x27, 0x5e, 0x85,
+	0xb6, 0x54, 0xd3, 0x94, 0x4a, 0x2d, 0xa4, 0xc6, 0x05, 0x68, 0x86, 0xcf, 0xe7, 0x05, 0x33, 0x30,
+	0xc7, 0x54, 0xf2, 0xba, 0x07, 0xa7, 0x0f, 0x4a, 0x59, 0x4a, 0x37, 0x62, 0x3b, 0xf9, 0xd3, 0xb4,
+	0x94, 0xb2, 0xac, 0x18, 0x76, 0x5f, 0x45, 0x7b, 0x86, 0x57, 0xad, 0x02, 0xc3, 0xa5, 0xa7, 0x1e,
+	0x7f, 0xdd, 0x89, 0xc6, 0x99, 0xfb, 0x7f, 0x7c, 0x14, 0xed, 0xea, 0x76, 0x25, 0x09, 0x85, 0xaa,
+	0x22, 0x25, 0x68, 0x52, 0x71, 0xc1, 0x4d, 0x12, 0x1e, 0x84, 0x87, 0xa3, 0xfc, 0xbe, 0x5d, 0x2d,
+	0xa1, 0xaa, 0x4e, 0x41, 0xbf, 0xb1, 0xe7, 0xf1, 0x32, 0x9a, 0x09, 0xe8, 0x48, 0xa3, 0x58, 0x03,
+	0x7c, 0x45, 0xfe, 0x87, 0xde, 0x70, 0xe8, 0x54, 0x40, 0x97, 0xf5, 0xaa, 0xb7, 0xdb, 0x26, 0x8b,
+	0xe8, 0x9e, 0x03, 0x2d, 0xd3, 0x28, 0x4e, 0x59, 0xb2, 0x73, 0x10, 0x1e, 0xde, 0x79, 0xfa, 0x08,
+	0xf5, 0x69, 0x91, 0x4d, 0x8b, 0x7c, 0x5a, 0x74, 0xc2, 0xe8, 0x52, 0xf2, 0x3a, 0xbf, 0x6b, 0x99,
+	0x53, 0xd0, 0x99, 0x25, 0xe2, 0x0f, 0x51, 0x72, 0x06, 0xbc, 0x6a, 0x15, 0x23, 0x8a, 0x19, 0x56,
+	0xdb, 0x74, 0xa4, 0x61, 0x8a, 0xcb, 0x55, 0x32, 0x72, 0x6e, 0xfb, 0xa8, 0x6f, 0x01, 0x0d, 0x2d,
+	0xa0, 0x13, 0xdf, 0xc2, 0xe2, 0xf6, 0xd5, 0xf7, 0x59, 0x70, 0xf9, 0x63, 0x16, 0xe6, 0x7b, 0xde,
+	0x24, 0x1f, 0x3c, 0x32, 0x67, 0x11, 0xbf, 0x88, 0xf6, 0x6d, 0x4e, 0xbf, 0xd5, 0xd6, 0x99, 0x0c,
+	0x77, 0x93, 0xdc, 0x74, 0x09, 0xf7, 0x04, 0x74, 0xaf, 0xfd, 0x3e, 0x63, 0x6a, 0xe9, 0xb7, 0xf1,
+	0xcb, 0xe8, 0xa1, 0x45, 0x15, 0x33, 0xea, 0x82, 0x80, 0x31, 0x4c, 0x34, 0xe6, 0xaf, 0x7a, 0xc6,
+	0x0e, 0x9e, 0x08, 0xe8, 0x72, 0xab, 0x78, 0xd5, 0x0b, 0x7e, 0x77, 0xf3, 0x3c, 0x9a, 0xfc, 0xa1,
+	0x5d, 0x41, 0x4c, 0x91, 0xa2, 0x92, 0xf4, 0x53, 0x72, 0xcb, 0x91, 0xbb, 0x03, 0x69, 0xab, 0x60,
+	0x6a, 0x61, 0x57, 0xc7, 0xa3, 0xcb, 0x2f, 0xb3, 0x60, 0xf1, 0xee, 0x6a, 0x9d, 0x86, 0xd7, 0xeb,
+	0x34, 0xfc, 0xb9, 0x4e, 0xc3, 0xcf, 0x9b, 0x34, 0xb8, 0xde, 0xa4, 0xc1, 0xb7, 0x4d, 0x1a, 0xbc,
+	0x3f, 0x2e, 0xb9, 0xf9, 0xd8, 0x16, 0x88, 0x4a, 0x81, 0xfd, 0x53, 0x3b, 0x92, 0xaa, 0x1c, 0x66,
+	0x7c, 0x3e, 0x9f, 0xe3, 0xee, 0x9f, 0x27, 0x6a, 0x2e, 0x1a, 0xa6, 0x8b, 0xb1, 0xeb, 0xef, 0xd9,
+	0xaf, 0x01, 0x00, 0x1d, 0x22, 0xe6, 0xc4, 0xca, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -112,6 +195,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRetryGasPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetryGasPerBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRetryAttemptGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRetryAttemptGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxFailuresPerContract != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFailuresPerContract))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FailureRetentionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FailureRetentionPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.SudoGasPrice != nil {
+		{
+			size, err := m.SudoGasPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxPrepaidSudoCallGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrepaidSudoCallGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.SudoCallGasLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.SudoCallGasLimit))
 		i--
@@ -140,6 +263,24 @@ func (m *Params) Size() (n int) {
 	if m.SudoCallGasLimit != 0 {
 		n += 1 + sovParams(uint64(m.SudoCallGasLimit))
 	}
+	if m.MaxPrepaidSudoCallGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrepaidSudoCallGasLimit))
+	}
+	if m.SudoGasPrice != nil {
+		l = m.SudoGasPrice.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FailureRetentionPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxFailuresPerContract != 0 {
+		n += 1 + sovParams(uint64(m.MaxFailuresPerContract))
+	}
+	if m.MaxRetryAttemptGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetryAttemptGasLimit))
+	}
+	if m.MaxRetryGasPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxRetryGasPerBlock))
+	}
 	return n
 }
 
@@ -197,6 +338,151 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrepaidSudoCallGasLimit", wireType)
+			}
+			m.MaxPrepaidSudoCallGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrepaidSudoCallGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SudoGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SudoGasPrice == nil {
+				m.SudoGasPrice = &types.DecCoin{}
+			}
+			if err := m.SudoGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureRetentionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FailureRetentionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailuresPerContract", wireType)
+			}
+			m.MaxFailuresPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailuresPerContract |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryAttemptGasLimit", wireType)
+			}
+			m.MaxRetryAttemptGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryAttemptGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryGasPerBlock", wireType)
+			}
+			m.MaxRetryGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
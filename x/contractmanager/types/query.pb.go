@@ -118,6 +118,22 @@ type QueryFailuresRequest struct {
 	// address of the contract which Sudo call failed.
 	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// kind filters the failures by their origin. Not applied if unspecified.
+	Kind FailureKind `protobuf:"varint,4,opt,name=kind,proto3,enum=neutron.contractmanager.FailureKind" json:"kind,omitempty"`
+	// channel_id filters the failures by the channel they are related to. Not applied if empty.
+	ChannelId string `protobuf:"bytes,5,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence filters the failures by the sequence of the packet they are related to. Not
+	// applied if zero.
+	Sequence uint64 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// query_id filters the failures by the interchain query they are related to. Not applied if
+	// zero.
+	QueryId uint64 `protobuf:"varint,7,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// min_height filters out the failures created before the height. Not applied if zero.
+	MinHeight uint64 `protobuf:"varint,8,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// max_height filters out the failures created after the height. Not applied if zero.
+	MaxHeight uint64 `protobuf:"varint,9,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	// min_gas_used filters out the failures which sudo calls used less gas. Not applied if zero.
+	MinGasUsed uint64 `protobuf:"varint,10,opt,name=min_gas_used,json=minGasUsed,proto3" json:"min_gas_used,omitempty"`
 }
 
 func (m *QueryFailuresRequest) Reset()         { *m = QueryFailuresRequest{} }
@@ -167,6 +183,55 @@ func (m *QueryFailuresRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryFailuresRequest) GetKind() FailureKind {
+	if m != nil {
+		return m.Kind
+	}
+	return FailureKind_FAILURE_KIND_UNSPECIFIED
+}
+
+func (m *QueryFailuresRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryFailuresRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueryFailuresRequest) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *QueryFailuresRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryFailuresRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *QueryFailuresRequest) GetMinGasUsed() uint64 {
+	if m != nil {
+		return m.MinGasUsed
+	}
+	return 0
+}
+
 // QueryFailureRequest is request type for the Query/Failures RPC method.
 type QueryFailureRequest struct {
 	// address of the contract which Sudo call failed.
@@ -320,6 +385,299 @@ func (m *QueryFailuresResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryAddressFailuresCountRequest is request type for the Query/AddressFailuresCount RPC method.
+type QueryAddressFailuresCountRequest struct {
+	// address of the contract which Sudo calls failed.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressFailuresCountRequest) Reset()         { *m = QueryAddressFailuresCountRequest{} }
+func (m *QueryAddressFailuresCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFailuresCountRequest) ProtoMessage()    {}
+func (*QueryAddressFailuresCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9524a427f219917, []int{6}
+}
+func (m *QueryAddressFailuresCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFailuresCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFailuresCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressFailuresCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFailuresCountRequest.Merge(m, src)
+}
+func (m *QueryAddressFailuresCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFailuresCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFailuresCountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressFailuresCountRequest proto.InternalMessageInfo
+
+func (m *QueryAddressFailuresCountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAddressFailuresCountResponse is response type for the Query/AddressFailuresCount RPC method.
+type QueryAddressFailuresCountResponse struct {
+	// count is the number of the stored failures of the contract.
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *QueryAddressFailuresCountResponse) Reset()         { *m = QueryAddressFailuresCountResponse{} }
+func (m *QueryAddressFailuresCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressFailuresCountResponse) ProtoMessage()    {}
+func (*QueryAddressFailuresCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9524a427f219917, []int{7}
+}
+func (m *QueryAddressFailuresCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressFailuresCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressFailuresCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressFailuresCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressFailuresCountResponse.Merge(m, src)
+}
+func (m *QueryAddressFailuresCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressFailuresCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressFailuresCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressFailuresCountResponse proto.InternalMessageInfo
+
+func (m *QueryAddressFailuresCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// QueryRetryPolicyRequest is request type for the Query/RetryPolicy RPC method.
+type QueryRetryPolicyRequest struct {
+	// address of the contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRetryPolicyRequest) Reset()         { *m = QueryRetryPolicyRequest{} }
+func (m *QueryRetryPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRetryPolicyRequest) ProtoMessage()    {}
+func (*QueryRetryPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9524a427f219917, []int{8}
+}
+func (m *QueryRetryPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetryPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetryPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRetryPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetryPolicyRequest.Merge(m, src)
+}
+func (m *QueryRetryPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetryPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetryPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetryPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryRetryPolicyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryRetryPolicyResponse is response type for the Query/RetryPolicy RPC method.
+type QueryRetryPolicyResponse struct {
+	RetryPolicy RetryPolicy `protobuf:"bytes,1,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy"`
+}
+
+func (m *QueryRetryPolicyResponse) Reset()         { *m = QueryRetryPolicyResponse{} }
+func (m *QueryRetryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRetryPolicyResponse) ProtoMessage()    {}
+func (*QueryRetryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9524a427f219917, []int{9}
+}
+func (m *QueryRetryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRetryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetryPolicyResponse.Merge(m, src)
+}
+func (m *QueryRetryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetryPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRetryPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryRetryPolicyResponse) GetRetryPolicy() RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return RetryPolicy{}
+}
+
+// QuerySudoGasLimitRequest is request type for the Query/SudoGasLimit RPC method.
+type QuerySudoGasLimitRequest struct {
+	// address of the contract.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySudoGasLimitRequest) Reset()         { *m = QuerySudoGasLimitRequest{} }
+func (m *QuerySudoGasLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySudoGasLimitRequest) ProtoMessage()    {}
+func (*QuerySudoGasLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9524a427f219917, []int{10}
+}
+func (m *QuerySudoGasLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySudoGasLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySudoGasLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySudoGasLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySudoGasLimitRequest.Merge(m, src)
+}
+func (m *QuerySudoGasLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySudoGasLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySudoGasLimitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySudoGasLimitRequest proto.InternalMessageInfo
+
+func (m *QuerySudoGasLimitRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySudoGasLimitResponse is response type for the Query/SudoGasLimit RPC method.
+type QuerySudoGasLimitResponse struct {
+	// gas_limit is the effective gas limit of the contract's sudo calls.
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// grant is the gas limit granted to the contract by the governance, if any.
+	Grant *SudoGasGrant `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
+	// prepayment is the gas prepayment of the contract, if any.
+	Prepayment *SudoGasPrepayment `protobuf:"bytes,3,opt,name=prepayment,proto3" json:"prepayment,omitempty"`
+}
+
+func (m *QuerySudoGasLimitResponse) Reset()         { *m = QuerySudoGasLimitResponse{} }
+func (m *QuerySudoGasLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySudoGasLimitResponse) ProtoMessage()    {}
+func (*QuerySudoGasLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9524a427f219917, []int{11}
+}
+func (m *QuerySudoGasLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySudoGasLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySudoGasLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySudoGasLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySudoGasLimitResponse.Merge(m, src)
+}
+func (m *QuerySudoGasLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySudoGasLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySudoGasLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySudoGasLimitResponse proto.InternalMessageInfo
+
+func (m *QuerySudoGasLimitResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *QuerySudoGasLimitResponse) GetGrant() *SudoGasGrant {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+func (m *QuerySudoGasLimitResponse) GetPrepayment() *SudoGasPrepayment {
+	if m != nil {
+		return m.Prepayment
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.contractmanager.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.contractmanager.QueryParamsResponse")
@@ -327,6 +685,12 @@ func init() {
 	proto.RegisterType((*QueryFailureRequest)(nil), "neutron.contractmanager.QueryFailureRequest")
 	proto.RegisterType((*QueryFailureResponse)(nil), "neutron.contractmanager.QueryFailureResponse")
 	proto.RegisterType((*QueryFailuresResponse)(nil), "neutron.contractmanager.QueryFailuresResponse")
+	proto.RegisterType((*QueryAddressFailuresCountRequest)(nil), "neutron.contractmanager.QueryAddressFailuresCountRequest")
+	proto.RegisterType((*QueryAddressFailuresCountResponse)(nil), "neutron.contractmanager.QueryAddressFailuresCountResponse")
+	proto.RegisterType((*QueryRetryPolicyRequest)(nil), "neutron.contractmanager.QueryRetryPolicyRequest")
+	proto.RegisterType((*QueryRetryPolicyResponse)(nil), "neutron.contractmanager.QueryRetryPolicyResponse")
+	proto.RegisterType((*QuerySudoGasLimitRequest)(nil), "neutron.contractmanager.QuerySudoGasLimitRequest")
+	proto.RegisterType((*QuerySudoGasLimitResponse)(nil), "neutron.contractmanager.QuerySudoGasLimitResponse")
 }
 
 func init() {
@@ -334,42 +698,67 @@ func init() {
 }
 
 var fileDescriptor_f9524a427f219917 = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x41, 0x4f, 0x13, 0x41,
-	0x1c, 0xc5, 0x3b, 0x05, 0x0a, 0x8c, 0x89, 0x26, 0x63, 0x8d, 0x4d, 0xa3, 0xdb, 0xb2, 0xa8, 0xa0,
-	0xd8, 0x99, 0x14, 0x2e, 0x86, 0xc4, 0x04, 0x7b, 0xc0, 0x78, 0x31, 0xb8, 0xe1, 0x60, 0xbc, 0x98,
-	0x69, 0x3b, 0xae, 0x9b, 0xd0, 0x9d, 0x65, 0x67, 0x96, 0x40, 0x08, 0x17, 0x2f, 0x5e, 0x3c, 0x98,
-	0xe8, 0x47, 0xd0, 0xb3, 0x5f, 0x83, 0x23, 0x89, 0x17, 0x4f, 0xc6, 0xb4, 0x7e, 0x10, 0xd3, 0x99,
-	0x7f, 0x0b, 0x0b, 0x59, 0xb7, 0x5c, 0xb8, 0x6d, 0xa7, 0xef, 0xbd, 0xff, 0x6f, 0xdf, 0xfc, 0x5b,
-	0xbc, 0x18, 0x8a, 0x44, 0xc7, 0x32, 0x64, 0x1d, 0x19, 0xea, 0x98, 0x77, 0x74, 0x8f, 0x87, 0xdc,
-	0x17, 0x31, 0xdb, 0x4d, 0x44, 0x7c, 0x40, 0xa3, 0x58, 0x6a, 0x49, 0x6e, 0x83, 0x88, 0x9e, 0x13,
-	0x55, 0x1f, 0x75, 0xa4, 0xea, 0x49, 0xc5, 0xda, 0x5c, 0x09, 0xeb, 0x60, 0x7b, 0xcd, 0xb6, 0xd0,
-	0xbc, 0xc9, 0x22, 0xee, 0x07, 0x21, 0xd7, 0x81, 0x0c, 0x6d, 0x48, 0xb5, 0xec, 0x4b, 0x5f, 0x9a,
-	0x47, 0x36, 0x7c, 0x82, 0xd3, 0x3b, 0xbe, 0x94, 0xfe, 0x8e, 0x60, 0x3c, 0x0a, 0x18, 0x0f, 0x43,
-	0xa9, 0x8d, 0x45, 0xc1, 0xb7, 0xf7, 0xb3, 0xe8, 0xde, 0xf1, 0x60, 0x27, 0x89, 0x05, 0xc8, 0xee,
-	0x65, 0xc9, 0x22, 0x1e, 0xf3, 0x1e, 0x84, 0xb9, 0x65, 0x4c, 0x5e, 0x0d, 0x11, 0xb7, 0xcc, 0xa1,
-	0x27, 0x76, 0x13, 0xa1, 0xb4, 0xbb, 0x8d, 0x6f, 0xa6, 0x4e, 0x55, 0x24, 0x43, 0x25, 0xc8, 0x53,
-	0x5c, 0xb2, 0xe6, 0x0a, 0xaa, 0xa3, 0xe5, 0x6b, 0xab, 0x35, 0x9a, 0xd1, 0x01, 0xb5, 0xc6, 0xd6,
-	0xf4, 0xf1, 0xef, 0x5a, 0xc1, 0x03, 0x93, 0xbb, 0x8f, 0xcb, 0x26, 0x75, 0xd3, 0x72, 0x8e, 0xa6,
-	0x91, 0x0a, 0x9e, 0xe5, 0xdd, 0x6e, 0x2c, 0x94, 0xcd, 0x9d, 0xf7, 0x46, 0x1f, 0xc9, 0x26, 0xc6,
-	0xa7, 0x95, 0x55, 0xa6, 0xcc, 0xd0, 0x07, 0xd4, 0xf6, 0x4b, 0x87, 0xfd, 0x52, 0x7b, 0x23, 0xd0,
-	0x2f, 0xdd, 0xe2, 0xbe, 0x80, 0x54, 0xef, 0x8c, 0xd3, 0x7d, 0x09, 0xef, 0x03, 0x93, 0xf3, 0x07,
-	0xdf, 0xc5, 0x18, 0xda, 0x7c, 0x1b, 0x74, 0x2b, 0xc5, 0x3a, 0x5a, 0x9e, 0xf6, 0xe6, 0xe1, 0xe4,
-	0x45, 0xd7, 0x7d, 0x9d, 0x7e, 0x93, 0x71, 0x41, 0x1b, 0x78, 0x16, 0x44, 0xd0, 0x50, 0x3d, 0xb3,
-	0x21, 0xb0, 0x42, 0x45, 0x23, 0x9b, 0xfb, 0x0d, 0xe1, 0x5b, 0xe7, 0x4a, 0x82, 0xec, 0x16, 0x9e,
-	0x03, 0xd1, 0x90, 0x76, 0xea, 0x12, 0xe1, 0x63, 0x1f, 0x79, 0x9e, 0xea, 0xb3, 0x68, 0x10, 0x97,
-	0x72, 0xfb, 0xb4, 0x00, 0x67, 0x0b, 0x5d, 0xfd, 0x38, 0x83, 0x67, 0x0c, 0x26, 0xf9, 0x84, 0x70,
-	0xc9, 0xde, 0x36, 0x59, 0xc9, 0xe4, 0xb9, 0xb8, 0x62, 0xd5, 0xc7, 0x93, 0x89, 0xed, 0x6c, 0x77,
-	0xe9, 0xc3, 0xcf, 0xbf, 0x5f, 0x8a, 0x0b, 0xa4, 0xc6, 0xfe, 0xbf, 0xd5, 0xe4, 0x07, 0xc2, 0xd7,
-	0x9f, 0xd9, 0x4b, 0x84, 0x12, 0x48, 0xce, 0xa4, 0xf4, 0x4e, 0x54, 0x1b, 0x13, 0xaa, 0x01, 0x6c,
-	0xc3, 0x80, 0xad, 0x93, 0x27, 0x2c, 0xe7, 0x57, 0xa9, 0xd8, 0x21, 0x2c, 0xd7, 0x11, 0x3b, 0x3c,
-	0xdd, 0xad, 0x23, 0xf2, 0x1d, 0xe1, 0x1b, 0x69, 0x62, 0x45, 0x26, 0x83, 0x18, 0x77, 0x49, 0x27,
-	0x95, 0x03, 0xf4, 0x9a, 0x81, 0x6e, 0x90, 0x95, 0x4b, 0x40, 0x93, 0xaf, 0x08, 0xcf, 0x5d, 0x15,
-	0xe0, 0x43, 0x03, 0xb8, 0x48, 0x16, 0x72, 0x01, 0x5b, 0xdb, 0xc7, 0x7d, 0x07, 0x9d, 0xf4, 0x1d,
-	0xf4, 0xa7, 0xef, 0xa0, 0xcf, 0x03, 0xa7, 0x70, 0x32, 0x70, 0x0a, 0xbf, 0x06, 0x4e, 0xe1, 0xcd,
-	0xba, 0x1f, 0xe8, 0xf7, 0x49, 0x9b, 0x76, 0x64, 0x6f, 0x14, 0xd3, 0x90, 0xb1, 0x3f, 0x8e, 0xdc,
-	0x6b, 0x36, 0xd9, 0xfe, 0x85, 0x60, 0x7d, 0x10, 0x09, 0xd5, 0x2e, 0x99, 0x7f, 0xc7, 0xb5, 0x7f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x01, 0x9c, 0x24, 0x6d, 0x0a, 0x06, 0x00, 0x00,
+	// 946 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0xbb, 0x69, 0x9b, 0xbc, 0x56, 0x8b, 0x34, 0x04, 0xad, 0xd7, 0xb0, 0x69, 0xea, 0xfd,
+	0x57, 0xba, 0xd4, 0x26, 0x2d, 0x12, 0xdb, 0x02, 0xd2, 0x52, 0xa4, 0x2d, 0xe5, 0x9f, 0x8a, 0x59,
+	0x24, 0xc4, 0x25, 0x9a, 0xc6, 0x83, 0x6b, 0x91, 0xcc, 0x78, 0x3d, 0xf6, 0xaa, 0xd5, 0x6a, 0x2f,
+	0x9c, 0x39, 0x20, 0xc1, 0x47, 0x80, 0x13, 0x07, 0x90, 0xf8, 0x04, 0xdc, 0x7a, 0xac, 0xc4, 0x85,
+	0x13, 0x42, 0x2d, 0x07, 0x3e, 0x06, 0xf2, 0xcc, 0x4b, 0xea, 0xb4, 0x75, 0x9c, 0x70, 0xd8, 0x5b,
+	0xe6, 0xcd, 0xfb, 0xfd, 0xde, 0xef, 0xfd, 0x99, 0x17, 0xc3, 0x4d, 0xce, 0xd2, 0x24, 0x16, 0xdc,
+	0xed, 0x08, 0x9e, 0xc4, 0xb4, 0x93, 0xf4, 0x28, 0xa7, 0x01, 0x8b, 0xdd, 0xc7, 0x29, 0x8b, 0x0f,
+	0x9d, 0x28, 0x16, 0x89, 0x20, 0xd7, 0xd0, 0xc9, 0x39, 0xe7, 0x64, 0xad, 0x74, 0x84, 0xec, 0x09,
+	0xe9, 0xee, 0x51, 0xc9, 0x34, 0xc2, 0x7d, 0xd2, 0xda, 0x63, 0x09, 0x6d, 0xb9, 0x11, 0x0d, 0x42,
+	0x4e, 0x93, 0x50, 0x70, 0x4d, 0x62, 0xd5, 0x03, 0x11, 0x08, 0xf5, 0xd3, 0xcd, 0x7e, 0xa1, 0xf5,
+	0x95, 0x40, 0x88, 0xa0, 0xcb, 0x5c, 0x1a, 0x85, 0x2e, 0xe5, 0x5c, 0x24, 0x0a, 0x22, 0xf1, 0xf6,
+	0x76, 0x91, 0xba, 0xaf, 0x68, 0xd8, 0x4d, 0x63, 0x86, 0x6e, 0xb7, 0x8a, 0xdc, 0x22, 0x1a, 0xd3,
+	0x5e, 0x9f, 0x6c, 0xa5, 0xc8, 0x2b, 0x66, 0x49, 0x7c, 0xd8, 0x8e, 0x44, 0x37, 0xec, 0x60, 0xc6,
+	0xd6, 0x9d, 0x22, 0x5f, 0x99, 0xfa, 0xa2, 0x1d, 0x50, 0xe4, 0xb4, 0xeb, 0x40, 0x3e, 0xcd, 0xd2,
+	0xde, 0x55, 0x81, 0x3c, 0xf6, 0x38, 0x65, 0x32, 0xb1, 0x1f, 0xc1, 0x8b, 0x43, 0x56, 0x19, 0x09,
+	0x2e, 0x19, 0x79, 0x07, 0x66, 0xb5, 0x20, 0xd3, 0x68, 0x1a, 0xcb, 0xf3, 0x6b, 0x8b, 0x4e, 0x41,
+	0x5d, 0x1d, 0x0d, 0xdc, 0xaa, 0x1c, 0xfd, 0xb5, 0x38, 0xe5, 0x21, 0xc8, 0xfe, 0x77, 0x1a, 0xea,
+	0x8a, 0xf6, 0xa1, 0x4e, 0xbe, 0x1f, 0x8e, 0x98, 0x30, 0x47, 0x7d, 0x3f, 0x66, 0x52, 0x13, 0xd7,
+	0xbc, 0xfe, 0x91, 0x3c, 0x04, 0x38, 0xeb, 0x83, 0x79, 0x45, 0x45, 0xbd, 0xe3, 0xe8, 0xa6, 0x39,
+	0x59, 0xd3, 0x1c, 0xdd, 0x66, 0x6c, 0x9a, 0xb3, 0x4b, 0x03, 0x86, 0xac, 0x5e, 0x0e, 0x49, 0xee,
+	0x43, 0xe5, 0xeb, 0x90, 0xfb, 0x66, 0xa5, 0x69, 0x2c, 0x5f, 0x5d, 0xbb, 0x55, 0xa8, 0x1b, 0x95,
+	0x7d, 0x18, 0x72, 0xdf, 0x53, 0x08, 0x72, 0x03, 0xa0, 0xb3, 0x4f, 0x39, 0x67, 0xdd, 0x76, 0xe8,
+	0x9b, 0x33, 0x4a, 0x5e, 0x0d, 0x2d, 0x3b, 0x3e, 0xb1, 0xa0, 0x2a, 0xb3, 0x78, 0xbc, 0xc3, 0xcc,
+	0xd9, 0xa6, 0xb1, 0x5c, 0xf1, 0x06, 0x67, 0x72, 0x1d, 0xaa, 0x4a, 0x5d, 0x06, 0x9c, 0x53, 0x77,
+	0x73, 0xea, 0xbc, 0xa3, 0x58, 0x7b, 0x21, 0x6f, 0xef, 0xb3, 0x30, 0xd8, 0x4f, 0xcc, 0xaa, 0xba,
+	0xac, 0xf5, 0x42, 0xfe, 0xbe, 0x32, 0xa8, 0x6b, 0x7a, 0xd0, 0xbf, 0xae, 0xe1, 0x35, 0x3d, 0xc0,
+	0xeb, 0x26, 0x2c, 0x64, 0xe8, 0x80, 0xca, 0x76, 0x2a, 0x99, 0x6f, 0x82, 0x72, 0xc8, 0x18, 0xb7,
+	0xa9, 0xfc, 0x5c, 0x32, 0xdf, 0xfe, 0x04, 0x1b, 0x88, 0xf9, 0x94, 0x17, 0xfa, 0x06, 0x00, 0x8e,
+	0x64, 0xa6, 0x76, 0x5a, 0x47, 0x44, 0xcb, 0x8e, 0x6f, 0x7f, 0x31, 0xdc, 0xb9, 0xc1, 0x44, 0x3c,
+	0x80, 0x39, 0x74, 0xc2, 0x91, 0x68, 0x96, 0x95, 0x16, 0x67, 0xa2, 0x0f, 0xb3, 0x7f, 0x34, 0xe0,
+	0xa5, 0x73, 0x43, 0x81, 0xdc, 0x5b, 0x50, 0x45, 0xa7, 0x4c, 0xed, 0x95, 0x09, 0xc8, 0x07, 0x38,
+	0xb2, 0x3d, 0x34, 0x3f, 0xd3, 0x4a, 0xe2, 0xdd, 0xd2, 0xf9, 0xd1, 0x02, 0xf2, 0x03, 0x64, 0xbf,
+	0x0d, 0x4d, 0xa5, 0xf2, 0x5d, 0x5d, 0xaf, 0xbe, 0xd8, 0xf7, 0x44, 0xca, 0x93, 0xd2, 0xea, 0xda,
+	0x1b, 0xb0, 0x34, 0x02, 0x8d, 0xf9, 0xd6, 0x61, 0xa6, 0x93, 0x19, 0x14, 0xb8, 0xe2, 0xe9, 0x83,
+	0xbd, 0x0e, 0xd7, 0x14, 0xd4, 0xcb, 0xde, 0xf8, 0xae, 0x7a, 0xe2, 0xe5, 0xf1, 0x42, 0x30, 0x2f,
+	0x82, 0x30, 0xcc, 0xc7, 0xb0, 0x90, 0xdf, 0x17, 0xd8, 0xb7, 0xe2, 0x27, 0x91, 0xe3, 0xc0, 0xf2,
+	0xce, 0xc7, 0x67, 0x26, 0xfb, 0x0d, 0x0c, 0xf5, 0x59, 0xea, 0x8b, 0x6d, 0x2a, 0x3f, 0x0a, 0x7b,
+	0xe1, 0x18, 0x05, 0xf9, 0xdd, 0x80, 0xeb, 0x97, 0xc0, 0x50, 0xe2, 0xcb, 0x50, 0xcb, 0x66, 0xbb,
+	0x9b, 0x19, 0xb1, 0x1a, 0xd5, 0x00, 0x9d, 0xc8, 0x5b, 0x30, 0x13, 0xc4, 0x94, 0x27, 0xd8, 0xcd,
+	0xdb, 0x85, 0xc2, 0x91, 0x7a, 0x3b, 0x73, 0xf6, 0x34, 0x86, 0x7c, 0x00, 0x10, 0xc5, 0x2c, 0xa2,
+	0x87, 0x3d, 0xc6, 0x13, 0xdc, 0x27, 0x2b, 0x65, 0x0c, 0xbb, 0x03, 0x84, 0x97, 0x43, 0xaf, 0xfd,
+	0x56, 0x83, 0x19, 0x95, 0x03, 0xf9, 0xd6, 0x80, 0x59, 0xbd, 0xf1, 0xc8, 0xbd, 0x42, 0xb2, 0x8b,
+	0x6b, 0xd6, 0x7a, 0x6d, 0x3c, 0x67, 0x5d, 0x15, 0xfb, 0xee, 0x37, 0x7f, 0xfc, 0xf3, 0xfd, 0xf4,
+	0x12, 0x59, 0x74, 0x47, 0xff, 0x5b, 0x90, 0x5f, 0x0c, 0xb8, 0x3a, 0x3c, 0x69, 0xa4, 0x24, 0xd2,
+	0xf0, 0x9a, 0xb0, 0x56, 0xc7, 0xf4, 0x46, 0x61, 0x0f, 0x94, 0xb0, 0x4d, 0x72, 0xdf, 0x2d, 0xf9,
+	0xb7, 0x93, 0xee, 0x53, 0x1c, 0x80, 0x67, 0xee, 0xd3, 0xb3, 0x75, 0xf3, 0x8c, 0xfc, 0x64, 0xc0,
+	0x0b, 0xe7, 0xde, 0x06, 0x19, 0x4f, 0xc4, 0xa0, 0x96, 0xce, 0xb8, 0xee, 0x28, 0x7a, 0x5d, 0x89,
+	0x5e, 0x25, 0xf7, 0x26, 0x10, 0x4d, 0x8e, 0x0c, 0xa8, 0x5f, 0xf6, 0x86, 0xc9, 0xc6, 0xe8, 0xe8,
+	0x23, 0xb6, 0x86, 0xb5, 0xf9, 0x7f, 0xa0, 0x98, 0xc4, 0x86, 0x4a, 0x62, 0x9d, 0xb4, 0x4a, 0x93,
+	0x68, 0xab, 0x6d, 0x92, 0x4b, 0xe5, 0x07, 0x03, 0xaa, 0xcf, 0xab, 0xd6, 0xaf, 0x2a, 0x99, 0x37,
+	0xc9, 0x52, 0xa9, 0x4c, 0xf2, 0xb3, 0x01, 0xf3, 0xb9, 0x8d, 0x43, 0x5e, 0x1f, 0x1d, 0xea, 0xe2,
+	0x56, 0xb4, 0x5a, 0x13, 0x20, 0x50, 0xdf, 0x9b, 0x4a, 0x5f, 0x8b, 0xb8, 0xee, 0x38, 0x5f, 0x58,
+	0xb9, 0x22, 0xfe, 0x6a, 0xc0, 0x42, 0x7e, 0x83, 0x91, 0x92, 0xe0, 0x97, 0x2c, 0x49, 0x6b, 0x6d,
+	0x12, 0xc8, 0xd8, 0x7d, 0xef, 0x7f, 0xe6, 0xe9, 0x25, 0x7a, 0x26, 0x79, 0xeb, 0xd1, 0xd1, 0x49,
+	0xc3, 0x38, 0x3e, 0x69, 0x18, 0x7f, 0x9f, 0x34, 0x8c, 0xef, 0x4e, 0x1b, 0x53, 0xc7, 0xa7, 0x8d,
+	0xa9, 0x3f, 0x4f, 0x1b, 0x53, 0x5f, 0x6e, 0x06, 0x61, 0xb2, 0x9f, 0xee, 0x39, 0x1d, 0xd1, 0xeb,
+	0xd3, 0xae, 0x8a, 0x38, 0x18, 0x84, 0x78, 0xd2, 0x6a, 0xb9, 0x07, 0x17, 0x02, 0x25, 0x87, 0x11,
+	0x93, 0x7b, 0xb3, 0xea, 0x6b, 0x72, 0xfd, 0xbf, 0x01, 0x00, 0x5a, 0x20, 0x4c, 0xf5, 0x8e, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -390,8 +779,14 @@ type QueryClient interface {
 	AddressFailure(ctx context.Context, in *QueryFailureRequest, opts ...grpc.CallOption) (*QueryFailureResponse, error)
 	// Queries Failures by contract address.
 	AddressFailures(ctx context.Context, in *QueryFailuresRequest, opts ...grpc.CallOption) (*QueryFailuresResponse, error)
+	// Queries the number of Failures of a contract.
+	AddressFailuresCount(ctx context.Context, in *QueryAddressFailuresCountRequest, opts ...grpc.CallOption) (*QueryAddressFailuresCountResponse, error)
 	// Queries a list of Failures occurred on the network.
 	Failures(ctx context.Context, in *QueryFailuresRequest, opts ...grpc.CallOption) (*QueryFailuresResponse, error)
+	// Queries the retry policy of a contract.
+	RetryPolicy(ctx context.Context, in *QueryRetryPolicyRequest, opts ...grpc.CallOption) (*QueryRetryPolicyResponse, error)
+	// Queries the effective sudo call gas limit of a contract.
+	SudoGasLimit(ctx context.Context, in *QuerySudoGasLimitRequest, opts ...grpc.CallOption) (*QuerySudoGasLimitResponse, error)
 }
 
 type queryClient struct {
@@ -429,6 +824,15 @@ func (c *queryClient) AddressFailures(ctx context.Context, in *QueryFailuresRequ
 	return out, nil
 }
 
+func (c *queryClient) AddressFailuresCount(ctx context.Context, in *QueryAddressFailuresCountRequest, opts ...grpc.CallOption) (*QueryAddressFailuresCountResponse, error) {
+	out := new(QueryAddressFailuresCountResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Query/AddressFailuresCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Failures(ctx context.Context, in *QueryFailuresRequest, opts ...grpc.CallOption) (*QueryFailuresResponse, error) {
 	out := new(QueryFailuresResponse)
 	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Query/Failures", in, out, opts...)
@@ -438,6 +842,24 @@ func (c *queryClient) Failures(ctx context.Context, in *QueryFailuresRequest, op
 	return out, nil
 }
 
+func (c *queryClient) RetryPolicy(ctx context.Context, in *QueryRetryPolicyRequest, opts ...grpc.CallOption) (*QueryRetryPolicyResponse, error) {
+	out := new(QueryRetryPolicyResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Query/RetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SudoGasLimit(ctx context.Context, in *QuerySudoGasLimitRequest, opts ...grpc.CallOption) (*QuerySudoGasLimitResponse, error) {
+	out := new(QuerySudoGasLimitResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Query/SudoGasLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -446,8 +868,14 @@ type QueryServer interface {
 	AddressFailure(context.Context, *QueryFailureRequest) (*QueryFailureResponse, error)
 	// Queries Failures by contract address.
 	AddressFailures(context.Context, *QueryFailuresRequest) (*QueryFailuresResponse, error)
+	// Queries the number of Failures of a contract.
+	AddressFailuresCount(context.Context, *QueryAddressFailuresCountRequest) (*QueryAddressFailuresCountResponse, error)
 	// Queries a list of Failures occurred on the network.
 	Failures(context.Context, *QueryFailuresRequest) (*QueryFailuresResponse, error)
+	// Queries the retry policy of a contract.
+	RetryPolicy(context.Context, *QueryRetryPolicyRequest) (*QueryRetryPolicyResponse, error)
+	// Queries the effective sudo call gas limit of a contract.
+	SudoGasLimit(context.Context, *QuerySudoGasLimitRequest) (*QuerySudoGasLimitResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -463,9 +891,18 @@ func (*UnimplementedQueryServer) AddressFailure(ctx context.Context, req *QueryF
 func (*UnimplementedQueryServer) AddressFailures(ctx context.Context, req *QueryFailuresRequest) (*QueryFailuresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddressFailures not implemented")
 }
+func (*UnimplementedQueryServer) AddressFailuresCount(ctx context.Context, req *QueryAddressFailuresCountRequest) (*QueryAddressFailuresCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressFailuresCount not implemented")
+}
 func (*UnimplementedQueryServer) Failures(ctx context.Context, req *QueryFailuresRequest) (*QueryFailuresResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Failures not implemented")
 }
+func (*UnimplementedQueryServer) RetryPolicy(ctx context.Context, req *QueryRetryPolicyRequest) (*QueryRetryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPolicy not implemented")
+}
+func (*UnimplementedQueryServer) SudoGasLimit(ctx context.Context, req *QuerySudoGasLimitRequest) (*QuerySudoGasLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoGasLimit not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -525,6 +962,24 @@ func _Query_AddressFailures_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AddressFailuresCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressFailuresCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressFailuresCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Query/AddressFailuresCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressFailuresCount(ctx, req.(*QueryAddressFailuresCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Failures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryFailuresRequest)
 	if err := dec(in); err != nil {
@@ -543,26 +998,75 @@ func _Query_Failures_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.contractmanager.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "AddressFailure",
-			Handler:    _Query_AddressFailure_Handler,
-		},
-		{
-			MethodName: "AddressFailures",
-			Handler:    _Query_AddressFailures_Handler,
-		},
-		{
+func _Query_RetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetryPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Query/RetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetryPolicy(ctx, req.(*QueryRetryPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SudoGasLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySudoGasLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SudoGasLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Query/SudoGasLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SudoGasLimit(ctx, req.(*QuerySudoGasLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.contractmanager.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AddressFailure",
+			Handler:    _Query_AddressFailure_Handler,
+		},
+		{
+			MethodName: "AddressFailures",
+			Handler:    _Query_AddressFailures_Handler,
+		},
+		{
+			MethodName: "AddressFailuresCount",
+			Handler:    _Query_AddressFailuresCount_Handler,
+		},
+		{
 			MethodName: "Failures",
 			Handler:    _Query_Failures_Handler,
 		},
+		{
+			MethodName: "RetryPolicy",
+			Handler:    _Query_RetryPolicy_Handler,
+		},
+		{
+			MethodName: "SudoGasLimit",
+			Handler:    _Query_SudoGasLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/contractmanager/query.proto",
@@ -644,6 +1148,43 @@ func (m *QueryFailuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinGasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinGasUsed))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Kind != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -783,6 +1324,209 @@ func (m *QueryFailuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAddressFailuresCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFailuresCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFailuresCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressFailuresCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressFailuresCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressFailuresCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySudoGasLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySudoGasLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySudoGasLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySudoGasLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySudoGasLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySudoGasLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Prepayment != nil {
+		{
+			size, err := m.Prepayment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Grant != nil {
+		{
+			size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -828,6 +1572,28 @@ func (m *QueryFailuresRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Kind != 0 {
+		n += 1 + sovQuery(uint64(m.Kind))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	if m.QueryId != 0 {
+		n += 1 + sovQuery(uint64(m.QueryId))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.MinGasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.MinGasUsed))
+	}
 	return n
 }
 
@@ -877,16 +1643,596 @@ func (m *QueryFailuresResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *QueryAddressFailuresCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressFailuresCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QueryRetryPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RetryPolicy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySudoGasLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySudoGasLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.Grant != nil {
+		l = m.Grant.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Prepayment != nil {
+		l = m.Prepayment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= FailureKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasUsed", wireType)
+			}
+			m.MinGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureId", wireType)
+			}
+			m.FailureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFailureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -902,16 +2248,49 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFailureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -933,7 +2312,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFailuresResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,15 +2335,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailuresResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -991,7 +2370,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failures = append(m.Failures, Failure{})
+			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1016,7 +2432,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAddressFailuresCountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,10 +2455,10 @@ func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFailuresRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAddressFailuresCountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFailuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAddressFailuresCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1077,11 +2493,61 @@ func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressFailuresCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressFailuresCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressFailuresCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1091,28 +2557,11 @@ func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Count |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1134,7 +2583,7 @@ func (m *QueryFailuresRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFailureRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRetryPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1157,10 +2606,10 @@ func (m *QueryFailureRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFailureRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRetryPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRetryPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1195,11 +2644,61 @@ func (m *QueryFailureRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FailureId = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetryPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetryPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1209,11 +2708,25 @@ func (m *QueryFailureRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailureId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1235,7 +2748,7 @@ func (m *QueryFailureRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFailureResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySudoGasLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1258,17 +2771,17 @@ func (m *QueryFailureResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFailureResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySudoGasLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySudoGasLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1278,24 +2791,23 @@ func (m *QueryFailureResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1318,7 +2830,7 @@ func (m *QueryFailureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFailuresResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySudoGasLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1341,15 +2853,34 @@ func (m *QueryFailuresResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFailuresResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySudoGasLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFailuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySudoGasLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1376,14 +2907,16 @@ func (m *QueryFailuresResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Failures = append(m.Failures, Failure{})
-			if err := m.Failures[len(m.Failures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Grant == nil {
+				m.Grant = &SudoGasGrant{}
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prepayment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1410,10 +2943,10 @@ func (m *QueryFailuresResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.Prepayment == nil {
+				m.Prepayment = &SudoGasPrepayment{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Prepayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
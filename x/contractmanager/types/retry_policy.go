@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs basic validation of the retry policy.
+func (p RetryPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRetryPolicy, "invalid address %s: %v", p.Address, err)
+	}
+
+	if p.MaxAttempts == 0 || p.MaxAttempts > MaxRetryAttempts {
+		return errorsmod.Wrapf(ErrInvalidRetryPolicy, "max attempts must be in range [1, %d], got %d", MaxRetryAttempts, p.MaxAttempts)
+	}
+
+	if p.BackoffBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidRetryPolicy, "backoff blocks must be greater than zero")
+	}
+
+	if p.GasLimitIncrement > MaxRetryGasLimitIncrement {
+		return errorsmod.Wrapf(ErrInvalidRetryPolicy, "gas limit increment must not exceed %d, got %d", MaxRetryGasLimitIncrement, p.GasLimitIncrement)
+	}
+
+	return nil
+}
+
+// AttemptGasLimit returns the sudo call gas limit of the given retry attempt.
+func (p RetryPolicy) AttemptGasLimit(sudoCallGasLimit uint64, attempt uint32) uint64 {
+	return sudoCallGasLimit + uint64(attempt)*p.GasLimitIncrement
+}
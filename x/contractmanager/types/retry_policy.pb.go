@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/contractmanager/retry_policy.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RetryPolicy defines how failed sudo calls of a contract are automatically
+// retried by the module
+type RetryPolicy struct {
+	// Address of the contract the policy belongs to
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Maximum number of automatic retry attempts of a failure. Once all the
+	// attempts are made, the failure is moved to the dead-letter state and can
+	// only be resubmitted manually
+	MaxAttempts uint32 `protobuf:"varint,2,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Number of blocks to wait before each retry attempt
+	BackoffBlocks uint64 `protobuf:"varint,3,opt,name=backoff_blocks,json=backoffBlocks,proto3" json:"backoff_blocks,omitempty"`
+	// Amount of gas added to the sudo call gas limit with each retry attempt
+	GasLimitIncrement uint64 `protobuf:"varint,4,opt,name=gas_limit_increment,json=gasLimitIncrement,proto3" json:"gas_limit_increment,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6623f5cd2a1a200, []int{0}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetBackoffBlocks() uint64 {
+	if m != nil {
+		return m.BackoffBlocks
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetGasLimitIncrement() uint64 {
+	if m != nil {
+		return m.GasLimitIncrement
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*RetryPolicy)(nil), "neutron.contractmanager.RetryPolicy")
+}
+
+func init() {
+	proto.RegisterFile("neutron/contractmanager/retry_policy.proto", fileDescriptor_d6623f5cd2a1a200)
+}
+
+var fileDescriptor_d6623f5cd2a1a200 = []byte{
+	// 265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4a, 0xc4, 0x40,
+	0x14, 0x45, 0x33, 0xba, 0x28, 0xce, 0xba, 0x82, 0xb1, 0x30, 0xd5, 0x10, 0x05, 0x21, 0x08, 0x26,
+	0x2c, 0x76, 0x76, 0x6e, 0x27, 0x58, 0x48, 0xb0, 0xb2, 0x09, 0x2f, 0xb3, 0xb3, 0x71, 0xd8, 0xcc,
+	0x4c, 0x98, 0x79, 0x2b, 0xc9, 0x5f, 0xf8, 0x0b, 0xfe, 0x8d, 0xe5, 0x96, 0x96, 0x92, 0xfc, 0x88,
+	0x6c, 0x48, 0x1a, 0xb7, 0x7b, 0xef, 0xdc, 0xd3, 0xdc, 0x4b, 0x6f, 0xb5, 0xd8, 0xa0, 0x35, 0x3a,
+	0xe1, 0x46, 0xa3, 0x05, 0x8e, 0x0a, 0x34, 0x14, 0xc2, 0x26, 0x56, 0xa0, 0x6d, 0xb2, 0xca, 0x94,
+	0x92, 0x37, 0x71, 0x65, 0x0d, 0x1a, 0xff, 0x72, 0x70, 0xe3, 0x7f, 0xee, 0xf5, 0x17, 0xa1, 0xd3,
+	0x74, 0xe7, 0xbf, 0xf4, 0xba, 0x1f, 0xd0, 0x63, 0x58, 0x2e, 0xad, 0x70, 0x2e, 0x20, 0x21, 0x89,
+	0x4e, 0xd2, 0xf1, 0xf5, 0xaf, 0xe8, 0xa9, 0x82, 0x3a, 0x03, 0x44, 0xa1, 0x2a, 0x74, 0xc1, 0x41,
+	0x48, 0xa2, 0x59, 0x3a, 0x55, 0x50, 0x3f, 0x0e, 0xc8, 0xbf, 0xa1, 0x67, 0x39, 0xf0, 0xb5, 0x59,
+	0xad, 0xb2, 0xbc, 0x34, 0x7c, 0xed, 0x82, 0xc3, 0x90, 0x44, 0x93, 0x74, 0x36, 0xd0, 0x45, 0x0f,
+	0xfd, 0x98, 0x5e, 0x14, 0xe0, 0xb2, 0x52, 0x2a, 0x89, 0x99, 0xd4, 0xdc, 0x0a, 0x25, 0x34, 0x06,
+	0x93, 0xde, 0x3d, 0x2f, 0xc0, 0x3d, 0xef, 0x92, 0xa7, 0x31, 0x58, 0xbc, 0x7e, 0xb7, 0x8c, 0x6c,
+	0x5b, 0x46, 0x7e, 0x5b, 0x46, 0x3e, 0x3b, 0xe6, 0x6d, 0x3b, 0xe6, 0xfd, 0x74, 0xcc, 0x7b, 0x7b,
+	0x28, 0x24, 0xbe, 0x6f, 0xf2, 0x98, 0x1b, 0x95, 0x0c, 0x0d, 0xef, 0x8c, 0x2d, 0xc6, 0x3b, 0xf9,
+	0x98, 0xcf, 0x93, 0x7a, 0x6f, 0x1f, 0x6c, 0x2a, 0xe1, 0xf2, 0xa3, 0x7e, 0x99, 0xfb, 0xbf, 0x01,
+	0x00, 0x7e, 0x34, 0x11, 0x8e, 0x47, 0x01, 0x00, 0x00,
+}
+
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimitIncrement != 0 {
+		i = encodeVarintRetryPolicy(dAtA, i, uint64(m.GasLimitIncrement))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BackoffBlocks != 0 {
+		i = encodeVarintRetryPolicy(dAtA, i, uint64(m.BackoffBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintRetryPolicy(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRetryPolicy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRetryPolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRetryPolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRetryPolicy(uint64(l))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovRetryPolicy(uint64(m.MaxAttempts))
+	}
+	if m.BackoffBlocks != 0 {
+		n += 1 + sovRetryPolicy(uint64(m.BackoffBlocks))
+	}
+	if m.GasLimitIncrement != 0 {
+		n += 1 + sovRetryPolicy(uint64(m.GasLimitIncrement))
+	}
+	return n
+}
+
+func sovRetryPolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRetryPolicy(x uint64) (n int) {
+	return sovRetryPolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetryPolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetryPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetryPolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetryPolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetryPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffBlocks", wireType)
+			}
+			m.BackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetryPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimitIncrement", wireType)
+			}
+			m.GasLimitIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetryPolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimitIncrement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetryPolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetryPolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRetryPolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRetryPolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetryPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetryPolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRetryPolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRetryPolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRetryPolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRetryPolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRetryPolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRetryPolicy = fmt.Errorf("proto: unexpected end of group")
+)
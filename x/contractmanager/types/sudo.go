@@ -40,14 +40,26 @@ type MessageKVQueryResult struct {
 // MessageSudoCallback is passed to a contract's sudo() entrypoint when an interchain
 // transaction ended up with Success/Error or timed out.
 type MessageSudoCallback struct {
-	Response *ResponseSudoPayload `json:"response,omitempty"`
-	Error    *ErrorSudoPayload    `json:"error,omitempty"`
-	Timeout  *TimeoutPayload      `json:"timeout,omitempty"`
+	Response    *ResponseSudoPayload `json:"response,omitempty"`
+	Error       *ErrorSudoPayload    `json:"error,omitempty"`
+	Timeout     *TimeoutPayload      `json:"timeout,omitempty"`
+	BatchResult *BatchResultPayload  `json:"batch_result,omitempty"`
 }
 
 type ResponseSudoPayload struct {
 	Request channeltypes.Packet `json:"request"`
 	Data    []byte              `json:"data"` // Message data
+	// MsgResponses are the decoded responses of the messages executed on the remote chain, in the
+	// order the messages were submitted. Only set for interchain transactions.
+	MsgResponses []MsgResponse `json:"msg_responses,omitempty"`
+}
+
+// MsgResponse is the response of a single message executed by an interchain account.
+type MsgResponse struct {
+	// TypeURL is the type URL of the message response, e.g. /cosmos.bank.v1beta1.MsgSendResponse.
+	TypeURL string `json:"type_url"`
+	// Data is the protobuf encoded message response.
+	Data []byte `json:"data"`
 }
 
 type ErrorSudoPayload struct {
@@ -59,6 +71,24 @@ type TimeoutPayload struct {
 	Request channeltypes.Packet `json:"request"`
 }
 
+// BatchResultPayload is passed to a contract's sudo() entrypoint once all the packets of an
+// interchain transaction split into several packets are acknowledged or timed out.
+type BatchResultPayload struct {
+	BatchID   uint64              `json:"batch_id"`
+	PortID    string              `json:"port_id"`
+	ChannelID string              `json:"channel_id"`
+	Packets   []BatchPacketResult `json:"packets"`
+}
+
+// BatchPacketResult is the outcome of a single packet of a batch. Exactly one of MsgResponses,
+// Error and Timeout describes the outcome.
+type BatchPacketResult struct {
+	Sequence     uint64        `json:"sequence"`
+	MsgResponses []MsgResponse `json:"msg_responses,omitempty"`
+	Error        string        `json:"error,omitempty"`
+	Timeout      bool          `json:"timeout,omitempty"`
+}
+
 // MessageOnChanOpenAck is passed to a contract's sudo() entrypoint when an interchain
 // account was successfully  registered.
 type MessageOnChanOpenAck struct {
@@ -71,3 +101,41 @@ type OpenAckDetails struct {
 	CounterpartyChannelID string `json:"counterparty_channel_id"`
 	CounterpartyVersion   string `json:"counterparty_version"`
 }
+
+// MessageOnChanClose is passed to a contract's sudo() entrypoint when the channel of an interchain
+// account was closed, e.g. an ordered channel after a packet timeout.
+type MessageOnChanClose struct {
+	ChanClose ChanCloseDetails `json:"chan_close"`
+}
+
+type ChanCloseDetails struct {
+	PortID       string `json:"port_id"`
+	ChannelID    string `json:"channel_id"`
+	ConnectionID string `json:"connection_id"`
+	// Reopening is set if a new channel for the interchain account is being opened automatically.
+	Reopening bool `json:"reopening"`
+}
+
+// MessageTransferReceived is passed to a contract's sudo() entrypoint when the contract received
+// an ICS-20 transfer and has enabled the notifications about the received transfers.
+type MessageTransferReceived struct {
+	TransferReceived TransferReceivedDetails `json:"transfer_received"`
+}
+
+type TransferReceivedDetails struct {
+	// Port and channel of the packet on the Neutron side.
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+	// Port and channel the packet was sent from on the counterparty chain.
+	SourcePort    string `json:"source_port"`
+	SourceChannel string `json:"source_channel"`
+	Sequence      uint64 `json:"sequence"`
+	Sender        string `json:"sender"`
+	Receiver      string `json:"receiver"`
+	// Denom is the denom of the received funds on Neutron, e.g. ibc/{hash} for a voucher.
+	Denom string `json:"denom"`
+	// DenomTrace is the full path of the received denom, e.g. transfer/channel-0/uatom.
+	DenomTrace string `json:"denom_trace"`
+	Amount     string `json:"amount"`
+	Memo       string `json:"memo"`
+}
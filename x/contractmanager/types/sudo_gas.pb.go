@@ -0,0 +1,631 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/contractmanager/sudo_gas.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SudoGasGrant is a sudo call gas limit of a contract granted by the governance
+type SudoGasGrant struct {
+	// Address of the contract the gas limit is granted to
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Gas limit of the contract's sudo calls
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *SudoGasGrant) Reset()         { *m = SudoGasGrant{} }
+func (m *SudoGasGrant) String() string { return proto.CompactTextString(m) }
+func (*SudoGasGrant) ProtoMessage()    {}
+func (*SudoGasGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ae55e8bd351eac3, []int{0}
+}
+func (m *SudoGasGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoGasGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoGasGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoGasGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoGasGrant.Merge(m, src)
+}
+func (m *SudoGasGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoGasGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoGasGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoGasGrant proto.InternalMessageInfo
+
+func (m *SudoGasGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SudoGasGrant) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+// SudoGasPrepayment is a sudo call gas limit of a contract paid for by the
+// contract itself. The gas used above the module-wide and granted limits is
+// charged from the prepaid balance at the sudo gas price set in the params
+type SudoGasPrepayment struct {
+	// Address of the contract which prepaid the gas
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Requested gas limit of the contract's sudo calls. The effective limit is
+	// lowered if the prepaid balance doesn't cover the gas above the module-wide
+	// limit
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Remaining prepaid balance
+	Balance types.Coin `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *SudoGasPrepayment) Reset()         { *m = SudoGasPrepayment{} }
+func (m *SudoGasPrepayment) String() string { return proto.CompactTextString(m) }
+func (*SudoGasPrepayment) ProtoMessage()    {}
+func (*SudoGasPrepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ae55e8bd351eac3, []int{1}
+}
+func (m *SudoGasPrepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SudoGasPrepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SudoGasPrepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SudoGasPrepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SudoGasPrepayment.Merge(m, src)
+}
+func (m *SudoGasPrepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SudoGasPrepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SudoGasPrepayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SudoGasPrepayment proto.InternalMessageInfo
+
+func (m *SudoGasPrepayment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SudoGasPrepayment) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *SudoGasPrepayment) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*SudoGasGrant)(nil), "neutron.contractmanager.SudoGasGrant")
+	proto.RegisterType((*SudoGasPrepayment)(nil), "neutron.contractmanager.SudoGasPrepayment")
+}
+
+func init() {
+	proto.RegisterFile("neutron/contractmanager/sudo_gas.proto", fileDescriptor_7ae55e8bd351eac3)
+}
+
+var fileDescriptor_7ae55e8bd351eac3 = []byte{
+	// 292 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x90, 0x31, 0x4f, 0xc3, 0x30,
+	0x14, 0x84, 0x63, 0xa8, 0x28, 0x35, 0x2c, 0x44, 0x48, 0x84, 0x22, 0x99, 0xaa, 0x03, 0xea, 0x82,
+	0xad, 0xc0, 0x04, 0x63, 0x11, 0xea, 0xc2, 0x80, 0x0a, 0x13, 0x4b, 0xf5, 0xe2, 0x58, 0x26, 0x52,
+	0xe3, 0x57, 0xd9, 0x4e, 0x45, 0x7f, 0x00, 0x3b, 0x3f, 0xab, 0x63, 0x47, 0x26, 0x84, 0xda, 0x3f,
+	0x82, 0xd2, 0x24, 0x0b, 0x6c, 0x6c, 0x77, 0x4f, 0xf7, 0x4e, 0xba, 0x8f, 0x5e, 0x18, 0x55, 0x78,
+	0x8b, 0x46, 0x48, 0x34, 0xde, 0x82, 0xf4, 0x39, 0x18, 0xd0, 0xca, 0x0a, 0x57, 0xa4, 0x38, 0xd1,
+	0xe0, 0xf8, 0xcc, 0xa2, 0xc7, 0xf0, 0xa4, 0xce, 0xf1, 0x5f, 0xb9, 0x2e, 0x93, 0xe8, 0x72, 0x74,
+	0x22, 0x01, 0xa7, 0xc4, 0x3c, 0x4e, 0x94, 0x87, 0x58, 0x48, 0xcc, 0x4c, 0xf5, 0xd8, 0x3d, 0xd6,
+	0xa8, 0x71, 0x2b, 0x45, 0xa9, 0xaa, 0x6b, 0xff, 0x9e, 0x1e, 0x3e, 0x15, 0x29, 0x8e, 0xc0, 0x8d,
+	0x2c, 0x18, 0x1f, 0x46, 0xb4, 0x0d, 0x69, 0x6a, 0x95, 0x73, 0x11, 0xe9, 0x91, 0x41, 0x67, 0xdc,
+	0xd8, 0xf0, 0x8c, 0x76, 0x34, 0xb8, 0xc9, 0x34, 0xcb, 0x33, 0x1f, 0xed, 0xf4, 0xc8, 0xa0, 0x35,
+	0xde, 0xd7, 0xe0, 0x1e, 0x4a, 0xdf, 0x7f, 0x27, 0xf4, 0xa8, 0xee, 0x79, 0xb4, 0x6a, 0x06, 0x8b,
+	0x5c, 0xfd, 0xbb, 0x2c, 0xbc, 0xa1, 0xed, 0x04, 0xa6, 0x60, 0xa4, 0x8a, 0x76, 0x7b, 0x64, 0x70,
+	0x70, 0x75, 0xca, 0xab, 0x6d, 0xbc, 0xdc, 0xc6, 0xeb, 0x6d, 0xfc, 0x0e, 0x33, 0x33, 0x6c, 0x2d,
+	0xbf, 0xce, 0x83, 0x71, 0x93, 0x1f, 0x3e, 0x2f, 0xd7, 0x8c, 0xac, 0xd6, 0x8c, 0x7c, 0xaf, 0x19,
+	0xf9, 0xd8, 0xb0, 0x60, 0xb5, 0x61, 0xc1, 0xe7, 0x86, 0x05, 0x2f, 0xb7, 0x3a, 0xf3, 0xaf, 0x45,
+	0xc2, 0x25, 0xe6, 0xa2, 0x46, 0x78, 0x89, 0x56, 0x37, 0x5a, 0xcc, 0xe3, 0x58, 0xbc, 0xfd, 0x81,
+	0xef, 0x17, 0x33, 0xe5, 0x92, 0xbd, 0x2d, 0xab, 0xeb, 0x9f, 0x01, 0x00, 0xfe, 0x05, 0x3b, 0x73,
+	0xa4, 0x01, 0x00, 0x00,
+}
+
+func (m *SudoGasGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoGasGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoGasGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintSudoGas(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSudoGas(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SudoGasPrepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SudoGasPrepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SudoGasPrepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSudoGas(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasLimit != 0 {
+		i = encodeVarintSudoGas(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSudoGas(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSudoGas(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSudoGas(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SudoGasGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSudoGas(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSudoGas(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *SudoGasPrepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovSudoGas(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSudoGas(uint64(m.GasLimit))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovSudoGas(uint64(l))
+	return n
+}
+
+func sovSudoGas(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSudoGas(x uint64) (n int) {
+	return sovSudoGas(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SudoGasGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSudoGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoGasGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoGasGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSudoGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SudoGasPrepayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSudoGas
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SudoGasPrepayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SudoGasPrepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSudoGas(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSudoGas
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSudoGas(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSudoGas
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSudoGas
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSudoGas
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSudoGas
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSudoGas
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSudoGas        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSudoGas          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSudoGas = fmt.Errorf("proto: unexpected end of group")
+)
@@ -62,3 +62,167 @@ func (msg *MsgResubmitFailure) Validate() error {
 	}
 	return nil
 }
+
+var _ sdk.Msg = &MsgSetRetryPolicy{}
+
+func (msg *MsgSetRetryPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetRetryPolicy) Type() string {
+	return "set-retry-policy"
+}
+
+func (msg *MsgSetRetryPolicy) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgSetRetryPolicy) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetRetryPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	return msg.RetryPolicy().Validate()
+}
+
+// RetryPolicy returns the retry policy set by the message.
+func (msg *MsgSetRetryPolicy) RetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		Address:           msg.Sender,
+		MaxAttempts:       msg.MaxAttempts,
+		BackoffBlocks:     msg.BackoffBlocks,
+		GasLimitIncrement: msg.GasLimitIncrement,
+	}
+}
+
+var _ sdk.Msg = &MsgRemoveRetryPolicy{}
+
+func (msg *MsgRemoveRetryPolicy) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRetryPolicy) Type() string {
+	return "remove-retry-policy"
+}
+
+func (msg *MsgRemoveRetryPolicy) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgRemoveRetryPolicy) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveRetryPolicy) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetSudoGasGrant{}
+
+func (msg *MsgSetSudoGasGrant) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetSudoGasGrant) Type() string {
+	return "set-sudo-gas-grant"
+}
+
+func (msg *MsgSetSudoGasGrant) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetSudoGasGrant) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetSudoGasGrant) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrap(err, "address is invalid")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgPrepaySudoGas{}
+
+func (msg *MsgPrepaySudoGas) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPrepaySudoGas) Type() string {
+	return "prepay-sudo-gas"
+}
+
+func (msg *MsgPrepaySudoGas) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgPrepaySudoGas) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgPrepaySudoGas) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	if msg.GasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidSudoGasLimit, "gas limit must be greater than zero")
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(err, "amount is invalid")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgWithdrawSudoGasPrepayment{}
+
+func (msg *MsgWithdrawSudoGasPrepayment) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawSudoGasPrepayment) Type() string {
+	return "withdraw-sudo-gas-prepayment"
+}
+
+func (msg *MsgWithdrawSudoGasPrepayment) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgWithdrawSudoGasPrepayment) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgWithdrawSudoGasPrepayment) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+	return nil
+}
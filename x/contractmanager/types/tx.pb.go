@@ -5,10 +5,11 @@ package types
 
 import (
 	context "context"
+	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
-	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -221,352 +222,2119 @@ func (m *MsgResubmitFailureResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResubmitFailureResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.contractmanager.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.contractmanager.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgResubmitFailure)(nil), "neutron.contractmanager.MsgResubmitFailure")
-	proto.RegisterType((*MsgResubmitFailureResponse)(nil), "neutron.contractmanager.MsgResubmitFailureResponse")
+// MsgSetRetryPolicy - contract opts into automatic retries of its failures
+type MsgSetRetryPolicy struct {
+	// sender is the contract which failures are retried.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// max_attempts is the maximum number of automatic retry attempts of a failure
+	MaxAttempts uint32 `protobuf:"varint,2,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// backoff_blocks is the number of blocks to wait before each retry attempt
+	BackoffBlocks uint64 `protobuf:"varint,3,opt,name=backoff_blocks,json=backoffBlocks,proto3" json:"backoff_blocks,omitempty"`
+	// gas_limit_increment is the amount of gas added to the sudo call gas limit
+	// with each retry attempt
+	GasLimitIncrement uint64 `protobuf:"varint,4,opt,name=gas_limit_increment,json=gasLimitIncrement,proto3" json:"gas_limit_increment,omitempty"`
 }
 
-func init() { proto.RegisterFile("neutron/contractmanager/tx.proto", fileDescriptor_4dc444ed708d435f) }
-
-var fileDescriptor_4dc444ed708d435f = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0xc9, 0x4d, 0xcc, 0x4b,
-	0x4c, 0x4f, 0x2d, 0xd2, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x87, 0xaa,
-	0xd0, 0x43, 0x53, 0x21, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x6a, 0xa5,
-	0xc4, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14,
-	0x54, 0x42, 0x12, 0x22, 0x11, 0x0f, 0xe6, 0xe9, 0x43, 0x38, 0x50, 0x29, 0x91, 0xf4, 0xfc, 0xf4,
-	0x7c, 0x88, 0x38, 0x88, 0x05, 0x15, 0x55, 0xc1, 0xe5, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8,
-	0x5e, 0xa5, 0x83, 0x8c, 0x5c, 0xfc, 0xbe, 0xc5, 0xe9, 0xa1, 0x05, 0x29, 0x89, 0x25, 0xa9, 0x01,
-	0x60, 0x19, 0x21, 0x33, 0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc, 0x92, 0x4a, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0x96, 0x3a, 0xa6, 0xa4,
-	0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0x21, 0x94, 0x0a, 0x39, 0x71,
-	0xb1, 0x41, 0xcc, 0x96, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd7, 0xc3, 0xe1, 0x71, 0x3d,
-	0x88, 0x45, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa,
-	0xd3, 0xca, 0xa8, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0x99, 0x5d, 0xcf, 0x37, 0x68, 0xc9, 0xa3, 0x7b,
-	0x00, 0xcd, 0xbd, 0x4a, 0x92, 0x5c, 0xe2, 0x68, 0x42, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5,
-	0xa9, 0x4a, 0x33, 0x19, 0xb9, 0x84, 0x7c, 0x8b, 0xd3, 0x83, 0x52, 0x8b, 0x4b, 0x93, 0x72, 0x33,
-	0x4b, 0xdc, 0x12, 0x33, 0x73, 0x4a, 0x8b, 0x52, 0x85, 0x0c, 0xb8, 0xd8, 0x8a, 0x53, 0xf3, 0x52,
-	0x52, 0x8b, 0x08, 0x7a, 0x0f, 0xaa, 0x4e, 0x48, 0x96, 0x8b, 0x2b, 0x0d, 0xa2, 0x39, 0x3e, 0x33,
-	0x05, 0xec, 0x3f, 0x96, 0x20, 0x4e, 0xa8, 0x88, 0x67, 0x0a, 0xc4, 0xd9, 0x50, 0xb5, 0x20, 0x37,
-	0x2b, 0x61, 0x71, 0x33, 0x9a, 0x23, 0x94, 0x64, 0xb8, 0xa4, 0x30, 0x45, 0x61, 0x2e, 0x37, 0x7a,
-	0xcb, 0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x94, 0xc5, 0xc5, 0x83, 0x12, 0x39, 0x1a, 0x38, 0x03,
-	0x15, 0x2d, 0x0c, 0xa4, 0x0c, 0x88, 0x55, 0x09, 0xb3, 0x53, 0xa8, 0x98, 0x8b, 0x1f, 0x3d, 0xa4,
-	0xb4, 0xf1, 0x19, 0x82, 0xa6, 0x58, 0xca, 0x98, 0x04, 0xc5, 0x30, 0x4b, 0xa5, 0x58, 0x1b, 0x40,
-	0x09, 0xc0, 0x29, 0xe4, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
-	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xac, 0xd2,
-	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xa1, 0xe6, 0xeb, 0xe6, 0x17, 0xa5,
-	0xc3, 0xd8, 0xfa, 0x65, 0x86, 0x86, 0xfa, 0x15, 0x98, 0xb9, 0xaf, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x9c, 0xca, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x35, 0x58, 0xa2, 0xb4, 0xa5, 0x03,
-	0x00, 0x00,
+func (m *MsgSetRetryPolicy) Reset()         { *m = MsgSetRetryPolicy{} }
+func (m *MsgSetRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetryPolicy) ProtoMessage()    {}
+func (*MsgSetRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{4}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	ResubmitFailure(ctx context.Context, in *MsgResubmitFailure, opts ...grpc.CallOption) (*MsgResubmitFailureResponse, error)
+func (m *MsgSetRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetryPolicy.Merge(m, src)
+}
+func (m *MsgSetRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetryPolicy.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgSetRetryPolicy proto.InternalMessageInfo
+
+func (m *MsgSetRetryPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) ResubmitFailure(ctx context.Context, in *MsgResubmitFailure, opts ...grpc.CallOption) (*MsgResubmitFailureResponse, error) {
-	out := new(MsgResubmitFailureResponse)
-	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/ResubmitFailure", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRetryPolicy) GetBackoffBlocks() uint64 {
+	if m != nil {
+		return m.BackoffBlocks
 	}
-	return out, nil
+	return 0
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	ResubmitFailure(context.Context, *MsgResubmitFailure) (*MsgResubmitFailureResponse, error)
+func (m *MsgSetRetryPolicy) GetGasLimitIncrement() uint64 {
+	if m != nil {
+		return m.GasLimitIncrement
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgSetRetryPolicyResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgSetRetryPolicyResponse) Reset()         { *m = MsgSetRetryPolicyResponse{} }
+func (m *MsgSetRetryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRetryPolicyResponse) ProtoMessage()    {}
+func (*MsgSetRetryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{5}
 }
-func (*UnimplementedMsgServer) ResubmitFailure(ctx context.Context, req *MsgResubmitFailure) (*MsgResubmitFailureResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResubmitFailure not implemented")
+func (m *MsgSetRetryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRetryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRetryPolicyResponse.Merge(m, src)
+}
+func (m *MsgSetRetryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRetryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRetryPolicyResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetRetryPolicyResponse proto.InternalMessageInfo
+
+// MsgRemoveRetryPolicy - contract opts out of automatic retries of its failures
+type MsgRemoveRetryPolicy struct {
+	// sender is the contract which retry policy is removed.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.contractmanager.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgRemoveRetryPolicy) Reset()         { *m = MsgRemoveRetryPolicy{} }
+func (m *MsgRemoveRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRetryPolicy) ProtoMessage()    {}
+func (*MsgRemoveRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{6}
+}
+func (m *MsgRemoveRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRemoveRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRetryPolicy.Merge(m, src)
+}
+func (m *MsgRemoveRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRetryPolicy.DiscardUnknown(m)
 }
 
-func _Msg_ResubmitFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgResubmitFailure)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ResubmitFailure(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.contractmanager.Msg/ResubmitFailure",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ResubmitFailure(ctx, req.(*MsgResubmitFailure))
+var xxx_messageInfo_MsgRemoveRetryPolicy proto.InternalMessageInfo
+
+func (m *MsgRemoveRetryPolicy) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.contractmanager.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "ResubmitFailure",
-			Handler:    _Msg_ResubmitFailure_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "neutron/contractmanager/tx.proto",
+type MsgRemoveRetryPolicyResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveRetryPolicyResponse) Reset()         { *m = MsgRemoveRetryPolicyResponse{} }
+func (m *MsgRemoveRetryPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRetryPolicyResponse) ProtoMessage()    {}
+func (*MsgRemoveRetryPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{7}
+}
+func (m *MsgRemoveRetryPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRetryPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRetryPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRemoveRetryPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRetryPolicyResponse.Merge(m, src)
+}
+func (m *MsgRemoveRetryPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRetryPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRetryPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveRetryPolicyResponse proto.InternalMessageInfo
+
+// MsgSetSudoGasGrant - governance grants a sudo call gas limit to a contract
+type MsgSetSudoGasGrant struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the contract the gas limit is granted to.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// gas_limit is the granted gas limit. Zero revokes the grant.
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetSudoGasGrant) Reset()         { *m = MsgSetSudoGasGrant{} }
+func (m *MsgSetSudoGasGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSudoGasGrant) ProtoMessage()    {}
+func (*MsgSetSudoGasGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{8}
+}
+func (m *MsgSetSudoGasGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSudoGasGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSudoGasGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetSudoGasGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSudoGasGrant.Merge(m, src)
+}
+func (m *MsgSetSudoGasGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSudoGasGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSudoGasGrant.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetSudoGasGrant proto.InternalMessageInfo
+
+func (m *MsgSetSudoGasGrant) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSudoGasGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetSudoGasGrant) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
 }
 
-func (m *MsgResubmitFailure) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgSetSudoGasGrantResponse struct {
 }
 
-func (m *MsgResubmitFailure) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSudoGasGrantResponse) Reset()         { *m = MsgSetSudoGasGrantResponse{} }
+func (m *MsgSetSudoGasGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSudoGasGrantResponse) ProtoMessage()    {}
+func (*MsgSetSudoGasGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{9}
+}
+func (m *MsgSetSudoGasGrantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSudoGasGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSudoGasGrantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSudoGasGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSudoGasGrantResponse.Merge(m, src)
+}
+func (m *MsgSetSudoGasGrantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSudoGasGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSudoGasGrantResponse.DiscardUnknown(m)
 }
 
-func (m *MsgResubmitFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailureId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FailureId))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgSetSudoGasGrantResponse proto.InternalMessageInfo
+
+// MsgPrepaySudoGas - contract pays for a sudo call gas limit above the
+// module-wide one
+type MsgPrepaySudoGas struct {
+	// sender is the contract which sudo call gas limit is paid for.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// gas_limit is the requested gas limit of the contract's sudo calls.
+	GasLimit uint64 `protobuf:"varint,2,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// amount is added to the prepaid balance of the contract.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgPrepaySudoGas) Reset()         { *m = MsgPrepaySudoGas{} }
+func (m *MsgPrepaySudoGas) String() string { return proto.CompactTextString(m) }
+func (*MsgPrepaySudoGas) ProtoMessage()    {}
+func (*MsgPrepaySudoGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{10}
+}
+func (m *MsgPrepaySudoGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPrepaySudoGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPrepaySudoGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPrepaySudoGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPrepaySudoGas.Merge(m, src)
+}
+func (m *MsgPrepaySudoGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPrepaySudoGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPrepaySudoGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPrepaySudoGas proto.InternalMessageInfo
+
+func (m *MsgPrepaySudoGas) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgResubmitFailureResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPrepaySudoGas) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgResubmitFailureResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPrepaySudoGas) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgResubmitFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgPrepaySudoGasResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgPrepaySudoGasResponse) Reset()         { *m = MsgPrepaySudoGasResponse{} }
+func (m *MsgPrepaySudoGasResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPrepaySudoGasResponse) ProtoMessage()    {}
+func (*MsgPrepaySudoGasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{11}
+}
+func (m *MsgPrepaySudoGasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPrepaySudoGasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPrepaySudoGasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPrepaySudoGasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPrepaySudoGasResponse.Merge(m, src)
+}
+func (m *MsgPrepaySudoGasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPrepaySudoGasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPrepaySudoGasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPrepaySudoGasResponse proto.InternalMessageInfo
+
+// MsgWithdrawSudoGasPrepayment - contract withdraws the remaining prepaid
+// balance and gives up the prepaid gas limit
+type MsgWithdrawSudoGasPrepayment struct {
+	// sender is the contract which prepayment is withdrawn.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgWithdrawSudoGasPrepayment) Reset()         { *m = MsgWithdrawSudoGasPrepayment{} }
+func (m *MsgWithdrawSudoGasPrepayment) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSudoGasPrepayment) ProtoMessage()    {}
+func (*MsgWithdrawSudoGasPrepayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{12}
+}
+func (m *MsgWithdrawSudoGasPrepayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSudoGasPrepayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSudoGasPrepayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgWithdrawSudoGasPrepayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSudoGasPrepayment.Merge(m, src)
+}
+func (m *MsgWithdrawSudoGasPrepayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSudoGasPrepayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSudoGasPrepayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSudoGasPrepayment proto.InternalMessageInfo
+
+func (m *MsgWithdrawSudoGasPrepayment) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgWithdrawSudoGasPrepaymentResponse struct {
+}
+
+func (m *MsgWithdrawSudoGasPrepaymentResponse) Reset()         { *m = MsgWithdrawSudoGasPrepaymentResponse{} }
+func (m *MsgWithdrawSudoGasPrepaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSudoGasPrepaymentResponse) ProtoMessage()    {}
+func (*MsgWithdrawSudoGasPrepaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dc444ed708d435f, []int{13}
+}
+func (m *MsgWithdrawSudoGasPrepaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSudoGasPrepaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSudoGasPrepaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgWithdrawSudoGasPrepaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSudoGasPrepaymentResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSudoGasPrepaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSudoGasPrepaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSudoGasPrepaymentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgResubmitFailure) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgWithdrawSudoGasPrepaymentResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.contractmanager.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.contractmanager.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgResubmitFailure)(nil), "neutron.contractmanager.MsgResubmitFailure")
+	proto.RegisterType((*MsgResubmitFailureResponse)(nil), "neutron.contractmanager.MsgResubmitFailureResponse")
+	proto.RegisterType((*MsgSetRetryPolicy)(nil), "neutron.contractmanager.MsgSetRetryPolicy")
+	proto.RegisterType((*MsgSetRetryPolicyResponse)(nil), "neutron.contractmanager.MsgSetRetryPolicyResponse")
+	proto.RegisterType((*MsgRemoveRetryPolicy)(nil), "neutron.contractmanager.MsgRemoveRetryPolicy")
+	proto.RegisterType((*MsgRemoveRetryPolicyResponse)(nil), "neutron.contractmanager.MsgRemoveRetryPolicyResponse")
+	proto.RegisterType((*MsgSetSudoGasGrant)(nil), "neutron.contractmanager.MsgSetSudoGasGrant")
+	proto.RegisterType((*MsgSetSudoGasGrantResponse)(nil), "neutron.contractmanager.MsgSetSudoGasGrantResponse")
+	proto.RegisterType((*MsgPrepaySudoGas)(nil), "neutron.contractmanager.MsgPrepaySudoGas")
+	proto.RegisterType((*MsgPrepaySudoGasResponse)(nil), "neutron.contractmanager.MsgPrepaySudoGasResponse")
+	proto.RegisterType((*MsgWithdrawSudoGasPrepayment)(nil), "neutron.contractmanager.MsgWithdrawSudoGasPrepayment")
+	proto.RegisterType((*MsgWithdrawSudoGasPrepaymentResponse)(nil), "neutron.contractmanager.MsgWithdrawSudoGasPrepaymentResponse")
+}
+
+func init() { proto.RegisterFile("neutron/contractmanager/tx.proto", fileDescriptor_4dc444ed708d435f) }
+
+var fileDescriptor_4dc444ed708d435f = []byte{
+	// 853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x4f, 0xe3, 0x46,
+	0x18, 0x8e, 0x81, 0xa6, 0xcd, 0xf0, 0xd5, 0xb8, 0x48, 0x24, 0x86, 0x9a, 0x60, 0xd1, 0x8a, 0x86,
+	0x62, 0x93, 0x50, 0x7a, 0x88, 0xe8, 0x81, 0x54, 0x2a, 0x42, 0x6a, 0x24, 0x94, 0xb4, 0xaa, 0xd4,
+	0x4b, 0x34, 0xb1, 0x07, 0xe3, 0x12, 0x7b, 0xac, 0x99, 0x49, 0x9a, 0x5c, 0xaa, 0xaa, 0xc7, 0x1e,
+	0xaa, 0xf6, 0xd8, 0x7f, 0xb0, 0x47, 0x0e, 0xfb, 0x07, 0xf6, 0xc6, 0x91, 0x45, 0x7b, 0xd8, 0xd3,
+	0x6a, 0x05, 0x07, 0xee, 0xfb, 0x0b, 0x56, 0xb1, 0x27, 0x06, 0x4f, 0x3e, 0x80, 0xec, 0x25, 0xb1,
+	0x9f, 0xf7, 0x79, 0xe7, 0x7d, 0xde, 0x8f, 0x79, 0x65, 0x90, 0xf3, 0x50, 0x8b, 0x11, 0xec, 0x19,
+	0x26, 0xf6, 0x18, 0x81, 0x26, 0x73, 0xa1, 0x07, 0x6d, 0x44, 0x0c, 0xd6, 0xd1, 0x7d, 0x82, 0x19,
+	0x96, 0x97, 0x39, 0x43, 0x17, 0x18, 0x4a, 0x1a, 0xba, 0x8e, 0x87, 0x8d, 0xe0, 0x37, 0xe4, 0x2a,
+	0xaa, 0x89, 0xa9, 0x8b, 0xa9, 0xd1, 0x80, 0x14, 0x19, 0xed, 0x42, 0x03, 0x31, 0x58, 0x30, 0x4c,
+	0xec, 0x78, 0xdc, 0xbe, 0xcc, 0xed, 0x2e, 0xb5, 0x8d, 0x76, 0xa1, 0xf7, 0xc7, 0x0d, 0xd9, 0xd0,
+	0x50, 0x0f, 0xde, 0x8c, 0xf0, 0x85, 0x9b, 0x96, 0x6c, 0x6c, 0xe3, 0x10, 0xef, 0x3d, 0x71, 0x74,
+	0x63, 0x94, 0x6e, 0x1f, 0x12, 0xe8, 0x72, 0x5f, 0xed, 0x85, 0x04, 0x16, 0x2b, 0xd4, 0xfe, 0xd9,
+	0xb7, 0x20, 0x43, 0xc7, 0x81, 0x45, 0xfe, 0x16, 0xa4, 0x60, 0x8b, 0x9d, 0x62, 0xe2, 0xb0, 0x6e,
+	0x46, 0xca, 0x49, 0x9b, 0xa9, 0x72, 0xe6, 0xea, 0xf9, 0xf6, 0x12, 0x0f, 0x7a, 0x60, 0x59, 0x04,
+	0x51, 0x5a, 0x63, 0xc4, 0xf1, 0xec, 0xea, 0x1d, 0x55, 0x2e, 0x83, 0x64, 0x78, 0x76, 0x66, 0x2a,
+	0x27, 0x6d, 0xce, 0x16, 0xd7, 0xf4, 0x11, 0x85, 0xd1, 0xc3, 0x40, 0xe5, 0xd4, 0xc5, 0x9b, 0xb5,
+	0xc4, 0xb3, 0xdb, 0xf3, 0xbc, 0x54, 0xe5, 0x9e, 0xa5, 0xe2, 0x5f, 0xb7, 0xe7, 0xf9, 0xbb, 0x33,
+	0xff, 0xbe, 0x3d, 0xcf, 0xaf, 0x89, 0x09, 0x08, 0x7a, 0xb5, 0x2c, 0x58, 0x16, 0xa0, 0x2a, 0xa2,
+	0x3e, 0xf6, 0x28, 0xd2, 0xfe, 0x97, 0x80, 0x5c, 0xa1, 0x76, 0x15, 0xd1, 0x56, 0xc3, 0x75, 0xd8,
+	0x0f, 0xd0, 0x69, 0xb6, 0x08, 0x92, 0x77, 0x40, 0x92, 0x22, 0xcf, 0x42, 0xe4, 0xc1, 0xf4, 0x38,
+	0x4f, 0xfe, 0x1c, 0x80, 0x93, 0xd0, 0xb9, 0xee, 0x58, 0x41, 0x7e, 0x33, 0xd5, 0x14, 0x47, 0x8e,
+	0xac, 0x50, 0x36, 0xe7, 0xf6, 0x34, 0x6b, 0x43, 0x34, 0x0b, 0x22, 0xb4, 0x55, 0xa0, 0x0c, 0xa2,
+	0x91, 0xf2, 0x77, 0x12, 0x48, 0x57, 0xa8, 0x5d, 0x43, 0xac, 0x8a, 0x18, 0xe9, 0x1e, 0xe3, 0xa6,
+	0x63, 0x76, 0x27, 0x10, 0xbe, 0x0e, 0xe6, 0x5c, 0xd8, 0xa9, 0x43, 0xc6, 0x90, 0xeb, 0xb3, 0xb0,
+	0x35, 0xf3, 0xd5, 0x59, 0x17, 0x76, 0x0e, 0x38, 0x24, 0x7f, 0x01, 0x16, 0x1a, 0xd0, 0x3c, 0xc3,
+	0x27, 0x27, 0xf5, 0x46, 0x13, 0x9b, 0x67, 0x34, 0x33, 0x1d, 0xe4, 0x37, 0xcf, 0xd1, 0x72, 0x00,
+	0xca, 0x3a, 0xf8, 0xcc, 0x86, 0xb4, 0xde, 0x74, 0x5c, 0x87, 0xd5, 0x1d, 0xcf, 0x24, 0xc8, 0x45,
+	0x1e, 0xcb, 0xcc, 0x04, 0xdc, 0xb4, 0x0d, 0xe9, 0x8f, 0x3d, 0xcb, 0x51, 0xdf, 0x50, 0x2a, 0x08,
+	0x35, 0x59, 0x1f, 0x52, 0x93, 0x78, 0x7a, 0xda, 0x0a, 0xc8, 0x0e, 0x80, 0x51, 0x45, 0xfe, 0x00,
+	0x4b, 0x41, 0xbd, 0x5c, 0xdc, 0x46, 0x1f, 0x54, 0x93, 0xd2, 0x37, 0x82, 0xb2, 0x8d, 0xa1, 0xdd,
+	0x12, 0xe2, 0x68, 0x2a, 0x58, 0x1d, 0x86, 0x47, 0xfa, 0x5e, 0x85, 0xb3, 0x56, 0x43, 0xac, 0xd6,
+	0xb2, 0xf0, 0x21, 0xa4, 0x87, 0x04, 0x7a, 0x6c, 0xe2, 0xdb, 0x54, 0x04, 0x1f, 0xc3, 0xd0, 0x96,
+	0x99, 0x7a, 0xc0, 0xab, 0x4f, 0x94, 0x57, 0x40, 0x2a, 0x6a, 0x11, 0x6f, 0xe2, 0x27, 0xfd, 0xc6,
+	0x94, 0xf6, 0x06, 0xaf, 0x96, 0x36, 0xbc, 0x25, 0xf7, 0xf5, 0xf3, 0x31, 0x15, 0xd0, 0x28, 0xe9,
+	0x97, 0x12, 0xf8, 0xb4, 0x42, 0xed, 0x63, 0x82, 0x7c, 0xd8, 0xe5, 0x8c, 0x09, 0xa6, 0x34, 0x26,
+	0x7c, 0x2a, 0x2e, 0x5c, 0xde, 0x07, 0x49, 0xe8, 0xe2, 0x96, 0x17, 0xa6, 0x34, 0x5b, 0xcc, 0xea,
+	0xfc, 0xac, 0xde, 0x12, 0xd5, 0xf9, 0x12, 0xd5, 0xbf, 0xc7, 0x8e, 0x17, 0xdb, 0x28, 0xa1, 0x4f,
+	0x69, 0x47, 0x68, 0x76, 0x6e, 0x48, 0xce, 0x31, 0xf9, 0x9a, 0x02, 0x32, 0x22, 0x16, 0xe5, 0xfb,
+	0x8f, 0x14, 0x4c, 0xc1, 0x2f, 0x0e, 0x3b, 0xb5, 0x08, 0xfc, 0x9d, 0x9b, 0x43, 0x6e, 0x6f, 0xea,
+	0x27, 0x98, 0xc6, 0x7d, 0x41, 0xe0, 0xd7, 0x43, 0x04, 0x8e, 0x8c, 0xa7, 0x7d, 0x09, 0x36, 0xc6,
+	0xd9, 0xfb, 0xc2, 0x8b, 0x57, 0x49, 0x30, 0x5d, 0xa1, 0xb6, 0xfc, 0x1b, 0x98, 0x8b, 0x2d, 0xfb,
+	0xcd, 0x91, 0x4b, 0x5a, 0xd8, 0xa9, 0xca, 0xce, 0x63, 0x99, 0xfd, 0x98, 0x32, 0x05, 0x8b, 0xe2,
+	0xe6, 0xdd, 0x1a, 0x77, 0x88, 0x40, 0x56, 0x76, 0x9f, 0x40, 0x8e, 0x82, 0xfa, 0x60, 0x41, 0x58,
+	0x9a, 0xf9, 0x71, 0xc7, 0xc4, 0xb9, 0x4a, 0xf1, 0xf1, 0xdc, 0x28, 0x62, 0x17, 0xa4, 0x07, 0xb7,
+	0xd2, 0xf6, 0x78, 0xed, 0x02, 0x5d, 0xd9, 0x7b, 0x12, 0xfd, 0x7e, 0x85, 0xc5, 0x7d, 0xb3, 0xf5,
+	0x40, 0x06, 0xf7, 0xc9, 0xca, 0xee, 0x13, 0xc8, 0x51, 0x50, 0x17, 0xcc, 0xc7, 0xef, 0xfb, 0x57,
+	0xe3, 0x4e, 0x89, 0x51, 0x95, 0xc2, 0xa3, 0xa9, 0x51, 0xb8, 0xff, 0x24, 0x90, 0x1d, 0x7d, 0xdf,
+	0xc6, 0x16, 0x6e, 0xa4, 0x9b, 0xf2, 0xdd, 0x44, 0x6e, 0x7d, 0x4d, 0xca, 0x47, 0x7f, 0xf6, 0x76,
+	0x4c, 0xf9, 0xa7, 0x8b, 0x6b, 0x55, 0xba, 0xbc, 0x56, 0xa5, 0xb7, 0xd7, 0xaa, 0xf4, 0xef, 0x8d,
+	0x9a, 0xb8, 0xbc, 0x51, 0x13, 0xaf, 0x6f, 0xd4, 0xc4, 0xaf, 0x25, 0xdb, 0x61, 0xa7, 0xad, 0x86,
+	0x6e, 0x62, 0xd7, 0xe0, 0x91, 0xb6, 0x31, 0xb1, 0xfb, 0xcf, 0x46, 0xbb, 0x50, 0x30, 0x3a, 0x83,
+	0x9f, 0x94, 0x5d, 0x1f, 0xd1, 0x46, 0x32, 0xf8, 0x34, 0xdb, 0x7d, 0x3f, 0x00, 0x78, 0xfb, 0x72,
+	0x4b, 0x7a, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	ResubmitFailure(ctx context.Context, in *MsgResubmitFailure, opts ...grpc.CallOption) (*MsgResubmitFailureResponse, error)
+	SetRetryPolicy(ctx context.Context, in *MsgSetRetryPolicy, opts ...grpc.CallOption) (*MsgSetRetryPolicyResponse, error)
+	RemoveRetryPolicy(ctx context.Context, in *MsgRemoveRetryPolicy, opts ...grpc.CallOption) (*MsgRemoveRetryPolicyResponse, error)
+	SetSudoGasGrant(ctx context.Context, in *MsgSetSudoGasGrant, opts ...grpc.CallOption) (*MsgSetSudoGasGrantResponse, error)
+	PrepaySudoGas(ctx context.Context, in *MsgPrepaySudoGas, opts ...grpc.CallOption) (*MsgPrepaySudoGasResponse, error)
+	WithdrawSudoGasPrepayment(ctx context.Context, in *MsgWithdrawSudoGasPrepayment, opts ...grpc.CallOption) (*MsgWithdrawSudoGasPrepaymentResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) ResubmitFailure(ctx context.Context, in *MsgResubmitFailure, opts ...grpc.CallOption) (*MsgResubmitFailureResponse, error) {
+	out := new(MsgResubmitFailureResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/ResubmitFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.FailureId != 0 {
-		n += 1 + sovTx(uint64(m.FailureId))
+	return out, nil
+}
+
+func (c *msgClient) SetRetryPolicy(ctx context.Context, in *MsgSetRetryPolicy, opts ...grpc.CallOption) (*MsgSetRetryPolicyResponse, error) {
+	out := new(MsgSetRetryPolicyResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/SetRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgResubmitFailureResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RemoveRetryPolicy(ctx context.Context, in *MsgRemoveRetryPolicy, opts ...grpc.CallOption) (*MsgRemoveRetryPolicyResponse, error) {
+	out := new(MsgRemoveRetryPolicyResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/RemoveRetryPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSudoGasGrant(ctx context.Context, in *MsgSetSudoGasGrant, opts ...grpc.CallOption) (*MsgSetSudoGasGrantResponse, error) {
+	out := new(MsgSetSudoGasGrantResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/SetSudoGasGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PrepaySudoGas(ctx context.Context, in *MsgPrepaySudoGas, opts ...grpc.CallOption) (*MsgPrepaySudoGasResponse, error) {
+	out := new(MsgPrepaySudoGasResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/PrepaySudoGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSudoGasPrepayment(ctx context.Context, in *MsgWithdrawSudoGasPrepayment, opts ...grpc.CallOption) (*MsgWithdrawSudoGasPrepaymentResponse, error) {
+	out := new(MsgWithdrawSudoGasPrepaymentResponse)
+	err := c.cc.Invoke(ctx, "/neutron.contractmanager.Msg/WithdrawSudoGasPrepayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	ResubmitFailure(context.Context, *MsgResubmitFailure) (*MsgResubmitFailureResponse, error)
+	SetRetryPolicy(context.Context, *MsgSetRetryPolicy) (*MsgSetRetryPolicyResponse, error)
+	RemoveRetryPolicy(context.Context, *MsgRemoveRetryPolicy) (*MsgRemoveRetryPolicyResponse, error)
+	SetSudoGasGrant(context.Context, *MsgSetSudoGasGrant) (*MsgSetSudoGasGrantResponse, error)
+	PrepaySudoGas(context.Context, *MsgPrepaySudoGas) (*MsgPrepaySudoGasResponse, error)
+	WithdrawSudoGasPrepayment(context.Context, *MsgWithdrawSudoGasPrepayment) (*MsgWithdrawSudoGasPrepaymentResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) ResubmitFailure(ctx context.Context, req *MsgResubmitFailure) (*MsgResubmitFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResubmitFailure not implemented")
+}
+func (*UnimplementedMsgServer) SetRetryPolicy(ctx context.Context, req *MsgSetRetryPolicy) (*MsgSetRetryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetryPolicy not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRetryPolicy(ctx context.Context, req *MsgRemoveRetryPolicy) (*MsgRemoveRetryPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRetryPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SetSudoGasGrant(ctx context.Context, req *MsgSetSudoGasGrant) (*MsgSetSudoGasGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSudoGasGrant not implemented")
+}
+func (*UnimplementedMsgServer) PrepaySudoGas(ctx context.Context, req *MsgPrepaySudoGas) (*MsgPrepaySudoGasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PrepaySudoGas not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSudoGasPrepayment(ctx context.Context, req *MsgWithdrawSudoGasPrepayment) (*MsgWithdrawSudoGasPrepaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSudoGasPrepayment not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResubmitFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResubmitFailure)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResubmitFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/ResubmitFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResubmitFailure(ctx, req.(*MsgResubmitFailure))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRetryPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/SetRetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRetryPolicy(ctx, req.(*MsgSetRetryPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRetryPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRetryPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRetryPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/RemoveRetryPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRetryPolicy(ctx, req.(*MsgRemoveRetryPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSudoGasGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSudoGasGrant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSudoGasGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/SetSudoGasGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSudoGasGrant(ctx, req.(*MsgSetSudoGasGrant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PrepaySudoGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPrepaySudoGas)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PrepaySudoGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/PrepaySudoGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PrepaySudoGas(ctx, req.(*MsgPrepaySudoGas))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSudoGasPrepayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSudoGasPrepayment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSudoGasPrepayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.contractmanager.Msg/WithdrawSudoGasPrepayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSudoGasPrepayment(ctx, req.(*MsgWithdrawSudoGasPrepayment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.contractmanager.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "ResubmitFailure",
+			Handler:    _Msg_ResubmitFailure_Handler,
+		},
+		{
+			MethodName: "SetRetryPolicy",
+			Handler:    _Msg_SetRetryPolicy_Handler,
+		},
+		{
+			MethodName: "RemoveRetryPolicy",
+			Handler:    _Msg_RemoveRetryPolicy_Handler,
+		},
+		{
+			MethodName: "SetSudoGasGrant",
+			Handler:    _Msg_SetSudoGasGrant_Handler,
+		},
+		{
+			MethodName: "PrepaySudoGas",
+			Handler:    _Msg_PrepaySudoGas_Handler,
+		},
+		{
+			MethodName: "WithdrawSudoGasPrepayment",
+			Handler:    _Msg_WithdrawSudoGasPrepayment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/contractmanager/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResubmitFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResubmitFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResubmitFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailureId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResubmitFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResubmitFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResubmitFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimitIncrement != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimitIncrement))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BackoffBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BackoffBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRetryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRetryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRetryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRetryPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRetryPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRetryPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSudoGasGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSudoGasGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSudoGasGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSudoGasGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSudoGasGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSudoGasGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPrepaySudoGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrepaySudoGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrepaySudoGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPrepaySudoGasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPrepaySudoGasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPrepaySudoGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSudoGasPrepayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSudoGasPrepayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSudoGasPrepayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSudoGasPrepaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSudoGasPrepaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSudoGasPrepaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResubmitFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FailureId != 0 {
+		n += 1 + sovTx(uint64(m.FailureId))
+	}
+	return n
+}
+
+func (m *MsgResubmitFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovTx(uint64(m.MaxAttempts))
+	}
+	if m.BackoffBlocks != 0 {
+		n += 1 + sovTx(uint64(m.BackoffBlocks))
+	}
+	if m.GasLimitIncrement != 0 {
+		n += 1 + sovTx(uint64(m.GasLimitIncrement))
+	}
+	return n
+}
+
+func (m *MsgSetRetryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRetryPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSudoGasGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *MsgSetSudoGasGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPrepaySudoGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPrepaySudoGasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSudoGasPrepayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawSudoGasPrepaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResubmitFailure) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResubmitFailure: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResubmitFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureId", wireType)
+			}
+			m.FailureId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResubmitFailureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResubmitFailureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResubmitFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffBlocks", wireType)
+			}
+			m.BackoffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimitIncrement", wireType)
+			}
+			m.GasLimitIncrement = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimitIncrement |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRetryPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRetryPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRetryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRemoveRetryPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRetryPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRetryPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSudoGasGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -589,10 +2357,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSudoGasGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSudoGasGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -629,9 +2397,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -641,25 +2409,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -681,7 +2467,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSudoGasGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -704,10 +2490,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSudoGasGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSudoGasGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -731,7 +2517,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResubmitFailure) Unmarshal(dAtA []byte) error {
+func (m *MsgPrepaySudoGas) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -754,10 +2540,10 @@ func (m *MsgResubmitFailure) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResubmitFailure: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPrepaySudoGas: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResubmitFailure: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPrepaySudoGas: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -794,9 +2580,9 @@ func (m *MsgResubmitFailure) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailureId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.FailureId = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -806,11 +2592,44 @@ func (m *MsgResubmitFailure) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FailureId |= uint64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -832,7 +2651,7 @@ func (m *MsgResubmitFailure) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResubmitFailureResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPrepaySudoGasResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -855,10 +2674,142 @@ func (m *MsgResubmitFailureResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResubmitFailureResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPrepaySudoGasResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResubmitFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPrepaySudoGasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSudoGasPrepayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSudoGasPrepayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSudoGasPrepayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawSudoGasPrepaymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawSudoGasPrepaymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawSudoGasPrepaymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
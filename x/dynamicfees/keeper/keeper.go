@@ -14,9 +14,11 @@ import (
 
 type (
 	Keeper struct {
-		cdc       codec.BinaryCodec
-		storeKey  storetypes.StoreKey
-		authority string
+		cdc          codec.BinaryCodec
+		storeKey     storetypes.StoreKey
+		authority    string
+		oracleKeeper types.OracleKeeper
+		dexKeeper    types.DexKeeper
 	}
 )
 
@@ -32,6 +34,17 @@ func NewKeeper(
 	}
 }
 
+// SetOracleKeeper sets the oracle keeper used to price the denoms of the oracle price sources.
+// The oracle keeper is created after the dynamicfees keeper, which the feemarket keeper depends on.
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.oracleKeeper = oracleKeeper
+}
+
+// SetDexKeeper sets the dex keeper used to observe the prices of the denoms of the dex TWAP sources.
+func (k *Keeper) SetDexKeeper(dexKeeper types.DexKeeper) {
+	k.dexKeeper = dexKeeper
+}
+
 func (k Keeper) GetAuthority() string {
 	return k.authority
 }
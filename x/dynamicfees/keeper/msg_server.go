@@ -33,9 +33,17 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	oldParams := k.GetParams(ctx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	// the observations of the removed dex TWAP sources would never be pruned otherwise
+	for _, source := range oldParams.DexTwapSources {
+		if _, found := req.Params.GetDexTwapSource(source.Denom); !found {
+			k.removeTwapObservations(ctx, source.Denom)
+		}
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
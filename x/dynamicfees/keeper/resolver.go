@@ -1,8 +1,14 @@
 package keeper
 
 import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 
 	appparams "github.com/neutron-org/neutron/v11/app/params"
 
@@ -13,24 +19,126 @@ var _ feemarkettypes.DenomResolver = Keeper{}
 
 // ConvertToDenom converts NTRN deccoin into the equivalent amount of the token denominated in denom.
 func (k Keeper) ConvertToDenom(ctx sdk.Context, fromCoin sdk.DecCoin, toDenom string) (sdk.DecCoin, error) {
+	if fromCoin.Denom == appparams.DefaultDenom {
+		price, err := k.GetNtrnPrice(ctx, toDenom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+		// converts NTRN into the denom
+		return sdk.NewDecCoinFromDec(toDenom, fromCoin.Amount.Quo(price)), nil
+	} else if toDenom == appparams.DefaultDenom {
+		price, err := k.GetNtrnPrice(ctx, fromCoin.Denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+		// converts the denom into NTRN
+		return sdk.NewDecCoinFromDec(appparams.DefaultDenom, fromCoin.Amount.Mul(price)), nil
+	}
+	return sdk.DecCoin{}, types.ErrUnknownDenom
+}
+
+// GetNtrnPrice returns the NTRN price of one unit of the denom. The price is taken from the oracle price
+// source of the denom if its oracle price is fresh, then from its dex TWAP source if the TWAP is available,
+// and falls back to the static price from the ntrn_prices param.
+func (k Keeper) GetNtrnPrice(ctx sdk.Context, denom string) (math.LegacyDec, error) {
 	params := k.GetParams(ctx)
+	var err error = types.ErrUnknownDenom
+
+	if source, found := params.GetOraclePriceSource(denom); found && k.oracleKeeper != nil {
+		var price math.LegacyDec
+		if price, err = k.getOraclePrice(ctx, params, source); err == nil {
+			return price, nil
+		}
+	}
+
+	if source, found := params.GetDexTwapSource(denom); found && k.dexKeeper != nil {
+		var price math.LegacyDec
+		if price, err = k.GetTwap(ctx, source); err == nil {
+			return price, nil
+		}
+	}
+
 	for _, c := range params.NtrnPrices {
-		if c.Denom == toDenom && fromCoin.Denom == appparams.DefaultDenom {
-			// converts NTRN into the denom
-			return sdk.NewDecCoinFromDec(toDenom, fromCoin.Amount.Quo(c.Amount)), nil
-		} else if toDenom == appparams.DefaultDenom && fromCoin.Denom == c.Denom {
-			// converts the denom into NTRN
-			return sdk.NewDecCoinFromDec(appparams.DefaultDenom, fromCoin.Amount.Mul(c.Amount)), nil
+		if c.Denom == denom {
+			return c.Amount, nil
 		}
 	}
-	return sdk.DecCoin{}, types.ErrUnknownDenom
+	return math.LegacyDec{}, err
 }
 
 func (k Keeper) ExtraDenoms(ctx sdk.Context) ([]string, error) {
 	params := k.GetParams(ctx)
-	denoms := make([]string, 0, params.NtrnPrices.Len())
+	denoms := make([]string, 0, params.NtrnPrices.Len()+len(params.OraclePriceSources)+len(params.DexTwapSources))
+	seen := make(map[string]bool, cap(denoms))
+	addDenom := func(denom string) {
+		if !seen[denom] {
+			seen[denom] = true
+			denoms = append(denoms, denom)
+		}
+	}
+
 	for _, coin := range params.NtrnPrices {
-		denoms = append(denoms, coin.Denom)
+		addDenom(coin.Denom)
+	}
+	for _, source := range params.OraclePriceSources {
+		addDenom(source.Denom)
+	}
+	for _, source := range params.DexTwapSources {
+		addDenom(source.Denom)
 	}
 	return denoms, nil
 }
+
+// getOraclePrice returns the NTRN price of one unit of the denom of the source computed from the oracle prices
+// of its currency pair and of the NTRN currency pair.
+func (k Keeper) getOraclePrice(ctx sdk.Context, params types.Params, source types.OraclePriceSource) (math.LegacyDec, error) {
+	price, priceDecimals, err := k.getFreshOraclePrice(ctx, params, source.CurrencyPair)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	ntrnPrice, ntrnPriceDecimals, err := k.getFreshOraclePrice(ctx, params, params.NtrnCurrencyPair)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	// price = (price / 10^(price decimals + denom decimals)) / (NTRN price / 10^(NTRN price decimals + untrn decimals))
+	result := math.LegacyNewDecFromInt(price).QuoInt(ntrnPrice)
+	exponent := int64(ntrnPriceDecimals+appparams.DefaultDenomDecimals) - int64(priceDecimals+uint64(source.Decimals)) //nolint:gosec
+	if exponent >= 0 {
+		result = result.Mul(math.LegacyNewDec(10).Power(uint64(exponent)))
+	} else {
+		result = result.Quo(math.LegacyNewDec(10).Power(uint64(-exponent)))
+	}
+	if !result.IsPositive() {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "oracle price of %s is too small", source.Denom)
+	}
+	return result, nil
+}
+
+// getFreshOraclePrice returns the oracle price of the currency pair along with the number of its decimals
+// if the price is not older than the max oracle price age.
+func (k Keeper) getFreshOraclePrice(ctx sdk.Context, params types.Params, currencyPair string) (math.Int, uint64, error) {
+	cp, err := slinkytypes.CurrencyPairFromString(currencyPair)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+
+	var price oracletypes.QuotePrice
+	price, err = k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+	if price.Price.IsNil() || !price.Price.IsPositive() {
+		return math.Int{}, 0, errorsmod.Wrapf(types.ErrPriceUnavailable, "no oracle price for %s", currencyPair)
+	}
+	if params.MaxOraclePriceAgeSeconds > 0 &&
+		ctx.BlockTime().Sub(price.BlockTimestamp) > time.Duration(params.MaxOraclePriceAgeSeconds)*time.Second { //nolint:gosec
+		return math.Int{}, 0, errorsmod.Wrapf(types.ErrPriceUnavailable, "oracle price of %s is stale", currencyPair)
+	}
+
+	decimals, err := k.oracleKeeper.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math.Int{}, 0, err
+	}
+	return price.Price, decimals, nil
+}
@@ -2,14 +2,19 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 	"github.com/stretchr/testify/require"
 
 	appparams "github.com/neutron-org/neutron/v11/app/params"
 	"github.com/neutron-org/neutron/v11/testutil/common/nullify"
 	testkeeper "github.com/neutron-org/neutron/v11/testutil/dynamicfees/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/dynamicfees/types"
 	"github.com/neutron-org/neutron/v11/x/dynamicfees/types"
 )
 
@@ -209,3 +214,81 @@ func TestExtraDenoms(t *testing.T) {
 	require.NoError(t, err)
 	require.EqualValues(t, expectedDenoms, denoms)
 }
+
+func TestConvertToDenomWithOraclePrices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	oracleKeeper := mock_types.NewMockOracleKeeper(ctrl)
+	k, ctx := testkeeper.DynamicFeesKeeper(t)
+	k.SetOracleKeeper(oracleKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	ntrnUsd := slinkytypes.NewCurrencyPair("NTRN", "USD")
+	atomUsd := slinkytypes.NewCurrencyPair("ATOM", "USD")
+	const atomDenom = "uatom"
+
+	params := types.DefaultParams()
+	params.NtrnCurrencyPair = ntrnUsd.String()
+	params.OraclePriceSources = []types.OraclePriceSource{{Denom: atomDenom, CurrencyPair: atomUsd.String(), Decimals: 6}}
+	params.MaxOraclePriceAgeSeconds = 60
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// 1 NTRN = 0.5 USD and 1 ATOM = 5 USD with 8 decimals, so 1 ATOM = 10 NTRN
+	oracleKeeper.EXPECT().GetPriceForCurrencyPair(gomock.Any(), ntrnUsd).Return(oracletypes.QuotePrice{
+		Price:          math.NewInt(50_000_000),
+		BlockTimestamp: ctx.BlockTime(),
+	}, nil).AnyTimes()
+	oracleKeeper.EXPECT().GetDecimalsForCurrencyPair(gomock.Any(), ntrnUsd).Return(uint64(8), nil).AnyTimes()
+	oracleKeeper.EXPECT().GetDecimalsForCurrencyPair(gomock.Any(), atomUsd).Return(uint64(8), nil).AnyTimes()
+	atomPrice := oracleKeeper.EXPECT().GetPriceForCurrencyPair(gomock.Any(), atomUsd).Return(oracletypes.QuotePrice{
+		Price:          math.NewInt(500_000_000),
+		BlockTimestamp: ctx.BlockTime(),
+	}, nil)
+
+	convertedCoin, err := k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(appparams.DefaultDenom, math.NewInt(10)), atomDenom)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(cosmostypes.NewDecCoinFromDec(atomDenom, math.LegacyOneDec())), nullify.Fill(convertedCoin))
+
+	// the denom isn't priced if its oracle price is stale and it has no other price
+	staleAtomPrice := oracleKeeper.EXPECT().GetPriceForCurrencyPair(gomock.Any(), atomUsd).Return(oracletypes.QuotePrice{
+		Price:          math.NewInt(500_000_000),
+		BlockTimestamp: ctx.BlockTime().Add(-time.Hour),
+	}, nil).After(atomPrice).Times(2)
+	_, err = k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(appparams.DefaultDenom, math.NewInt(10)), atomDenom)
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+
+	// the static price is used as a fallback
+	params.NtrnPrices = cosmostypes.DecCoins{{Denom: atomDenom, Amount: math.LegacyNewDec(20)}}
+	require.NoError(t, k.SetParams(ctx, params))
+	convertedCoin, err = k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(appparams.DefaultDenom, math.NewInt(10)), atomDenom)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(cosmostypes.NewDecCoinFromDec(atomDenom, math.LegacyMustNewDecFromStr("0.5"))), nullify.Fill(convertedCoin))
+
+	// the fresh oracle price is preferred over the static one
+	oracleKeeper.EXPECT().GetPriceForCurrencyPair(gomock.Any(), atomUsd).Return(oracletypes.QuotePrice{
+		Price:          math.NewInt(500_000_000),
+		BlockTimestamp: ctx.BlockTime(),
+	}, nil).After(staleAtomPrice)
+	convertedCoin, err = k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(atomDenom, math.NewInt(1)), appparams.DefaultDenom)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(cosmostypes.NewDecCoinFromDec(appparams.DefaultDenom, math.LegacyNewDec(10))), nullify.Fill(convertedCoin))
+}
+
+func TestExtraDenomsWithPriceSources(t *testing.T) {
+	k, ctx := testkeeper.DynamicFeesKeeper(t)
+	params := types.DefaultParams()
+	params.NtrnPrices = cosmostypes.DecCoins{{Denom: "uatom", Amount: math.LegacyNewDec(10)}}
+	params.NtrnCurrencyPair = "NTRN/USD"
+	params.OraclePriceSources = []types.OraclePriceSource{
+		{Denom: "uatom", CurrencyPair: "ATOM/USD", Decimals: 6},
+		{Denom: "uosmo", CurrencyPair: "OSMO/USD", Decimals: 6},
+	}
+	params.DexTwapSources = []types.DexTwapSource{
+		{Denom: "uosmo", WindowSeconds: 600},
+		{Denom: "utia", WindowSeconds: 600},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	denoms, err := k.ExtraDenoms(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"uatom", "uosmo", "utia"}, denoms)
+}
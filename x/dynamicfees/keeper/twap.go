@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appparams "github.com/neutron-org/neutron/v11/app/params"
+	math_utils "github.com/neutron-org/neutron/v11/utils/math"
+	dextypes "github.com/neutron-org/neutron/v11/x/dex/types"
+	"github.com/neutron-org/neutron/v11/x/dynamicfees/types"
+)
+
+// precDecToLegacyPrecision is used to drop the decimals of a PrecDec that don't fit in a LegacyDec
+var precDecToLegacyPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(math_utils.Precision-math.LegacyPrecision), nil)
+
+// RecordTwapObservations records the current dex prices of the denoms of the dex TWAP sources
+// and removes their observations that are out of the TWAP windows. Called at the end of each block.
+func (k Keeper) RecordTwapObservations(ctx sdk.Context) {
+	if k.dexKeeper == nil {
+		return
+	}
+
+	now := ctx.BlockTime().Unix()
+	for _, source := range k.GetParams(ctx).DexTwapSources {
+		k.pruneTwapObservations(ctx, source.Denom, now-int64(source.WindowSeconds)) //nolint:gosec
+
+		price, found := k.getDexPrice(ctx, source.Denom)
+		if !found {
+			continue
+		}
+
+		observation := types.TwapObservation{
+			Price:           price,
+			CumulativePrice: math.LegacyZeroDec(),
+			Timestamp:       now,
+		}
+		if last, found := k.GetLastTwapObservation(ctx, source.Denom); found {
+			observation.CumulativePrice = last.CumulativePrice.Add(last.Price.MulInt64(now - last.Timestamp))
+		}
+		k.setTwapObservation(ctx, source.Denom, observation)
+	}
+}
+
+// GetTwap returns the time-weighted average untrn price of the denom on x/dex over the window of the source.
+// The price is unavailable if the denom had no dex price observed within the window.
+func (k Keeper) GetTwap(ctx sdk.Context, source types.DexTwapSource) (math.LegacyDec, error) {
+	now := ctx.BlockTime().Unix()
+	since := now - int64(source.WindowSeconds) //nolint:gosec
+
+	last, found := k.GetLastTwapObservation(ctx, source.Denom)
+	if !found || last.Timestamp < since {
+		return math.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceUnavailable, "no dex price of %s observed in the TWAP window", source.Denom)
+	}
+	// the first observation exists since the last one is in the window
+	first, _ := k.getFirstTwapObservation(ctx, source.Denom, since)
+	if first.Timestamp >= now {
+		return last.Price, nil
+	}
+
+	cumulativePrice := last.CumulativePrice.Add(last.Price.MulInt64(now - last.Timestamp))
+	return cumulativePrice.Sub(first.CumulativePrice).QuoInt64(now - first.Timestamp), nil
+}
+
+// GetLastTwapObservation returns the latest dex price observation of the denom
+func (k Keeper) GetLastTwapObservation(ctx sdk.Context, denom string) (types.TwapObservation, bool) {
+	iterator := storetypes.KVStoreReversePrefixIterator(k.twapObservationsStore(ctx, denom), []byte{})
+	defer iterator.Close()
+
+	var observation types.TwapObservation
+	if !iterator.Valid() {
+		return observation, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+	return observation, true
+}
+
+// getFirstTwapObservation returns the earliest dex price observation of the denom made at or after the timestamp
+func (k Keeper) getFirstTwapObservation(ctx sdk.Context, denom string, timestamp int64) (types.TwapObservation, bool) {
+	iterator := k.twapObservationsStore(ctx, denom).Iterator(sdk.Uint64ToBigEndian(uint64(timestamp)), nil) //nolint:gosec
+	defer iterator.Close()
+
+	var observation types.TwapObservation
+	if !iterator.Valid() {
+		return observation, false
+	}
+	k.cdc.MustUnmarshal(iterator.Value(), &observation)
+	return observation, true
+}
+
+func (k Keeper) setTwapObservation(ctx sdk.Context, denom string, observation types.TwapObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTwapObservationKey(denom, observation.Timestamp), k.cdc.MustMarshal(&observation))
+}
+
+// pruneTwapObservations removes the dex price observations of the denom made before the timestamp
+func (k Keeper) pruneTwapObservations(ctx sdk.Context, denom string, timestamp int64) {
+	if timestamp <= 0 {
+		return
+	}
+
+	k.deleteTwapObservations(ctx, denom, sdk.Uint64ToBigEndian(uint64(timestamp)))
+}
+
+// removeTwapObservations removes all the dex price observations of the denom. Used when the dex TWAP source
+// of the denom is removed from the params, since its observations aren't pruned at the end of the blocks anymore.
+func (k Keeper) removeTwapObservations(ctx sdk.Context, denom string) {
+	k.deleteTwapObservations(ctx, denom, nil)
+}
+
+// deleteTwapObservations removes the dex price observations of the denom whose keys are before the end key,
+// or all of them if the end key is nil
+func (k Keeper) deleteTwapObservations(ctx sdk.Context, denom string, end []byte) {
+	store := k.twapObservationsStore(ctx, denom)
+	iterator := store.Iterator(nil, end)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) twapObservationsStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTwapObservationsPrefix(denom))
+}
+
+// getDexPrice returns the current untrn price of one unit of the denom offered on x/dex
+func (k Keeper) getDexPrice(ctx sdk.Context, denom string) (math.LegacyDec, bool) {
+	tradePairID := &dextypes.TradePairID{TakerDenom: appparams.DefaultDenom, MakerDenom: denom}
+	price, found := k.dexKeeper.GetCurrPrice(ctx, tradePairID)
+	if !found {
+		return math.LegacyDec{}, false
+	}
+
+	legacyPrice := math.LegacyNewDecFromBigIntWithPrec(new(big.Int).Quo(price.BigInt(), precDecToLegacyPrecision), math.LegacyPrecision)
+	return legacyPrice, legacyPrice.IsPositive()
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	appparams "github.com/neutron-org/neutron/v11/app/params"
+	"github.com/neutron-org/neutron/v11/testutil/common/nullify"
+	testkeeper "github.com/neutron-org/neutron/v11/testutil/dynamicfees/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/dynamicfees/types"
+	math_utils "github.com/neutron-org/neutron/v11/utils/math"
+	dextypes "github.com/neutron-org/neutron/v11/x/dex/types"
+	"github.com/neutron-org/neutron/v11/x/dynamicfees/types"
+)
+
+func TestTwap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	dexKeeper := mock_types.NewMockDexKeeper(ctrl)
+	k, ctx := testkeeper.DynamicFeesKeeper(t)
+	k.SetDexKeeper(dexKeeper)
+	start := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(start)
+
+	const osmoDenom = "uosmo"
+	source := types.DexTwapSource{Denom: osmoDenom, WindowSeconds: 20}
+	params := types.DefaultParams()
+	params.DexTwapSources = []types.DexTwapSource{source}
+	require.NoError(t, k.SetParams(ctx, params))
+	tradePairID := &dextypes.TradePairID{TakerDenom: appparams.DefaultDenom, MakerDenom: osmoDenom}
+
+	// the denom isn't priced before its dex price is observed
+	_, err := k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(appparams.DefaultDenom, math.NewInt(2)), osmoDenom)
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+
+	// 1 OSMO = 2 NTRN
+	dexKeeper.EXPECT().GetCurrPrice(gomock.Any(), tradePairID).Return(math_utils.NewPrecDec(2), true)
+	k.RecordTwapObservations(ctx)
+	twap, err := k.GetTwap(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(2), twap)
+
+	// 1 OSMO = 4 NTRN 10 seconds later
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Second))
+	dexKeeper.EXPECT().GetCurrPrice(gomock.Any(), tradePairID).Return(math_utils.NewPrecDec(4), true)
+	k.RecordTwapObservations(ctx)
+
+	// the prices are averaged over the time they were observed for
+	ctx = ctx.WithBlockTime(start.Add(20 * time.Second))
+	twap, err = k.GetTwap(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(3), twap)
+
+	convertedCoin, err := k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(appparams.DefaultDenom, math.NewInt(6)), osmoDenom)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(cosmostypes.NewDecCoinFromDec(osmoDenom, math.LegacyNewDec(2))), nullify.Fill(convertedCoin))
+
+	// the blocks without dex liquidity aren't observed, the observations out of the window are removed
+	ctx = ctx.WithBlockTime(start.Add(25 * time.Second))
+	dexKeeper.EXPECT().GetCurrPrice(gomock.Any(), tradePairID).Return(math_utils.ZeroPrecDec(), false)
+	k.RecordTwapObservations(ctx)
+	last, found := k.GetLastTwapObservation(ctx, osmoDenom)
+	require.True(t, found)
+	require.Equal(t, start.Add(10*time.Second).Unix(), last.Timestamp)
+	twap, err = k.GetTwap(ctx, source)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(4), twap)
+
+	// the TWAP is unavailable if no price was observed within the window
+	ctx = ctx.WithBlockTime(start.Add(time.Minute))
+	_, err = k.GetTwap(ctx, source)
+	require.ErrorIs(t, err, types.ErrPriceUnavailable)
+
+	// the static price is used as a fallback
+	params.NtrnPrices = cosmostypes.DecCoins{{Denom: osmoDenom, Amount: math.LegacyNewDec(1)}}
+	require.NoError(t, k.SetParams(ctx, params))
+	convertedCoin, err = k.ConvertToDenom(ctx, cosmostypes.NewDecCoin(appparams.DefaultDenom, math.NewInt(6)), osmoDenom)
+	require.NoError(t, err)
+	require.Equal(t, nullify.Fill(cosmostypes.NewDecCoinFromDec(osmoDenom, math.LegacyNewDec(6))), nullify.Fill(convertedCoin))
+
+	// the observations of the source removed from the params are removed as well
+	_, found = k.GetLastTwapObservation(ctx, osmoDenom)
+	require.True(t, found)
+	params.DexTwapSources = nil
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	_, found = k.GetLastTwapObservation(ctx, osmoDenom)
+	require.False(t, found)
+}
@@ -26,8 +26,9 @@ import (
 )
 
 var (
-	_ appmodule.AppModule   = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
+	_ module.AppModuleBasic   = AppModuleBasic{}
 )
 
 // ----------------------------------------------------------------------------
@@ -149,5 +150,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock records the dex prices of the denoms priced by their TWAPs.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.RecordTwapObservations(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return types.ConsensusVersion }
@@ -1,3 +1,15 @@
 package types
 
+import (
+	"math"
+	"time"
+)
+
 const ConsensusVersion = 1
+
+const (
+	// MaxDenomDecimals is the maximum number of decimals of a denom priced by the oracle
+	MaxDenomDecimals = 18
+	// MaxDurationSeconds is the maximum duration in seconds that fits in time.Duration
+	MaxDurationSeconds = uint64(math.MaxInt64 / int64(time.Second))
+)
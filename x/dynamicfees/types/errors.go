@@ -8,5 +8,7 @@ import (
 
 // x/dynamicfees module sentinel errors
 var (
-	ErrUnknownDenom = errors.Register(ModuleName, 1100, "unknown denom")
+	ErrUnknownDenom     = errors.Register(ModuleName, 1100, "unknown denom")
+	ErrInvalidParams    = errors.Register(ModuleName, 1101, "invalid params")
+	ErrPriceUnavailable = errors.Register(ModuleName, 1102, "price unavailable")
 )
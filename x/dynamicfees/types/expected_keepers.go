@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+
+	math_utils "github.com/neutron-org/neutron/v11/utils/math"
+	dextypes "github.com/neutron-org/neutron/v11/x/dex/types"
+)
+
+// OracleKeeper defines the expected interface needed to price the denoms by the slinky oracle.
+type OracleKeeper interface {
+	GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (oracletypes.QuotePrice, error)
+	GetDecimalsForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, error)
+}
+
+// DexKeeper defines the expected interface needed to observe the dex prices of the denoms.
+type DexKeeper interface {
+	GetCurrPrice(ctx sdk.Context, tradePairID *dextypes.TradePairID) (math_utils.PrecDec, bool)
+}
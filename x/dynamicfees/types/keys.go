@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "dynamicfees"
@@ -10,6 +14,20 @@ const (
 
 const (
 	prefixParamsKey = iota + 1
+	prefixTwapObservationKey
 )
 
-var ParamsKey = []byte{prefixParamsKey}
+var (
+	ParamsKey          = []byte{prefixParamsKey}
+	TwapObservationKey = []byte{prefixTwapObservationKey}
+)
+
+// GetTwapObservationsPrefix returns the store prefix of the dex price observations of the denom
+func GetTwapObservationsPrefix(denom string) []byte {
+	return append(append(TwapObservationKey, []byte(denom)...), 0x00)
+}
+
+// GetTwapObservationKey returns the store key of the dex price observation of the denom made at the unix timestamp
+func GetTwapObservationKey(denom string, timestamp int64) []byte {
+	return append(GetTwapObservationsPrefix(denom), sdk.Uint64ToBigEndian(uint64(timestamp))...)
+}
@@ -1,8 +1,12 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 	"gopkg.in/yaml.v2"
+
+	appparams "github.com/neutron-org/neutron/v11/app/params"
 )
 
 // NewParams creates a new Params instance
@@ -23,9 +27,108 @@ func (p Params) Validate() error {
 	//	return
 	//}
 
+	var ntrnPair slinkytypes.CurrencyPair
+	if p.NtrnCurrencyPair != "" {
+		var err error
+		if ntrnPair, err = slinkytypes.CurrencyPairFromString(p.NtrnCurrencyPair); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid NTRN currency pair: %s", err)
+		}
+	} else if len(p.OraclePriceSources) > 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "NTRN currency pair is required to use the oracle price sources")
+	}
+
+	denoms := make(map[string]bool, len(p.OraclePriceSources))
+	for _, source := range p.OraclePriceSources {
+		if err := source.Validate(); err != nil {
+			return err
+		}
+		if denoms[source.Denom] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate oracle price source for denom %s", source.Denom)
+		}
+		denoms[source.Denom] = true
+		// the source's currency pair is validated above
+		cp, _ := slinkytypes.CurrencyPairFromString(source.CurrencyPair)
+		if cp.Quote != ntrnPair.Quote {
+			return errorsmod.Wrapf(ErrInvalidParams, "currency pair of denom %s must be quoted in %s", source.Denom, ntrnPair.Quote)
+		}
+	}
+
+	if p.MaxOraclePriceAgeSeconds > MaxDurationSeconds {
+		return errorsmod.Wrap(ErrInvalidParams, "max oracle price age is too long")
+	}
+
+	denoms = make(map[string]bool, len(p.DexTwapSources))
+	for _, source := range p.DexTwapSources {
+		if err := source.Validate(); err != nil {
+			return err
+		}
+		if denoms[source.Denom] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate dex TWAP source for denom %s", source.Denom)
+		}
+		denoms[source.Denom] = true
+	}
+
+	return nil
+}
+
+// Validate validates the oracle price source
+func (s OraclePriceSource) Validate() error {
+	if err := validateSourceDenom(s.Denom); err != nil {
+		return err
+	}
+	if _, err := slinkytypes.CurrencyPairFromString(s.CurrencyPair); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid currency pair of denom %s: %s", s.Denom, err)
+	}
+	if s.Decimals > MaxDenomDecimals {
+		return errorsmod.Wrapf(ErrInvalidParams, "decimals of denom %s cannot exceed %d", s.Denom, MaxDenomDecimals)
+	}
+	return nil
+}
+
+// Validate validates the dex TWAP source
+func (s DexTwapSource) Validate() error {
+	if err := validateSourceDenom(s.Denom); err != nil {
+		return err
+	}
+	if s.WindowSeconds == 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "TWAP window of denom %s must be positive", s.Denom)
+	}
+	if s.WindowSeconds > MaxDurationSeconds {
+		return errorsmod.Wrapf(ErrInvalidParams, "TWAP window of denom %s is too long", s.Denom)
+	}
+	return nil
+}
+
+func validateSourceDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	if denom == appparams.DefaultDenom {
+		return errorsmod.Wrapf(ErrInvalidParams, "%s cannot have a price source", appparams.DefaultDenom)
+	}
 	return nil
 }
 
+// GetOraclePriceSource returns the oracle price source of the denom
+func (p Params) GetOraclePriceSource(denom string) (OraclePriceSource, bool) {
+	for _, source := range p.OraclePriceSources {
+		if source.Denom == denom {
+			return source, true
+		}
+	}
+	return OraclePriceSource{}, false
+}
+
+// GetDexTwapSource returns the dex TWAP source of the denom
+func (p Params) GetDexTwapSource(denom string) (DexTwapSource, bool) {
+	for _, source := range p.DexTwapSources {
+		if source.Denom == denom {
+			return source, true
+		}
+	}
+	return DexTwapSource{}, false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
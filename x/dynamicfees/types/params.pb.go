@@ -31,7 +31,18 @@ type Params struct {
 	// it's used in cooperation with feemarket module
 	// ntrn_prices is a data source to convert gas_price from feemarket's base_denom (untrn)
 	// into a given asset
+	// the prices are used as a fallback when the oracle and dex prices of the asset are unavailable
 	NtrnPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=ntrn_prices,json=ntrnPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"ntrn_prices"`
+	// currency pair of the oracle pricing NTRN, e.g. NTRN/USD
+	// the oracle prices of the assets are converted into NTRN through it,
+	// so the currency pairs of oracle_price_sources must have the same quote
+	NtrnCurrencyPair string `protobuf:"bytes,2,opt,name=ntrn_currency_pair,json=ntrnCurrencyPair,proto3" json:"ntrn_currency_pair,omitempty"`
+	// assets priced by the slinky oracle, preferred over the dex TWAPs and ntrn_prices
+	OraclePriceSources []OraclePriceSource `protobuf:"bytes,3,rep,name=oracle_price_sources,json=oraclePriceSources,proto3" json:"oracle_price_sources"`
+	// maximum age of an oracle price in seconds, older prices are ignored. 0 means no limit
+	MaxOraclePriceAgeSeconds uint64 `protobuf:"varint,4,opt,name=max_oracle_price_age_seconds,json=maxOraclePriceAgeSeconds,proto3" json:"max_oracle_price_age_seconds,omitempty"`
+	// assets priced by the TWAP of their untrn pair on x/dex, preferred over ntrn_prices
+	DexTwapSources []DexTwapSource `protobuf:"bytes,5,rep,name=dex_twap_sources,json=dexTwapSources,proto3" json:"dex_twap_sources"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -73,8 +84,158 @@ func (m *Params) GetNtrnPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
 	return nil
 }
 
+func (m *Params) GetNtrnCurrencyPair() string {
+	if m != nil {
+		return m.NtrnCurrencyPair
+	}
+	return ""
+}
+
+func (m *Params) GetOraclePriceSources() []OraclePriceSource {
+	if m != nil {
+		return m.OraclePriceSources
+	}
+	return nil
+}
+
+func (m *Params) GetMaxOraclePriceAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxOraclePriceAgeSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetDexTwapSources() []DexTwapSource {
+	if m != nil {
+		return m.DexTwapSources
+	}
+	return nil
+}
+
+// OraclePriceSource defines how an asset is priced in NTRN by the slinky oracle.
+type OraclePriceSource struct {
+	// the denom on Neutron, e.g. ibc/{hash}
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the oracle currency pair of the asset, e.g. ATOM/USD
+	CurrencyPair string `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// the number of decimals of the denom, e.g. 6 for uatom
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *OraclePriceSource) Reset()         { *m = OraclePriceSource{} }
+func (m *OraclePriceSource) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceSource) ProtoMessage()    {}
+func (*OraclePriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4ff446f82722ba2, []int{1}
+}
+func (m *OraclePriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceSource.Merge(m, src)
+}
+func (m *OraclePriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceSource proto.InternalMessageInfo
+
+func (m *OraclePriceSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OraclePriceSource) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *OraclePriceSource) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// DexTwapSource defines how an asset is priced in NTRN by the time-weighted average price
+// of the untrn/denom pair on x/dex.
+type DexTwapSource struct {
+	// the denom on Neutron, e.g. ibc/{hash}
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the period over which the price is averaged in seconds
+	WindowSeconds uint64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *DexTwapSource) Reset()         { *m = DexTwapSource{} }
+func (m *DexTwapSource) String() string { return proto.CompactTextString(m) }
+func (*DexTwapSource) ProtoMessage()    {}
+func (*DexTwapSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4ff446f82722ba2, []int{2}
+}
+func (m *DexTwapSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexTwapSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexTwapSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DexTwapSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexTwapSource.Merge(m, src)
+}
+func (m *DexTwapSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexTwapSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexTwapSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexTwapSource proto.InternalMessageInfo
+
+func (m *DexTwapSource) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DexTwapSource) GetWindowSeconds() uint64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "neutron.dynamicfees.v1.Params")
+	proto.RegisterType((*OraclePriceSource)(nil), "neutron.dynamicfees.v1.OraclePriceSource")
+	proto.RegisterType((*DexTwapSource)(nil), "neutron.dynamicfees.v1.DexTwapSource")
 }
 
 func init() {
@@ -82,24 +243,37 @@ func init() {
 }
 
 var fileDescriptor_f4ff446f82722ba2 = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0xa9, 0xcc, 0x4b, 0xcc, 0xcd, 0x4c, 0x4e, 0x4b, 0x4d, 0x2d, 0xd6,
-	0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x83, 0x2a, 0xd2, 0x43, 0x52, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x97, 0x9c, 0x5f, 0x9c, 0x9b,
-	0x5f, 0xac, 0x9f, 0x94, 0x58, 0x9c, 0xaa, 0x5f, 0x66, 0x98, 0x94, 0x5a, 0x92, 0x68, 0xa8, 0x9f,
-	0x9c, 0x9f, 0x99, 0x07, 0xd1, 0x27, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x66, 0xea, 0x83, 0x58,
-	0x10, 0x51, 0xa5, 0x26, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0xf1, 0x42, 0x45, 0x5c, 0xdc, 0x79, 0x25,
-	0x45, 0x79, 0xf1, 0x05, 0x45, 0x99, 0xc9, 0xa9, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46,
-	0x32, 0x7a, 0x10, 0x63, 0xf5, 0x40, 0xc6, 0xea, 0x41, 0x8d, 0xd5, 0x73, 0x49, 0x4d, 0x76, 0xce,
-	0xcf, 0xcc, 0x73, 0x32, 0x3e, 0x71, 0x4f, 0x9e, 0x61, 0xd5, 0x7d, 0x79, 0xed, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x33, 0x20, 0x94, 0x6e, 0x71, 0x4a, 0xb6,
-	0x7e, 0x49, 0x65, 0x41, 0x6a, 0x31, 0x4c, 0x4f, 0x71, 0x10, 0x17, 0xc8, 0x96, 0x00, 0xb0, 0x25,
-	0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c,
-	0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1,
-	0x1c, 0x43, 0x94, 0x19, 0x92, 0xc1, 0x50, 0x7f, 0xeb, 0xe6, 0x17, 0xa5, 0xc3, 0xd8, 0xfa, 0x65,
-	0x86, 0x86, 0xfa, 0x15, 0x28, 0xc1, 0x05, 0xb6, 0x2c, 0x89, 0x0d, 0xec, 0x3b, 0x63, 0x40, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xde, 0x55, 0x9e, 0x2c, 0x52, 0x01, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x93, 0xad, 0x9b, 0xc0, 0xa3, 0xd3, 0xb0, 0x2a, 0x14, 0x55, 0x53, 0x5a, 0x75, 0x9a,
+	0x54, 0x04, 0xb3, 0x15, 0x26, 0x71, 0xe0, 0x80, 0x44, 0xb7, 0x23, 0x12, 0x55, 0x06, 0x17, 0x2e,
+	0x91, 0xeb, 0x98, 0x60, 0x58, 0xfc, 0x22, 0x3b, 0x6d, 0xd3, 0x6f, 0xc1, 0x91, 0x23, 0xe7, 0x7d,
+	0x92, 0x1d, 0x77, 0xe4, 0x04, 0xa8, 0xfd, 0x22, 0x28, 0x4e, 0x56, 0xa5, 0xb0, 0x9d, 0xf2, 0xfc,
+	0x9e, 0xdf, 0xfb, 0xff, 0xf2, 0xd7, 0x33, 0x3a, 0x52, 0x62, 0x9a, 0x6b, 0x50, 0x34, 0x5e, 0x28,
+	0x96, 0x4a, 0xfe, 0x49, 0x08, 0x43, 0x67, 0x01, 0xcd, 0x98, 0x66, 0xa9, 0x21, 0x99, 0x86, 0x1c,
+	0xf0, 0x93, 0xfa, 0x12, 0x69, 0x5c, 0x22, 0xb3, 0xa0, 0xeb, 0x73, 0x30, 0x29, 0x18, 0x3a, 0x61,
+	0x46, 0xd0, 0x59, 0x30, 0x11, 0x39, 0x0b, 0x28, 0x07, 0xa9, 0xaa, 0xbe, 0x6e, 0x27, 0x81, 0x04,
+	0x6c, 0x48, 0xcb, 0xa8, 0xca, 0x0e, 0xae, 0xb6, 0xd1, 0xee, 0xd8, 0x8e, 0xc7, 0x1a, 0xed, 0xa9,
+	0x5c, 0xab, 0x28, 0xd3, 0x92, 0x0b, 0xe3, 0xb9, 0xfd, 0xed, 0xe1, 0xde, 0x8b, 0x43, 0x52, 0x8d,
+	0x25, 0xe5, 0x58, 0x52, 0x8f, 0x25, 0xe7, 0x82, 0x9f, 0x81, 0x54, 0xa3, 0xd3, 0xeb, 0x5f, 0x3d,
+	0xe7, 0xea, 0x77, 0xef, 0x59, 0x22, 0xf3, 0xcf, 0xd3, 0x09, 0xe1, 0x90, 0xd2, 0x1a, 0xa3, 0xfa,
+	0x9c, 0x98, 0xf8, 0x2b, 0xcd, 0x17, 0x99, 0x30, 0xb7, 0x3d, 0x26, 0x44, 0xa5, 0xca, 0xd8, 0x8a,
+	0xe0, 0xe7, 0x08, 0x5b, 0x4d, 0x3e, 0xd5, 0x5a, 0x28, 0xbe, 0x88, 0x32, 0x26, 0xb5, 0xb7, 0xd5,
+	0x77, 0x87, 0x0f, 0xc3, 0x83, 0xb2, 0x72, 0x56, 0x17, 0xc6, 0x4c, 0x6a, 0xcc, 0x50, 0x07, 0x34,
+	0xe3, 0x97, 0xa2, 0x62, 0x8c, 0x0c, 0x4c, 0x75, 0x89, 0xba, 0x6d, 0x51, 0x9f, 0x92, 0xbb, 0x9d,
+	0x21, 0xef, 0x6c, 0x8f, 0x55, 0xbc, 0xb0, 0x1d, 0xa3, 0x56, 0xc9, 0x1d, 0x62, 0xf8, 0xb7, 0x60,
+	0xf0, 0x6b, 0x74, 0x98, 0xb2, 0x22, 0xda, 0x90, 0x61, 0x89, 0x88, 0x8c, 0xe0, 0xa0, 0x62, 0xe3,
+	0xb5, 0xfa, 0xee, 0xb0, 0x15, 0x7a, 0x29, 0x2b, 0x1a, 0x53, 0xdf, 0x24, 0xe2, 0xa2, 0xaa, 0xe3,
+	0x0f, 0xe8, 0x20, 0x16, 0x45, 0x94, 0xcf, 0x59, 0xb6, 0xc6, 0xdb, 0xb1, 0x78, 0xc7, 0xf7, 0xe1,
+	0x9d, 0x8b, 0xe2, 0xfd, 0x9c, 0x65, 0x1b, 0x68, 0xfb, 0x71, 0x33, 0x69, 0x5e, 0xb5, 0xbe, 0xff,
+	0xe8, 0x39, 0x83, 0x2f, 0xe8, 0xf1, 0x7f, 0xff, 0x82, 0x3b, 0x68, 0x27, 0x16, 0x0a, 0x52, 0xcf,
+	0xb5, 0xae, 0x55, 0x07, 0x7c, 0x84, 0xda, 0x77, 0x79, 0xfa, 0x88, 0x37, 0xfd, 0xec, 0xa2, 0x07,
+	0xb1, 0xe0, 0x32, 0x65, 0x97, 0xa5, 0x87, 0xee, 0xb0, 0x1d, 0xae, 0xcf, 0x83, 0xb7, 0xa8, 0xbd,
+	0x01, 0x76, 0x8f, 0xce, 0x31, 0xda, 0x9f, 0x4b, 0x15, 0xc3, 0x7c, 0xed, 0xd0, 0x96, 0x75, 0xa8,
+	0x5d, 0x65, 0x6b, 0x5b, 0x46, 0xe3, 0xeb, 0xa5, 0xef, 0xde, 0x2c, 0x7d, 0xf7, 0xcf, 0xd2, 0x77,
+	0xbf, 0xad, 0x7c, 0xe7, 0x66, 0xe5, 0x3b, 0x3f, 0x57, 0xbe, 0xf3, 0xf1, 0x65, 0x63, 0x75, 0x6a,
+	0x83, 0x4e, 0x40, 0x27, 0xb7, 0x31, 0x9d, 0x05, 0x01, 0x2d, 0x36, 0x1e, 0x84, 0x5d, 0xa7, 0xc9,
+	0xae, 0xdd, 0xdf, 0xd3, 0xbf, 0x03, 0x00, 0x4c, 0x5f, 0x82, 0xff, 0x34, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +296,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DexTwapSources) > 0 {
+		for iNdEx := len(m.DexTwapSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DexTwapSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxOraclePriceAgeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOraclePriceAgeSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OraclePriceSources) > 0 {
+		for iNdEx := len(m.OraclePriceSources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePriceSources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NtrnCurrencyPair) > 0 {
+		i -= len(m.NtrnCurrencyPair)
+		copy(dAtA[i:], m.NtrnCurrencyPair)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.NtrnCurrencyPair)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.NtrnPrices) > 0 {
 		for iNdEx := len(m.NtrnPrices) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -139,6 +353,83 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OraclePriceSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexTwapSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexTwapSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexTwapSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -162,6 +453,61 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = len(m.NtrnCurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.OraclePriceSources) > 0 {
+		for _, e := range m.OraclePriceSources {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxOraclePriceAgeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MaxOraclePriceAgeSeconds))
+	}
+	if len(m.DexTwapSources) > 0 {
+		for _, e := range m.DexTwapSources {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OraclePriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovParams(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *DexTwapSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovParams(uint64(m.WindowSeconds))
+	}
 	return n
 }
 
@@ -234,6 +580,359 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NtrnCurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NtrnCurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePriceSources = append(m.OraclePriceSources, OraclePriceSource{})
+			if err := m.OraclePriceSources[len(m.OraclePriceSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOraclePriceAgeSeconds", wireType)
+			}
+			m.MaxOraclePriceAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOraclePriceAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DexTwapSources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DexTwapSources = append(m.DexTwapSources, DexTwapSource{})
+			if err := m.DexTwapSources[len(m.DexTwapSources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexTwapSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexTwapSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexTwapSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1,5 +1,5 @@
 package types
 
 func (q *MsgUpdateParams) Validate() error {
-	return nil
+	return q.Params.Validate()
 }
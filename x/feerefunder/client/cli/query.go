@@ -26,6 +26,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdFeeInfo())
+	cmd.AddCommand(CmdFeeInfos())
+	cmd.AddCommand(CmdPayee())
 
 	return cmd
 }
@@ -61,3 +63,66 @@ func CmdFeeInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdFeeInfos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-infos [payer]",
+		Short: "queries all fee infos or fee infos of a specific payer",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			payer := ""
+			if len(args) > 0 {
+				payer = args[0]
+			}
+
+			res, err := queryClient.FeeInfos(context.Background(), &types.QueryFeeInfosRequest{
+				Payer:      payer,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "payee [relayer] [channel_id]",
+		Short: "queries the address the relayer's fees for the channel are paid to",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Payee(context.Background(), &types.QueryPayeeRequest{
+				Relayer:   args[0],
+				ChannelId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
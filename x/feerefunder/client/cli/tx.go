@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRegisterPayee())
+	cmd.AddCommand(CmdIncreasePacketFee())
+	cmd.AddCommand(CmdRefundFees())
+
+	return cmd
+}
+
+func CmdRegisterPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-payee [channel_id] [payee]",
+		Short: "Register an address the relayer's fees for the channel are paid to",
+		Long: "Register an address the relayer's fees for the channel are paid to. Pass an empty channel_id to register " +
+			"the payee for all the relayer's channels, and an empty payee to remove the registered payee",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRegisterPayee{
+				Relayer:   clientCtx.GetFromAddress().String(),
+				ChannelId: args[0],
+				Payee:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdIncreasePacketFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-packet-fee [port_id] [channel_id] [sequence] [ack_fee] [timeout_fee]",
+		Short: "Add fees to the fees escrowed for an in-flight packet",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse sequence: %w", err)
+			}
+
+			ackFee, err := sdk.ParseCoinsNormalized(args[3])
+			if err != nil {
+				return fmt.Errorf("failed to parse ack fee: %w", err)
+			}
+
+			timeoutFee, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return fmt.Errorf("failed to parse timeout fee: %w", err)
+			}
+
+			msg := types.MsgIncreasePacketFee{
+				Payer:    clientCtx.GetFromAddress().String(),
+				PacketId: types.NewPacketID(args[0], args[1], sequence),
+				Fee:      types.NewFee(nil, ackFee, timeoutFee),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRefundFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-fees [port_id] [channel_id] [sequence]",
+		Short: "Return the fees escrowed for a packet sent over a closed channel to the payer",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse sequence: %w", err)
+			}
+
+			msg := types.MsgRefundFees{
+				Sender:   clientCtx.GetFromAddress().String(),
+				PacketId: types.NewPacketID(args[0], args[1], sequence),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
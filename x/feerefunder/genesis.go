@@ -18,6 +18,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, info := range genState.FeeInfos {
 		k.StoreFeeInfo(ctx, info)
 	}
+
+	for _, payee := range genState.RegisteredPayees {
+		k.SetPayee(ctx, payee)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -26,6 +30,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	genesis.Params = k.GetParams(ctx)
 	genesis.FeeInfos = k.GetAllFeeInfos(ctx)
+	genesis.RegisteredPayees = k.GetAllRegisteredPayees(ctx)
 
 	return genesis
 }
@@ -30,6 +30,11 @@ func TestGenesis(t *testing.T) {
 				TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(types.DefaultFees.TimeoutFee.AmountOf(params.DefaultDenom).Int64()+1))),
 			},
 		}},
+		RegisteredPayees: []types.RegisteredPayee{{
+			Relayer:   "neutron13xvjxhkkxxhztcugr6weyt76eedj5ucpt4xluv",
+			ChannelId: "channel-1",
+			Payee:     TestContractAddressNeutron,
+		}},
 	}
 
 	require.EqualValues(t, genesisState.Params, types.DefaultParams())
@@ -40,6 +45,7 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualValues(t, got.Params, types.DefaultParams())
 	require.NotNil(t, got)
+	require.ElementsMatch(t, genesisState.RegisteredPayees, got.RegisteredPayees)
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
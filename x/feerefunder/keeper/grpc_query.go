@@ -4,9 +4,13 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
 )
@@ -23,3 +27,59 @@ func (k Keeper) FeeInfo(goCtx context.Context, request *types.FeeInfoRequest) (*
 
 	return &types.FeeInfoResponse{FeeInfo: feeInfo}, nil
 }
+
+func (k Keeper) Payee(goCtx context.Context, request *types.QueryPayeeRequest) (*types.QueryPayeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	relayer, err := sdk.AccAddressFromBech32(request.Relayer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relayer address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	_, channelSpecific := k.GetPayee(ctx, request.Relayer, request.ChannelId)
+
+	return &types.QueryPayeeResponse{
+		Payee:           k.GetFeeReceiver(ctx, relayer, request.ChannelId).String(),
+		ChannelSpecific: channelSpecific && request.ChannelId != "",
+	}, nil
+}
+
+func (k Keeper) FeeInfos(goCtx context.Context, request *types.QueryFeeInfosRequest) (*types.QueryFeeInfosResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if request.Payer != "" {
+		if _, err := sdk.AccAddressFromBech32(request.Payer); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid payer address: %v", err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FeeKey)
+
+	var feeInfos []types.FeeInfo
+	pageRes, err := query.FilteredPaginate(store, request.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var feeInfo types.FeeInfo
+		if err := k.cdc.Unmarshal(value, &feeInfo); err != nil {
+			return false, err
+		}
+
+		if request.Payer != "" && feeInfo.Payer != request.Payer {
+			return false, nil
+		}
+
+		if accumulate {
+			feeInfos = append(feeInfos, feeInfo)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryFeeInfosResponse{FeeInfos: feeInfos, Pagination: pageRes}, nil
+}
@@ -15,17 +15,19 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
+	appparams "github.com/neutron-org/neutron/v11/app/params"
 	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
 )
 
 type (
 	Keeper struct {
-		cdc           codec.BinaryCodec
-		bankKeeper    types.BankKeeper
-		storeKey      storetypes.StoreKey
-		memKey        storetypes.StoreKey
-		channelKeeper types.ChannelKeeper
-		authority     string
+		cdc               codec.BinaryCodec
+		bankKeeper        types.BankKeeper
+		dynamicfeesKeeper types.DynamicFeesKeeper
+		storeKey          storetypes.StoreKey
+		memKey            storetypes.StoreKey
+		channelKeeper     types.ChannelKeeper
+		authority         string
 	}
 )
 
@@ -35,15 +37,17 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	channelKeeper types.ChannelKeeper,
 	bankKeeper types.BankKeeper,
+	dynamicfeesKeeper types.DynamicFeesKeeper,
 	authority string,
 ) *Keeper {
 	return &Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		memKey:        memKey,
-		channelKeeper: channelKeeper,
-		bankKeeper:    bankKeeper,
-		authority:     authority,
+		cdc:               cdc,
+		storeKey:          storeKey,
+		memKey:            memKey,
+		channelKeeper:     channelKeeper,
+		bankKeeper:        bankKeeper,
+		dynamicfeesKeeper: dynamicfeesKeeper,
+		authority:         authority,
 	}
 }
 
@@ -103,8 +107,8 @@ func (k Keeper) LockFees(ctx context.Context, payer sdk.AccAddress, packetID typ
 	return nil
 }
 
-// DistributeAcknowledgementFee distributes ack fee to the `receiver`
-// and returns back unused timeout fee to the `feeInfo.Payer`.
+// DistributeAcknowledgementFee distributes ack fee to the `receiver` or to the payee registered by
+// the `receiver` for the packet's channel and returns back unused timeout fee to the `feeInfo.Payer`.
 // In case feeInfo for this `packetID` is not found, do nothing.
 func (k Keeper) DistributeAcknowledgementFee(ctx context.Context, receiver sdk.AccAddress, packetID types.PacketID) {
 	c := sdk.UnwrapSDKContext(ctx)
@@ -117,6 +121,7 @@ func (k Keeper) DistributeAcknowledgementFee(ctx context.Context, receiver sdk.A
 	}
 
 	// try to distribute ack fee
+	receiver = k.GetFeeReceiver(c, receiver, packetID.ChannelId)
 	if err := k.distributeFee(c, receiver, feeInfo.Fee.AckFee); err != nil {
 		k.Logger(c).Error("error distributing ack fee", "receiver", receiver, "payer", feeInfo.Payer, "packet", packetID)
 		panic(errors.Wrapf(err, "error distributing ack fee: receiver = %s, packetID=%v", receiver, packetID))
@@ -145,8 +150,8 @@ func (k Keeper) DistributeAcknowledgementFee(ctx context.Context, receiver sdk.A
 	k.removeFeeInfo(c, packetID)
 }
 
-// DistributeTimeoutFee distributes timeout fee to the `receiver`
-// and returns back unused ack fee to the `feeInfo.Payer`.
+// DistributeTimeoutFee distributes timeout fee to the `receiver` or to the payee registered by
+// the `receiver` for the packet's channel and returns back unused ack fee to the `feeInfo.Payer`.
 // In case feeInfo for this `packetID` is not found, do nothing.
 func (k Keeper) DistributeTimeoutFee(ctx context.Context, receiver sdk.AccAddress, packetID types.PacketID) {
 	c := sdk.UnwrapSDKContext(ctx)
@@ -159,6 +164,7 @@ func (k Keeper) DistributeTimeoutFee(ctx context.Context, receiver sdk.AccAddres
 	}
 
 	// try to distribute timeout fee
+	receiver = k.GetFeeReceiver(c, receiver, packetID.ChannelId)
 	if err := k.distributeFee(c, receiver, feeInfo.Fee.TimeoutFee); err != nil {
 		k.Logger(c).Error("error distributing timeout fee", "receiver", receiver, "payer", feeInfo.Payer, "packet", packetID)
 		panic(errors.Wrapf(err, "error distributing timeout fee: receiver = %s, packetID=%v", receiver, packetID))
@@ -247,25 +253,54 @@ func (k Keeper) checkFees(ctx sdk.Context, fees types.Fee) error {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, "provided ack fee or timeout fee is zero")
 	}
 
-	if !fees.TimeoutFee.IsAnyGTE(params.MinFee.TimeoutFee) {
-		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided timeout fee is less than min governance set timeout fee: %v < %v", fees.TimeoutFee, params.MinFee.TimeoutFee)
+	minTimeoutFee := k.extendMinFee(ctx, params.MinFee.TimeoutFee, fees.TimeoutFee)
+	minAckFee := k.extendMinFee(ctx, params.MinFee.AckFee, fees.AckFee)
+
+	if !fees.TimeoutFee.IsAnyGTE(minTimeoutFee) {
+		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided timeout fee is less than min governance set timeout fee: %v < %v", fees.TimeoutFee, minTimeoutFee)
 	}
 
-	if !fees.AckFee.IsAnyGTE(params.MinFee.AckFee) {
-		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided ack fee is less than min governance set ack fee: %v < %v", fees.AckFee, params.MinFee.AckFee)
+	if !fees.AckFee.IsAnyGTE(minAckFee) {
+		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "provided ack fee is less than min governance set ack fee: %v < %v", fees.AckFee, minAckFee)
 	}
 
-	if hasNotAllowedCoins(fees.TimeoutFee, params.MinFee.TimeoutFee) {
-		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "timeout fee cannot have coins other than in params")
+	if hasNotAllowedCoins(fees.TimeoutFee, minTimeoutFee) {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "timeout fee cannot have coins other than in params or priced by dynamicfees")
 	}
 
-	if hasNotAllowedCoins(fees.AckFee, params.MinFee.AckFee) {
-		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "ack fee cannot have coins other than in params")
+	if hasNotAllowedCoins(fees.AckFee, minAckFee) {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "ack fee cannot have coins other than in params or priced by dynamicfees")
 	}
 
 	return nil
 }
 
+// extendMinFee adds to the `minFee` the NTRN amount of the `minFee` converted to each of the `fee`
+// denoms which are not in the `minFee` but are priced by x/dynamicfees. The converted amount is
+// rounded up, so the fee paid in such a denom is never worth less than the min NTRN fee.
+func (k Keeper) extendMinFee(ctx sdk.Context, minFee, fee sdk.Coins) sdk.Coins {
+	minNtrnFee := minFee.AmountOf(appparams.DefaultDenom)
+	if k.dynamicfeesKeeper == nil || !minNtrnFee.IsPositive() {
+		return minFee
+	}
+
+	extended := minFee
+	for _, coin := range fee {
+		if !minFee.AmountOf(coin.Denom).IsZero() {
+			continue
+		}
+
+		converted, err := k.dynamicfeesKeeper.ConvertToDenom(ctx, sdk.NewDecCoin(appparams.DefaultDenom, minNtrnFee), coin.Denom)
+		if err != nil {
+			// the denom is not priced by x/dynamicfees, so it's not allowed
+			continue
+		}
+		extended = extended.Add(sdk.NewCoin(coin.Denom, converted.Amount.Ceil().TruncateInt()))
+	}
+
+	return extended
+}
+
 func (k Keeper) distributeFee(ctx sdk.Context, receiver sdk.AccAddress, fee sdk.Coins) error {
 	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, fee)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/feerefunder/types"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -132,6 +133,84 @@ func TestKeeperCheckFees(t *testing.T) {
 	}
 }
 
+func TestKeeperCheckFeesInDynamicFeesDenoms(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	dynamicfeesKeeper := mock_types.NewMockDynamicFeesKeeper(ctrl)
+	k, ctx := testutil_keeper.FeeKeeperWithDynamicFees(t, nil, nil, dynamicfeesKeeper)
+
+	err := k.SetParams(ctx, types.Params{
+		MinFee: types.Fee{
+			RecvFee:    nil,
+			AckFee:     sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000))),
+			TimeoutFee: sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000))),
+		},
+		FeeEnabled: true,
+	})
+	require.NoError(t, err)
+
+	dynamicfeesKeeper.EXPECT().ConvertToDenom(gomock.Any(), gomock.Any(), "uatom").
+		Return(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("333.333333333333333333")), nil).AnyTimes()
+	dynamicfeesKeeper.EXPECT().ConvertToDenom(gomock.Any(), gomock.Any(), "uunknown").
+		Return(sdk.DecCoin{}, fmt.Errorf("unknown denom")).AnyTimes()
+
+	for _, tc := range []struct {
+		desc string
+		fees types.Fee
+		err  error
+	}{
+		{
+			desc: "PricedDenomSufficient",
+			fees: types.Fee{
+				AckFee:     sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(334))),
+				TimeoutFee: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(334))),
+			},
+			err: nil,
+		},
+		{
+			desc: "PricedDenomInsufficient",
+			fees: types.Fee{
+				AckFee:     sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(333))),
+				TimeoutFee: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(334))),
+			},
+			err: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			desc: "PricedDenomPlusNtrn",
+			fees: types.Fee{
+				AckFee:     sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000)), sdk.NewCoin("uatom", math.NewInt(1))),
+				TimeoutFee: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(334))),
+			},
+			err: nil,
+		},
+		{
+			desc: "UnpricedDenom",
+			fees: types.Fee{
+				AckFee:     sdk.NewCoins(sdk.NewCoin("uunknown", math.NewInt(1_000_000))),
+				TimeoutFee: sdk.NewCoins(sdk.NewCoin("uunknown", math.NewInt(1_000_000))),
+			},
+			err: sdkerrors.ErrInsufficientFee,
+		},
+		{
+			desc: "NtrnPlusUnpricedDenom",
+			fees: types.Fee{
+				AckFee:     sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000))),
+				TimeoutFee: sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000)), sdk.NewCoin("uunknown", math.NewInt(1))),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := k.CheckFees(ctx, tc.fees)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+}
+
 func TestKeeperLockFees(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -379,3 +458,50 @@ func TestFeeInfo(t *testing.T) {
 	infos := k.GetAllFeeInfos(ctx)
 	require.Equal(t, 1000, len(infos))
 }
+
+func TestFeeInfosQuery(t *testing.T) {
+	k, ctx := testutil_keeper.FeeKeeper(t, nil, nil)
+
+	fee := types.Fee{
+		AckFee:     sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000))),
+		TimeoutFee: sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1000))),
+	}
+	var payerFeeInfos []types.FeeInfo
+	for i := uint64(0); i < 5; i++ {
+		payer := testutil.TestOwnerAddress
+		if i%2 == 1 {
+			payer = TestAddress
+		}
+		feeInfo := types.FeeInfo{Payer: payer, PacketId: types.NewPacketID("transfer", "channel-0", i), Fee: fee}
+		k.StoreFeeInfo(ctx, feeInfo)
+		if payer == testutil.TestOwnerAddress {
+			payerFeeInfos = append(payerFeeInfos, feeInfo)
+		}
+	}
+
+	resp, err := k.FeeInfos(ctx, &types.QueryFeeInfosRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.FeeInfos, 5)
+
+	resp, err = k.FeeInfos(ctx, &types.QueryFeeInfosRequest{Payer: testutil.TestOwnerAddress})
+	require.NoError(t, err)
+	require.Equal(t, payerFeeInfos, resp.FeeInfos)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+
+	resp, err = k.FeeInfos(ctx, &types.QueryFeeInfosRequest{
+		Payer:      testutil.TestOwnerAddress,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, payerFeeInfos[:2], resp.FeeInfos)
+
+	resp, err = k.FeeInfos(ctx, &types.QueryFeeInfosRequest{
+		Payer:      testutil.TestOwnerAddress,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, payerFeeInfos[2:], resp.FeeInfos)
+
+	_, err = k.FeeInfos(ctx, &types.QueryFeeInfosRequest{Payer: "invalid"})
+	require.ErrorContains(t, err, "invalid payer address")
+}
@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
 )
@@ -40,3 +42,118 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RegisterPayee registers the address the relayer's fees are paid to
+func (k Keeper) RegisterPayee(goCtx context.Context, req *types.MsgRegisterPayee) (*types.MsgRegisterPayeeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRegisterPayee")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if req.Payee == "" {
+		k.RemovePayee(ctx, req.Relayer, req.ChannelId)
+	} else {
+		if k.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(req.Payee)) {
+			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", req.Payee)
+		}
+
+		k.SetPayee(ctx, types.RegisteredPayee{
+			Relayer:   req.Relayer,
+			ChannelId: req.ChannelId,
+			Payee:     req.Payee,
+		})
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterPayee,
+		sdk.NewAttribute(types.AttributeKeyRelayer, req.Relayer),
+		sdk.NewAttribute(types.AttributeKeyChannelID, req.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyPayee, req.Payee),
+	))
+
+	return &types.MsgRegisterPayeeResponse{}, nil
+}
+
+// IncreasePacketFee adds fees to the fees escrowed for an in-flight packet
+func (k Keeper) IncreasePacketFee(goCtx context.Context, req *types.MsgIncreasePacketFee) (*types.MsgIncreasePacketFeeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgIncreasePacketFee")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	feeInfo, found := k.GetFeeInfo(ctx, req.PacketId)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "no fee info found for port_id = %s, channel_id=%s, sequence=%d", req.PacketId.PortId, req.PacketId.ChannelId, req.PacketId.Sequence)
+	}
+
+	if feeInfo.Payer != req.Payer {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the fee payer %s can increase the packet fee", feeInfo.Payer)
+	}
+
+	params := k.GetParams(ctx)
+	if hasNotAllowedCoins(req.Fee.AckFee, k.extendMinFee(ctx, params.MinFee.AckFee, req.Fee.AckFee)) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "ack fee cannot have coins other than in params or priced by dynamicfees")
+	}
+
+	if hasNotAllowedCoins(req.Fee.TimeoutFee, k.extendMinFee(ctx, params.MinFee.TimeoutFee, req.Fee.TimeoutFee)) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidCoins, "timeout fee cannot have coins other than in params or priced by dynamicfees")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.MustAccAddressFromBech32(req.Payer), types.ModuleName, req.Fee.Total()); err != nil {
+		return nil, errors.Wrapf(err, "failed to send coins during packet fee increasing")
+	}
+
+	feeInfo.Fee.AckFee = feeInfo.Fee.AckFee.Add(req.Fee.AckFee...)
+	feeInfo.Fee.TimeoutFee = feeInfo.Fee.TimeoutFee.Add(req.Fee.TimeoutFee...)
+	k.StoreFeeInfo(ctx, *feeInfo)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeIncreasePacketFee,
+		sdk.NewAttribute(types.AttributeKeyPayer, req.Payer),
+		sdk.NewAttribute(types.AttributeKeyPortID, req.PacketId.PortId),
+		sdk.NewAttribute(types.AttributeKeyChannelID, req.PacketId.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(req.PacketId.Sequence, 10)),
+		sdk.NewAttribute(types.AttributeKeyAckFee, feeInfo.Fee.AckFee.String()),
+		sdk.NewAttribute(types.AttributeKeyTimeoutFee, feeInfo.Fee.TimeoutFee.String()),
+	))
+
+	return &types.MsgIncreasePacketFeeResponse{}, nil
+}
+
+// RefundFees returns the fees escrowed for a packet sent over a closed channel to the payer
+func (k Keeper) RefundFees(goCtx context.Context, req *types.MsgRefundFees) (*types.MsgRefundFeesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRefundFees")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	feeInfo, found := k.GetFeeInfo(ctx, req.PacketId)
+	if !found {
+		return nil, errors.Wrapf(sdkerrors.ErrNotFound, "no fee info found for port_id = %s, channel_id=%s, sequence=%d", req.PacketId.PortId, req.PacketId.ChannelId, req.PacketId.Sequence)
+	}
+
+	if req.Sender != feeInfo.Payer && req.Sender != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "only the fee payer %s or the authority %s can refund the packet fees", feeInfo.Payer, k.GetAuthority())
+	}
+
+	// fees of the packets on open channels are distributed once the packets are acknowledged or timed out
+	channel, found := k.channelKeeper.GetChannel(ctx, req.PacketId.PortId, req.PacketId.ChannelId)
+	if found && channel.State != channeltypes.CLOSED {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "channel with id %s and port %s is not closed", req.PacketId.ChannelId, req.PacketId.PortId)
+	}
+
+	if err := k.distributeFee(ctx, sdk.MustAccAddressFromBech32(feeInfo.Payer), feeInfo.Fee.Total()); err != nil {
+		return nil, errors.Wrapf(err, "failed to refund packet fees")
+	}
+	k.removeFeeInfo(ctx, req.PacketId)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRefundFees,
+		sdk.NewAttribute(types.AttributeKeyPayer, feeInfo.Payer),
+		sdk.NewAttribute(types.AttributeKeyPortID, req.PacketId.PortId),
+		sdk.NewAttribute(types.AttributeKeyChannelID, req.PacketId.ChannelId),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(req.PacketId.Sequence, 10)),
+	))
+
+	return &types.MsgRefundFeesResponse{}, nil
+}
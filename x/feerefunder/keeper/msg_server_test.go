@@ -1,16 +1,20 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/neutron-org/neutron/v11/app/params"
 	"github.com/neutron-org/neutron/v11/testutil"
 	"github.com/neutron-org/neutron/v11/testutil/feerefunder/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/feerefunder/types"
 	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
 )
 
@@ -126,3 +130,95 @@ func TestMsgUpdateParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgIncreasePacketFee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	k, ctx := keeper.FeeKeeper(t, nil, bankKeeper)
+
+	payer := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	packet := types.NewPacketID("transfer", "channel-0", 1)
+	lockedFee := types.Fee{
+		AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+		TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+	}
+	addedFee := types.Fee{
+		AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(500))),
+		TimeoutFee: sdk.NewCoins(),
+	}
+
+	// invalid message
+	_, err := k.IncreasePacketFee(ctx, &types.MsgIncreasePacketFee{Payer: payer.String(), PacketId: packet})
+	require.ErrorContains(t, err, "ack fee and timeout fee are zero")
+
+	// no fee info
+	_, err = k.IncreasePacketFee(ctx, &types.MsgIncreasePacketFee{Payer: payer.String(), PacketId: packet, Fee: addedFee})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), PacketId: packet, Fee: lockedFee})
+
+	// not a payer
+	_, err = k.IncreasePacketFee(ctx, &types.MsgIncreasePacketFee{Payer: TestAddress, PacketId: packet, Fee: addedFee})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// not allowed denom
+	_, err = k.IncreasePacketFee(ctx, &types.MsgIncreasePacketFee{Payer: payer.String(), PacketId: packet, Fee: types.Fee{
+		AckFee: sdk.NewCoins(sdk.NewCoin("uunknown", math.NewInt(500))),
+	}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	// bank error
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, addedFee.Total()).Return(fmt.Errorf("bank error"))
+	_, err = k.IncreasePacketFee(ctx, &types.MsgIncreasePacketFee{Payer: payer.String(), PacketId: packet, Fee: addedFee})
+	require.ErrorContains(t, err, "bank error")
+
+	// valid case
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, addedFee.Total()).Return(nil)
+	_, err = k.IncreasePacketFee(ctx, &types.MsgIncreasePacketFee{Payer: payer.String(), PacketId: packet, Fee: addedFee})
+	require.NoError(t, err)
+
+	feeInfo, found := k.GetFeeInfo(ctx, packet)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1500))), feeInfo.Fee.AckFee)
+	require.Equal(t, lockedFee.TimeoutFee, feeInfo.Fee.TimeoutFee)
+}
+
+func TestMsgRefundFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
+	k, ctx := keeper.FeeKeeper(t, channelKeeper, bankKeeper)
+
+	payer := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	packet := types.NewPacketID("transfer", "channel-0", 1)
+	lockedFee := types.Fee{
+		AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+		TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(2000))),
+	}
+
+	// no fee info
+	_, err := k.RefundFees(ctx, &types.MsgRefundFees{Sender: payer.String(), PacketId: packet})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), PacketId: packet, Fee: lockedFee})
+
+	// neither a payer nor the authority
+	_, err = k.RefundFees(ctx, &types.MsgRefundFees{Sender: TestAddress, PacketId: packet})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// channel is open
+	channelKeeper.EXPECT().GetChannel(ctx, packet.PortId, packet.ChannelId).Return(channeltypes.Channel{State: channeltypes.OPEN}, true)
+	_, err = k.RefundFees(ctx, &types.MsgRefundFees{Sender: payer.String(), PacketId: packet})
+	require.ErrorContains(t, err, "is not closed")
+
+	// refund by the authority
+	channelKeeper.EXPECT().GetChannel(ctx, packet.PortId, packet.ChannelId).Return(channeltypes.Channel{State: channeltypes.CLOSED}, true)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, lockedFee.Total()).Return(nil)
+	_, err = k.RefundFees(ctx, &types.MsgRefundFees{Sender: k.GetAuthority(), PacketId: packet})
+	require.NoError(t, err)
+
+	_, found := k.GetFeeInfo(ctx, packet)
+	require.False(t, found)
+}
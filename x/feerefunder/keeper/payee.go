@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
+)
+
+// GetPayee returns the payee registered for the relayer and the channel. An empty channelID stands
+// for the payee used for all the relayer's channels.
+func (k Keeper) GetPayee(ctx sdk.Context, relayer, channelID string) (string, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetPayeeKey(relayer, channelID))
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// SetPayee registers the payee for the relayer and the channel.
+func (k Keeper) SetPayee(ctx sdk.Context, payee types.RegisteredPayee) {
+	ctx.KVStore(k.storeKey).Set(types.GetPayeeKey(payee.Relayer, payee.ChannelId), []byte(payee.Payee))
+}
+
+// RemovePayee removes the payee registered for the relayer and the channel.
+func (k Keeper) RemovePayee(ctx sdk.Context, relayer, channelID string) {
+	ctx.KVStore(k.storeKey).Delete(types.GetPayeeKey(relayer, channelID))
+}
+
+// GetAllRegisteredPayees returns all the registered payees.
+func (k Keeper) GetAllRegisteredPayees(ctx sdk.Context) []types.RegisteredPayee {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PayeeKey)
+
+	payees := make([]types.RegisteredPayee, 0)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		relayer, channelID, _ := bytes.Cut(iterator.Key(), []byte(types.Separator))
+		payees = append(payees, types.RegisteredPayee{
+			Relayer:   string(relayer),
+			ChannelId: string(channelID),
+			Payee:     string(iterator.Value()),
+		})
+	}
+
+	return payees
+}
+
+// GetFeeReceiver returns the address the relayer's fees for the channel are paid to: the payee
+// registered for the channel, the payee registered for all the relayer's channels or the relayer
+// itself if there is no payee registered.
+func (k Keeper) GetFeeReceiver(ctx sdk.Context, relayer sdk.AccAddress, channelID string) sdk.AccAddress {
+	payee, found := k.GetPayee(ctx, relayer.String(), channelID)
+	if !found {
+		payee, found = k.GetPayee(ctx, relayer.String(), "")
+	}
+	if !found {
+		return relayer
+	}
+
+	return sdk.MustAccAddressFromBech32(payee)
+}
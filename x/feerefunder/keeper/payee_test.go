@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/testutil"
+	testutil_keeper "github.com/neutron-org/neutron/v11/testutil/feerefunder/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/feerefunder/types"
+	"github.com/neutron-org/neutron/v11/x/feerefunder/types"
+)
+
+const (
+	TestPayeeAddress        = "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2"
+	TestDefaultPayeeAddress = "neutron1fxudpred77a0grgh69u0j7y84yks5ev4n5050z45kecz792jnd6scqu98z"
+)
+
+func TestRegisterPayee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	k, ctx := testutil_keeper.FeeKeeper(t, nil, bankKeeper)
+
+	relayer := sdk.MustAccAddressFromBech32(TestAddress)
+	payee := sdk.MustAccAddressFromBech32(TestPayeeAddress)
+	defaultPayee := sdk.MustAccAddressFromBech32(TestDefaultPayeeAddress)
+
+	// invalid messages
+	_, err := k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: "invalid", ChannelId: "channel-0", Payee: payee.String()})
+	require.ErrorContains(t, err, "relayer is invalid")
+	_, err = k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: relayer.String(), ChannelId: "invalid channel", Payee: payee.String()})
+	require.ErrorContains(t, err, "channel id invalid channel is invalid")
+	_, err = k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: relayer.String(), ChannelId: "channel-0", Payee: "invalid"})
+	require.ErrorContains(t, err, "payee is invalid")
+
+	// blocked payee
+	bankKeeper.EXPECT().BlockedAddr(payee).Return(true)
+	_, err = k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: relayer.String(), ChannelId: "channel-0", Payee: payee.String()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// no payee registered
+	require.Equal(t, relayer, k.GetFeeReceiver(ctx, relayer, "channel-0"))
+
+	// channel specific payee
+	bankKeeper.EXPECT().BlockedAddr(payee).Return(false)
+	_, err = k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: relayer.String(), ChannelId: "channel-0", Payee: payee.String()})
+	require.NoError(t, err)
+	require.Equal(t, payee, k.GetFeeReceiver(ctx, relayer, "channel-0"))
+	require.Equal(t, relayer, k.GetFeeReceiver(ctx, relayer, "channel-1"))
+
+	// payee for all the channels
+	bankKeeper.EXPECT().BlockedAddr(defaultPayee).Return(false)
+	_, err = k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: relayer.String(), ChannelId: "", Payee: defaultPayee.String()})
+	require.NoError(t, err)
+	require.Equal(t, payee, k.GetFeeReceiver(ctx, relayer, "channel-0"))
+	require.Equal(t, defaultPayee, k.GetFeeReceiver(ctx, relayer, "channel-1"))
+
+	resp, err := k.Payee(ctx, &types.QueryPayeeRequest{Relayer: relayer.String(), ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryPayeeResponse{Payee: payee.String(), ChannelSpecific: true}, resp)
+	resp, err = k.Payee(ctx, &types.QueryPayeeRequest{Relayer: relayer.String(), ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryPayeeResponse{Payee: defaultPayee.String(), ChannelSpecific: false}, resp)
+
+	require.ElementsMatch(t, []types.RegisteredPayee{
+		{Relayer: relayer.String(), ChannelId: "channel-0", Payee: payee.String()},
+		{Relayer: relayer.String(), ChannelId: "", Payee: defaultPayee.String()},
+	}, k.GetAllRegisteredPayees(ctx))
+
+	// remove the channel specific payee
+	_, err = k.RegisterPayee(ctx, &types.MsgRegisterPayee{Relayer: relayer.String(), ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, defaultPayee, k.GetFeeReceiver(ctx, relayer, "channel-0"))
+	require.Equal(t, sdk.NewEvent(
+		types.EventTypeRegisterPayee,
+		sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+		sdk.NewAttribute(types.AttributeKeyChannelID, "channel-0"),
+		sdk.NewAttribute(types.AttributeKeyPayee, ""),
+	), ctx.EventManager().Events()[len(ctx.EventManager().Events())-1])
+}
+
+func TestDistributeFeesToPayee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	k, ctx := testutil_keeper.FeeKeeper(t, nil, bankKeeper)
+
+	validFee := types.Fee{
+		RecvFee:    nil,
+		AckFee:     sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(1001))),
+		TimeoutFee: sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(2001))),
+	}
+	ackPacket := types.NewPacketID("transfer", "channel-0", 1)
+	timeoutPacket := types.NewPacketID("transfer", "channel-0", 2)
+	payer := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	relayer := sdk.MustAccAddressFromBech32(TestAddress)
+	payee := sdk.MustAccAddressFromBech32(TestPayeeAddress)
+
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), Fee: validFee, PacketId: ackPacket})
+	k.StoreFeeInfo(ctx, types.FeeInfo{Payer: payer.String(), Fee: validFee, PacketId: timeoutPacket})
+	k.SetPayee(ctx, types.RegisteredPayee{Relayer: relayer.String(), ChannelId: "channel-0", Payee: payee.String()})
+
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payee, validFee.AckFee).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, validFee.TimeoutFee).Return(nil)
+	k.DistributeAcknowledgementFee(ctx, relayer, ackPacket)
+
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payee, validFee.TimeoutFee).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, validFee.AckFee).Return(nil)
+	k.DistributeTimeoutFee(ctx, relayer, timeoutPacket)
+
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDistributeAcknowledgementFee || event.Type == types.EventTypeDistributeTimeoutFee {
+			receiver, found := event.GetAttribute(types.AttributeKeyReceiver)
+			require.True(t, found)
+			require.Equal(t, payee.String(), receiver.Value)
+		}
+	}
+}
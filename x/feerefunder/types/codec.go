@@ -9,12 +9,18 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron.feerefunder.MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRegisterPayee{}, "neutron.feerefunder.MsgRegisterPayee", nil)
+	cdc.RegisterConcrete(&MsgIncreasePacketFee{}, "neutron.feerefunder.MsgIncreasePacketFee", nil)
+	cdc.RegisterConcrete(&MsgRefundFees{}, "neutron.feerefunder.MsgRefundFees", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRegisterPayee{},
+		&MsgIncreasePacketFee{},
+		&MsgRefundFees{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,10 +5,17 @@ const (
 	EventTypeDistributeAcknowledgementFee = "distribute_ack_fee"
 	EventTypeDistributeTimeoutFee         = "distribute_timeout_fee"
 	EventTypeLockFees                     = "lock_fees"
+	EventTypeRegisterPayee                = "register_payee"
+	EventTypeIncreasePacketFee            = "increase_packet_fee"
+	EventTypeRefundFees                   = "refund_fees"
 
-	AttributeKeyReceiver  = "receiver"
-	AttributeKeyChannelID = "channel_id"
-	AttributeKeyPortID    = "port_id"
-	AttributeKeySequence  = "sequence"
-	AttributeKeyPayer     = "payer"
+	AttributeKeyReceiver   = "receiver"
+	AttributeKeyChannelID  = "channel_id"
+	AttributeKeyPortID     = "port_id"
+	AttributeKeySequence   = "sequence"
+	AttributeKeyPayer      = "payer"
+	AttributeKeyRelayer    = "relayer"
+	AttributeKeyPayee      = "payee"
+	AttributeKeyAckFee     = "ack_fee"
+	AttributeKeyTimeoutFee = "timeout_fee"
 )
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
 func NewPacketID(portID, channelID string, sequence uint64) PacketID {
@@ -17,6 +18,19 @@ func NewPacketID(portID, channelID string, sequence uint64) PacketID {
 	}
 }
 
+// Validate checks the port and channel identifiers of the packet ID.
+func (m PacketID) Validate() error {
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return errors.Wrapf(err, "port id %s is invalid", m.PortId)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errors.Wrapf(err, "channel id %s is invalid", m.ChannelId)
+	}
+
+	return nil
+}
+
 // NewFee creates and returns a new Fee struct encapsulating the receive, acknowledgement and timeout fees as sdk.Coins
 func NewFee(recvFee, ackFee, timeoutFee sdk.Coins) Fee {
 	return Fee{
@@ -44,5 +44,23 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("invalid fees %s: %w", info.Fee, err)
 		}
 	}
+
+	payees := make(map[string]struct{}, len(gs.RegisteredPayees))
+	for _, payee := range gs.RegisteredPayees {
+		msg := MsgRegisterPayee{Relayer: payee.Relayer, ChannelId: payee.ChannelId, Payee: payee.Payee}
+		if err := msg.Validate(); err != nil {
+			return fmt.Errorf("invalid registered payee of relayer %s: %w", payee.Relayer, err)
+		}
+
+		if payee.Payee == "" {
+			return fmt.Errorf("registered payee of relayer %s is empty", payee.Relayer)
+		}
+
+		key := string(GetPayeeKey(payee.Relayer, payee.ChannelId))
+		if _, ok := payees[key]; ok {
+			return fmt.Errorf("duplicated payee for relayer %s and channel %s", payee.Relayer, payee.ChannelId)
+		}
+		payees[key] = struct{}{}
+	}
 	return gs.Params.Validate()
 }
@@ -25,8 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the fee module's genesis state.
 type GenesisState struct {
-	Params   Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	FeeInfos []FeeInfo `protobuf:"bytes,2,rep,name=fee_infos,json=feeInfos,proto3" json:"fee_infos"`
+	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	FeeInfos         []FeeInfo         `protobuf:"bytes,2,rep,name=fee_infos,json=feeInfos,proto3" json:"fee_infos"`
+	RegisteredPayees []RegisteredPayee `protobuf:"bytes,3,rep,name=registered_payees,json=registeredPayees,proto3" json:"registered_payees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +77,13 @@ func (m *GenesisState) GetFeeInfos() []FeeInfo {
 	return nil
 }
 
+func (m *GenesisState) GetRegisteredPayees() []RegisteredPayee {
+	if m != nil {
+		return m.RegisteredPayees
+	}
+	return nil
+}
+
 type FeeInfo struct {
 	Payer    string   `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
 	PacketId PacketID `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
@@ -144,27 +152,29 @@ func init() {
 func init() { proto.RegisterFile("neutron/feerefunder/genesis.proto", fileDescriptor_43aedfe31f06653d) }
 
 var fileDescriptor_43aedfe31f06653d = []byte{
-	// 316 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0x32, 0x41,
-	0x14, 0x85, 0x77, 0xe0, 0xff, 0x51, 0x06, 0xab, 0x91, 0x62, 0x83, 0x32, 0x22, 0x15, 0x8d, 0x3b,
-	0x82, 0x89, 0x89, 0x95, 0x86, 0x18, 0x0d, 0x1d, 0xc1, 0xce, 0x86, 0x2c, 0x70, 0x67, 0xdd, 0x18,
-	0x66, 0x36, 0xb3, 0x83, 0x91, 0x57, 0xb0, 0x32, 0xf1, 0xa5, 0x28, 0x29, 0xad, 0x8c, 0x61, 0x5f,
-	0xc4, 0xec, 0xcc, 0x90, 0x68, 0xb2, 0x74, 0xf7, 0xe6, 0x7c, 0xe7, 0x9e, 0x93, 0x8b, 0x4f, 0x05,
-	0x2c, 0xb4, 0x92, 0x82, 0x71, 0x00, 0x05, 0x7c, 0x21, 0x66, 0xa0, 0x58, 0x04, 0x02, 0xd2, 0x38,
-	0x0d, 0x12, 0x25, 0xb5, 0x24, 0x87, 0x0e, 0x09, 0x7e, 0x21, 0x8d, 0x7a, 0x24, 0x23, 0x69, 0x74,
-	0x96, 0x4f, 0x16, 0x6d, 0x34, 0x8b, 0xae, 0x71, 0x00, 0x27, 0xb7, 0x8a, 0xe4, 0x24, 0x54, 0xe1,
-	0xdc, 0x65, 0xb5, 0xdf, 0x10, 0x3e, 0xb8, 0xb7, 0xe9, 0x0f, 0x3a, 0xd4, 0x40, 0xae, 0x70, 0xc5,
-	0x02, 0x3e, 0x6a, 0xa1, 0x4e, 0xad, 0x77, 0x14, 0x14, 0xb4, 0x09, 0x86, 0x06, 0xe9, 0xff, 0x5b,
-	0x7d, 0x9d, 0x78, 0x23, 0x67, 0x20, 0xd7, 0xb8, 0xca, 0x01, 0xc6, 0xb1, 0xe0, 0x32, 0xf5, 0x4b,
-	0xad, 0x72, 0xa7, 0xd6, 0x3b, 0x2e, 0x74, 0xdf, 0x01, 0x0c, 0x04, 0x97, 0xce, 0xbe, 0xcf, 0xed,
-	0x9a, 0xb6, 0x3f, 0x10, 0xde, 0x73, 0x1a, 0xa9, 0xe3, 0xff, 0x49, 0xb8, 0x04, 0x65, 0x6a, 0x54,
-	0x47, 0x76, 0x21, 0x37, 0xb8, 0x9a, 0x84, 0xd3, 0x67, 0xd0, 0xe3, 0x78, 0xe6, 0x97, 0x4c, 0xc1,
-	0xe6, 0x8e, 0x82, 0x39, 0x35, 0xb8, 0xdd, 0x66, 0x58, 0xd7, 0x60, 0x46, 0xce, 0x71, 0x99, 0x03,
-	0xf8, 0x65, 0xe3, 0xf5, 0x77, 0xd5, 0x73, 0xb6, 0x1c, 0xed, 0x0f, 0x57, 0x1b, 0x8a, 0xd6, 0x1b,
-	0x8a, 0xbe, 0x37, 0x14, 0xbd, 0x67, 0xd4, 0x5b, 0x67, 0xd4, 0xfb, 0xcc, 0xa8, 0xf7, 0x78, 0x19,
-	0xc5, 0xfa, 0x69, 0x31, 0x09, 0xa6, 0x72, 0xce, 0xdc, 0xa1, 0x33, 0xa9, 0xa2, 0xed, 0xcc, 0x5e,
-	0xba, 0x5d, 0xf6, 0xfa, 0xe7, 0xf7, 0x7a, 0x99, 0x40, 0x3a, 0xa9, 0x98, 0xdf, 0x5f, 0xfc, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x3d, 0x02, 0x05, 0xfc, 0x0c, 0x02, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4f, 0xfa, 0x30,
+	0x14, 0xc7, 0x57, 0xf6, 0xfb, 0xa1, 0x14, 0x0f, 0x5a, 0x39, 0x2c, 0x28, 0x03, 0x89, 0x07, 0x2e,
+	0x6e, 0x82, 0x89, 0x89, 0x27, 0x0d, 0x31, 0x1a, 0x6e, 0x04, 0x0f, 0x26, 0x5e, 0xc8, 0x60, 0xaf,
+	0x73, 0x31, 0xac, 0x4b, 0x5b, 0x8c, 0xfc, 0x17, 0x26, 0xfe, 0x53, 0x1c, 0x39, 0x7a, 0x32, 0x86,
+	0xfd, 0x23, 0x66, 0x5d, 0x67, 0x24, 0x19, 0xb7, 0xb6, 0xef, 0xf3, 0xfd, 0xf4, 0xbd, 0x3c, 0x7c,
+	0x12, 0xc1, 0x5c, 0x72, 0x16, 0xb9, 0x14, 0x80, 0x03, 0x9d, 0x47, 0x3e, 0x70, 0x37, 0x80, 0x08,
+	0x44, 0x28, 0x9c, 0x98, 0x33, 0xc9, 0xc8, 0xa1, 0x46, 0x9c, 0x3f, 0x48, 0xbd, 0x16, 0xb0, 0x80,
+	0xa9, 0xba, 0x9b, 0x9e, 0x32, 0xb4, 0xde, 0x28, 0xb2, 0x51, 0x00, 0x5d, 0x6e, 0x15, 0x95, 0x63,
+	0x8f, 0x7b, 0x33, 0xfd, 0x57, 0xbd, 0x59, 0x4c, 0x2c, 0x72, 0x45, 0x3b, 0x41, 0x78, 0xef, 0x3e,
+	0x6b, 0xef, 0x41, 0x7a, 0x12, 0xc8, 0x15, 0x2e, 0x67, 0x06, 0x0b, 0xb5, 0x50, 0xa7, 0xda, 0x3b,
+	0x72, 0x0a, 0xda, 0x75, 0x86, 0x0a, 0xe9, 0xff, 0x5b, 0x7e, 0x35, 0x8d, 0x91, 0x0e, 0x90, 0x6b,
+	0x5c, 0xa1, 0x00, 0xe3, 0x30, 0xa2, 0x4c, 0x58, 0xa5, 0x96, 0xd9, 0xa9, 0xf6, 0x8e, 0x0b, 0xd3,
+	0x77, 0x00, 0x83, 0x88, 0x32, 0x1d, 0xdf, 0xa5, 0xd9, 0x55, 0x90, 0x47, 0x7c, 0xc0, 0x21, 0x08,
+	0x85, 0x04, 0x0e, 0xfe, 0x58, 0xb5, 0x29, 0x2c, 0x53, 0x89, 0x4e, 0x0b, 0x45, 0xa3, 0x5f, 0x7a,
+	0x98, 0xc2, 0x5a, 0xb8, 0xcf, 0x37, 0x9f, 0x45, 0xfb, 0x03, 0xe1, 0x1d, 0xfd, 0x29, 0xa9, 0xe1,
+	0xff, 0xa9, 0x99, 0xab, 0xf9, 0x2a, 0xa3, 0xec, 0x42, 0x6e, 0x70, 0x25, 0xf6, 0xa6, 0x2f, 0x20,
+	0xc7, 0xa1, 0x6f, 0x95, 0xd4, 0xe4, 0x8d, 0x2d, 0x93, 0xa7, 0xd4, 0xe0, 0x36, 0x6f, 0x3e, 0x4b,
+	0x0d, 0x7c, 0x72, 0x8e, 0x4d, 0x0a, 0x60, 0x99, 0x2a, 0x6b, 0x6d, 0x9b, 0x5b, 0xc7, 0x52, 0xb4,
+	0x3f, 0x5c, 0xae, 0x6d, 0xb4, 0x5a, 0xdb, 0xe8, 0x7b, 0x6d, 0xa3, 0xf7, 0xc4, 0x36, 0x56, 0x89,
+	0x6d, 0x7c, 0x26, 0xb6, 0xf1, 0x74, 0x19, 0x84, 0xf2, 0x79, 0x3e, 0x71, 0xa6, 0x6c, 0xe6, 0x6a,
+	0xd1, 0x19, 0xe3, 0x41, 0x7e, 0x76, 0x5f, 0xbb, 0x5d, 0xf7, 0x6d, 0x63, 0xa7, 0x72, 0x11, 0x83,
+	0x98, 0x94, 0xd5, 0x52, 0x2f, 0x7e, 0x06, 0x00, 0x91, 0x9b, 0xed, 0xcd, 0x86, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -187,6 +197,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RegisteredPayees) > 0 {
+		for iNdEx := len(m.RegisteredPayees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredPayees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeeInfos) > 0 {
 		for iNdEx := len(m.FeeInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -289,6 +313,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RegisteredPayees) > 0 {
+		for _, e := range m.RegisteredPayees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -411,6 +441,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPayees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredPayees = append(m.RegisteredPayees, RegisteredPayee{})
+			if err := m.RegisteredPayees[len(m.RegisteredPayees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -180,6 +180,51 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid registered payees",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegisteredPayees: []types.RegisteredPayee{
+					{Relayer: TestAddressNeutron, ChannelId: "channel-1", Payee: TestContractAddressNeutron},
+					{Relayer: TestAddressNeutron, ChannelId: "", Payee: TestContractAddressNeutron},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "empty registered payee",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegisteredPayees: []types.RegisteredPayee{
+					{Relayer: TestAddressNeutron, ChannelId: "channel-1", Payee: ""},
+				},
+			},
+			valid:            false,
+			expectedErrorMsg: "is empty",
+		},
+		{
+			desc: "invalid registered payee",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegisteredPayees: []types.RegisteredPayee{
+					{Relayer: TestAddressNeutron, ChannelId: "channel-1", Payee: TestContractAddressJuno},
+				},
+			},
+			valid:            false,
+			expectedErrorMsg: "payee is invalid",
+		},
+		{
+			desc: "duplicated registered payee",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RegisteredPayees: []types.RegisteredPayee{
+					{Relayer: TestAddressNeutron, ChannelId: "channel-1", Payee: TestContractAddressNeutron},
+					{Relayer: TestAddressNeutron, ChannelId: "channel-1", Payee: TestAddressNeutron},
+				},
+			},
+			valid:            false,
+			expectedErrorMsg: "duplicated payee",
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
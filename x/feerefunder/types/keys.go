@@ -19,6 +19,7 @@ const (
 const (
 	prefixFeeKey = iota + 1
 	prefixParamsKey
+	prefixPayeeKey
 
 	Separator = ";"
 )
@@ -26,8 +27,15 @@ const (
 var (
 	FeeKey    = []byte{prefixFeeKey}
 	ParamsKey = []byte{prefixParamsKey}
+	PayeeKey  = []byte{prefixPayeeKey}
 )
 
 func GetFeePacketKey(packet PacketID) []byte {
 	return append(append(FeeKey, []byte(packet.ChannelId+Separator+packet.PortId+Separator)...), sdk.Uint64ToBigEndian(packet.Sequence)...)
 }
+
+// GetPayeeKey returns the key of the payee registered for the relayer and the channel. An empty
+// channelID stands for the payee used for all the relayer's channels.
+func GetPayeeKey(relayer, channelID string) []byte {
+	return append(append(PayeeKey, []byte(relayer+Separator)...), []byte(channelID)...)
+}
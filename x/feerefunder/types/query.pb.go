@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -217,45 +217,280 @@ func (m *FeeInfoResponse) GetFeeInfo() *FeeInfo {
 	return nil
 }
 
+// QueryFeeInfosRequest is request type for the Query/FeeInfos RPC method.
+type QueryFeeInfosRequest struct {
+	// Address of the payer of the fees. If empty, the fees of all the payers are returned.
+	Payer      string             `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeInfosRequest) Reset()         { *m = QueryFeeInfosRequest{} }
+func (m *QueryFeeInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeInfosRequest) ProtoMessage()    {}
+func (*QueryFeeInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c20b5686ec46d4e6, []int{4}
+}
+func (m *QueryFeeInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeInfosRequest.Merge(m, src)
+}
+func (m *QueryFeeInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeInfosRequest proto.InternalMessageInfo
+
+func (m *QueryFeeInfosRequest) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *QueryFeeInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeInfosResponse is response type for the Query/FeeInfos RPC method.
+type QueryFeeInfosResponse struct {
+	FeeInfos   []FeeInfo           `protobuf:"bytes,1,rep,name=fee_infos,json=feeInfos,proto3" json:"fee_infos"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeInfosResponse) Reset()         { *m = QueryFeeInfosResponse{} }
+func (m *QueryFeeInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeInfosResponse) ProtoMessage()    {}
+func (*QueryFeeInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c20b5686ec46d4e6, []int{5}
+}
+func (m *QueryFeeInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeInfosResponse.Merge(m, src)
+}
+func (m *QueryFeeInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeInfosResponse proto.InternalMessageInfo
+
+func (m *QueryFeeInfosResponse) GetFeeInfos() []FeeInfo {
+	if m != nil {
+		return m.FeeInfos
+	}
+	return nil
+}
+
+func (m *QueryFeeInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPayeeRequest is request type for the Query/Payee RPC method.
+type QueryPayeeRequest struct {
+	// Address of the relayer.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// ID of the channel the fees are paid for.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *QueryPayeeRequest) Reset()         { *m = QueryPayeeRequest{} }
+func (m *QueryPayeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayeeRequest) ProtoMessage()    {}
+func (*QueryPayeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c20b5686ec46d4e6, []int{6}
+}
+func (m *QueryPayeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayeeRequest.Merge(m, src)
+}
+func (m *QueryPayeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayeeRequest proto.InternalMessageInfo
+
+func (m *QueryPayeeRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *QueryPayeeRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// QueryPayeeResponse is response type for the Query/Payee RPC method.
+type QueryPayeeResponse struct {
+	// Address the relayer's fees for the channel are paid to. Equals to the relayer's address if no
+	// payee is registered.
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// Whether the payee is registered for the relayer and the channel or is used for all the
+	// relayer's channels.
+	ChannelSpecific bool `protobuf:"varint,2,opt,name=channel_specific,json=channelSpecific,proto3" json:"channel_specific,omitempty"`
+}
+
+func (m *QueryPayeeResponse) Reset()         { *m = QueryPayeeResponse{} }
+func (m *QueryPayeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayeeResponse) ProtoMessage()    {}
+func (*QueryPayeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c20b5686ec46d4e6, []int{7}
+}
+func (m *QueryPayeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayeeResponse.Merge(m, src)
+}
+func (m *QueryPayeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayeeResponse proto.InternalMessageInfo
+
+func (m *QueryPayeeResponse) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *QueryPayeeResponse) GetChannelSpecific() bool {
+	if m != nil {
+		return m.ChannelSpecific
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.feerefunder.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.feerefunder.QueryParamsResponse")
 	proto.RegisterType((*FeeInfoRequest)(nil), "neutron.feerefunder.FeeInfoRequest")
 	proto.RegisterType((*FeeInfoResponse)(nil), "neutron.feerefunder.FeeInfoResponse")
+	proto.RegisterType((*QueryFeeInfosRequest)(nil), "neutron.feerefunder.QueryFeeInfosRequest")
+	proto.RegisterType((*QueryFeeInfosResponse)(nil), "neutron.feerefunder.QueryFeeInfosResponse")
+	proto.RegisterType((*QueryPayeeRequest)(nil), "neutron.feerefunder.QueryPayeeRequest")
+	proto.RegisterType((*QueryPayeeResponse)(nil), "neutron.feerefunder.QueryPayeeResponse")
 }
 
 func init() { proto.RegisterFile("neutron/feerefunder/query.proto", fileDescriptor_c20b5686ec46d4e6) }
 
 var fileDescriptor_c20b5686ec46d4e6 = []byte{
-	// 441 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x6f, 0xd3, 0x30,
-	0x14, 0xc6, 0xeb, 0x32, 0xda, 0xcd, 0x48, 0x20, 0x79, 0x93, 0x98, 0xca, 0xc8, 0x4a, 0x00, 0xb5,
-	0x20, 0x2d, 0x56, 0x87, 0x04, 0xe2, 0xba, 0x03, 0x52, 0x39, 0x95, 0x1c, 0xb9, 0x4c, 0x4e, 0xf2,
-	0xe2, 0x45, 0x5a, 0xfd, 0x32, 0xdb, 0x99, 0xd8, 0x15, 0xae, 0x1c, 0x90, 0xb8, 0xf2, 0x07, 0xed,
-	0x38, 0x89, 0x0b, 0x27, 0x84, 0x5a, 0xfe, 0x10, 0x14, 0xc7, 0x9b, 0x98, 0x08, 0xeb, 0xcd, 0x7e,
-	0xef, 0xfb, 0x3e, 0xff, 0xde, 0x4b, 0xe8, 0xae, 0x82, 0xca, 0x6a, 0x54, 0x3c, 0x07, 0xd0, 0x90,
-	0x57, 0x2a, 0x03, 0xcd, 0x4f, 0x2a, 0xd0, 0x67, 0x51, 0xa9, 0xd1, 0x22, 0xdb, 0xf4, 0x82, 0xe8,
-	0x2f, 0xc1, 0xe0, 0x79, 0x8a, 0x66, 0x8e, 0x86, 0x27, 0xc2, 0x40, 0xa3, 0xe6, 0xa7, 0x93, 0x04,
-	0xac, 0x98, 0xf0, 0x52, 0xc8, 0x42, 0x09, 0x5b, 0xa0, 0x6a, 0x02, 0x06, 0x5b, 0x12, 0x25, 0xba,
-	0x23, 0xaf, 0x4f, 0xbe, 0xba, 0x23, 0x11, 0xe5, 0x31, 0x70, 0x51, 0x16, 0x5c, 0x28, 0x85, 0xd6,
-	0x59, 0x8c, 0xef, 0x3e, 0x6a, 0xa3, 0x92, 0xa0, 0xc0, 0x14, 0x97, 0x92, 0x61, 0x9b, 0xa4, 0x14,
-	0x5a, 0xcc, 0xbd, 0x22, 0xdc, 0xa2, 0xec, 0x5d, 0x8d, 0x36, 0x73, 0xc5, 0x18, 0x4e, 0x2a, 0x30,
-	0x36, 0x9c, 0xd1, 0xcd, 0x6b, 0x55, 0x53, 0xa2, 0x32, 0xc0, 0x5e, 0xd3, 0x5e, 0x63, 0xde, 0x26,
-	0x43, 0x32, 0xbe, 0xb3, 0xff, 0x20, 0x6a, 0x99, 0x3b, 0x6a, 0x4c, 0x07, 0x6b, 0xe7, 0x3f, 0x77,
-	0x3b, 0xb1, 0x37, 0x84, 0x19, 0xbd, 0xfb, 0x06, 0x60, 0xaa, 0x72, 0xf4, 0x6f, 0xb0, 0x87, 0x94,
-	0xa6, 0x47, 0x42, 0x29, 0x38, 0x3e, 0x2c, 0x32, 0x17, 0xb8, 0x11, 0x6f, 0xf8, 0xca, 0x34, 0x63,
-	0xf7, 0x69, 0xbf, 0x44, 0x6d, 0xeb, 0x5e, 0xd7, 0xf5, 0x7a, 0xf5, 0x75, 0x9a, 0xb1, 0x01, 0x5d,
-	0x37, 0x75, 0x84, 0x4a, 0x61, 0xfb, 0xd6, 0x90, 0x8c, 0xd7, 0xe2, 0xab, 0x7b, 0xf8, 0x96, 0xde,
-	0xbb, 0x7a, 0xc5, 0x33, 0xbf, 0xa2, 0xeb, 0x39, 0xc0, 0x61, 0xa1, 0x72, 0xf4, 0xd4, 0x3b, 0xad,
-	0xd4, 0x97, 0xbe, 0x7e, 0xde, 0x1c, 0xf6, 0xbf, 0x75, 0xe9, 0x6d, 0xb7, 0x04, 0xf6, 0x99, 0xd0,
-	0x5e, 0x33, 0x14, 0x1b, 0xb5, 0x7a, 0xff, 0xdd, 0xe0, 0x60, 0xbc, 0x5a, 0xd8, 0x00, 0x86, 0xfc,
-	0xe3, 0xf7, 0xdf, 0x5f, 0xbb, 0xcf, 0xd8, 0x88, 0x7b, 0xc7, 0x1e, 0x6a, 0xc9, 0xff, 0xff, 0xe1,
-	0xd8, 0x27, 0x42, 0xfb, 0x9e, 0x96, 0x3d, 0xbe, 0x71, 0x16, 0xcf, 0xf2, 0xe4, 0x66, 0x91, 0xe7,
-	0xd8, 0x73, 0x1c, 0x23, 0xf6, 0x74, 0x25, 0x47, 0xbd, 0xcb, 0x83, 0xd9, 0xf9, 0x22, 0x20, 0x17,
-	0x8b, 0x80, 0xfc, 0x5a, 0x04, 0xe4, 0xcb, 0x32, 0xe8, 0x5c, 0x2c, 0x83, 0xce, 0x8f, 0x65, 0xd0,
-	0x79, 0xff, 0x52, 0x16, 0xf6, 0xa8, 0x4a, 0xa2, 0x14, 0xe7, 0xad, 0x51, 0xa7, 0x93, 0x09, 0xff,
-	0x70, 0x2d, 0xd0, 0x9e, 0x95, 0x60, 0x92, 0x9e, 0xfb, 0x23, 0x5f, 0xfc, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0xb8, 0x6b, 0x4c, 0x6d, 0x6e, 0x03, 0x00, 0x00,
+	// 638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xfe, 0x49, 0xd2, 0xa9, 0xf4, 0xeb, 0x8f, 0x6d, 0x10, 0x95, 0x29, 0x6e, 0x31,
+	0xd0, 0xa4, 0x95, 0xea, 0x55, 0x8a, 0x04, 0xe2, 0x84, 0xd4, 0x43, 0x51, 0x11, 0x87, 0x60, 0xc4,
+	0x85, 0x4b, 0xe5, 0x38, 0x63, 0xd7, 0x52, 0xbb, 0xeb, 0x7a, 0x9d, 0x8a, 0x5c, 0xe1, 0xc2, 0x81,
+	0x03, 0x88, 0x27, 0xe0, 0x39, 0x78, 0x81, 0x1e, 0x2b, 0x71, 0xe1, 0x84, 0x50, 0xcb, 0x83, 0x20,
+	0xaf, 0xc7, 0x6d, 0x1a, 0xdc, 0xa6, 0xdc, 0x76, 0x67, 0x67, 0xbe, 0xfb, 0x99, 0xd9, 0xaf, 0x0d,
+	0x4b, 0x02, 0xfb, 0x69, 0x22, 0x05, 0x0f, 0x10, 0x13, 0x0c, 0xfa, 0xa2, 0x87, 0x09, 0x3f, 0xe8,
+	0x63, 0x32, 0x70, 0xe2, 0x44, 0xa6, 0x92, 0xcd, 0x53, 0x82, 0x33, 0x94, 0x60, 0xae, 0xf9, 0x52,
+	0xed, 0x4b, 0xc5, 0xbb, 0x9e, 0xc2, 0x3c, 0x9b, 0x1f, 0xb6, 0xbb, 0x98, 0x7a, 0x6d, 0x1e, 0x7b,
+	0x61, 0x24, 0xbc, 0x34, 0x92, 0x22, 0x17, 0x30, 0x1b, 0xa1, 0x0c, 0xa5, 0x5e, 0xf2, 0x6c, 0x45,
+	0xd1, 0xc5, 0x50, 0xca, 0x70, 0x0f, 0xb9, 0x17, 0x47, 0xdc, 0x13, 0x42, 0xa6, 0xba, 0x44, 0xd1,
+	0xe9, 0xdd, 0x32, 0xaa, 0x10, 0x05, 0xaa, 0xa8, 0x48, 0x59, 0x2e, 0x4b, 0x89, 0xbd, 0xc4, 0xdb,
+	0xa7, 0x0c, 0xbb, 0x01, 0xec, 0x65, 0x86, 0xd6, 0xd1, 0x41, 0x17, 0x0f, 0xfa, 0xa8, 0x52, 0xbb,
+	0x03, 0xf3, 0x17, 0xa2, 0x2a, 0x96, 0x42, 0x21, 0x7b, 0x02, 0xd5, 0xbc, 0x78, 0xc1, 0x58, 0x36,
+	0x5a, 0xb3, 0x1b, 0xb7, 0x9d, 0x92, 0xbe, 0x9d, 0xbc, 0x68, 0x73, 0xea, 0xe8, 0xe7, 0x52, 0xc5,
+	0xa5, 0x02, 0xbb, 0x07, 0xff, 0x6d, 0x21, 0x6e, 0x8b, 0x40, 0xd2, 0x1d, 0xec, 0x0e, 0x80, 0xbf,
+	0xeb, 0x09, 0x81, 0x7b, 0x3b, 0x51, 0x4f, 0x0b, 0xce, 0xb8, 0x33, 0x14, 0xd9, 0xee, 0xb1, 0x5b,
+	0x50, 0x8b, 0x65, 0x92, 0x66, 0x67, 0x13, 0xfa, 0xac, 0x9a, 0x6d, 0xb7, 0x7b, 0xcc, 0x84, 0xba,
+	0xca, 0x24, 0x84, 0x8f, 0x0b, 0x93, 0xcb, 0x46, 0x6b, 0xca, 0x3d, 0xdb, 0xdb, 0xcf, 0x61, 0xee,
+	0xec, 0x16, 0x62, 0x7e, 0x0c, 0xf5, 0x00, 0x71, 0x27, 0x12, 0x81, 0x24, 0xea, 0xc5, 0x52, 0xea,
+	0xa2, 0xae, 0x16, 0xe4, 0x0b, 0x3b, 0x85, 0x86, 0x9e, 0x01, 0x1d, 0x14, 0xb3, 0x61, 0x0d, 0x98,
+	0x8e, 0xbd, 0x01, 0x26, 0x84, 0x9c, 0x6f, 0xd8, 0x16, 0xc0, 0xf9, 0xa3, 0x6a, 0xe2, 0xd9, 0x8d,
+	0x15, 0x27, 0x77, 0x80, 0x93, 0x39, 0xc0, 0xc9, 0xfd, 0x42, 0x0e, 0x70, 0x3a, 0x5e, 0x88, 0xa4,
+	0xe8, 0x0e, 0x55, 0xda, 0x5f, 0x0d, 0xb8, 0x39, 0x72, 0x2d, 0x35, 0xf2, 0x14, 0x66, 0x8a, 0x46,
+	0xb2, 0xf9, 0x4f, 0x8e, 0xeb, 0x84, 0x1e, 0xa0, 0x4e, 0xfd, 0x28, 0xf6, 0xac, 0x04, 0xb1, 0x39,
+	0x16, 0x31, 0xbf, 0xfd, 0x02, 0xe3, 0x0b, 0xb8, 0x41, 0xee, 0x18, 0x60, 0xd1, 0x04, 0x5b, 0x80,
+	0x5a, 0x82, 0x7b, 0x43, 0x83, 0x29, 0xb6, 0x23, 0x0f, 0x3d, 0x31, 0xf2, 0xd0, 0xf6, 0x6b, 0x60,
+	0xc3, 0x6a, 0xd4, 0x2d, 0x4d, 0x19, 0x87, 0xa7, 0x8c, 0x6c, 0x15, 0xfe, 0x2f, 0xa4, 0x54, 0x8c,
+	0x7e, 0x14, 0x44, 0xbe, 0x16, 0xac, 0xbb, 0x73, 0x14, 0x7f, 0x45, 0xe1, 0x8d, 0x6f, 0x53, 0x30,
+	0xad, 0x75, 0xd9, 0x47, 0x03, 0xaa, 0xb9, 0x27, 0x59, 0xb3, 0x74, 0x60, 0x7f, 0x7f, 0x00, 0x66,
+	0x6b, 0x7c, 0x62, 0x0e, 0x6a, 0xf3, 0x77, 0xdf, 0x7f, 0x7f, 0x99, 0x58, 0x65, 0x4d, 0x4e, 0x15,
+	0xeb, 0x32, 0x09, 0xf9, 0xe5, 0xdf, 0x1d, 0x7b, 0x6f, 0x40, 0x8d, 0x9e, 0x88, 0xdd, 0xbb, 0xd2,
+	0x8a, 0xc4, 0x72, 0xff, 0xea, 0x24, 0xe2, 0x58, 0xd7, 0x1c, 0x4d, 0xf6, 0x60, 0x2c, 0x47, 0xe6,
+	0x20, 0xf6, 0xd9, 0x80, 0x7a, 0x61, 0x31, 0xb6, 0x7a, 0x79, 0xb7, 0x23, 0xee, 0x37, 0xd7, 0xae,
+	0x93, 0x4a, 0x48, 0x8e, 0x46, 0x6a, 0xb1, 0x95, 0x6b, 0x21, 0x29, 0xf6, 0xc1, 0x80, 0x69, 0xed,
+	0x02, 0xb6, 0x72, 0xd5, 0xf8, 0xcf, 0x4d, 0x67, 0x36, 0xc7, 0xe6, 0xfd, 0x33, 0x8a, 0x36, 0xda,
+	0x66, 0xe7, 0xe8, 0xc4, 0x32, 0x8e, 0x4f, 0x2c, 0xe3, 0xd7, 0x89, 0x65, 0x7c, 0x3a, 0xb5, 0x2a,
+	0xc7, 0xa7, 0x56, 0xe5, 0xc7, 0xa9, 0x55, 0x79, 0xf3, 0x28, 0x8c, 0xd2, 0xdd, 0x7e, 0xd7, 0xf1,
+	0xe5, 0x7e, 0xa9, 0xd6, 0x61, 0xbb, 0xcd, 0xdf, 0x5e, 0x50, 0x4c, 0x07, 0x31, 0xaa, 0x6e, 0x55,
+	0xff, 0x6f, 0x1f, 0xfe, 0x19, 0x00, 0xde, 0x58, 0x2f, 0x77, 0x4c, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -273,6 +508,10 @@ type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	FeeInfo(ctx context.Context, in *FeeInfoRequest, opts ...grpc.CallOption) (*FeeInfoResponse, error)
+	// Queries the escrowed packet fees, optionally filtered by the payer.
+	FeeInfos(ctx context.Context, in *QueryFeeInfosRequest, opts ...grpc.CallOption) (*QueryFeeInfosResponse, error)
+	// Queries the address the relayer's fees for the channel are paid to.
+	Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error)
 }
 
 type queryClient struct {
@@ -301,11 +540,33 @@ func (c *queryClient) FeeInfo(ctx context.Context, in *FeeInfoRequest, opts ...g
 	return out, nil
 }
 
+func (c *queryClient) FeeInfos(ctx context.Context, in *QueryFeeInfosRequest, opts ...grpc.CallOption) (*QueryFeeInfosResponse, error) {
+	out := new(QueryFeeInfosResponse)
+	err := c.cc.Invoke(ctx, "/neutron.feerefunder.Query/FeeInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Payee(ctx context.Context, in *QueryPayeeRequest, opts ...grpc.CallOption) (*QueryPayeeResponse, error) {
+	out := new(QueryPayeeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.feerefunder.Query/Payee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	FeeInfo(context.Context, *FeeInfoRequest) (*FeeInfoResponse, error)
+	// Queries the escrowed packet fees, optionally filtered by the payer.
+	FeeInfos(context.Context, *QueryFeeInfosRequest) (*QueryFeeInfosResponse, error)
+	// Queries the address the relayer's fees for the channel are paid to.
+	Payee(context.Context, *QueryPayeeRequest) (*QueryPayeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -318,6 +579,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) FeeInfo(ctx context.Context, req *FeeInfoRequest) (*FeeInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FeeInfo not implemented")
 }
+func (*UnimplementedQueryServer) FeeInfos(ctx context.Context, req *QueryFeeInfosRequest) (*QueryFeeInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeInfos not implemented")
+}
+func (*UnimplementedQueryServer) Payee(ctx context.Context, req *QueryPayeeRequest) (*QueryPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Payee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -359,6 +626,43 @@ func _Query_FeeInfo_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeeInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.feerefunder.Query/FeeInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeInfos(ctx, req.(*QueryFeeInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Payee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Payee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.feerefunder.Query/Payee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Payee(ctx, req.(*QueryPayeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.feerefunder.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -371,6 +675,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FeeInfo",
 			Handler:    _Query_FeeInfo_Handler,
 		},
+		{
+			MethodName: "FeeInfos",
+			Handler:    _Query_FeeInfos_Handler,
+		},
+		{
+			MethodName: "Payee",
+			Handler:    _Query_Payee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/feerefunder/query.proto",
@@ -509,77 +821,580 @@ func (m *FeeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeeInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFeeInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFeeInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FeeInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FeeInfos) > 0 {
+		for iNdEx := len(m.FeeInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovQuery(uint64(m.Sequence))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChannelSpecific {
+		i--
+		if m.ChannelSpecific {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *FeeInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *FeeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeInfo != nil {
+		l = m.FeeInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeInfos) > 0 {
+		for _, e := range m.FeeInfos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChannelSpecific {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *FeeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeeInfo != nil {
-		l = m.FeeInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -602,12 +1417,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeInfo == nil {
+				m.FeeInfo = &FeeInfo{}
+			}
+			if err := m.FeeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -629,7 +1480,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeInfosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -652,15 +1503,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeInfosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -687,7 +1570,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -712,7 +1598,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeInfosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -735,17 +1621,17 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeInfosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfos", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -755,27 +1641,115 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.FeeInfos = append(m.FeeInfos, FeeInfo{})
+			if err := m.FeeInfos[len(m.FeeInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPayeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPayeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPayeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -803,13 +1777,13 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PortId = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			m.Sequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -819,11 +1793,24 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -845,7 +1832,7 @@ func (m *FeeInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -868,17 +1855,17 @@ func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -888,28 +1875,44 @@ func (m *FeeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeInfo == nil {
-				m.FeeInfo = &FeeInfo{}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelSpecific", wireType)
 			}
-			if err := m.FeeInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.ChannelSpecific = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3,9 +3,16 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgRegisterPayee{}
+	_ sdk.Msg = &MsgIncreasePacketFee{}
+	_ sdk.Msg = &MsgRefundFees{}
+)
 
 func (msg *MsgUpdateParams) Route() string {
 	return RouterKey
@@ -42,3 +49,111 @@ func (msg *MsgUpdateParams) Validate() error {
 
 	return nil
 }
+
+func (msg *MsgRegisterPayee) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterPayee) Type() string {
+	return "register-payee"
+}
+
+func (msg *MsgRegisterPayee) GetSigners() []sdk.AccAddress {
+	relayer, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{relayer}
+}
+
+func (msg *MsgRegisterPayee) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRegisterPayee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Relayer); err != nil {
+		return errorsmod.Wrap(err, "relayer is invalid")
+	}
+
+	if msg.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "channel id %s is invalid", msg.ChannelId)
+		}
+	}
+
+	if msg.Payee != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Payee); err != nil {
+			return errorsmod.Wrap(err, "payee is invalid")
+		}
+	}
+
+	return nil
+}
+
+func (msg *MsgIncreasePacketFee) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgIncreasePacketFee) Type() string {
+	return "increase-packet-fee"
+}
+
+func (msg *MsgIncreasePacketFee) GetSigners() []sdk.AccAddress {
+	payer, err := sdk.AccAddressFromBech32(msg.Payer)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{payer}
+}
+
+func (msg *MsgIncreasePacketFee) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgIncreasePacketFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Payer); err != nil {
+		return errorsmod.Wrap(err, "payer is invalid")
+	}
+
+	if err := msg.PacketId.Validate(); err != nil {
+		return err
+	}
+
+	if err := msg.Fee.Validate(); err != nil {
+		return err
+	}
+
+	if msg.Fee.AckFee.IsZero() && msg.Fee.TimeoutFee.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "ack fee and timeout fee are zero")
+	}
+
+	return nil
+}
+
+func (msg *MsgRefundFees) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRefundFees) Type() string {
+	return "refund-fees"
+}
+
+func (msg *MsgRefundFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgRefundFees) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRefundFees) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+
+	return msg.PacketId.Validate()
+}
@@ -5,10 +5,10 @@ package types
 
 import (
 	context "context"
+	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
-	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -130,37 +130,361 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRegisterPayee registers an address the relayer's acknowledgement and timeout fees are paid
+// to instead of the relayer's signing address.
+type MsgRegisterPayee struct {
+	// Address of the relayer signing the acknowledgement and timeout messages.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// ID of the channel to register the payee for. If empty, the payee is registered for all the
+	// channels the relayer has no channel specific payee registered for.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// Address the relayer's fees are paid to. If empty, the registered payee is removed and the
+	// fees are paid to the relayer's address again.
+	Payee string `protobuf:"bytes,3,opt,name=payee,proto3" json:"payee,omitempty"`
+}
+
+func (m *MsgRegisterPayee) Reset()         { *m = MsgRegisterPayee{} }
+func (m *MsgRegisterPayee) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPayee) ProtoMessage()    {}
+func (*MsgRegisterPayee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e613aff856d34ed, []int{2}
+}
+func (m *MsgRegisterPayee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPayee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPayee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPayee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPayee.Merge(m, src)
+}
+func (m *MsgRegisterPayee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPayee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPayee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPayee proto.InternalMessageInfo
+
+func (m *MsgRegisterPayee) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *MsgRegisterPayee) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgRegisterPayee) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+// MsgRegisterPayeeResponse defines the response structure for executing a
+// MsgRegisterPayee message.
+type MsgRegisterPayeeResponse struct {
+}
+
+func (m *MsgRegisterPayeeResponse) Reset()         { *m = MsgRegisterPayeeResponse{} }
+func (m *MsgRegisterPayeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPayeeResponse) ProtoMessage()    {}
+func (*MsgRegisterPayeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e613aff856d34ed, []int{3}
+}
+func (m *MsgRegisterPayeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPayeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPayeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterPayeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPayeeResponse.Merge(m, src)
+}
+func (m *MsgRegisterPayeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPayeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPayeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPayeeResponse proto.InternalMessageInfo
+
+// MsgIncreasePacketFee adds fees to the fees escrowed for an in-flight packet.
+type MsgIncreasePacketFee struct {
+	// Address of the payer of the packet fees.
+	Payer string `protobuf:"bytes,1,opt,name=payer,proto3" json:"payer,omitempty"`
+	// ID of the packet to increase the fees of.
+	PacketId PacketID `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// Fees added to the escrowed packet fees. The recv fee must be zero.
+	Fee Fee `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgIncreasePacketFee) Reset()         { *m = MsgIncreasePacketFee{} }
+func (m *MsgIncreasePacketFee) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreasePacketFee) ProtoMessage()    {}
+func (*MsgIncreasePacketFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e613aff856d34ed, []int{4}
+}
+func (m *MsgIncreasePacketFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreasePacketFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreasePacketFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreasePacketFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreasePacketFee.Merge(m, src)
+}
+func (m *MsgIncreasePacketFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreasePacketFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreasePacketFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreasePacketFee proto.InternalMessageInfo
+
+func (m *MsgIncreasePacketFee) GetPayer() string {
+	if m != nil {
+		return m.Payer
+	}
+	return ""
+}
+
+func (m *MsgIncreasePacketFee) GetPacketId() PacketID {
+	if m != nil {
+		return m.PacketId
+	}
+	return PacketID{}
+}
+
+func (m *MsgIncreasePacketFee) GetFee() Fee {
+	if m != nil {
+		return m.Fee
+	}
+	return Fee{}
+}
+
+// MsgIncreasePacketFeeResponse defines the response structure for executing a
+// MsgIncreasePacketFee message.
+type MsgIncreasePacketFeeResponse struct {
+}
+
+func (m *MsgIncreasePacketFeeResponse) Reset()         { *m = MsgIncreasePacketFeeResponse{} }
+func (m *MsgIncreasePacketFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreasePacketFeeResponse) ProtoMessage()    {}
+func (*MsgIncreasePacketFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e613aff856d34ed, []int{5}
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreasePacketFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreasePacketFeeResponse.Merge(m, src)
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreasePacketFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreasePacketFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreasePacketFeeResponse proto.InternalMessageInfo
+
+// MsgRefundFees returns the fees escrowed for a packet sent over a closed channel to the payer.
+type MsgRefundFees struct {
+	// Address of the payer of the packet fees or of the governance account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ID of the packet to refund the fees of.
+	PacketId PacketID `protobuf:"bytes,2,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+}
+
+func (m *MsgRefundFees) Reset()         { *m = MsgRefundFees{} }
+func (m *MsgRefundFees) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundFees) ProtoMessage()    {}
+func (*MsgRefundFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e613aff856d34ed, []int{6}
+}
+func (m *MsgRefundFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundFees.Merge(m, src)
+}
+func (m *MsgRefundFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundFees proto.InternalMessageInfo
+
+func (m *MsgRefundFees) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRefundFees) GetPacketId() PacketID {
+	if m != nil {
+		return m.PacketId
+	}
+	return PacketID{}
+}
+
+// MsgRefundFeesResponse defines the response structure for executing a
+// MsgRefundFees message.
+type MsgRefundFeesResponse struct {
+}
+
+func (m *MsgRefundFeesResponse) Reset()         { *m = MsgRefundFeesResponse{} }
+func (m *MsgRefundFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundFeesResponse) ProtoMessage()    {}
+func (*MsgRefundFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2e613aff856d34ed, []int{7}
+}
+func (m *MsgRefundFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundFeesResponse.Merge(m, src)
+}
+func (m *MsgRefundFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundFeesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.feerefunder.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.feerefunder.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterPayee)(nil), "neutron.feerefunder.MsgRegisterPayee")
+	proto.RegisterType((*MsgRegisterPayeeResponse)(nil), "neutron.feerefunder.MsgRegisterPayeeResponse")
+	proto.RegisterType((*MsgIncreasePacketFee)(nil), "neutron.feerefunder.MsgIncreasePacketFee")
+	proto.RegisterType((*MsgIncreasePacketFeeResponse)(nil), "neutron.feerefunder.MsgIncreasePacketFeeResponse")
+	proto.RegisterType((*MsgRefundFees)(nil), "neutron.feerefunder.MsgRefundFees")
+	proto.RegisterType((*MsgRefundFeesResponse)(nil), "neutron.feerefunder.MsgRefundFeesResponse")
 }
 
 func init() { proto.RegisterFile("neutron/feerefunder/tx.proto", fileDescriptor_2e613aff856d34ed) }
 
 var fileDescriptor_2e613aff856d34ed = []byte{
-	// 348 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x4b, 0x4d, 0x2d, 0x4a, 0x4d, 0x2b, 0xcd, 0x4b, 0x49, 0x2d, 0xd2,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xca, 0xea, 0x21, 0xc9, 0x4a,
-	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x3a, 0x29, 0xf1, 0xe4, 0xfc, 0xe2,
-	0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0xfd, 0x32, 0x43, 0x10, 0x05, 0x95, 0x90, 0x84, 0x48,
-	0xc4, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0x22, 0x0e, 0x62,
-	0x41, 0x45, 0x15, 0xb0, 0xb9, 0xa7, 0x20, 0xb1, 0x28, 0x31, 0x17, 0xaa, 0x4f, 0x69, 0x27, 0x23,
-	0x17, 0xbf, 0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x00, 0x58, 0x46, 0xc8, 0x8c,
-	0x8b, 0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x85, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5,
-	0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x08, 0xa5, 0x42, 0x76, 0x5c, 0x6c, 0x10, 0xb3, 0x25,
-	0x98, 0x14, 0x18, 0x35, 0xb8, 0x8d, 0xa4, 0xf5, 0xb0, 0x78, 0x58, 0x0f, 0x62, 0x89, 0x13, 0xe7,
-	0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0xea, 0xb2, 0xd2, 0x6b, 0x7a,
-	0xbe, 0x41, 0x0b, 0x61, 0x5e, 0xd7, 0xf3, 0x0d, 0x5a, 0xd2, 0xc8, 0x0e, 0x47, 0x73, 0xa7, 0x92,
-	0x24, 0x97, 0x38, 0x9a, 0x50, 0x50, 0x6a, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x51, 0x01, 0x17,
-	0xb3, 0x6f, 0x71, 0xba, 0x50, 0x12, 0x17, 0x0f, 0x8a, 0xcf, 0x54, 0xb0, 0xba, 0x08, 0xcd, 0x10,
-	0x29, 0x1d, 0x62, 0x54, 0xc1, 0xac, 0x92, 0x62, 0x6d, 0x00, 0x79, 0xc2, 0x29, 0xe0, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xcc, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4,
-	0x92, 0xf3, 0x73, 0xf5, 0xa1, 0x06, 0xeb, 0xe6, 0x17, 0xa5, 0xc3, 0xd8, 0xfa, 0x65, 0x86, 0x86,
-	0xfa, 0x15, 0xa8, 0x29, 0xa6, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x43, 0xc6, 0x80, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x1a, 0x65, 0x11, 0xee, 0x55, 0x02, 0x00, 0x00,
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x89, 0x5a, 0xc8, 0x2b, 0x15, 0xf4, 0x08, 0x6a, 0xea, 0xb6, 0x26, 0xb2, 0x40, 0x6a,
+	0x23, 0x62, 0x93, 0x20, 0x3a, 0x64, 0x40, 0xa2, 0x82, 0x4a, 0x19, 0x22, 0x45, 0x41, 0x2c, 0x08,
+	0xa9, 0x72, 0xe3, 0x17, 0xd7, 0xa2, 0xf1, 0x99, 0x3b, 0xa7, 0x6a, 0x36, 0xc4, 0xc8, 0xc4, 0xcf,
+	0xa8, 0x98, 0x2a, 0xc1, 0xc2, 0x3f, 0xe8, 0x58, 0x31, 0x31, 0x21, 0xd4, 0x0e, 0xfd, 0x05, 0xec,
+	0xe8, 0xec, 0x73, 0x1a, 0x3b, 0x8e, 0x9a, 0x81, 0x25, 0xf1, 0xbd, 0xef, 0x7b, 0xdf, 0x7b, 0xdf,
+	0x7b, 0xe7, 0x04, 0xd6, 0x3c, 0x1c, 0x04, 0x8c, 0x7a, 0x66, 0x0f, 0x91, 0x61, 0x6f, 0xe0, 0xd9,
+	0xc8, 0xcc, 0xe0, 0xc8, 0xf0, 0x19, 0x0d, 0x28, 0xb9, 0x27, 0x51, 0x63, 0x0c, 0x55, 0x97, 0xac,
+	0xbe, 0xeb, 0x51, 0x33, 0xfc, 0x8c, 0x78, 0xea, 0x72, 0x97, 0xf2, 0x3e, 0xe5, 0x66, 0x9f, 0x3b,
+	0xe6, 0x61, 0x4d, 0x7c, 0x49, 0x60, 0x25, 0x02, 0x76, 0xc3, 0x93, 0x19, 0x1d, 0x24, 0x54, 0x74,
+	0xa8, 0x43, 0xa3, 0xb8, 0x78, 0x92, 0xd1, 0xf5, 0xac, 0x7e, 0x7a, 0x88, 0x12, 0x2e, 0x67, 0xc1,
+	0xbe, 0xc5, 0xac, 0xbe, 0x94, 0xd5, 0x7f, 0x28, 0x70, 0xa7, 0xc5, 0x9d, 0x37, 0xbe, 0x6d, 0x05,
+	0xd8, 0x0e, 0x11, 0xb2, 0x05, 0x05, 0x6b, 0x10, 0xec, 0x53, 0xe6, 0x06, 0xc3, 0x92, 0x52, 0x56,
+	0x36, 0x0a, 0xdb, 0xa5, 0x9f, 0xdf, 0xab, 0x45, 0xd9, 0xcf, 0x0b, 0xdb, 0x66, 0xc8, 0xf9, 0xeb,
+	0x80, 0xb9, 0x9e, 0xd3, 0xb9, 0xa2, 0x92, 0xe7, 0x30, 0x1f, 0x69, 0x97, 0x6e, 0x94, 0x95, 0x8d,
+	0x85, 0xfa, 0xaa, 0x91, 0x31, 0x0f, 0x23, 0x2a, 0xb2, 0x5d, 0x38, 0xfd, 0xfd, 0x20, 0x77, 0x7c,
+	0x79, 0x52, 0x51, 0x3a, 0x32, 0xab, 0x61, 0x7c, 0xba, 0x3c, 0xa9, 0x5c, 0xe9, 0x7d, 0xbe, 0x3c,
+	0xa9, 0xac, 0x8e, 0x37, 0x9e, 0xea, 0x53, 0x5f, 0x81, 0xe5, 0x54, 0xa8, 0x83, 0xdc, 0xa7, 0x1e,
+	0x47, 0xfd, 0xab, 0x02, 0x77, 0x5b, 0xdc, 0xe9, 0xa0, 0xe3, 0xf2, 0x00, 0x59, 0xdb, 0x1a, 0x22,
+	0x92, 0x3a, 0xdc, 0x64, 0x78, 0x60, 0x0d, 0x91, 0x5d, 0xeb, 0x2a, 0x26, 0x92, 0x75, 0x80, 0xee,
+	0xbe, 0xe5, 0x79, 0x78, 0xb0, 0xeb, 0xda, 0xa1, 0xaf, 0x42, 0xa7, 0x20, 0x23, 0x4d, 0x9b, 0x14,
+	0x61, 0xce, 0x17, 0xda, 0xa5, 0x7c, 0x88, 0x44, 0x87, 0x46, 0x55, 0x18, 0x89, 0x25, 0x84, 0x8d,
+	0xb5, 0x94, 0x8d, 0x44, 0x5f, 0xba, 0x0a, 0xa5, 0x74, 0x6c, 0x64, 0xe4, 0xaf, 0x02, 0xc5, 0x16,
+	0x77, 0x9a, 0x5e, 0x97, 0xa1, 0xc5, 0xb1, 0x6d, 0x75, 0xdf, 0x63, 0xb0, 0x83, 0x48, 0x8c, 0xa8,
+	0xf2, 0xf5, 0x56, 0x22, 0x1a, 0x79, 0x05, 0x05, 0x3f, 0x4c, 0x8e, 0x7d, 0x2c, 0xd4, 0xd7, 0xa7,
+	0xec, 0x47, 0xb0, 0x9a, 0x2f, 0xc7, 0x37, 0x74, 0x2b, 0x4a, 0x6d, 0xda, 0xe4, 0x19, 0xe4, 0x7b,
+	0xd2, 0xee, 0x42, 0xbd, 0x94, 0x29, 0xb0, 0x83, 0x38, 0x9e, 0x2b, 0xf8, 0x0d, 0x53, 0x4c, 0x64,
+	0xce, 0x8f, 0xe7, 0x51, 0x4e, 0xcd, 0x63, 0xc2, 0x9e, 0xae, 0xc1, 0x5a, 0x56, 0x7c, 0x34, 0x97,
+	0x6f, 0x0a, 0x2c, 0x86, 0x43, 0x13, 0x22, 0x3b, 0x88, 0x9c, 0x3c, 0x81, 0x79, 0x8e, 0x9e, 0x3d,
+	0xc3, 0x44, 0x24, 0xef, 0x3f, 0x8d, 0xa4, 0xb1, 0x29, 0xbc, 0x49, 0x4d, 0x61, 0x6e, 0x65, 0x62,
+	0xd9, 0x71, 0x8f, 0xfa, 0x32, 0xdc, 0x4f, 0x04, 0x62, 0x3b, 0xf5, 0xe3, 0x3c, 0xe4, 0x5b, 0xdc,
+	0x21, 0x7b, 0x70, 0x3b, 0xf1, 0x2a, 0x3e, 0xcc, 0xec, 0x27, 0x75, 0xeb, 0xd5, 0xc7, 0xb3, 0xb0,
+	0xe2, 0x5a, 0x04, 0x61, 0x31, 0xf9, 0x5e, 0x3c, 0x9a, 0x96, 0x9e, 0xa0, 0xa9, 0xd5, 0x99, 0x68,
+	0xa3, 0x32, 0x1f, 0x60, 0x69, 0xf2, 0xd6, 0x6e, 0x4e, 0xd3, 0x98, 0xa0, 0xaa, 0xb5, 0x99, 0xa9,
+	0xa3, 0x92, 0xef, 0x00, 0xc6, 0x2e, 0x84, 0x3e, 0xbd, 0xdf, 0x98, 0xa3, 0x56, 0xae, 0xe7, 0xc4,
+	0xea, 0xea, 0xdc, 0x47, 0xb1, 0xf8, 0xed, 0xf6, 0xe9, 0xb9, 0xa6, 0x9c, 0x9d, 0x6b, 0xca, 0x9f,
+	0x73, 0x4d, 0xf9, 0x72, 0xa1, 0xe5, 0xce, 0x2e, 0xb4, 0xdc, 0xaf, 0x0b, 0x2d, 0xf7, 0x76, 0xcb,
+	0x71, 0x83, 0xfd, 0xc1, 0x9e, 0xd1, 0xa5, 0x7d, 0x53, 0xca, 0x56, 0x29, 0x73, 0xe2, 0x67, 0xf3,
+	0xb0, 0x56, 0x33, 0x8f, 0x92, 0xff, 0x1c, 0x43, 0x1f, 0xf9, 0xde, 0x7c, 0xf8, 0x53, 0xfc, 0xf4,
+	0xdf, 0x00, 0x1a, 0x5b, 0x8e, 0xda, 0x5d, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +500,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RegisterPayee(ctx context.Context, in *MsgRegisterPayee, opts ...grpc.CallOption) (*MsgRegisterPayeeResponse, error)
+	IncreasePacketFee(ctx context.Context, in *MsgIncreasePacketFee, opts ...grpc.CallOption) (*MsgIncreasePacketFeeResponse, error)
+	RefundFees(ctx context.Context, in *MsgRefundFees, opts ...grpc.CallOption) (*MsgRefundFeesResponse, error)
 }
 
 type msgClient struct {
@@ -195,9 +522,39 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterPayee(ctx context.Context, in *MsgRegisterPayee, opts ...grpc.CallOption) (*MsgRegisterPayeeResponse, error) {
+	out := new(MsgRegisterPayeeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.feerefunder.Msg/RegisterPayee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IncreasePacketFee(ctx context.Context, in *MsgIncreasePacketFee, opts ...grpc.CallOption) (*MsgIncreasePacketFeeResponse, error) {
+	out := new(MsgIncreasePacketFeeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.feerefunder.Msg/IncreasePacketFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RefundFees(ctx context.Context, in *MsgRefundFees, opts ...grpc.CallOption) (*MsgRefundFeesResponse, error) {
+	out := new(MsgRefundFeesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.feerefunder.Msg/RefundFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RegisterPayee(context.Context, *MsgRegisterPayee) (*MsgRegisterPayeeResponse, error)
+	IncreasePacketFee(context.Context, *MsgIncreasePacketFee) (*MsgIncreasePacketFeeResponse, error)
+	RefundFees(context.Context, *MsgRefundFees) (*MsgRefundFeesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -207,6 +564,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterPayee(ctx context.Context, req *MsgRegisterPayee) (*MsgRegisterPayeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPayee not implemented")
+}
+func (*UnimplementedMsgServer) IncreasePacketFee(ctx context.Context, req *MsgIncreasePacketFee) (*MsgIncreasePacketFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreasePacketFee not implemented")
+}
+func (*UnimplementedMsgServer) RefundFees(ctx context.Context, req *MsgRefundFees) (*MsgRefundFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundFees not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -230,6 +596,61 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterPayee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPayee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPayee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.feerefunder.Msg/RegisterPayee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPayee(ctx, req.(*MsgRegisterPayee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IncreasePacketFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreasePacketFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreasePacketFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.feerefunder.Msg/IncreasePacketFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreasePacketFee(ctx, req.(*MsgIncreasePacketFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RefundFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefundFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RefundFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.feerefunder.Msg/RefundFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RefundFees(ctx, req.(*MsgRefundFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.feerefunder.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -238,6 +659,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterPayee",
+			Handler:    _Msg_RegisterPayee_Handler,
+		},
+		{
+			MethodName: "IncreasePacketFee",
+			Handler:    _Msg_IncreasePacketFee_Handler,
+		},
+		{
+			MethodName: "RefundFees",
+			Handler:    _Msg_RefundFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/feerefunder/tx.proto",
@@ -306,24 +739,227 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRegisterPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRegisterPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPayeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPayeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPayeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreasePacketFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreasePacketFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreasePacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Payer) > 0 {
+		i -= len(m.Payer)
+		copy(dAtA[i:], m.Payer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreasePacketFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreasePacketFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreasePacketFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -341,6 +977,86 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRegisterPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterPayeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgIncreasePacketFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgIncreasePacketFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRefundFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PacketId.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRefundFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -512,6 +1228,565 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRegisterPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPayeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPayeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreasePacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreasePacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreasePacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreasePacketFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreasePacketFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreasePacketFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,28 @@
+package gmp_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/app/config"
+	"github.com/neutron-org/neutron/v11/testutil/gmp/keeper"
+	"github.com/neutron-org/neutron/v11/x/gmp"
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
+)
+
+func TestGenesis(t *testing.T) {
+	_ = config.GetDefaultConfig()
+
+	genesisState := types.GenesisState{
+		Params: types.NewParams([]types.TrustedSource{
+			{ChannelId: "channel-2", Sender: "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"},
+		}),
+	}
+
+	k, ctx := keeper.GmpKeeper(t)
+	gmp.InitGenesis(ctx, *k, genesisState)
+	got := gmp.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
+}
@@ -4,22 +4,30 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/neutron-org/neutron/v11/x/gmp/keeper"
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
 )
 
 type IBCMiddleware struct {
-	app porttypes.IBCModule
+	app        porttypes.IBCModule
+	keeper     *keeper.Keeper
+	wasmKeeper types.WasmKeeper
 }
 
 var _ porttypes.IBCModule = &IBCMiddleware{}
 
-func NewIBCMiddleware(app porttypes.IBCModule) IBCMiddleware {
+func NewIBCMiddleware(app porttypes.IBCModule, keeper *keeper.Keeper, wasmKeeper types.WasmKeeper) IBCMiddleware {
 	return IBCMiddleware{
-		app: app,
+		app:        app,
+		keeper:     keeper,
+		wasmKeeper: wasmKeeper,
 	}
 }
 
@@ -95,8 +103,8 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
-	var data types.FungibleTokenPacketData
-	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot unmarshal ICS-20 transfer packet data"))
 	}
 
@@ -114,10 +122,14 @@ func (im IBCMiddleware) OnRecvPacket(
 		// the rest of the data fields should be normal
 		fallthrough
 	case TypeGeneralMessageWithToken:
+		if im.keeper.IsTrustedSource(ctx, packet.DestinationChannel, data.Sender) {
+			return im.handleVerifiedMessage(ctx, channelVersion, packet, relayer, data, msg)
+		}
+
 		// we throw out the rest of the msg.Payload fields here, for better or worse
 		data.Memo = string(msg.Payload)
 		var dataBytes []byte
-		if dataBytes, err = types.ModuleCdc.MarshalJSON(&data); err != nil {
+		if dataBytes, err = transfertypes.ModuleCdc.MarshalJSON(&data); err != nil {
 			return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
 		}
 		packet.Data = dataBytes
@@ -127,6 +139,61 @@ func (im IBCMiddleware) OnRecvPacket(
 	}
 }
 
+// handleVerifiedMessage processes a GMP message from a trusted source: the transfer is passed to the
+// next layer without the memo and the message is passed to the receiving contract via a sudo call
+// along with the source chain and the source address. Since only the chain can call sudo, the
+// contract can rely on the source of the message. The sudo call is expected to be limited by the
+// contractmanager's sudo gas limit. If the contract fails to process the message or runs out of
+// gas, the transfer is reverted and an error acknowledgement is returned.
+func (im IBCMiddleware) handleVerifiedMessage(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+	data transfertypes.FungibleTokenPacketData,
+	msg Message,
+) ibcexported.Acknowledgement {
+	contractAddress, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil || !im.wasmKeeper.HasContractInfo(ctx, contractAddress) {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrapf(types.ErrInvalidReceiver, "%s is not a contract address", data.Receiver))
+	}
+
+	sudoMsg, err := json.Marshal(MessageGMPVerified{GMPMessage: VerifiedMessage{
+		SourceChain:   msg.SourceChain,
+		SourceAddress: msg.SourceAddress,
+		Payload:       msg.Payload,
+		Type:          msg.Type,
+		ChannelID:     packet.DestinationChannel,
+		Denom:         data.Denom,
+		Amount:        data.Amount,
+	}})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal gmp message"))
+	}
+
+	data.Memo = ""
+	dataBytes, err := transfertypes.ModuleCdc.MarshalJSON(&data)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(fmt.Errorf("cannot marshal ICS-20 post-processed transfer packet data"))
+	}
+	packet.Data = dataBytes
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	ack := im.app.OnRecvPacket(cacheCtx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	if _, err := im.wasmKeeper.Sudo(cacheCtx, contractAddress, sudoMsg); err != nil {
+		im.keeper.Logger(ctx).Debug("handleVerifiedMessage: failed to Sudo contract on gmp message", "error", err)
+		return channeltypes.NewErrorAcknowledgement(errors.Wrap(types.ErrSudoFailed, err.Error()))
+	}
+
+	writeFn()
+
+	return ack
+}
+
 // OnAcknowledgementPacket implements the IBCMiddleware interface
 func (im IBCMiddleware) OnAcknowledgementPacket(
 	ctx sdk.Context,
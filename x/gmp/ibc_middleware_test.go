@@ -0,0 +1,132 @@
+package gmp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/testutil"
+	testkeeper "github.com/neutron-org/neutron/v11/testutil/gmp/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/gmp/types"
+	"github.com/neutron-org/neutron/v11/x/gmp"
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
+)
+
+const (
+	TestAxelarGMPAccount = "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"
+	TestEventType        = "transfer_received"
+)
+
+// transferApp records the packets passed to the next layer of the stack and emits an event for
+// the received transfers to check whether the changes made by the next layer are discarded.
+type transferApp struct {
+	porttypes.IBCModule
+	packets []channeltypes.Packet
+	ack     ibcexported.Acknowledgement
+}
+
+func (a *transferApp) OnRecvPacket(ctx sdk.Context, _ string, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	a.packets = append(a.packets, packet)
+	if a.ack.Success() {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(TestEventType))
+	}
+	return a.ack
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wasmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	k, ctx := testkeeper.GmpKeeper(t)
+	require.NoError(t, k.SetParams(ctx, types.NewParams([]types.TrustedSource{
+		{ChannelId: "channel-2", Sender: TestAxelarGMPAccount},
+	})))
+
+	app := &transferApp{ack: channeltypes.NewResultAcknowledgement([]byte{byte(1)})}
+	middleware := gmp.NewIBCMiddleware(app, k, wasmKeeper)
+
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	message := gmp.Message{
+		SourceChain:   "ethereum",
+		SourceAddress: "0x2E2Ac9fE2aF2A1E7C3aA2e6A8E5bB2E1a5C9d4F1",
+		Payload:       []byte("payload"),
+		Type:          gmp.TypeGeneralMessageWithToken,
+	}
+	packetFor := func(channelID, sender string) channeltypes.Packet {
+		memo, err := json.Marshal(message)
+		require.NoError(t, err)
+		data := transfertypes.FungibleTokenPacketData{
+			Denom:    "uusdc",
+			Amount:   "1000",
+			Sender:   sender,
+			Receiver: contractAddress.String(),
+			Memo:     string(memo),
+		}
+		return channeltypes.Packet{
+			Sequence:           1,
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-9",
+			DestinationPort:    "transfer",
+			DestinationChannel: channelID,
+			Data:               transfertypes.ModuleCdc.MustMarshalJSON(&data),
+		}
+	}
+	sudoMsg, err := json.Marshal(gmp.MessageGMPVerified{GMPMessage: gmp.VerifiedMessage{
+		SourceChain:   message.SourceChain,
+		SourceAddress: message.SourceAddress,
+		Payload:       message.Payload,
+		Type:          message.Type,
+		ChannelID:     "channel-2",
+		Denom:         "uusdc",
+		Amount:        "1000",
+	}})
+	require.NoError(t, err)
+
+	// untrusted sender: the memo is replaced with the payload as before
+	ack := middleware.OnRecvPacket(ctx, transfertypes.V1, packetFor("channel-2", "axelar1untrusted"), nil)
+	require.True(t, ack.Success())
+	var data transfertypes.FungibleTokenPacketData
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(app.packets[0].Data, &data))
+	require.Equal(t, "payload", data.Memo)
+
+	// trusted sender on another channel is not trusted
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packetFor("channel-3", TestAxelarGMPAccount), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.packets, 2)
+
+	// the untrusted transfers are processed by the next layer directly
+	require.Len(t, ctx.EventManager().Events(), 2)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	// the receiver is not a contract
+	wasmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(false)
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packetFor("channel-2", TestAxelarGMPAccount), nil)
+	require.False(t, ack.Success())
+	require.Len(t, app.packets, 2)
+
+	// the contract fails to process the message, the transfer is reverted
+	wasmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), contractAddress, sudoMsg).Return(nil, fmt.Errorf("contract error"))
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packetFor("channel-2", TestAxelarGMPAccount), nil)
+	require.False(t, ack.Success())
+	require.Len(t, app.packets, 3)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the verified message is passed to the contract
+	wasmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), contractAddress, sudoMsg).Return(nil, nil)
+	ack = middleware.OnRecvPacket(ctx, transfertypes.V1, packetFor("channel-2", TestAxelarGMPAccount), nil)
+	require.True(t, ack.Success())
+	require.Len(t, app.packets, 4)
+	require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(app.packets[3].Data, &data))
+	require.Empty(t, data.Memo)
+	require.Equal(t, sdk.Events{sdk.NewEvent(TestEventType)}, ctx.EventManager().Events())
+}
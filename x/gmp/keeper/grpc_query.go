@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
+)
+
+var _ types.QueryServer = Keeper{}
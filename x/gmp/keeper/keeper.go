@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
+)
+
+type (
+	Keeper struct {
+		cdc       codec.BinaryCodec
+		storeKey  storetypes.StoreKey
+		authority string
+	}
+)
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:       cdc,
+		storeKey:  storeKey,
+		authority: authority,
+	}
+}
+
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// IsTrustedSource returns true if the GMP packets of the sender received on the channel are
+// passed to the receiving contracts with the verified source chain and source address.
+func (k Keeper) IsTrustedSource(ctx sdk.Context, channelID, sender string) bool {
+	return k.GetParams(ctx).IsTrustedSource(channelID, sender)
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"testing"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/neutron-org/neutron/v11/testutil/gmp/keeper"
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ctx := testkeeper.GmpKeeper(t)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	newParams := types.NewParams([]types.TrustedSource{
+		{ChannelId: "channel-2", Sender: "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"},
+	})
+
+	// update params from non-authority
+	resp, err := k.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: "neutron1m9l358xunhhwds0568za49mzhvuxx9ux8xafx2",
+		Params:    newParams,
+	})
+	require.Nil(t, resp)
+	require.ErrorContains(t, err, "invalid authority")
+
+	// invalid params
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams([]types.TrustedSource{{ChannelId: "channel-2"}}),
+	})
+	require.ErrorContains(t, err, "empty trusted source sender")
+
+	// everything is ok
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    newParams,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newParams, k.GetParams(ctx))
+	require.True(t, k.IsTrustedSource(ctx, "channel-2", newParams.TrustedSources[0].Sender))
+
+	response, err := k.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryParamsResponse{Params: newParams}, response)
+}
@@ -8,6 +8,24 @@ type Message struct {
 	Type          int64  `json:"type"`
 }
 
+// MessageGMPVerified is passed to a contract's sudo() entrypoint when the contract receives a GMP
+// message from a trusted source.
+type MessageGMPVerified struct {
+	GMPMessage VerifiedMessage `json:"gmp_message"`
+}
+
+type VerifiedMessage struct {
+	SourceChain   string `json:"source_chain"`
+	SourceAddress string `json:"source_address"`
+	Payload       []byte `json:"payload"`
+	Type          int64  `json:"type"`
+	// ChannelID is the channel on the Neutron side the message was received on.
+	ChannelID string `json:"channel_id"`
+	// Denom and Amount are the tokens of the ICS-20 packet as they were sent by the counterparty.
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
 type MessageType int
 
 const (
@@ -0,0 +1,13 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/gmp module sentinel errors
+var (
+	ErrInvalidReceiver = errors.Register(ModuleName, 1100, "invalid gmp message receiver")
+	ErrSudoFailed      = errors.Register(ModuleName, 1101, "failed to pass gmp message to the contract")
+)
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "gmp"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+const (
+	prefixParamsKey = iota + 1
+)
+
+var ParamsKey = []byte{prefixParamsKey}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	"gopkg.in/yaml.v2"
+)
+
+// NewParams creates a new Params instance
+func NewParams(trustedSources []TrustedSource) Params {
+	return Params{
+		TrustedSources: trustedSources,
+	}
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return NewParams([]TrustedSource{})
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	seen := make(map[TrustedSource]struct{}, len(p.TrustedSources))
+	for _, source := range p.TrustedSources {
+		if err := host.ChannelIdentifierValidator(source.ChannelId); err != nil {
+			return fmt.Errorf("invalid trusted source channel id %s: %w", source.ChannelId, err)
+		}
+		if source.Sender == "" {
+			return fmt.Errorf("empty trusted source sender for channel %s", source.ChannelId)
+		}
+		if _, ok := seen[source]; ok {
+			return fmt.Errorf("duplicate trusted source %s on channel %s", source.Sender, source.ChannelId)
+		}
+		seen[source] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsTrustedSource returns true if the GMP packets of the sender received on the channel are trusted.
+func (p Params) IsTrustedSource(channelID, sender string) bool {
+	for _, source := range p.TrustedSources {
+		if source.ChannelId == channelID && source.Sender == sender {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
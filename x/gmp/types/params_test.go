@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v11/x/gmp/types"
+)
+
+const TestAxelarGMPAccount = "axelar1dv4u5k73pzqrxlzujxg3qp8kvc3pje7jtdvu72npnt5zhq05ejcsn5qme5"
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		params types.Params
+		err    string
+	}{
+		{
+			desc:   "default params",
+			params: types.DefaultParams(),
+		},
+		{
+			desc: "valid trusted sources",
+			params: types.NewParams([]types.TrustedSource{
+				{ChannelId: "channel-2", Sender: TestAxelarGMPAccount},
+				{ChannelId: "channel-3", Sender: TestAxelarGMPAccount},
+			}),
+		},
+		{
+			desc:   "invalid channel id",
+			params: types.NewParams([]types.TrustedSource{{ChannelId: "", Sender: TestAxelarGMPAccount}}),
+			err:    "invalid trusted source channel id",
+		},
+		{
+			desc:   "empty sender",
+			params: types.NewParams([]types.TrustedSource{{ChannelId: "channel-2"}}),
+			err:    "empty trusted source sender",
+		},
+		{
+			desc: "duplicate trusted source",
+			params: types.NewParams([]types.TrustedSource{
+				{ChannelId: "channel-2", Sender: TestAxelarGMPAccount},
+				{ChannelId: "channel-2", Sender: TestAxelarGMPAccount},
+			}),
+			err: "duplicate trusted source",
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestParamsIsTrustedSource(t *testing.T) {
+	params := types.NewParams([]types.TrustedSource{{ChannelId: "channel-2", Sender: TestAxelarGMPAccount}})
+
+	require.True(t, params.IsTrustedSource("channel-2", TestAxelarGMPAccount))
+	require.False(t, params.IsTrustedSource("channel-3", TestAxelarGMPAccount))
+	require.False(t, params.IsTrustedSource("channel-2", "axelar1untrusted"))
+}
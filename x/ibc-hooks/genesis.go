@@ -0,0 +1,28 @@
+package ibchooks
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/keeper"
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state, which includes the received
+// packets awaiting the async acknowledgements and the contracts registered for the callbacks of the sent packets.
+func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState) {
+	for _, packet := range genState.AsyncAckPackets {
+		k.SetAsyncAckPacket(ctx, packet)
+	}
+
+	for _, callback := range genState.PacketCallbacks {
+		k.SetPacketCallback(ctx, callback.ChannelId, callback.Sequence, sdk.MustAccAddressFromBech32(callback.Contract))
+	}
+}
+
+// ExportGenesis returns the module's exported genesis
+func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		AsyncAckPackets: k.GetAllAsyncAckPackets(ctx),
+		PacketCallbacks: k.GetAllPacketCallbacks(ctx),
+	}
+}
@@ -0,0 +1,85 @@
+package ibchooks_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" //nolint:staticcheck
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v11/testutil/apptesting"
+	ibchooks "github.com/neutron-org/neutron/v11/x/ibc-hooks"
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+)
+
+type GenesisTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func (suite *GenesisTestSuite) receivedPacket(sequence uint64) channeltypes.Packet {
+	contract := suite.SetupAddr(0).String()
+	memo := `{"wasm":{"contract":"` + contract + `","msg":{"swap":{}}}}`
+	data := transfertypes.NewFungibleTokenPacketData("uatom", "1000", "cosmos1sender", contract, memo)
+	return channeltypes.NewPacket(data.GetBytes(), sequence, "transfer", "channel-1", "transfer", "channel-0", clienttypes.NewHeight(1, 110), 0)
+}
+
+func (suite *GenesisTestSuite) TestInitExportGenesis() {
+	k := suite.App.IBCHooksKeeper
+
+	initialGenesis := types.GenesisState{
+		AsyncAckPackets: []channeltypes.Packet{suite.receivedPacket(1), suite.receivedPacket(2)},
+		PacketCallbacks: []types.PacketCallback{
+			{ChannelId: "channel-0", Sequence: 3, Contract: suite.SetupAddr(1).String()},
+			{ChannelId: "channel-10", Sequence: 1, Contract: suite.SetupAddr(2).String()},
+		},
+	}
+	suite.Require().NoError(initialGenesis.Validate())
+
+	ibchooks.InitGenesis(suite.Ctx, k, initialGenesis)
+
+	packet, found := k.GetAsyncAckPacket(suite.Ctx, "channel-0", 2)
+	suite.Require().True(found)
+	suite.Require().Equal(initialGenesis.AsyncAckPackets[1], packet)
+	contract, found := k.GetPacketCallback(suite.Ctx, "channel-10", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.SetupAddr(2), contract)
+
+	exportedGenesis := ibchooks.ExportGenesis(suite.Ctx, k)
+	suite.Require().Equal(initialGenesis, *exportedGenesis)
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	suite.Require().NoError(types.DefaultGenesis().Validate())
+
+	duplicate := types.GenesisState{
+		AsyncAckPackets: []channeltypes.Packet{suite.receivedPacket(1), suite.receivedPacket(1)},
+	}
+	suite.Require().ErrorContains(duplicate.Validate(), "duplicate async ack packet")
+
+	invalid := types.GenesisState{
+		AsyncAckPackets: []channeltypes.Packet{suite.receivedPacket(0)},
+	}
+	suite.Require().Error(invalid.Validate())
+
+	duplicateCallback := types.GenesisState{
+		PacketCallbacks: []types.PacketCallback{
+			{ChannelId: "channel-0", Sequence: 1, Contract: suite.SetupAddr(1).String()},
+			{ChannelId: "channel-0", Sequence: 1, Contract: suite.SetupAddr(2).String()},
+		},
+	}
+	suite.Require().ErrorContains(duplicateCallback.Validate(), "duplicate packet callback")
+
+	invalidContract := types.GenesisState{
+		PacketCallbacks: []types.PacketCallback{{ChannelId: "channel-0", Sequence: 1, Contract: "contract"}},
+	}
+	suite.Require().Error(invalidContract.Validate())
+}
@@ -111,7 +111,7 @@ type OnTimeoutPacketAfterHooks interface {
 
 // SendPacket Hooks
 type SendPacketOverrideHooks interface {
-	SendPacketOverride(i ICS4Middleware, ctx sdk.Context, packet ibcexported.PacketI) error
+	SendPacketOverride(i ICS4Middleware, ctx sdk.Context, packet ibcexported.PacketI) (uint64, error)
 }
 type SendPacketBeforeHooks interface {
 	SendPacketBeforeHook(ctx sdk.Context, packet ibcexported.PacketI)
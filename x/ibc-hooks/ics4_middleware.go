@@ -41,7 +41,7 @@ func (i ICS4Middleware) SendPacket(ctx sdk.Context, sourcePort, sourceChannel st
 	packet := channeltypes.NewPacket(data, sequence, sourcePort, sourceChannel,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId, timeoutHeight, timeoutTimestamp)
 	if hook, ok := i.Hooks.(SendPacketOverrideHooks); ok {
-		return 0, hook.SendPacketOverride(i, ctx, packet)
+		return hook.SendPacketOverride(i, ctx, packet)
 	}
 
 	if hook, ok := i.Hooks.(SendPacketBeforeHooks); ok {
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/utils"
+)
+
+// SetAsyncAckPacket stores the received packet whose acknowledgement is postponed by the contract.
+func (k Keeper) SetAsyncAckPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAsyncAckPacketKey(packet.DestinationChannel, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetAsyncAckPacket returns the received packet awaiting the acknowledgement.
+func (k Keeper) GetAsyncAckPacket(ctx sdk.Context, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAsyncAckPacketKey(channelID, sequence))
+	if bz == nil {
+		return channeltypes.Packet{}, false
+	}
+
+	var packet channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// RemoveAsyncAckPacket removes the received packet awaiting the acknowledgement.
+func (k Keeper) RemoveAsyncAckPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetAsyncAckPacketKey(channelID, sequence))
+}
+
+// GetAllAsyncAckPackets returns all the received packets awaiting the acknowledgement.
+func (k Keeper) GetAllAsyncAckPackets(ctx sdk.Context) (packets []channeltypes.Packet) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AsyncAckPacketKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packet channeltypes.Packet
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
+
+// WriteAsyncAcknowledgement writes the acknowledgement of the packet postponed by the contract. Only the contract
+// invoked by the wasm hook of the packet can write it. If ackErr is set, an error acknowledgement is written and the
+// received tokens are taken back from the contract, so that they are refunded to the sender on the counterparty chain.
+// The inflow recorded for the packet by the rate limits on receive is reverted as well.
+// Otherwise, the result is passed to the sender in the successful acknowledgement the same way as for the synchronous ones.
+func (k Keeper) WriteAsyncAcknowledgement(ctx sdk.Context, contract sdk.AccAddress, channelID string, sequence uint64, result []byte, ackErr string) error {
+	packet, found := k.GetAsyncAckPacket(ctx, channelID, sequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrAsyncAckNotFound, "channel %s, sequence %d", channelID, sequence)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidPacket, err.Error())
+	}
+	// the wasm hooks require the receiver of the packet to be the invoked contract
+	if data.Receiver != contract.String() {
+		return errorsmod.Wrapf(types.ErrAsyncAckUnauthorized, "the packet was received by %s", data.Receiver)
+	}
+
+	var ack ibcexported.Acknowledgement
+	if ackErr != "" {
+		if err := k.revertReceive(ctx, contract, packet, data); err != nil {
+			return errorsmod.Wrap(err, "failed to take back the received tokens")
+		}
+		if err := k.rateLimiter.RevertReceivedPacket(ctx, packet); err != nil {
+			return errorsmod.Wrap(err, "failed to revert the rate limits inflow")
+		}
+		ack = utils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAck, ackErr)
+	} else {
+		// the tokens have been successfully received by the transfer app before the contract was executed
+		ibcAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		bz, err := json.Marshal(types.ContractAck{ContractResult: result, IbcAck: ibcAck.Acknowledgement()})
+		if err != nil {
+			return errorsmod.Wrap(types.ErrBadResponse, err.Error())
+		}
+		ack = channeltypes.NewResultAcknowledgement(bz)
+	}
+
+	k.RemoveAsyncAckPacket(ctx, channelID, sequence)
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, packet, ack)
+}
+
+// revertReceive takes the received tokens back from the contract the same way the transfer app would refund
+// them: the tokens returning to Neutron are escrowed again, the vouchers are burned.
+func (k Keeper) revertReceive(ctx sdk.Context, contract sdk.AccAddress, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidPacket, "invalid amount %s", data.Amount)
+	}
+	coin := sdk.NewCoin(utils.MustExtractDenomFromPacketOnRecv(packet), amount)
+
+	if transfertypes.ExtractDenomFromPath(data.Denom).HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, contract, escrowAddress, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+		totalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, totalEscrow.Add(coin))
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, contract, transfertypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(coin))
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" //nolint:staticcheck
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v11/app/params"
+	"github.com/neutron-org/neutron/v11/testutil"
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+	ratelimittypes "github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+type AsyncAckTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestAsyncAckTestSuite(t *testing.T) {
+	suite.Run(t, new(AsyncAckTestSuite))
+}
+
+// receivedPacket returns a packet received on chain A with the wasm hook of the contract
+func (suite *AsyncAckTestSuite) receivedPacket(sequence uint64, denom string, contract sdk.AccAddress) channeltypes.Packet {
+	memo := `{"wasm":{"contract":"` + contract.String() + `","msg":{"swap":{}}}}`
+	data := transfertypes.NewFungibleTokenPacketData(denom, "1000", "cosmos1sender", contract.String(), memo)
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		suite.TransferPath.EndpointB.ChannelConfig.PortID,
+		suite.TransferPath.EndpointB.ChannelID,
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		clienttypes.NewHeight(1, 110),
+		0,
+	)
+}
+
+func (suite *AsyncAckTestSuite) TestWriteSuccessAck() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	contract := suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := suite.receivedPacket(1, "uatom", contract)
+	app.IBCHooksKeeper.SetAsyncAckPacket(ctx, packet)
+
+	// only the contract invoked by the hook can write the acknowledgement
+	err := app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, suite.ChainA.SenderAccount.GetAddress(), packet.DestinationChannel, 1, []byte("result"), "")
+	suite.Require().ErrorIs(err, types.ErrAsyncAckUnauthorized)
+
+	err = app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, contract, packet.DestinationChannel, 2, []byte("result"), "")
+	suite.Require().ErrorIs(err, types.ErrAsyncAckNotFound)
+
+	err = app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, contract, packet.DestinationChannel, 1, []byte("result"), "")
+	suite.Require().NoError(err)
+
+	contractAck, err := json.Marshal(types.ContractAck{
+		ContractResult: []byte("result"),
+		IbcAck:         channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement(),
+	})
+	suite.Require().NoError(err)
+	ackCommitment, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewResultAcknowledgement(contractAck).Acknowledgement()), ackCommitment)
+
+	// the acknowledgement can be written only once
+	_, found = app.IBCHooksKeeper.GetAsyncAckPacket(ctx, packet.DestinationChannel, 1)
+	suite.Require().False(found)
+	err = app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, contract, packet.DestinationChannel, 1, []byte("result"), "")
+	suite.Require().ErrorIs(err, types.ErrAsyncAckNotFound)
+}
+
+func (suite *AsyncAckTestSuite) TestWriteErrorAckBurnsVouchers() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	contract := suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := suite.receivedPacket(1, "uatom", contract)
+	voucher := transfertypes.NewDenom("uatom", transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)).IBCDenom()
+	vouchers := sdk.NewCoins(sdk.NewCoin(voucher, math.NewInt(1000)))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, vouchers))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, contract, vouchers))
+	app.IBCHooksKeeper.SetAsyncAckPacket(ctx, packet)
+
+	err := app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, contract, packet.DestinationChannel, 1, nil, "swap failed")
+	suite.Require().NoError(err)
+
+	suite.Require().True(app.BankKeeper.GetBalance(ctx, contract, voucher).IsZero())
+	suite.Require().True(app.BankKeeper.GetSupply(ctx, voucher).IsZero())
+	_, found := app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, 1)
+	suite.Require().True(found)
+}
+
+func (suite *AsyncAckTestSuite) TestWriteErrorAckRevertsRateLimitInflow() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	contract := suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	packet := suite.receivedPacket(1, "uatom", contract)
+	voucher := transfertypes.NewDenom("uatom", transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)).IBCDenom()
+	suite.Require().NoError(app.RateLimitingICS4Wrapper.SetParams(ctx, ratelimittypes.Params{UseNativeRateLimits: true}))
+	app.RateLimitingICS4Wrapper.IbcratelimitKeeper.SetRateLimit(ctx, ratelimittypes.RateLimit{
+		Path: ratelimittypes.RateLimitPath{Denom: voucher, ChannelId: packet.DestinationChannel},
+		Quotas: []ratelimittypes.Quota{
+			{Name: "daily", MaxPercentSend: 100, MaxPercentRecv: 100, DurationSeconds: 86400},
+		},
+	})
+
+	// the inflow is recorded when the packet is received, before the contract postpones the acknowledgement
+	suite.Require().NoError(app.RateLimitingICS4Wrapper.CheckRecvRateLimits(ctx, packet))
+	vouchers := sdk.NewCoins(sdk.NewCoin(voucher, math.NewInt(1000)))
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, vouchers))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, contract, vouchers))
+	app.IBCHooksKeeper.SetAsyncAckPacket(ctx, packet)
+
+	flow, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetFlow(ctx, voucher, packet.DestinationChannel, "daily")
+	suite.Require().True(found)
+	suite.Require().Equal(math.NewInt(1000), flow.Inflow)
+
+	err := app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, contract, packet.DestinationChannel, 1, nil, "swap failed")
+	suite.Require().NoError(err)
+
+	flow, found = app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetFlow(ctx, voucher, packet.DestinationChannel, "daily")
+	suite.Require().True(found)
+	suite.Require().True(flow.Inflow.IsZero())
+}
+
+func (suite *AsyncAckTestSuite) TestWriteErrorAckEscrowsNativeTokens() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	contract := suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// the native tokens are returning to chain A
+	returningDenom := transfertypes.NewDenom(params.DefaultDenom, transfertypes.NewHop(
+		suite.TransferPath.EndpointB.ChannelConfig.PortID,
+		suite.TransferPath.EndpointB.ChannelID,
+	)).Path()
+	packet := suite.receivedPacket(1, returningDenom, contract)
+	suite.FundAcc(contract, sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1000))))
+	app.IBCHooksKeeper.SetAsyncAckPacket(ctx, packet)
+
+	escrowAddress := transfertypes.GetEscrowAddress(packet.DestinationPort, packet.DestinationChannel)
+	escrowBefore := app.BankKeeper.GetBalance(ctx, escrowAddress, params.DefaultDenom)
+	totalEscrowBefore := app.TransferKeeper.GetTotalEscrowForDenom(ctx, params.DefaultDenom)
+	contractBefore := app.BankKeeper.GetBalance(ctx, contract, params.DefaultDenom)
+
+	err := app.IBCHooksKeeper.WriteAsyncAcknowledgement(ctx, contract, packet.DestinationChannel, 1, nil, "swap failed")
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(contractBefore.SubAmount(math.NewInt(1000)), app.BankKeeper.GetBalance(ctx, contract, params.DefaultDenom))
+	suite.Require().Equal(escrowBefore.AddAmount(math.NewInt(1000)), app.BankKeeper.GetBalance(ctx, escrowAddress, params.DefaultDenom))
+	suite.Require().Equal(totalEscrowBefore.AddAmount(math.NewInt(1000)), app.TransferKeeper.GetTotalEscrowForDenom(ctx, params.DefaultDenom))
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+)
+
+// SetPacketCallback registers the contract to be notified about the outcome of the sent packet.
+func (k Keeper) SetPacketCallback(ctx sdk.Context, channelID string, sequence uint64, contract sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPacketCallbackKey(channelID, sequence), contract)
+}
+
+// GetPacketCallback returns the contract registered to be notified about the outcome of the sent packet.
+func (k Keeper) GetPacketCallback(ctx sdk.Context, channelID string, sequence uint64) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPacketCallbackKey(channelID, sequence))
+	if bz == nil {
+		return nil, false
+	}
+	return bz, true
+}
+
+// RemovePacketCallback removes the contract registered for the sent packet.
+func (k Keeper) RemovePacketCallback(ctx sdk.Context, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPacketCallbackKey(channelID, sequence))
+}
+
+// GetAllPacketCallbacks returns all the contracts registered to be notified about the outcome of the sent packets.
+func (k Keeper) GetAllPacketCallbacks(ctx sdk.Context) (callbacks []types.PacketCallback) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PacketCallbackKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix followed by the channel id, a separator and the big endian sequence
+		key := iterator.Key()[len(types.PacketCallbackKey):]
+		callbacks = append(callbacks, types.PacketCallback{
+			ChannelId: string(key[:len(key)-9]),
+			Sequence:  sdk.BigEndianToUint64(key[len(key)-8:]),
+			Contract:  sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+
+	return callbacks
+}
+
+// IsContract checks whether the address belongs to a contract that can be notified with a sudo call.
+func (k Keeper) IsContract(ctx sdk.Context, address sdk.AccAddress) bool {
+	return k.sudoKeeper != nil && k.sudoKeeper.HasContractInfo(ctx, address)
+}
+
+// OnAcknowledgementPacketCallback notifies the contract registered for the sent packet about its acknowledgement.
+func (k Keeper) OnAcknowledgementPacketCallback(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	contract, found := k.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemovePacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+
+	var ack channeltypes.Acknowledgement
+	success := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack) == nil && ack.Success()
+
+	k.notifyContract(ctx, contract, types.IBCLifecycleComplete{IBCLifecycleComplete: types.IBCLifecycleCompleteMsg{
+		IBCAck: &types.IBCAck{
+			Channel:  packet.SourceChannel,
+			Sequence: packet.Sequence,
+			Ack:      string(acknowledgement),
+			Success:  success,
+		},
+	}})
+}
+
+// OnTimeoutPacketCallback notifies the contract registered for the sent packet about its timeout.
+func (k Keeper) OnTimeoutPacketCallback(ctx sdk.Context, packet channeltypes.Packet) {
+	contract, found := k.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemovePacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+
+	k.notifyContract(ctx, contract, types.IBCLifecycleComplete{IBCLifecycleComplete: types.IBCLifecycleCompleteMsg{
+		IBCTimeout: &types.IBCTimeout{
+			Channel:  packet.SourceChannel,
+			Sequence: packet.Sequence,
+		},
+	}})
+}
+
+// notifyContract passes the message to the contract with a sudo call. The outcome of the packet is already
+// final, so the errors are only logged. The sudo keeper records the failed calls to be resubmitted later.
+func (k Keeper) notifyContract(ctx sdk.Context, contract sdk.AccAddress, msg types.IBCLifecycleComplete) {
+	if !k.IsContract(ctx, contract) {
+		return
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		ctx.Logger().Error("failed to marshal ibc lifecycle message", "contract", contract.String(), "error", err)
+		return
+	}
+
+	if _, err := k.sudoKeeper.Sudo(ctx, contract, bz); err != nil {
+		ctx.Logger().Debug("failed to notify the contract about the outcome of the packet", "contract", contract.String(), "error", err)
+	}
+}
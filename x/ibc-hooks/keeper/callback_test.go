@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" //nolint:staticcheck
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v11/testutil"
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+)
+
+type CallbackTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestCallbackTestSuite(t *testing.T) {
+	suite.Run(t, new(CallbackTestSuite))
+}
+
+// sendPacket sends an ICS-20 packet from chain A through the ibc-hooks ICS4 middleware
+func (suite *CallbackTestSuite) sendPacket(sender sdk.AccAddress, memo string) (uint64, uint64, error) {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	data := transfertypes.NewFungibleTokenPacketData("untrn", "1000", sender.String(), "cosmos1receiver", memo)
+	timeout := uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+	sequence, err := app.HooksICS4Wrapper.SendPacket(
+		ctx,
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		clienttypes.ZeroHeight(),
+		timeout,
+		data.GetBytes(),
+	)
+	return sequence, timeout, err
+}
+
+// sentPacket returns the packet sent from chain A with the given memo
+func (suite *CallbackTestSuite) sentPacket(sequence, timeout uint64, sender sdk.AccAddress, memo string) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("untrn", "1000", sender.String(), "cosmos1receiver", memo)
+	return channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		suite.TransferPath.EndpointA.ChannelID,
+		suite.TransferPath.EndpointB.ChannelConfig.PortID,
+		suite.TransferPath.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		timeout,
+	)
+}
+
+func (suite *CallbackTestSuite) TestSendPacketWithCallback() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	// the callback must be the sender of the packet
+	_, _, err := suite.sendPacket(owner, `{"ibc_callback":"`+contract.String()+`"}`)
+	suite.Require().ErrorIs(err, types.ErrBadCallback)
+
+	// the callback must be a contract
+	_, _, err = suite.sendPacket(owner, `{"ibc_callback":"`+owner.String()+`"}`)
+	suite.Require().ErrorIs(err, types.ErrBadCallback)
+
+	_, _, err = suite.sendPacket(contract, `{"ibc_callback":1}`)
+	suite.Require().ErrorIs(err, types.ErrBadCallback)
+
+	// the callback key is removed from the memo of the sent packet
+	sequence, timeout, err := suite.sendPacket(contract, `{"ibc_callback":"`+contract.String()+`","forward":{"receiver":"cosmos1receiver"}}`)
+	suite.Require().NoError(err)
+	commitment := app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, sequence)
+	packet := suite.sentPacket(sequence, timeout, contract, `{"forward":{"receiver":"cosmos1receiver"}}`)
+	suite.Require().Equal(channeltypes.CommitPacket(packet), commitment)
+
+	callback, found := app.IBCHooksKeeper.GetPacketCallback(ctx, suite.TransferPath.EndpointA.ChannelID, sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(contract, callback)
+
+	// the memo is left empty if the callback is the only key
+	sequence, timeout, err = suite.sendPacket(contract, `{"ibc_callback":"`+contract.String()+`"}`)
+	suite.Require().NoError(err)
+	commitment = app.IBCKeeper.ChannelKeeper.GetPacketCommitment(ctx, suite.TransferPath.EndpointA.ChannelConfig.PortID, suite.TransferPath.EndpointA.ChannelID, sequence)
+	suite.Require().Equal(channeltypes.CommitPacket(suite.sentPacket(sequence, timeout, contract, "")), commitment)
+
+	// the packets without the callback aren't registered
+	sequence, _, err = suite.sendPacket(contract, `{"forward":{"receiver":"cosmos1receiver"}}`)
+	suite.Require().NoError(err)
+	_, found = app.IBCHooksKeeper.GetPacketCallback(ctx, suite.TransferPath.EndpointA.ChannelID, sequence)
+	suite.Require().False(found)
+}
+
+func (suite *CallbackTestSuite) TestAcknowledgementCallback() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	packet := suite.sentPacket(1, 0, contract, "")
+	app.IBCHooksKeeper.SetPacketCallback(ctx, packet.SourceChannel, packet.Sequence, contract)
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	app.Ics20WasmHooks.OnAcknowledgementPacketAfterHook(ctx, packet, ack, owner, nil)
+
+	_, found := app.IBCHooksKeeper.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	// the echo contract has no sudo entry point, so the call is recorded as a failure
+	expectedMsg, err := json.Marshal(types.IBCLifecycleComplete{IBCLifecycleComplete: types.IBCLifecycleCompleteMsg{
+		IBCAck: &types.IBCAck{Channel: packet.SourceChannel, Sequence: packet.Sequence, Ack: string(ack), Success: true},
+	}})
+	suite.Require().NoError(err)
+	failure, err := app.ContractManagerKeeper.GetFailure(ctx, contract, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMsg, failure.SudoPayload)
+}
+
+func (suite *CallbackTestSuite) TestTimeoutCallback() {
+	suite.ConfigureTransferChannel()
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	owner := suite.ChainA.SenderAccount.GetAddress()
+	codeID := suite.StoreTestCode(ctx, owner, "../bytecode/echo.wasm")
+	contract := suite.InstantiateTestContract(ctx, owner, codeID)
+
+	packet := suite.sentPacket(1, 0, contract, "")
+	app.IBCHooksKeeper.SetPacketCallback(ctx, packet.SourceChannel, packet.Sequence, contract)
+
+	// the callback is kept if the timeout isn't processed
+	app.Ics20WasmHooks.OnTimeoutPacketAfterHook(ctx, packet, owner, channeltypes.ErrInvalidPacket)
+	_, found := app.IBCHooksKeeper.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().True(found)
+
+	app.Ics20WasmHooks.OnTimeoutPacketAfterHook(ctx, packet, owner, nil)
+	_, found = app.IBCHooksKeeper.GetPacketCallback(ctx, packet.SourceChannel, packet.Sequence)
+	suite.Require().False(found)
+
+	expectedMsg, err := json.Marshal(types.IBCLifecycleComplete{IBCLifecycleComplete: types.IBCLifecycleCompleteMsg{
+		IBCTimeout: &types.IBCTimeout{Channel: packet.SourceChannel, Sequence: packet.Sequence},
+	}})
+	suite.Require().NoError(err)
+	failure, err := app.ContractManagerKeeper.GetFailure(ctx, contract, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedMsg, failure.SudoPayload)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	storetypes "cosmossdk.io/store/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
+)
+
+// Keeper of the ibc-hooks store. It keeps the received packets whose acknowledgement is postponed by
+// the contracts invoked by the wasm hooks and the contracts registered to be notified about the outcome
+// of the sent packets.
+type Keeper struct {
+	cdc            codec.BinaryCodec
+	storeKey       storetypes.StoreKey
+	ics4Wrapper    porttypes.ICS4Wrapper
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+	rateLimiter    types.RateLimiter
+	sudoKeeper     types.WasmKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	rateLimiter types.RateLimiter,
+	sudoKeeper types.WasmKeeper,
+) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		ics4Wrapper:    ics4Wrapper,
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		rateLimiter:    rateLimiter,
+		sudoKeeper:     sudoKeeper,
+	}
+}
@@ -0,0 +1,13 @@
+package types
+
+// ContractAck is the result of a successful acknowledgement of a wasm-hooked transfer.
+type ContractAck struct {
+	ContractResult []byte `json:"contract_result"`
+	IbcAck         []byte `json:"ibc_ack"`
+}
+
+// OnRecvPacketAsyncAckResponse is returned by the contract in the execution result data to postpone the
+// acknowledgement of the transfer. The contract writes the acknowledgement later with the write_async_ack message.
+type OnRecvPacketAsyncAckResponse struct {
+	IsAsyncAck bool `json:"is_async_ack"`
+}
@@ -0,0 +1,27 @@
+package types
+
+// IBCLifecycleComplete is the sudo message passed to the contract registered with the ibc_callback memo key
+// when the outcome of the sent packet is known.
+type IBCLifecycleComplete struct {
+	IBCLifecycleComplete IBCLifecycleCompleteMsg `json:"ibc_lifecycle_complete"`
+}
+
+// IBCLifecycleCompleteMsg holds either the acknowledgement or the timeout of the sent packet.
+type IBCLifecycleCompleteMsg struct {
+	IBCAck     *IBCAck     `json:"ibc_ack,omitempty"`
+	IBCTimeout *IBCTimeout `json:"ibc_timeout,omitempty"`
+}
+
+// IBCAck is the acknowledgement of the sent packet.
+type IBCAck struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+	Ack      string `json:"ack"`
+	Success  bool   `json:"success"`
+}
+
+// IBCTimeout is the timeout of the sent packet.
+type IBCTimeout struct {
+	Channel  string `json:"channel"`
+	Sequence uint64 `json:"sequence"`
+}
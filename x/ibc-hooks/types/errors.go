@@ -14,4 +14,11 @@ var (
 	ErrBadResponse   = errors.Register("wasm-hooks", 5, "cannot create response")
 	ErrWasmError     = errors.Register("wasm-hooks", 6, "wasm error")
 	ErrBadSender     = errors.Register("wasm-hooks", 7, "bad sender")
+
+	ErrAsyncAck             = errors.Register("wasm-hooks", 8, "async acknowledgement error")
+	ErrAsyncAckNotFound     = errors.Register("wasm-hooks", 9, "no packet awaiting an async acknowledgement")
+	ErrAsyncAckUnauthorized = errors.Register("wasm-hooks", 10, "unauthorized to write the async acknowledgement")
+	ErrAsyncAckNotAllowed   = errors.Register("wasm-hooks", 11, "async acknowledgements are not supported")
+
+	ErrBadCallback = errors.Register("wasm-hooks", 12, "invalid ibc callback")
 )
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 )
 
 type AccountKeeper interface {
@@ -18,3 +19,28 @@ type AccountKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 }
+
+// BankKeeper defines the expected bank keeper needed to revert the receive of the tokens on an error acknowledgement
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+}
+
+// TransferKeeper defines the expected IBC transfer keeper needed to track the escrowed tokens
+type TransferKeeper interface {
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+	SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin)
+}
+
+// RateLimiter defines the expected IBC rate limiter needed to revert the inflow of the received packets
+// refunded with an asynchronous error acknowledgement
+type RateLimiter interface {
+	RevertReceivedPacket(ctx sdk.Context, packet ibcexported.PacketI) error
+}
+
+// WasmKeeper defines the expected interface needed to notify the contracts about the outcome of the sent packets
+type WasmKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
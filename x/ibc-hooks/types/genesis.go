@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.AsyncAckPackets))
+	for _, packet := range gs.AsyncAckPackets {
+		if err := packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid async ack packet %d on channel %s: %w", packet.Sequence, packet.DestinationChannel, err)
+		}
+		var data transfertypes.FungibleTokenPacketData
+		if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+			return fmt.Errorf("invalid data of async ack packet %d on channel %s: %w", packet.Sequence, packet.DestinationChannel, err)
+		}
+
+		key := string(GetAsyncAckPacketKey(packet.DestinationChannel, packet.Sequence))
+		if seen[key] {
+			return fmt.Errorf("duplicate async ack packet %d on channel %s", packet.Sequence, packet.DestinationChannel)
+		}
+		seen[key] = true
+	}
+
+	seenCallbacks := make(map[string]bool, len(gs.PacketCallbacks))
+	for _, callback := range gs.PacketCallbacks {
+		if err := host.ChannelIdentifierValidator(callback.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel of packet callback %d: %w", callback.Sequence, err)
+		}
+		if callback.Sequence == 0 {
+			return fmt.Errorf("packet callback on channel %s must have a positive sequence", callback.ChannelId)
+		}
+		if _, err := sdk.AccAddressFromBech32(callback.Contract); err != nil {
+			return fmt.Errorf("invalid contract of packet callback %d on channel %s: %w", callback.Sequence, callback.ChannelId, err)
+		}
+
+		key := string(GetPacketCallbackKey(callback.ChannelId, callback.Sequence))
+		if seenCallbacks[key] {
+			return fmt.Errorf("duplicate packet callback %d on channel %s", callback.Sequence, callback.ChannelId)
+		}
+		seenCallbacks[key] = true
+	}
+
+	return nil
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName     = "ibchooks"
 	RouteKey       = ModuleName
@@ -7,3 +11,31 @@ const (
 	IBCCallbackKey = "ibc_callback"
 	SenderPrefix   = "ibc-wasm-hook-intermediary"
 )
+
+const (
+	prefixAsyncAckPacketKey = iota + 1
+	prefixPacketCallbackKey
+)
+
+// AsyncAckPacketKey is the prefix of the received packets whose acknowledgement is postponed by the contract
+var AsyncAckPacketKey = []byte{prefixAsyncAckPacketKey}
+
+// PacketCallbackKey is the prefix of the contracts to notify about the outcome of the sent packets
+var PacketCallbackKey = []byte{prefixPacketCallbackKey}
+
+// GetAsyncAckPacketKey returns the store key of the received packet with the given destination channel and sequence
+func GetAsyncAckPacketKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, AsyncAckPacketKey...)
+	key = append(key, []byte(channelID)...)
+	key = append(key, '/')
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// GetPacketCallbackKey returns the store key of the contract to notify about the outcome of the packet with the given
+// source channel and sequence
+func GetPacketCallbackKey(channelID string, sequence uint64) []byte {
+	key := append([]byte{}, PacketCallbackKey...)
+	key = append(key, []byte(channelID)...)
+	key = append(key, '/')
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	"github.com/neutron-org/neutron/v11/x/ibc-hooks/utils"
@@ -13,19 +14,20 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types" //nolint:staticcheck
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
+	"github.com/neutron-org/neutron/v11/x/ibc-hooks/keeper"
 	"github.com/neutron-org/neutron/v11/x/ibc-hooks/types"
 )
 
-type ContractAck struct {
-	ContractResult []byte `json:"contract_result"`
-	IbcAck         []byte `json:"ibc_ack"`
-}
-
 type WasmHooks struct {
-	ContractKeeper      *wasmkeeper.Keeper
+	ContractKeeper *wasmkeeper.Keeper
+	// IbcHooksKeeper keeps the packets whose acknowledgement is postponed by the contracts and the contracts
+	// registered for the callbacks of the sent packets. If it isn't set, the contracts can't postpone the
+	// acknowledgements and the ibc_callback memo key of the sent packets is ignored.
+	IbcHooksKeeper      *keeper.Keeper
 	bech32PrefixAccAddr string
 }
 
@@ -62,6 +64,9 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
 	}
 
+	// Keep the packet as it was sent in case the contract postpones the acknowledgement
+	originalPacket := packet
+
 	// Calculate the receiver / contract caller based on the packet's channel and sender
 	channel := packet.GetDestChannel()
 	sender := data.GetSender()
@@ -112,7 +117,16 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
 	}
 
-	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
+	// The contract may postpone the acknowledgement to write it later with the write_async_ack message
+	if isAsyncAck(response.Data) {
+		if h.IbcHooksKeeper == nil {
+			return utils.NewEmitErrorAcknowledgement(ctx, types.ErrAsyncAckNotAllowed)
+		}
+		h.IbcHooksKeeper.SetAsyncAckPacket(ctx, originalPacket)
+		return nil
+	}
+
+	fullAck := types.ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
 	bz, err = json.Marshal(fullAck)
 	if err != nil {
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
@@ -121,6 +135,89 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, chann
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
+// SendPacketOverride registers the contract set in the ibc_callback memo key of the sent ICS-20 packet to be notified
+// about the acknowledgement or the timeout of the packet. The key is removed from the memo before the packet is sent.
+func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, packet ibcexported.PacketI) (uint64, error) {
+	data := packet.GetData()
+	var contract sdk.AccAddress
+	if h.IbcHooksKeeper != nil {
+		var err error
+		data, contract, err = h.removeCallbackFromMemo(ctx, data)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	timeoutHeight := clienttypes.NewHeight(packet.GetTimeoutHeight().GetRevisionNumber(), packet.GetTimeoutHeight().GetRevisionHeight())
+	sequence, err := i.channel.SendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), timeoutHeight, packet.GetTimeoutTimestamp(), data)
+	if err != nil {
+		return 0, err
+	}
+
+	if contract != nil {
+		h.IbcHooksKeeper.SetPacketCallback(ctx, packet.GetSourceChannel(), sequence, contract)
+	}
+
+	return sequence, nil
+}
+
+// OnAcknowledgementPacketAfterHook notifies the contract registered for the sent packet about its acknowledgement.
+func (h WasmHooks) OnAcknowledgementPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress, err error) {
+	if err != nil || h.IbcHooksKeeper == nil {
+		return
+	}
+	h.IbcHooksKeeper.OnAcknowledgementPacketCallback(ctx, packet, acknowledgement)
+}
+
+// OnTimeoutPacketAfterHook notifies the contract registered for the sent packet about its timeout.
+func (h WasmHooks) OnTimeoutPacketAfterHook(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress, err error) {
+	if err != nil || h.IbcHooksKeeper == nil {
+		return
+	}
+	h.IbcHooksKeeper.OnTimeoutPacketCallback(ctx, packet)
+}
+
+// removeCallbackFromMemo validates the ibc_callback memo key of the ICS-20 packet data and returns the data without
+// the key along with the contract to notify. Only the contract sending the packet can register itself for the callbacks.
+func (h WasmHooks) removeCallbackFromMemo(ctx sdk.Context, data []byte) ([]byte, sdk.AccAddress, error) {
+	var ics20data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(data, &ics20data); err != nil {
+		return data, nil, nil
+	}
+
+	hasCallback, metadata := jsonStringHasKey(ics20data.GetMemo(), types.IBCCallbackKey)
+	if !hasCallback {
+		return data, nil, nil
+	}
+
+	callback, ok := metadata[types.IBCCallbackKey].(string)
+	if !ok {
+		return nil, nil, errorsmod.Wrapf(types.ErrBadCallback, "%s is not a string", types.IBCCallbackKey)
+	}
+	contract, err := sdk.AccAddressFromBech32(callback)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(types.ErrBadCallback, "%s is not a valid bech32 address", types.IBCCallbackKey)
+	}
+	if callback != ics20data.GetSender() {
+		return nil, nil, errorsmod.Wrapf(types.ErrBadCallback, "%s should be the same as the sender of the packet", types.IBCCallbackKey)
+	}
+	if !h.IbcHooksKeeper.IsContract(ctx, contract) {
+		return nil, nil, errorsmod.Wrapf(types.ErrBadCallback, "%s is not a contract", types.IBCCallbackKey)
+	}
+
+	delete(metadata, types.IBCCallbackKey)
+	ics20data.Memo = ""
+	if len(metadata) > 0 {
+		memo, err := json.Marshal(metadata)
+		if err != nil {
+			return nil, nil, errorsmod.Wrap(types.ErrMarshaling, err.Error())
+		}
+		ics20data.Memo = string(memo)
+	}
+
+	return ics20data.GetBytes(), contract, nil
+}
+
 func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteContract) (*wasmtypes.MsgExecuteContractResponse, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
@@ -129,6 +226,15 @@ func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *wasmtypes.MsgExecuteCon
 	return wasmMsgServer.ExecuteContract(ctx, execMsg)
 }
 
+// isAsyncAck checks whether the contract execution result requests to postpone the acknowledgement.
+func isAsyncAck(data []byte) bool {
+	var asyncAckResponse types.OnRecvPacketAsyncAckResponse
+	if err := json.Unmarshal(data, &asyncAckResponse); err != nil {
+		return false
+	}
+	return asyncAckResponse.IsAsyncAck
+}
+
 func isIcs20Packet(packet channeltypes.Packet) (isIcs20 bool, ics20data transfertypes.FungibleTokenPacketData) {
 	var data transfertypes.FungibleTokenPacketData
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -23,9 +24,15 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetParams(),
+		GetRateLimits(),
+		GetRateLimitUsage(),
+		GetUsdRateLimits(),
+		GetUsdRateLimitUsage(),
+		GetQueuedTransfers(),
+		GetQueuedTransfer(),
 	)
 
-	return nil
+	return cmd
 }
 
 // GetParams returns the params for the module
@@ -54,3 +61,191 @@ func GetParams() *cobra.Command {
 
 	return cmd
 }
+
+// GetRateLimits returns the native rate limits
+func GetRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits [flags]",
+		Short: "Get the native rate limits of the x/ibc-rate-limit module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetRateLimitUsage returns the current flows of the quotas of a native rate limit
+func GetRateLimitUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limit-usage [denom] [channel-id]",
+		Short: "Get the current usage of the quotas of a native rate limit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimitUsage(cmd.Context(), &types.QueryRateLimitUsageRequest{
+				Denom:     args[0],
+				ChannelId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUsdRateLimits returns the channel rate limits in USD
+func GetUsdRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usd-rate-limits [flags]",
+		Short: "Get the channel rate limits in USD of the x/ibc-rate-limit module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UsdRateLimits(cmd.Context(), &types.QueryUsdRateLimitsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetUsdRateLimitUsage returns the current flow of a channel rate limit in USD
+func GetUsdRateLimitUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usd-rate-limit-usage [channel-id]",
+		Short: "Get the current usage of a channel rate limit in USD",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UsdRateLimitUsage(cmd.Context(), &types.QueryUsdRateLimitUsageRequest{ChannelId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetQueuedTransfers returns the incoming transfers queued for exceeding the rate limits
+func GetQueuedTransfers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-transfers [flags]",
+		Short: "Get the incoming transfers queued for exceeding the rate limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedTransfers(cmd.Context(), &types.QueryQueuedTransfersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+
+	return cmd
+}
+
+// GetQueuedTransfer returns a queued transfer by its id
+func GetQueuedTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-transfer [id]",
+		Short: "Get a queued transfer by its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedTransfer(cmd.Context(), &types.QueryQueuedTransferRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,17 +7,35 @@ import (
 )
 
 // InitGenesis initializes the x/ibc-rate-limit module's state from a provided genesis
-// state, which includes the parameter for the contract address.
+// state, which includes the parameter for the contract address, the native rate limits,
+// the USD rate limits and the queued transfers.
 func (i *ICS4Wrapper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	err := i.IbcratelimitKeeper.SetParams(ctx, genState.Params)
 	if err != nil {
 		panic(err)
 	}
+
+	for _, rateLimit := range genState.RateLimits {
+		i.IbcratelimitKeeper.SetRateLimit(ctx, rateLimit)
+	}
+
+	for _, rateLimit := range genState.UsdRateLimits {
+		i.IbcratelimitKeeper.SetUsdRateLimit(ctx, rateLimit)
+	}
+
+	for _, queuedTransfer := range genState.QueuedTransfers {
+		i.IbcratelimitKeeper.SetQueuedTransfer(ctx, queuedTransfer)
+	}
+	i.IbcratelimitKeeper.SetLastQueuedTransferID(ctx, genState.LastQueuedTransferId)
 }
 
 // ExportGenesis returns the x/ibc-rate-limit module's exported genesis.
 func (i *ICS4Wrapper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:               i.GetParams(ctx),
+		RateLimits:           i.IbcratelimitKeeper.GetAllRateLimits(ctx),
+		UsdRateLimits:        i.IbcratelimitKeeper.GetAllUsdRateLimits(ctx),
+		QueuedTransfers:      i.IbcratelimitKeeper.GetAllQueuedTransfers(ctx),
+		LastQueuedTransferId: i.IbcratelimitKeeper.GetLastQueuedTransferID(ctx),
 	}
 }
@@ -32,6 +32,14 @@ func (suite *GenesisTestSuite) TestInitExportGenesis() {
 		Params: types.Params{
 			ContractAddress: testAddress,
 		},
+		RateLimits: []types.RateLimit{
+			{
+				Path: types.RateLimitPath{Denom: "untrn", ChannelId: "channel-0"},
+				Quotas: []types.Quota{
+					{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+				},
+			},
+		},
 	}
 
 	k.InitGenesis(suite.Ctx, initialGenesis)
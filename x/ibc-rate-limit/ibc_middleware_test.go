@@ -21,9 +21,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	marketmaptypes "github.com/skip-mev/slinky/x/marketmap/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 	"github.com/stretchr/testify/suite"
 
+	ibcratelimit "github.com/neutron-org/neutron/v11/x/ibc-rate-limit"
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/keeper"
 	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
 )
 
@@ -535,6 +541,186 @@ func (suite *MiddlewareTestSuite) TestRecvTransferWithRateLimitingNonNative() {
 	suite.fullRecvTest(false)
 }
 
+// Test native rate limiting on sends
+func (suite *MiddlewareTestSuite) TestSendTransferWithNativeRateLimits() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	channelID := suite.TransferPath.EndpointA.ChannelID
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	channelValue := CalculateChannelValue(suite.ChainA.GetContext(), denom, app.BankKeeper)
+
+	// The amount to be sent is 2.5% (quota is 5%)
+	quota := channelValue.QuoRaw(20)
+	sendAmount := quota.QuoRaw(2)
+
+	suite.RegisterNativeRateLimit(denom, channelID, 5, 5)
+
+	// send 2.5% (quota is 5%)
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	// send 2.5% (quota is 5%)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Require().NoError(err)
+
+	flow, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetFlow(suite.ChainA.GetContext(), denom, channelID, "weekly")
+	suite.Require().True(found)
+	suite.Require().Equal(sendAmount.MulRaw(2), flow.Outflow)
+
+	// Sending above the quota should fail. We use 2 instead of 1 here to avoid rounding issues
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, sdkmath.NewInt(2)))
+	suite.Require().Error(err)
+}
+
+// Test USD rate limiting on sends valued with the oracle prices of the app
+func (suite *MiddlewareTestSuite) TestSendTransferWithUsdRateLimits() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	channelID := suite.TransferPath.EndpointA.ChannelID
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+
+	// 1 stake = 1 USD
+	cp := slinkytypes.NewCurrencyPair("STAKE", "USD")
+	suite.Require().NoError(app.MarketMapKeeper.CreateMarket(ctx, marketmaptypes.Market{
+		Ticker: marketmaptypes.Ticker{CurrencyPair: cp, Decimals: 0, MinProviderCount: 1, Enabled: true},
+		ProviderConfigs: []marketmaptypes.ProviderConfig{
+			{Name: "test", OffChainTicker: "STAKEUSD"},
+		},
+	}))
+	suite.Require().NoError(app.OracleKeeper.SetPriceForCurrencyPair(ctx, cp, oracletypes.QuotePrice{
+		Price:          sdkmath.NewInt(1),
+		BlockTimestamp: ctx.BlockTime(),
+		BlockHeight:    uint64(ctx.BlockHeight()), //nolint:gosec
+	}))
+
+	suite.Require().NoError(app.RateLimitingICS4Wrapper.SetParams(ctx, types.Params{
+		DenomPricings: []types.DenomPricing{{Denom: denom, CurrencyPair: cp.String(), Decimals: 0}},
+	}))
+	app.RateLimitingICS4Wrapper.IbcratelimitKeeper.SetUsdRateLimit(ctx, types.UsdRateLimit{
+		ChannelId:       channelID,
+		MaxSendUsd:      sdkmath.LegacyNewDec(150),
+		MaxRecvUsd:      sdkmath.LegacyNewDec(150),
+		DurationSeconds: 86400,
+	})
+
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sdkmath.NewInt(100)))
+	suite.Require().NoError(err)
+
+	flow, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetUsdFlow(suite.ChainA.GetContext(), channelID)
+	suite.Require().True(found)
+	suite.Require().True(sdkmath.LegacyNewDec(100).Equal(flow.Outflow))
+
+	// sending above the USD quota should fail
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, sdkmath.NewInt(100)))
+	suite.Require().Error(err)
+}
+
+// Test native rate limiting on receives
+func (suite *MiddlewareTestSuite) TestRecvTransferWithNativeRateLimits() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	// stake of chainB is received as a voucher
+	localDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop("transfer", suite.TransferPath.EndpointA.ChannelID)).IBCDenom()
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	channelValue := CalculateChannelValue(suite.ChainA.GetContext(), localDenom, app.BankKeeper)
+
+	// The amount to be received is 2% (quota is 4%)
+	quota := channelValue.QuoRaw(25)
+	sendAmount := quota.QuoRaw(2)
+
+	suite.RegisterNativeRateLimit(localDenom, types.AnyChannel, 4, 4)
+
+	// receive 2% (quota is 4%)
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount))
+	suite.Require().NoError(err)
+
+	// receiving above the quota should fail
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount.MulRaw(2)))
+	suite.Require().NoError(err)
+}
+
+// Test receives exceeding the native rate limits are queued and released
+func (suite *MiddlewareTestSuite) TestRecvTransferQueuedWithNativeRateLimits() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	localDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop("transfer", suite.TransferPath.EndpointA.ChannelID)).IBCDenom()
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	channelValue := CalculateChannelValue(suite.ChainA.GetContext(), localDenom, app.BankKeeper)
+	sendAmount := channelValue.QuoRaw(25)
+
+	suite.RegisterNativeRateLimit(localDenom, types.AnyChannel, 4, 4)
+	params := app.RateLimitingICS4Wrapper.GetParams(suite.ChainA.GetContext())
+	params.QueueExceededTransfers = true
+	params.QueueRetrySeconds = types.DefaultQueueRetrySeconds
+	suite.Require().NoError(app.RateLimitingICS4Wrapper.SetParams(suite.ChainA.GetContext(), params))
+
+	receiver := suite.ChainA.SenderAccount.GetAddress()
+	balanceBefore := app.BankKeeper.GetBalance(suite.ChainA.GetContext(), receiver, localDenom)
+
+	// receiving above the quota is acknowledged, but the tokens are queued
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sdk.DefaultBondDenom, sendAmount.MulRaw(2)))
+	suite.Require().NoError(err)
+
+	ctx := suite.ChainA.GetContext()
+	queuedTransfers := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetAllQueuedTransfers(ctx)
+	suite.Require().Len(queuedTransfers, 1)
+	suite.Require().Equal(receiver.String(), queuedTransfers[0].Receiver)
+	suite.Require().Equal(sdk.NewCoin(localDenom, sendAmount.MulRaw(2)), queuedTransfers[0].Coin)
+	suite.Require().Equal(balanceBefore, app.BankKeeper.GetBalance(ctx, receiver, localDenom))
+	suite.Require().Equal(sendAmount.MulRaw(2), app.BankKeeper.GetBalance(ctx, types.QueueEscrowAddress, localDenom).Amount)
+
+	// the queued transfer still exceeds the quota and is rescheduled
+	ctx = ctx.WithBlockTime(queuedTransfers[0].NextReleaseAttempt)
+	app.RateLimitingICS4Wrapper.ReleaseQueuedTransfers(ctx)
+	rescheduled, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetQueuedTransfer(ctx, queuedTransfers[0].Id)
+	suite.Require().True(found)
+	suite.Require().True(rescheduled.NextReleaseAttempt.After(queuedTransfers[0].NextReleaseAttempt))
+
+	// governance releases the queued transfer regardless of the rate limits
+	msgServer := keeper.NewMsgServerImpl(*app.RateLimitingICS4Wrapper.IbcratelimitKeeper)
+	_, err = msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender: app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetAuthority(),
+		Id:     queuedTransfers[0].Id,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(balanceBefore.Amount.Add(sendAmount.MulRaw(2)), app.BankKeeper.GetBalance(ctx, receiver, localDenom).Amount)
+	suite.Require().Empty(app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetAllQueuedTransfers(ctx))
+}
+
+// Test native rate limits are reverted if a "send" fails
+func (suite *MiddlewareTestSuite) TestFailedSendTransferWithNativeRateLimits() {
+	suite.ConfigureTransferChannel()
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	channelID := suite.TransferPath.EndpointA.ChannelID
+	suite.RegisterNativeRateLimit(denom, channelID, 5, 5)
+
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	packet := channeltypes.NewPacket(
+		transfertypes.NewFungibleTokenPacketData(denom, "1000", "sender", "receiver", "").GetBytes(),
+		1,
+		"transfer",
+		channelID,
+		"transfer",
+		suite.TransferPath.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	suite.Require().NoError(ibcratelimit.CheckAndUpdateNativeRateLimits(suite.ChainA.GetContext(), app.RateLimitingICS4Wrapper.IbcratelimitKeeper, "send_packet", packet))
+	suite.Require().NoError(ibcratelimit.UndoSendNativeRateLimit(suite.ChainA.GetContext(), app.RateLimitingICS4Wrapper.IbcratelimitKeeper, packet))
+
+	flow, found := app.RateLimitingICS4Wrapper.IbcratelimitKeeper.GetFlow(suite.ChainA.GetContext(), denom, channelID, "weekly")
+	suite.Require().True(found)
+	suite.Require().True(flow.Outflow.IsZero())
+}
+
 // Test no rate limiting occurs when the contract is set, but no quotas are configured for the path
 func (suite *MiddlewareTestSuite) TestSendTransferNoQuota() {
 	suite.ConfigureTransferChannel()
@@ -745,6 +931,18 @@ func (suite *MiddlewareTestSuite) RegisterRateLimitingContract(addr []byte) {
 	require.True(suite.ChainA.TB, true)
 }
 
+func (suite *MiddlewareTestSuite) RegisterNativeRateLimit(denom, channelID string, sendPercentage, recvPercentage uint32) {
+	app := suite.GetNeutronZoneApp(suite.ChainA)
+	ctx := suite.ChainA.GetContext()
+	suite.Require().NoError(app.RateLimitingICS4Wrapper.SetParams(ctx, types.Params{UseNativeRateLimits: true}))
+	app.RateLimitingICS4Wrapper.IbcratelimitKeeper.SetRateLimit(ctx, types.RateLimit{
+		Path: types.RateLimitPath{Denom: denom, ChannelId: channelID},
+		Quotas: []types.Quota{
+			{Name: "weekly", MaxPercentSend: sendPercentage, MaxPercentRecv: recvPercentage, DurationSeconds: 604800},
+		},
+	})
+}
+
 // AssertEventEmitted asserts that ctx's event manager has emitted the given number of events
 // of the given type.
 func (suite *MiddlewareTestSuite) AssertEventEmitted(ctx sdk.Context, eventTypeExpected string, numEventsExpected int) {
@@ -2,7 +2,7 @@ package ibcratelimit
 
 import (
 	"encoding/json"
-	"strings"
+	"errors"
 
 	"github.com/neutron-org/neutron/v11/x/ibc-hooks/utils"
 
@@ -134,20 +134,17 @@ func (im *IBCModule) OnRecvPacket(
 		return utils.NewEmitErrorAcknowledgement(ctx, types.ErrBadMessage, err.Error())
 	}
 
-	contract := im.ics4Middleware.GetContractAddress(ctx)
-	if contract == "" {
-		// The contract has not been configured. Continue as usual
-		return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
-	}
-
-	err := CheckAndUpdateRateLimits(ctx, im.ics4Middleware.ContractKeeper, msgRecv, contract, packet)
-	if err != nil {
-		if strings.Contains(err.Error(), types.RateLimitExceededSubStr) {
-			return utils.NewEmitErrorAcknowledgement(ctx, types.ErrRateLimitExceeded)
+	// the checks are run in a cached context so that the flows aren't updated if the transfer gets queued
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := im.ics4Middleware.CheckRecvRateLimits(cacheCtx, packet); err != nil {
+		if errors.Is(err, types.ErrRateLimitExceeded) && im.ics4Middleware.GetParams(ctx).QueueExceededTransfers {
+			if ack, queued := im.queueTransfer(ctx, channelVersion, packet, relayer); queued {
+				return ack
+			}
 		}
-		fullError := errorsmod.Wrap(types.ErrContractError, err.Error())
-		return utils.NewEmitErrorAcknowledgement(ctx, fullError)
+		return utils.NewEmitErrorAcknowledgement(ctx, err)
 	}
+	writeCache()
 
 	// if this returns an Acknowledgement that isn't successful, all state changes are discarded
 	return im.app.OnRecvPacket(ctx, channelVersion, packet, relayer)
@@ -207,12 +204,21 @@ func (im *IBCModule) OnTimeoutPacket(
 	return im.app.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
 }
 
-// RevertSentPacket Notifies the contract that a sent packet wasn't properly received
+// RevertSentPacket Notifies the contract or the native rate limits that a sent packet wasn't properly received
 func (im *IBCModule) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
-	contract := im.ics4Middleware.GetContractAddress(ctx)
+	if err := UndoSendUsdRateLimit(ctx, im.ics4Middleware.IbcratelimitKeeper, packet); err != nil {
+		return err
+	}
+
+	params := im.ics4Middleware.GetParams(ctx)
+	if params.UseNativeRateLimits {
+		return UndoSendNativeRateLimit(ctx, im.ics4Middleware.IbcratelimitKeeper, packet)
+	}
+
+	contract := params.ContractAddress
 	if contract == "" {
 		// The contract has not been configured. Continue as usual
 		return nil
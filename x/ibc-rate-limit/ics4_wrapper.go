@@ -2,6 +2,7 @@ package ibcratelimit
 
 import (
 	"encoding/json"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -56,7 +57,9 @@ func NewICS4Middleware(
 // This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
 // the current transfer, in which case it returns an error preventing the IBC send from taking place.
 // If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// used, transfers are not prevented and handled by the wrapped IBC app.
+// If the native rate limits are enabled in the params, the limits are checked by the module instead of the contract.
+// The USD rate limit of the channel, if any, is checked in both cases.
 func (i *ICS4Wrapper) SendPacket(
 	ctx sdk.Context,
 	sourcePort,
@@ -72,12 +75,6 @@ func (i *ICS4Wrapper) SendPacket(
 	if packetdata.Denom == "" || packetdata.Amount == "" {
 		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
-	contract := i.GetContractAddress(ctx)
-	if contract == "" {
-		// The contract has not been configured. Continue as usual
-		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
-	}
-
 	// setting 0 as a default so it can be properly parsed by cosmwasm
 	fullPacket := channeltypes.Packet{
 		Sequence:           0,
@@ -90,7 +87,23 @@ func (i *ICS4Wrapper) SendPacket(
 		TimeoutHeight:      timeoutHeight,
 	}
 
-	err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgSend, contract, fullPacket)
+	// the USD rate limits of the channels are checked regardless of the way the per-denom limits are checked
+	if err := CheckAndUpdateUsdRateLimits(ctx, i.IbcratelimitKeeper, msgSend, fullPacket); err != nil {
+		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
+	}
+
+	params := i.GetParams(ctx)
+	if !params.UseNativeRateLimits && params.ContractAddress == "" {
+		// The contract has not been configured. Continue as usual
+		return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	var err error
+	if params.UseNativeRateLimits {
+		err = CheckAndUpdateNativeRateLimits(ctx, i.IbcratelimitKeeper, msgSend, fullPacket)
+	} else {
+		err = CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgSend, params.ContractAddress, fullPacket)
+	}
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
 	}
@@ -98,6 +111,59 @@ func (i *ICS4Wrapper) SendPacket(
 	return i.channel.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 }
 
+// CheckRecvRateLimits checks the received packet against the USD rate limit of the channel and against
+// either the native rate limits or the contract, and records it in the flows.
+func (i *ICS4Wrapper) CheckRecvRateLimits(ctx sdk.Context, packet exported.PacketI) error {
+	return i.checkRecvRateLimits(ctx, packet, msgRecv)
+}
+
+// CheckReleaseRateLimits checks the packet of the queued transfer being released like CheckRecvRateLimits
+// does, except that the native rate limits don't count the already minted tokens in the channel value.
+func (i *ICS4Wrapper) CheckReleaseRateLimits(ctx sdk.Context, packet exported.PacketI) error {
+	return i.checkRecvRateLimits(ctx, packet, msgRelease)
+}
+
+func (i *ICS4Wrapper) checkRecvRateLimits(ctx sdk.Context, packet exported.PacketI, nativeMsgType string) error {
+	// the USD rate limits of the channels are checked regardless of the way the per-denom limits are checked
+	if err := CheckAndUpdateUsdRateLimits(ctx, i.IbcratelimitKeeper, msgRecv, packet); err != nil {
+		return err
+	}
+
+	params := i.GetParams(ctx)
+	if params.UseNativeRateLimits {
+		return CheckAndUpdateNativeRateLimits(ctx, i.IbcratelimitKeeper, nativeMsgType, packet)
+	}
+
+	contract := params.ContractAddress
+	if contract == "" {
+		// The contract has not been configured. Continue as usual
+		return nil
+	}
+
+	err := CheckAndUpdateRateLimits(ctx, i.ContractKeeper, msgRecv, contract, packet)
+	if err != nil {
+		if strings.Contains(err.Error(), types.RateLimitExceededSubStr) {
+			return types.ErrRateLimitExceeded
+		}
+		return errorsmod.Wrap(types.ErrContractError, err.Error())
+	}
+	return nil
+}
+
+// RevertReceivedPacket reverts the inflow recorded for the received packet whose tokens are refunded to the
+// sender with an error acknowledgement written after the packet had been received. The contract has no way
+// to revert a received packet, so only the USD and the native rate limits are reverted.
+func (i *ICS4Wrapper) RevertReceivedPacket(ctx sdk.Context, packet exported.PacketI) error {
+	if err := UndoRecvUsdRateLimit(ctx, i.IbcratelimitKeeper, packet); err != nil {
+		return err
+	}
+
+	if i.GetParams(ctx).UseNativeRateLimits {
+		return UndoRecvNativeRateLimit(ctx, i.IbcratelimitKeeper, packet)
+	}
+	return nil
+}
+
 func (i *ICS4Wrapper) WriteAcknowledgement(ctx sdk.Context, packet exported.PacketI, ack exported.Acknowledgement) error {
 	return i.channel.WriteAcknowledgement(ctx, packet, ack)
 }
@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var rateLimits []types.RateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimitStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+
+	pageRes, err := query.Paginate(rateLimitStore, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(value, &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) RateLimitUsage(c context.Context, req *types.QueryRateLimitUsageRequest) (*types.QueryRateLimitUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "rate limit not found")
+	}
+
+	return &types.QueryRateLimitUsageResponse{Usages: k.GetQuotaUsages(ctx, rateLimit)}, nil
+}
+
+func (k Keeper) UsdRateLimits(c context.Context, req *types.QueryUsdRateLimitsRequest) (*types.QueryUsdRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var rateLimits []types.UsdRateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimitStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UsdRateLimitKey)
+
+	pageRes, err := query.Paginate(rateLimitStore, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.UsdRateLimit
+		k.cdc.MustUnmarshal(value, &rateLimit)
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUsdRateLimitsResponse{UsdRateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UsdRateLimitUsage(c context.Context, req *types.QueryUsdRateLimitUsageRequest) (*types.QueryUsdRateLimitUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetUsdRateLimit(ctx, req.ChannelId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "USD rate limit not found")
+	}
+
+	return &types.QueryUsdRateLimitUsageResponse{UsdRateLimit: rateLimit, Flow: k.GetCurrentUsdFlow(ctx, rateLimit)}, nil
+}
+
+func (k Keeper) QueuedTransfers(c context.Context, req *types.QueryQueuedTransfersRequest) (*types.QueryQueuedTransfersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var queuedTransfers []types.QueuedTransfer
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queuedTransferStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedTransferKey)
+
+	pageRes, err := query.Paginate(queuedTransferStore, req.Pagination, func(_, value []byte) error {
+		var queuedTransfer types.QueuedTransfer
+		k.cdc.MustUnmarshal(value, &queuedTransfer)
+
+		queuedTransfers = append(queuedTransfers, queuedTransfer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryQueuedTransfersResponse{QueuedTransfers: queuedTransfers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QueuedTransfer(c context.Context, req *types.QueryQueuedTransferRequest) (*types.QueryQueuedTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queuedTransfer, found := k.GetQueuedTransfer(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "queued transfer not found")
+	}
+
+	return &types.QueryQueuedTransferResponse{QueuedTransfer: queuedTransfer}, nil
+}
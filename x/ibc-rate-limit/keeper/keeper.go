@@ -10,8 +10,11 @@ import (
 
 // Keeper of the globalfee store
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	bankKeeper   types.BankKeeper
+	oracleKeeper types.OracleKeeper
+	sudoKeeper   types.WasmKeeper
 
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/adminmodule module account.
@@ -21,15 +24,27 @@ type Keeper struct {
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	oracleKeeper types.OracleKeeper,
+	sudoKeeper types.WasmKeeper,
 	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:       cdc,
-		storeKey:  key,
-		authority: authority,
+		cdc:          cdc,
+		storeKey:     key,
+		bankKeeper:   bankKeeper,
+		oracleKeeper: oracleKeeper,
+		sudoKeeper:   sudoKeeper,
+		authority:    authority,
 	}
 }
 
+// SetOracleKeeper sets the oracle keeper used to value the denoms for the USD rate limits.
+// The oracle keeper is created after the transfer stack the rate limit keeper is a part of.
+func (k *Keeper) SetOracleKeeper(oracleKeeper types.OracleKeeper) {
+	k.oracleKeeper = oracleKeeper
+}
+
 // GetAuthority returns the x/ibcratelimit module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
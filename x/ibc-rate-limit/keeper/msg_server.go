@@ -39,3 +39,100 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// AddRateLimit adds a native rate limit or replaces the quotas of an existing one
+func (k Keeper) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgAddRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetRateLimit(ctx, req.RateLimit)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes a native rate limit along with its flows
+func (k Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.Path.Denom, req.Path.ChannelId); !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel %s", req.Path.Denom, req.Path.ChannelId)
+	}
+	k.DeleteRateLimit(ctx, req.Path.Denom, req.Path.ChannelId)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// AddUsdRateLimit adds a channel rate limit in USD or replaces an existing one
+func (k Keeper) AddUsdRateLimit(goCtx context.Context, req *types.MsgAddUsdRateLimit) (*types.MsgAddUsdRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgAddUsdRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetUsdRateLimit(ctx, req.UsdRateLimit)
+
+	return &types.MsgAddUsdRateLimitResponse{}, nil
+}
+
+// RemoveUsdRateLimit removes a channel rate limit in USD along with its flow
+func (k Keeper) RemoveUsdRateLimit(goCtx context.Context, req *types.MsgRemoveUsdRateLimit) (*types.MsgRemoveUsdRateLimitResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveUsdRateLimit")
+	}
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetUsdRateLimit(ctx, req.ChannelId); !found {
+		return nil, errors.Wrapf(types.ErrRateLimitNotFound, "channel %s", req.ChannelId)
+	}
+	k.DeleteUsdRateLimit(ctx, req.ChannelId)
+
+	return &types.MsgRemoveUsdRateLimitResponse{}, nil
+}
+
+// ReleaseQueuedTransfer releases a queued transfer to its receiver or the given recipient regardless of the rate limits
+func (k Keeper) ReleaseQueuedTransfer(goCtx context.Context, req *types.MsgReleaseQueuedTransfer) (*types.MsgReleaseQueuedTransferResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgReleaseQueuedTransfer")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	securityAddress := k.GetParams(ctx).SecurityAddress
+	if req.Sender != k.GetAuthority() && (securityAddress == "" || req.Sender != securityAddress) {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s is neither the security address nor the authority", req.Sender)
+	}
+
+	queuedTransfer, found := k.GetQueuedTransfer(ctx, req.Id)
+	if !found {
+		return nil, errors.Wrapf(types.ErrQueuedTransferNotFound, "id %d", req.Id)
+	}
+	recipient := req.Recipient
+	if recipient == "" {
+		recipient = queuedTransfer.Receiver
+	}
+	if err := k.ReleaseTransferTo(ctx, queuedTransfer, req.Sender, recipient); err != nil {
+		return nil, errors.Wrapf(err, "failed to release queued transfer %d", req.Id)
+	}
+
+	return &types.MsgReleaseQueuedTransferResponse{}, nil
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+// QueueTransfer records the incoming transfer whose tokens have been received by the queue escrow address
+// and notifies the receiver if it is a contract.
+func (k Keeper) QueueTransfer(ctx sdk.Context, packet channeltypes.Packet, receiver string, coin sdk.Coin) types.QueuedTransfer {
+	id := k.GetLastQueuedTransferID(ctx) + 1
+	k.SetLastQueuedTransferID(ctx, id)
+
+	queuedTransfer := types.QueuedTransfer{
+		Id:                 id,
+		Packet:             packet,
+		Receiver:           receiver,
+		Coin:               coin,
+		QueuedAt:           ctx.BlockTime(),
+		NextReleaseAttempt: k.nextReleaseAttempt(ctx),
+	}
+	k.SetQueuedTransfer(ctx, queuedTransfer)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferQueued,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyQueuedTransferID, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+		sdk.NewAttribute(types.AttributeKeyAmount, coin.String()),
+		sdk.NewAttribute(types.AttributeKeyNextReleaseAttempt, queuedTransfer.NextReleaseAttempt.String()),
+	))
+
+	k.notifyReceiver(ctx, receiver, types.MessageTransferQueued{
+		TransferQueued: types.NewQueuedTransferDetails(queuedTransfer),
+	})
+
+	return queuedTransfer
+}
+
+// ReleaseTransfer sends the tokens of the queued transfer to the receiver, removes the transfer from
+// the queue and notifies the receiver if it is a contract.
+func (k Keeper) ReleaseTransfer(ctx sdk.Context, queuedTransfer types.QueuedTransfer, releasedBy string) error {
+	return k.ReleaseTransferTo(ctx, queuedTransfer, releasedBy, queuedTransfer.Receiver)
+}
+
+// ReleaseTransferTo is ReleaseTransfer sending the tokens to the recipient instead of the receiver, which
+// allows to release the transfers whose receiver can no longer receive the tokens.
+func (k Keeper) ReleaseTransferTo(ctx sdk.Context, queuedTransfer types.QueuedTransfer, releasedBy, recipient string) error {
+	recipientAddr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, types.QueueEscrowAddress, recipientAddr, sdk.NewCoins(queuedTransfer.Coin)); err != nil {
+		return err
+	}
+	k.RemoveQueuedTransfer(ctx, queuedTransfer)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferReleased,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyQueuedTransferID, strconv.FormatUint(queuedTransfer.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyReceiver, queuedTransfer.Receiver),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+		sdk.NewAttribute(types.AttributeKeyAmount, queuedTransfer.Coin.String()),
+		sdk.NewAttribute(types.AttributeKeyReleasedBy, releasedBy),
+	))
+
+	details := types.NewQueuedTransferDetails(queuedTransfer)
+	if recipient != queuedTransfer.Receiver {
+		details.Recipient = recipient
+	}
+	k.notifyReceiver(ctx, queuedTransfer.Receiver, types.MessageTransferReleased{
+		TransferReleased: details,
+	})
+
+	return nil
+}
+
+// RescheduleQueuedTransfer postpones the next attempt to release the queued transfer by the retry interval.
+func (k Keeper) RescheduleQueuedTransfer(ctx sdk.Context, queuedTransfer types.QueuedTransfer) {
+	k.RemoveQueuedTransfer(ctx, queuedTransfer)
+	queuedTransfer.NextReleaseAttempt = k.nextReleaseAttempt(ctx)
+	k.SetQueuedTransfer(ctx, queuedTransfer)
+}
+
+// GetReadyQueuedTransfers returns at most limit queued transfers whose next release attempt is due, in the
+// order of their release attempts.
+func (k Keeper) GetReadyQueuedTransfers(ctx sdk.Context, limit int) []types.QueuedTransfer {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.QueuedTransferReleaseKey, storetypes.InclusiveEndBytes(types.GetQueuedTransferReleasePrefix(ctx.BlockTime())))
+	defer iterator.Close()
+
+	var queuedTransfers []types.QueuedTransfer
+	for ; iterator.Valid() && len(queuedTransfers) < limit; iterator.Next() {
+		id := sdk.BigEndianToUint64(iterator.Value())
+		if queuedTransfer, found := k.GetQueuedTransfer(ctx, id); found {
+			queuedTransfers = append(queuedTransfers, queuedTransfer)
+		}
+	}
+	return queuedTransfers
+}
+
+// SetQueuedTransfer stores the queued transfer along with its release index entry.
+func (k Keeper) SetQueuedTransfer(ctx sdk.Context, queuedTransfer types.QueuedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedTransferKey(queuedTransfer.Id), k.cdc.MustMarshal(&queuedTransfer))
+	store.Set(
+		types.GetQueuedTransferReleaseKey(queuedTransfer.NextReleaseAttempt, queuedTransfer.Id),
+		sdk.Uint64ToBigEndian(queuedTransfer.Id),
+	)
+}
+
+// GetQueuedTransfer returns the queued transfer by its id.
+func (k Keeper) GetQueuedTransfer(ctx sdk.Context, id uint64) (types.QueuedTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQueuedTransferKey(id))
+	if bz == nil {
+		return types.QueuedTransfer{}, false
+	}
+
+	var queuedTransfer types.QueuedTransfer
+	k.cdc.MustUnmarshal(bz, &queuedTransfer)
+	return queuedTransfer, true
+}
+
+// RemoveQueuedTransfer removes the queued transfer along with its release index entry.
+func (k Keeper) RemoveQueuedTransfer(ctx sdk.Context, queuedTransfer types.QueuedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedTransferKey(queuedTransfer.Id))
+	store.Delete(types.GetQueuedTransferReleaseKey(queuedTransfer.NextReleaseAttempt, queuedTransfer.Id))
+}
+
+// GetAllQueuedTransfers returns all the queued transfers.
+func (k Keeper) GetAllQueuedTransfers(ctx sdk.Context) (queuedTransfers []types.QueuedTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.QueuedTransferKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedTransfer types.QueuedTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &queuedTransfer)
+		queuedTransfers = append(queuedTransfers, queuedTransfer)
+	}
+	return queuedTransfers
+}
+
+// GetLastQueuedTransferID returns the id of the last queued transfer.
+func (k Keeper) GetLastQueuedTransferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastQueuedTransferIDKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastQueuedTransferID stores the id of the last queued transfer.
+func (k Keeper) SetLastQueuedTransferID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastQueuedTransferIDKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) nextReleaseAttempt(ctx sdk.Context) time.Time {
+	retrySeconds := k.GetParams(ctx).QueueRetrySeconds
+	if retrySeconds == 0 {
+		retrySeconds = types.DefaultQueueRetrySeconds
+	}
+	return ctx.BlockTime().Add(time.Duration(retrySeconds) * time.Second)
+}
+
+// notifyReceiver passes the message to the receiver if it is a contract. The failed notifications don't
+// affect the transfer, they are recorded by the contract manager.
+func (k Keeper) notifyReceiver(ctx sdk.Context, receiver string, msg interface{}) {
+	receiverAddr, err := sdk.AccAddressFromBech32(receiver)
+	if err != nil || !k.sudoKeeper.HasContractInfo(ctx, receiverAddr) {
+		return
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		ctx.Logger().Error("failed to marshal queued transfer notification", "error", err)
+		return
+	}
+
+	_, _ = k.sudoKeeper.Sudo(ctx, receiverAddr, bz)
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/neutron-org/neutron/v11/testutil/ibc-rate-limit/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/ibc-rate-limit/types"
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/keeper"
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+func queuedPacket(receiver sdk.AccAddress) channeltypes.Packet {
+	data := transfertypes.NewFungibleTokenPacketData("transfer/channel-10/uatom", "1000", "cosmos1sender", receiver.String(), "")
+	return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-10", "transfer", testChannel, clienttypes.ZeroHeight(), 1)
+}
+
+func TestQueuedTransfers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	wasmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	k, ctx := testkeeper.IbcRateLimitKeeper(t, bankKeeper, mock_types.NewMockOracleKeeper(ctrl), wasmKeeper)
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	params := types.DefaultParams()
+	params.QueueExceededTransfers = true
+	require.NoError(t, k.SetParams(ctx, params))
+
+	receiver := sdk.AccAddress("receiver_contract___")
+	coin := sdk.NewCoin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", sdkmath.NewInt(1000))
+
+	// the receiving contract is notified about its queued transfer
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), receiver).Return(true)
+	wasmKeeper.EXPECT().Sudo(gomock.Any(), receiver, gomock.Any()).DoAndReturn(
+		func(_ interface{}, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+			var queued types.MessageTransferQueued
+			require.NoError(t, json.Unmarshal(msg, &queued))
+			require.Equal(t, uint64(1), queued.TransferQueued.ID)
+			require.Equal(t, "cosmos1sender", queued.TransferQueued.Sender)
+			require.Equal(t, "1000", queued.TransferQueued.Amount)
+			return nil, nil
+		})
+
+	queuedTransfer := k.QueueTransfer(ctx, queuedPacket(receiver), receiver.String(), coin)
+	require.Equal(t, uint64(1), queuedTransfer.Id)
+	require.Equal(t, uint64(1), k.GetLastQueuedTransferID(ctx))
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), queuedTransfer.NextReleaseAttempt)
+
+	// the release attempt is due after the retry interval
+	require.Empty(t, k.GetReadyQueuedTransfers(ctx, types.MaxQueueReleasesPerBlock))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.Len(t, k.GetReadyQueuedTransfers(ctx, types.MaxQueueReleasesPerBlock), 1)
+
+	k.RescheduleQueuedTransfer(ctx, queuedTransfer)
+	require.Empty(t, k.GetReadyQueuedTransfers(ctx, types.MaxQueueReleasesPerBlock))
+	rescheduled, found := k.GetQueuedTransfer(ctx, queuedTransfer.Id)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), rescheduled.NextReleaseAttempt)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	ready := k.GetReadyQueuedTransfers(ctx, types.MaxQueueReleasesPerBlock)
+	require.Len(t, ready, 1)
+
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), types.QueueEscrowAddress, receiver, sdk.NewCoins(coin)).Return(nil)
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), receiver).Return(false)
+	require.NoError(t, k.ReleaseTransfer(ctx, ready[0], types.ModuleName))
+
+	_, found = k.GetQueuedTransfer(ctx, queuedTransfer.Id)
+	require.False(t, found)
+	require.Empty(t, k.GetReadyQueuedTransfers(ctx, types.MaxQueueReleasesPerBlock))
+	require.Empty(t, k.GetAllQueuedTransfers(ctx))
+}
+
+func TestMsgReleaseQueuedTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	wasmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	k, ctx := testkeeper.IbcRateLimitKeeper(t, bankKeeper, mock_types.NewMockOracleKeeper(ctrl), wasmKeeper)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	securityAddress := sdk.AccAddress("security_address____").String()
+	params := types.DefaultParams()
+	params.QueueExceededTransfers = true
+	params.SecurityAddress = securityAddress
+	require.NoError(t, k.SetParams(ctx, params))
+
+	receiver := sdk.AccAddress("receiver____________")
+	coin := sdk.NewCoin("uatom", sdkmath.NewInt(1000))
+	wasmKeeper.EXPECT().HasContractInfo(gomock.Any(), receiver).Return(false).AnyTimes()
+	queuedTransfer := k.QueueTransfer(ctx, queuedPacket(receiver), receiver.String(), coin)
+
+	_, err := msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender: sdk.AccAddress("stranger____________").String(),
+		Id:     queuedTransfer.Id,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender: securityAddress,
+		Id:     queuedTransfer.Id + 1,
+	})
+	require.ErrorIs(t, err, types.ErrQueuedTransferNotFound)
+
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), types.QueueEscrowAddress, receiver, sdk.NewCoins(coin)).Return(nil)
+	_, err = msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender: securityAddress,
+		Id:     queuedTransfer.Id,
+	})
+	require.NoError(t, err)
+	_, found := k.GetQueuedTransfer(ctx, queuedTransfer.Id)
+	require.False(t, found)
+
+	// governance can release the queued transfers as well
+	queuedTransfer = k.QueueTransfer(ctx, queuedPacket(receiver), receiver.String(), coin)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), types.QueueEscrowAddress, receiver, sdk.NewCoins(coin)).Return(nil)
+	_, err = msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender: k.GetAuthority(),
+		Id:     queuedTransfer.Id,
+	})
+	require.NoError(t, err)
+
+	// the transfer which can't be released to its receiver stays in the queue
+	queuedTransfer = k.QueueTransfer(ctx, queuedPacket(receiver), receiver.String(), coin)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), types.QueueEscrowAddress, receiver, sdk.NewCoins(coin)).Return(fmt.Errorf("blocked receiver"))
+	_, err = msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender: securityAddress,
+		Id:     queuedTransfer.Id,
+	})
+	require.ErrorContains(t, err, "blocked receiver")
+	_, found = k.GetQueuedTransfer(ctx, queuedTransfer.Id)
+	require.True(t, found)
+
+	// until it is released to another recipient
+	recipient := sdk.AccAddress("recipient___________")
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), types.QueueEscrowAddress, recipient, sdk.NewCoins(coin)).Return(nil)
+	_, err = msgServer.ReleaseQueuedTransfer(ctx, &types.MsgReleaseQueuedTransfer{
+		Sender:    securityAddress,
+		Id:        queuedTransfer.Id,
+		Recipient: recipient.String(),
+	})
+	require.NoError(t, err)
+	_, found = k.GetQueuedTransfer(ctx, queuedTransfer.Id)
+	require.False(t, found)
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+// SetRateLimit stores the rate limit replacing the quotas of an existing one. The flows of the quotas
+// that are no longer present are removed, the flows of the kept quotas are preserved.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	path := rateLimit.Path
+
+	names := make(map[string]bool, len(rateLimit.Quotas))
+	for _, quota := range rateLimit.Quotas {
+		names[quota.Name] = true
+	}
+	flowStore := prefix.NewStore(store, types.GetFlowPrefix(path.Denom, path.ChannelId))
+	iterator := flowStore.Iterator(nil, nil)
+	var staleFlows [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if !names[string(iterator.Key())] {
+			staleFlows = append(staleFlows, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range staleFlows {
+		flowStore.Delete(key)
+	}
+
+	store.Set(types.GetRateLimitKey(path.Denom, path.ChannelId), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetRateLimit returns the rate limit of the denom over the channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRateLimitKey(denom, channelID))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// DeleteRateLimit removes the rate limit of the denom over the channel along with its flows.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRateLimitKey(denom, channelID))
+
+	flowStore := prefix.NewStore(store, types.GetFlowPrefix(denom, channelID))
+	iterator := flowStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		flowStore.Delete(key)
+	}
+}
+
+// GetAllRateLimits returns all the native rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) (rateLimits []types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RateLimitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+// GetFlow returns the flow of the quota of the rate limit of the denom over the channel.
+func (k Keeper) GetFlow(ctx sdk.Context, denom, channelID, quotaName string) (types.Flow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFlowKey(denom, channelID, quotaName))
+	if bz == nil {
+		return types.Flow{}, false
+	}
+
+	var flow types.Flow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetFlow stores the flow of the quota of the rate limit of the denom over the channel.
+func (k Keeper) SetFlow(ctx sdk.Context, denom, channelID, quotaName string, flow types.Flow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFlowKey(denom, channelID, quotaName), k.cdc.MustMarshal(&flow))
+}
+
+// GetQuotaUsages returns the current flows of the quotas of the rate limit. The flows of the quotas
+// whose period has ended are returned as they would be at the beginning of a new period.
+func (k Keeper) GetQuotaUsages(ctx sdk.Context, rateLimit types.RateLimit) []types.QuotaUsage {
+	usages := make([]types.QuotaUsage, 0, len(rateLimit.Quotas))
+	for _, quota := range rateLimit.Quotas {
+		usages = append(usages, types.QuotaUsage{
+			Quota: quota,
+			Flow:  k.currentFlow(ctx, rateLimit.Path, quota, math.ZeroInt()),
+		})
+	}
+	return usages
+}
+
+// CheckAndUpdateRateLimits checks that the transfer of the amount of the denom over the channel keeps the
+// net flow within all the quotas of the rate limits of the denom over the channel and over any channel,
+// and records the transfer in the flows. No flow is updated if any of the quotas is exceeded.
+func (k Keeper) CheckAndUpdateRateLimits(ctx sdk.Context, send bool, denom, channelID string, amount math.Int) error {
+	return k.checkAndUpdateRateLimits(ctx, send, denom, channelID, amount, !send)
+}
+
+// CheckAndUpdateReleaseRateLimits checks and records the release of a queued incoming transfer like
+// CheckAndUpdateRateLimits does for a received one. The tokens of a queued transfer have already been
+// minted to the queue escrow address, so they are not counted in the channel value once more.
+func (k Keeper) CheckAndUpdateReleaseRateLimits(ctx sdk.Context, denom, channelID string, amount math.Int) error {
+	return k.checkAndUpdateRateLimits(ctx, false, denom, channelID, amount, false)
+}
+
+// checkAndUpdateRateLimits checks and records the transfer in the flows. If unminted is set, the amount is
+// added to the channel value of the new flows, since the supply doesn't include the transferred tokens yet.
+func (k Keeper) checkAndUpdateRateLimits(ctx sdk.Context, send bool, denom, channelID string, amount math.Int, unminted bool) error {
+	type pendingFlow struct {
+		path      types.RateLimitPath
+		quotaName string
+		flow      types.Flow
+	}
+	var pending []pendingFlow
+
+	for _, ch := range []string{channelID, types.AnyChannel} {
+		rateLimit, found := k.GetRateLimit(ctx, denom, ch)
+		if !found {
+			continue
+		}
+
+		for _, quota := range rateLimit.Quotas {
+			// for the incoming transfers the minted tokens are counted in the channel value, so that
+			// the first transfer of a denom new to the chain is not rejected
+			extraValue := math.ZeroInt()
+			if unminted {
+				extraValue = amount
+			}
+			flow := k.currentFlow(ctx, rateLimit.Path, quota, extraValue)
+
+			if send {
+				if !flow.CheckOutflow(quota, amount) {
+					return errorsmod.Wrapf(types.ErrRateLimitExceeded, "outflow of %s over channel %s exceeds quota %s", denom, ch, quota.Name)
+				}
+				flow.Outflow = flow.Outflow.Add(amount)
+			} else {
+				if !flow.CheckInflow(quota, amount) {
+					return errorsmod.Wrapf(types.ErrRateLimitExceeded, "inflow of %s over channel %s exceeds quota %s", denom, ch, quota.Name)
+				}
+				flow.Inflow = flow.Inflow.Add(amount)
+			}
+			pending = append(pending, pendingFlow{path: rateLimit.Path, quotaName: quota.Name, flow: flow})
+		}
+	}
+
+	for _, p := range pending {
+		k.SetFlow(ctx, p.path.Denom, p.path.ChannelId, p.quotaName, p.flow)
+	}
+	return nil
+}
+
+// UndoSend reverts the outflow recorded for a transfer that failed or timed out. The flows of the
+// periods that have ended since the transfer was sent are left as they are.
+func (k Keeper) UndoSend(ctx sdk.Context, denom, channelID string, amount math.Int) {
+	k.undoFlows(ctx, true, denom, channelID, amount)
+}
+
+// UndoRecv reverts the inflow recorded for a received transfer that was refunded to the sender with
+// an error acknowledgement written after the packet had been received, e.g. an asynchronous one.
+func (k Keeper) UndoRecv(ctx sdk.Context, denom, channelID string, amount math.Int) {
+	k.undoFlows(ctx, false, denom, channelID, amount)
+}
+
+func (k Keeper) undoFlows(ctx sdk.Context, send bool, denom, channelID string, amount math.Int) {
+	for _, ch := range []string{channelID, types.AnyChannel} {
+		rateLimit, found := k.GetRateLimit(ctx, denom, ch)
+		if !found {
+			continue
+		}
+
+		for _, quota := range rateLimit.Quotas {
+			flow, found := k.GetFlow(ctx, denom, ch, quota.Name)
+			if !found || !ctx.BlockTime().Before(flow.PeriodEnd) {
+				continue
+			}
+			if send {
+				flow.Outflow = math.MaxInt(flow.Outflow.Sub(amount), math.ZeroInt())
+			} else {
+				flow.Inflow = math.MaxInt(flow.Inflow.Sub(amount), math.ZeroInt())
+			}
+			k.SetFlow(ctx, denom, ch, quota.Name, flow)
+		}
+	}
+}
+
+// currentFlow returns the stored flow of the quota or a new one if there is none or its period has ended.
+// The channel value of a new flow is the supply of the denom increased by the extra value.
+func (k Keeper) currentFlow(ctx sdk.Context, path types.RateLimitPath, quota types.Quota, extraValue math.Int) types.Flow {
+	flow, found := k.GetFlow(ctx, path.Denom, path.ChannelId, quota.Name)
+	if found && ctx.BlockTime().Before(flow.PeriodEnd) {
+		return flow
+	}
+
+	return types.Flow{
+		Inflow:       math.ZeroInt(),
+		Outflow:      math.ZeroInt(),
+		ChannelValue: k.bankKeeper.GetSupply(ctx, path.Denom).Amount.Add(extraValue),
+		PeriodEnd:    ctx.BlockTime().Add(time.Duration(quota.DurationSeconds) * time.Second),
+	}
+}
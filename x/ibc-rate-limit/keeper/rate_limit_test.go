@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v11/testutil/apptesting"
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/keeper"
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+const (
+	testDenom   = "utest"
+	testChannel = "channel-0"
+)
+
+type RateLimitTestSuite struct {
+	apptesting.KeeperTestHelper
+	keeper *keeper.Keeper
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	suite.Setup()
+	suite.Ctx = suite.Ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+	suite.keeper = suite.App.RateLimitingICS4Wrapper.IbcratelimitKeeper
+}
+
+// setSupply makes the supply of the test denom equal to the amount.
+func (suite *RateLimitTestSuite) setSupply(amount int64) {
+	supply := suite.App.BankKeeper.GetSupply(suite.Ctx, testDenom).Amount
+	suite.Require().True(supply.LTE(sdkmath.NewInt(amount)))
+	if missing := sdkmath.NewInt(amount).Sub(supply); missing.IsPositive() {
+		suite.FundAcc(suite.SetupAddr(0), sdk.NewCoins(sdk.NewCoin(testDenom, missing)))
+	}
+}
+
+func (suite *RateLimitTestSuite) rateLimit(channelID string, quotas ...types.Quota) types.RateLimit {
+	return types.RateLimit{
+		Path:   types.RateLimitPath{Denom: testDenom, ChannelId: channelID},
+		Quotas: quotas,
+	}
+}
+
+func (suite *RateLimitTestSuite) TestSendWithinQuota() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+	))
+
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(60_000_000)))
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(40_000_000)))
+	err := suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// the inflow reduces the net outflow
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, false, testDenom, testChannel, sdkmath.NewInt(5_000_000)))
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(5_000_000)))
+
+	flow, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(105_000_000), flow.Outflow)
+	suite.Require().Equal(sdkmath.NewInt(5_000_000), flow.Inflow)
+	suite.Require().Equal(sdkmath.NewInt(1_000_000_000), flow.ChannelValue)
+}
+
+func (suite *RateLimitTestSuite) TestNoRateLimit() {
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(1_000_000_000)))
+	_, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().False(found)
+}
+
+func (suite *RateLimitTestSuite) TestPeriodReset() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+	))
+
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(100_000_000)))
+	err := suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(24 * time.Hour))
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(1)))
+
+	flow, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(1), flow.Outflow)
+	suite.Require().Equal(suite.Ctx.BlockTime().Add(24*time.Hour), flow.PeriodEnd)
+}
+
+func (suite *RateLimitTestSuite) TestRecvIncludesAmountInChannelValue() {
+	suite.keeper.SetRateLimit(suite.Ctx, types.RateLimit{
+		Path: types.RateLimitPath{Denom: "ibc/new", ChannelId: testChannel},
+		Quotas: []types.Quota{
+			{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 100, DurationSeconds: 86400},
+		},
+	})
+
+	// the denom has no supply yet, the first transfer still fits in the quota
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, false, "ibc/new", testChannel, sdkmath.NewInt(1_000)))
+	err := suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, false, "ibc/new", testChannel, sdkmath.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+}
+
+func (suite *RateLimitTestSuite) TestReleaseExcludesAmountFromChannelValue() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+	))
+
+	// the released tokens are already a part of the supply
+	suite.Require().NoError(suite.keeper.CheckAndUpdateReleaseRateLimits(suite.Ctx, testDenom, testChannel, sdkmath.NewInt(100_000_000)))
+	err := suite.keeper.CheckAndUpdateReleaseRateLimits(suite.Ctx, testDenom, testChannel, sdkmath.NewInt(1))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	flow, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(1_000_000_000), flow.ChannelValue)
+}
+
+func (suite *RateLimitTestSuite) TestAnyChannel() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 50, MaxPercentRecv: 50, DurationSeconds: 86400},
+	))
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(types.AnyChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+	))
+
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, "channel-1", sdkmath.NewInt(60_000_000)))
+	err := suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(50_000_000))
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	// the exceeded quota of any channel prevents the update of the channel quota
+	_, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().False(found)
+}
+
+func (suite *RateLimitTestSuite) TestUndoSend() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+	))
+
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(100_000_000)))
+	suite.keeper.UndoSend(suite.Ctx, testDenom, testChannel, sdkmath.NewInt(100_000_000))
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(100_000_000)))
+}
+
+func (suite *RateLimitTestSuite) TestUndoRecv() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+	))
+
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, false, testDenom, testChannel, sdkmath.NewInt(100_000_000)))
+	suite.keeper.UndoRecv(suite.Ctx, testDenom, testChannel, sdkmath.NewInt(100_000_000))
+
+	flow, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().True(found)
+	suite.Require().True(flow.Inflow.IsZero())
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, false, testDenom, testChannel, sdkmath.NewInt(100_000_000)))
+}
+
+func (suite *RateLimitTestSuite) TestSetAndDeleteRateLimit() {
+	suite.setSupply(1_000_000_000)
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400},
+		types.Quota{Name: "weekly", MaxPercentSend: 20, MaxPercentRecv: 20, DurationSeconds: 604800},
+	))
+	suite.Require().NoError(suite.keeper.CheckAndUpdateRateLimits(suite.Ctx, true, testDenom, testChannel, sdkmath.NewInt(1)))
+
+	// replacing the quotas keeps the flows of the kept quotas only
+	suite.keeper.SetRateLimit(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "weekly", MaxPercentSend: 30, MaxPercentRecv: 30, DurationSeconds: 604800},
+	))
+	_, found := suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "daily")
+	suite.Require().False(found)
+	_, found = suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "weekly")
+	suite.Require().True(found)
+
+	usages := suite.keeper.GetQuotaUsages(suite.Ctx, suite.rateLimit(testChannel,
+		types.Quota{Name: "weekly", MaxPercentSend: 30, MaxPercentRecv: 30, DurationSeconds: 604800},
+	))
+	suite.Require().Len(usages, 1)
+	suite.Require().Equal(sdkmath.NewInt(1), usages[0].Flow.Outflow)
+
+	suite.Require().Len(suite.keeper.GetAllRateLimits(suite.Ctx), 1)
+	suite.keeper.DeleteRateLimit(suite.Ctx, testDenom, testChannel)
+	_, found = suite.keeper.GetRateLimit(suite.Ctx, testDenom, testChannel)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetFlow(suite.Ctx, testDenom, testChannel, "weekly")
+	suite.Require().False(found)
+	suite.Require().Empty(suite.keeper.GetAllRateLimits(suite.Ctx))
+}
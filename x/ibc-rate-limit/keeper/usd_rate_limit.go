@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+// SetUsdRateLimit stores the USD rate limit of the channel replacing an existing one. The flow of the
+// current period is preserved.
+func (k Keeper) SetUsdRateLimit(ctx sdk.Context, rateLimit types.UsdRateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUsdRateLimitKey(rateLimit.ChannelId), k.cdc.MustMarshal(&rateLimit))
+}
+
+// GetUsdRateLimit returns the USD rate limit of the channel.
+func (k Keeper) GetUsdRateLimit(ctx sdk.Context, channelID string) (types.UsdRateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUsdRateLimitKey(channelID))
+	if bz == nil {
+		return types.UsdRateLimit{}, false
+	}
+
+	var rateLimit types.UsdRateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// DeleteUsdRateLimit removes the USD rate limit of the channel along with its flow.
+func (k Keeper) DeleteUsdRateLimit(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUsdRateLimitKey(channelID))
+	store.Delete(types.GetUsdFlowKey(channelID))
+}
+
+// GetAllUsdRateLimits returns all the USD rate limits.
+func (k Keeper) GetAllUsdRateLimits(ctx sdk.Context) (rateLimits []types.UsdRateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.UsdRateLimitKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.UsdRateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+	return rateLimits
+}
+
+// GetUsdFlow returns the flow of the USD rate limit of the channel.
+func (k Keeper) GetUsdFlow(ctx sdk.Context, channelID string) (types.UsdFlow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUsdFlowKey(channelID))
+	if bz == nil {
+		return types.UsdFlow{}, false
+	}
+
+	var flow types.UsdFlow
+	k.cdc.MustUnmarshal(bz, &flow)
+	return flow, true
+}
+
+// SetUsdFlow stores the flow of the USD rate limit of the channel.
+func (k Keeper) SetUsdFlow(ctx sdk.Context, channelID string, flow types.UsdFlow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetUsdFlowKey(channelID), k.cdc.MustMarshal(&flow))
+}
+
+// GetCurrentUsdFlow returns the flow of the USD rate limit as it is in the current period.
+func (k Keeper) GetCurrentUsdFlow(ctx sdk.Context, rateLimit types.UsdRateLimit) types.UsdFlow {
+	flow, found := k.GetUsdFlow(ctx, rateLimit.ChannelId)
+	if found && ctx.BlockTime().Before(flow.PeriodEnd) {
+		return flow
+	}
+
+	return types.UsdFlow{
+		Inflow:    math.LegacyZeroDec(),
+		Outflow:   math.LegacyZeroDec(),
+		PeriodEnd: ctx.BlockTime().Add(time.Duration(rateLimit.DurationSeconds) * time.Second),
+	}
+}
+
+// CheckAndUpdateUsdRateLimit checks that the transfer of the amount of the denom keeps the net flow in USD
+// over the channel within the USD rate limit of the channel and records the transfer in the flow. The
+// transfers of the denoms without a pricing in the params are not counted, the transfers of the denoms
+// whose price is stale or missing are handled according to the stale price policy.
+func (k Keeper) CheckAndUpdateUsdRateLimit(ctx sdk.Context, send bool, denom, channelID string, amount math.Int) error {
+	rateLimit, found := k.GetUsdRateLimit(ctx, channelID)
+	if !found {
+		return nil
+	}
+
+	value, counted, err := k.ValueInUsd(ctx, denom, amount)
+	if err != nil {
+		return err
+	}
+	if !counted {
+		return nil
+	}
+
+	flow := k.GetCurrentUsdFlow(ctx, rateLimit)
+	if send {
+		if !flow.CheckOutflow(rateLimit, value) {
+			return errorsmod.Wrapf(types.ErrRateLimitExceeded, "outflow of %s USD over channel %s exceeds the USD rate limit", value, channelID)
+		}
+		flow.Outflow = flow.Outflow.Add(value)
+	} else {
+		if !flow.CheckInflow(rateLimit, value) {
+			return errorsmod.Wrapf(types.ErrRateLimitExceeded, "inflow of %s USD over channel %s exceeds the USD rate limit", value, channelID)
+		}
+		flow.Inflow = flow.Inflow.Add(value)
+	}
+
+	k.SetUsdFlow(ctx, channelID, flow)
+	return nil
+}
+
+// UndoUsdSend reverts the outflow recorded for a transfer that failed or timed out. The transfer is valued
+// at the current price, so the reverted value may differ from the recorded one.
+func (k Keeper) UndoUsdSend(ctx sdk.Context, denom, channelID string, amount math.Int) {
+	k.undoUsdFlow(ctx, true, denom, channelID, amount)
+}
+
+// UndoUsdRecv reverts the inflow recorded for a received transfer that was refunded to the sender. Like
+// in UndoUsdSend, the transfer is valued at the current price.
+func (k Keeper) UndoUsdRecv(ctx sdk.Context, denom, channelID string, amount math.Int) {
+	k.undoUsdFlow(ctx, false, denom, channelID, amount)
+}
+
+func (k Keeper) undoUsdFlow(ctx sdk.Context, send bool, denom, channelID string, amount math.Int) {
+	flow, found := k.GetUsdFlow(ctx, channelID)
+	if !found || !ctx.BlockTime().Before(flow.PeriodEnd) {
+		return
+	}
+
+	value, counted, err := k.ValueInUsd(ctx, denom, amount)
+	if err != nil || !counted {
+		return
+	}
+
+	if send {
+		flow.Outflow = math.LegacyMaxDec(flow.Outflow.Sub(value), math.LegacyZeroDec())
+	} else {
+		flow.Inflow = math.LegacyMaxDec(flow.Inflow.Sub(value), math.LegacyZeroDec())
+	}
+	k.SetUsdFlow(ctx, channelID, flow)
+}
+
+// ValueInUsd returns the value of the amount of the denom in USD using the oracle price of the currency
+// pair set for the denom in the params. If the denom has no pricing, or its price is stale or missing and
+// the stale price policy is to skip such transfers, the returned flag is false.
+func (k Keeper) ValueInUsd(ctx sdk.Context, denom string, amount math.Int) (math.LegacyDec, bool, error) {
+	params := k.GetParams(ctx)
+	pricing, found := params.GetDenomPricing(denom)
+	if !found {
+		return math.LegacyZeroDec(), false, nil
+	}
+
+	price, priceDecimals, err := k.getPrice(ctx, pricing.CurrencyPair)
+	stale := err == nil && params.MaxPriceAgeSeconds > 0 &&
+		ctx.BlockTime().Sub(price.BlockTimestamp) > time.Duration(params.MaxPriceAgeSeconds)*time.Second
+	if err != nil || stale {
+		if params.StalePricePolicy == types.STALE_PRICE_POLICY_REJECT {
+			return math.LegacyZeroDec(), false, errorsmod.Wrapf(types.ErrPriceUnavailable, "price of %s is stale or missing", pricing.CurrencyPair)
+		}
+		// the last price can only be used if there is one
+		if params.StalePricePolicy != types.STALE_PRICE_POLICY_USE_LAST_PRICE || err != nil {
+			return math.LegacyZeroDec(), false, nil
+		}
+	}
+
+	// value = amount * price / 10^(price decimals + denom decimals)
+	value := math.LegacyNewDecFromInt(amount.Mul(price.Price)).
+		Quo(math.LegacyNewDec(10).Power(priceDecimals + uint64(pricing.Decimals)))
+	return value, true, nil
+}
+
+// getPrice returns the oracle price of the currency pair along with the number of its decimals.
+func (k Keeper) getPrice(ctx sdk.Context, currencyPair string) (price oracletypes.QuotePrice, decimals uint64, err error) {
+	cp, err := slinkytypes.CurrencyPairFromString(currencyPair)
+	if err != nil {
+		return price, 0, err
+	}
+
+	price, err = k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil {
+		return price, 0, err
+	}
+	if price.Price.IsNil() || !price.Price.IsPositive() {
+		return price, 0, errorsmod.Wrapf(types.ErrPriceUnavailable, "no price for %s", currencyPair)
+	}
+
+	decimals, err = k.oracleKeeper.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return price, 0, err
+	}
+
+	return price, decimals, nil
+}
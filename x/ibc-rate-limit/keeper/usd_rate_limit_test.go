@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/neutron-org/neutron/v11/testutil/ibc-rate-limit/keeper"
+	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/ibc-rate-limit/types"
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+var ntrnUsd = slinkytypes.NewCurrencyPair("NTRN", "USD")
+
+func TestUsdRateLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	oracleKeeper := mock_types.NewMockOracleKeeper(ctrl)
+	k, ctx := testkeeper.IbcRateLimitKeeper(t, mock_types.NewMockBankKeeper(ctrl), oracleKeeper, mock_types.NewMockWasmKeeper(ctrl))
+	ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+	require.NoError(t, k.SetParams(ctx, types.Params{
+		DenomPricings: []types.DenomPricing{{Denom: "untrn", CurrencyPair: ntrnUsd.String(), Decimals: 6}},
+	}))
+	k.SetUsdRateLimit(ctx, types.UsdRateLimit{
+		ChannelId:       testChannel,
+		MaxSendUsd:      sdkmath.LegacyNewDec(100),
+		MaxRecvUsd:      sdkmath.LegacyNewDec(50),
+		DurationSeconds: 86400,
+	})
+
+	// 0.5 USD with 8 decimals
+	oracleKeeper.EXPECT().GetPriceForCurrencyPair(gomock.Any(), ntrnUsd).Return(oracletypes.QuotePrice{
+		Price:          sdkmath.NewInt(50_000_000),
+		BlockTimestamp: ctx.BlockTime(),
+	}, nil).AnyTimes()
+	oracleKeeper.EXPECT().GetDecimalsForCurrencyPair(gomock.Any(), ntrnUsd).Return(uint64(8), nil).AnyTimes()
+
+	// 200 NTRN are worth 100 USD
+	require.NoError(t, k.CheckAndUpdateUsdRateLimit(ctx, true, "untrn", testChannel, sdkmath.NewInt(200_000_000)))
+	err := k.CheckAndUpdateUsdRateLimit(ctx, true, "untrn", testChannel, sdkmath.NewInt(2))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	// the denoms without a pricing are not counted
+	require.NoError(t, k.CheckAndUpdateUsdRateLimit(ctx, true, "uatom", testChannel, sdkmath.NewInt(1_000_000_000)))
+
+	// the channels without a USD rate limit are not limited
+	require.NoError(t, k.CheckAndUpdateUsdRateLimit(ctx, true, "untrn", "channel-1", sdkmath.NewInt(1_000_000_000)))
+
+	// the inflow reduces the net outflow
+	require.NoError(t, k.CheckAndUpdateUsdRateLimit(ctx, false, "untrn", testChannel, sdkmath.NewInt(20_000_000)))
+	require.NoError(t, k.CheckAndUpdateUsdRateLimit(ctx, true, "untrn", testChannel, sdkmath.NewInt(20_000_000)))
+
+	flow, found := k.GetUsdFlow(ctx, testChannel)
+	require.True(t, found)
+	require.Equal(t, sdkmath.LegacyNewDec(110), flow.Outflow)
+	require.Equal(t, sdkmath.LegacyNewDec(10), flow.Inflow)
+
+	// the undone send is valued at the current price
+	k.UndoUsdSend(ctx, "untrn", testChannel, sdkmath.NewInt(20_000_000))
+	flow, _ = k.GetUsdFlow(ctx, testChannel)
+	require.Equal(t, sdkmath.LegacyNewDec(100), flow.Outflow)
+
+	// the flow is reset once the period ends
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(24 * time.Hour))
+	require.NoError(t, k.CheckAndUpdateUsdRateLimit(ctx, false, "untrn", testChannel, sdkmath.NewInt(100_000_000)))
+	err = k.CheckAndUpdateUsdRateLimit(ctx, false, "untrn", testChannel, sdkmath.NewInt(2))
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+
+	k.DeleteUsdRateLimit(ctx, testChannel)
+	_, found = k.GetUsdFlow(ctx, testChannel)
+	require.False(t, found)
+	require.Empty(t, k.GetAllUsdRateLimits(ctx))
+}
+
+func TestValueInUsdStalePrice(t *testing.T) {
+	testCases := map[string]struct {
+		policy     types.StalePricePolicy
+		priceErr   error
+		expCounted bool
+		expErr     error
+	}{
+		"skip stale price": {
+			policy:     types.STALE_PRICE_POLICY_SKIP,
+			expCounted: false,
+		},
+		"use last price": {
+			policy:     types.STALE_PRICE_POLICY_USE_LAST_PRICE,
+			expCounted: true,
+		},
+		"use last price when missing": {
+			policy:     types.STALE_PRICE_POLICY_USE_LAST_PRICE,
+			priceErr:   fmt.Errorf("no price"),
+			expCounted: false,
+		},
+		"reject stale price": {
+			policy: types.STALE_PRICE_POLICY_REJECT,
+			expErr: types.ErrPriceUnavailable,
+		},
+		"reject missing price": {
+			policy:   types.STALE_PRICE_POLICY_REJECT,
+			priceErr: fmt.Errorf("no price"),
+			expErr:   types.ErrPriceUnavailable,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			oracleKeeper := mock_types.NewMockOracleKeeper(ctrl)
+			k, ctx := testkeeper.IbcRateLimitKeeper(t, mock_types.NewMockBankKeeper(ctrl), oracleKeeper, mock_types.NewMockWasmKeeper(ctrl))
+			ctx = ctx.WithBlockTime(time.Unix(1_700_000_000, 0))
+
+			require.NoError(t, k.SetParams(ctx, types.Params{
+				DenomPricings:      []types.DenomPricing{{Denom: "untrn", CurrencyPair: ntrnUsd.String(), Decimals: 6}},
+				MaxPriceAgeSeconds: 60,
+				StalePricePolicy:   tc.policy,
+			}))
+
+			// the price is two minutes old
+			oracleKeeper.EXPECT().GetPriceForCurrencyPair(gomock.Any(), ntrnUsd).Return(oracletypes.QuotePrice{
+				Price:          sdkmath.NewInt(50_000_000),
+				BlockTimestamp: ctx.BlockTime().Add(-2 * time.Minute),
+			}, tc.priceErr)
+			if tc.priceErr == nil {
+				oracleKeeper.EXPECT().GetDecimalsForCurrencyPair(gomock.Any(), ntrnUsd).Return(uint64(8), nil)
+			}
+
+			value, counted, err := k.ValueInUsd(ctx, "untrn", sdkmath.NewInt(2_000_000))
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expCounted, counted)
+			if counted {
+				require.Equal(t, sdkmath.LegacyOneDec(), value)
+			}
+		})
+	}
+}
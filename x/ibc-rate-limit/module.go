@@ -31,6 +31,7 @@ var (
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 type AppModuleBasic struct {
@@ -145,5 +146,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock releases the queued transfers which fit into the rate limits.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.ics4wrapper.ReleaseQueuedTransfers(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
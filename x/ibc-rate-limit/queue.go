@@ -0,0 +1,69 @@
+package ibcratelimit
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
+)
+
+// queueTransfer receives the tokens of the transfer exceeding the rate limits on the queue escrow address
+// and records the transfer in the queue. Only plain ICS-20 transfers without a memo to a valid receiver are
+// queued, since the actions requested in a memo can't be postponed.
+func (im *IBCModule) queueTransfer(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) (exported.Acknowledgement, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil || data.Memo != "" {
+		return nil, false
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil || im.ics4Middleware.bankKeeper.BlockedAddr(receiver) {
+		return nil, false
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return nil, false
+	}
+
+	escrowedPacket := packet
+	escrowedData := data
+	escrowedData.Receiver = types.QueueEscrowAddress.String()
+	escrowedPacket.Data = escrowedData.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, channelVersion, escrowedPacket, relayer)
+	if ack == nil || !ack.Success() {
+		return ack, true
+	}
+
+	coin := sdk.NewCoin(receivedDenom(packet, data), amount)
+	im.ics4Middleware.IbcratelimitKeeper.QueueTransfer(ctx, packet, data.Receiver, coin)
+
+	return ack, true
+}
+
+// ReleaseQueuedTransfers releases the queued transfers whose release attempt is due and which fit into the
+// rate limits now. The transfers which still exceed the rate limits are rescheduled.
+func (i *ICS4Wrapper) ReleaseQueuedTransfers(ctx sdk.Context) {
+	for _, queuedTransfer := range i.IbcratelimitKeeper.GetReadyQueuedTransfers(ctx, types.MaxQueueReleasesPerBlock) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := i.CheckReleaseRateLimits(cacheCtx, queuedTransfer.Packet)
+		if err == nil {
+			err = i.IbcratelimitKeeper.ReleaseTransfer(cacheCtx, queuedTransfer, types.ModuleName)
+		}
+		if err != nil {
+			ctx.Logger().Debug("queued transfer is not released", "id", queuedTransfer.Id, "error", err)
+			i.IbcratelimitKeeper.RescheduleQueuedTransfer(ctx, queuedTransfer)
+			continue
+		}
+		writeCache()
+	}
+}
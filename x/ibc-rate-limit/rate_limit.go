@@ -4,18 +4,23 @@ import (
 	"encoding/json"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v10/modules/core/exported"
 
+	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/keeper"
 	"github.com/neutron-org/neutron/v11/x/ibc-rate-limit/types"
 )
 
 var (
 	msgSend = "send_packet"
 	msgRecv = "recv_packet"
+	// msgRelease is the release of a queued incoming transfer, which is checked as a received packet
+	// whose tokens have already been minted
+	msgRelease = "release_packet"
 )
 
 func CheckAndUpdateRateLimits(ctx sdk.Context, contractKeeper *wasmkeeper.PermissionedKeeper,
@@ -151,3 +156,129 @@ func BuildWasmExecMsg(msgType string, packet exported.PacketI) ([]byte, error) {
 
 	return asJSON, nil
 }
+
+// CheckAndUpdateNativeRateLimits checks the transfer of the packet against the native rate limits of the
+// denom over the channel on the Neutron side and records it in the flows.
+func CheckAndUpdateNativeRateLimits(ctx sdk.Context, rateLimitKeeper *keeper.Keeper, msgType string, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
+		return errorsmod.Wrap(types.ErrBadMessage, err.Error())
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetData.Amount)
+	}
+
+	switch msgType {
+	case msgSend:
+		return rateLimitKeeper.CheckAndUpdateRateLimits(ctx, true, sentDenom(packetData), packet.GetSourceChannel(), amount)
+	case msgRecv:
+		return rateLimitKeeper.CheckAndUpdateRateLimits(ctx, false, receivedDenom(packet, packetData), packet.GetDestChannel(), amount)
+	case msgRelease:
+		return rateLimitKeeper.CheckAndUpdateReleaseRateLimits(ctx, receivedDenom(packet, packetData), packet.GetDestChannel(), amount)
+	default:
+		return types.ErrBadMessage
+	}
+}
+
+// UndoSendNativeRateLimit reverts the outflow recorded by the native rate limits for the sent packet.
+func UndoSendNativeRateLimit(ctx sdk.Context, rateLimitKeeper *keeper.Keeper, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
+		return errorsmod.Wrap(types.ErrBadMessage, err.Error())
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetData.Amount)
+	}
+
+	rateLimitKeeper.UndoSend(ctx, sentDenom(packetData), packet.GetSourceChannel(), amount)
+	return nil
+}
+
+// UndoRecvNativeRateLimit reverts the inflow recorded by the native rate limits for the received packet.
+func UndoRecvNativeRateLimit(ctx sdk.Context, rateLimitKeeper *keeper.Keeper, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil {
+		return errorsmod.Wrap(types.ErrBadMessage, err.Error())
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetData.Amount)
+	}
+
+	rateLimitKeeper.UndoRecv(ctx, receivedDenom(packet, packetData), packet.GetDestChannel(), amount)
+	return nil
+}
+
+// sentDenom returns the denom of the sent tokens on Neutron, e.g. untrn or ibc/{hash}.
+func sentDenom(packetData transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ExtractDenomFromPath(packetData.Denom).IBCDenom()
+}
+
+// receivedDenom returns the denom the received tokens have on Neutron: the tokens returning to Neutron
+// lose the source hop, the others get the destination hop prepended.
+func receivedDenom(packet exported.PacketI, packetData transfertypes.FungibleTokenPacketData) string {
+	denom := transfertypes.ExtractDenomFromPath(packetData.Denom)
+	if denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		denom.Trace = denom.Trace[1:]
+	} else {
+		denom.Trace = append([]transfertypes.Hop{transfertypes.NewHop(packet.GetDestPort(), packet.GetDestChannel())}, denom.Trace...)
+	}
+	return denom.IBCDenom()
+}
+
+// CheckAndUpdateUsdRateLimits checks the transfer of the packet against the USD rate limit of the channel on
+// the Neutron side and records it in the flow.
+func CheckAndUpdateUsdRateLimits(ctx sdk.Context, rateLimitKeeper *keeper.Keeper, msgType string, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil || packetData.Denom == "" || packetData.Amount == "" {
+		// not an ICS-20 transfer, there is nothing to value
+		return nil
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetData.Amount)
+	}
+
+	switch msgType {
+	case msgSend:
+		return rateLimitKeeper.CheckAndUpdateUsdRateLimit(ctx, true, sentDenom(packetData), packet.GetSourceChannel(), amount)
+	case msgRecv:
+		return rateLimitKeeper.CheckAndUpdateUsdRateLimit(ctx, false, receivedDenom(packet, packetData), packet.GetDestChannel(), amount)
+	default:
+		return types.ErrBadMessage
+	}
+}
+
+// UndoSendUsdRateLimit reverts the outflow recorded by the USD rate limit for the sent packet.
+func UndoSendUsdRateLimit(ctx sdk.Context, rateLimitKeeper *keeper.Keeper, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil || packetData.Denom == "" || packetData.Amount == "" {
+		// not an ICS-20 transfer, there is nothing to value
+		return nil
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetData.Amount)
+	}
+
+	rateLimitKeeper.UndoUsdSend(ctx, sentDenom(packetData), packet.GetSourceChannel(), amount)
+	return nil
+}
+
+// UndoRecvUsdRateLimit reverts the inflow recorded by the USD rate limit for the received packet.
+func UndoRecvUsdRateLimit(ctx sdk.Context, rateLimitKeeper *keeper.Keeper, packet exported.PacketI) error {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &packetData); err != nil || packetData.Denom == "" || packetData.Amount == "" {
+		// not an ICS-20 transfer, there is nothing to value
+		return nil
+	}
+	amount, ok := math.NewIntFromString(packetData.Amount)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrBadMessage, "invalid amount %s", packetData.Amount)
+	}
+
+	rateLimitKeeper.UndoUsdRecv(ctx, receivedDenom(packet, packetData), packet.GetDestChannel(), amount)
+	return nil
+}
@@ -9,12 +9,22 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "neutron/ibc-rate-limit/update-params", nil)
+	cdc.RegisterConcrete(&MsgAddRateLimit{}, "neutron/ibc-rate-limit/MsgAddRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "neutron/ibc-rate-limit/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgAddUsdRateLimit{}, "neutron/ibc-rate-limit/MsgAddUsdRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveUsdRateLimit{}, "neutron/ibc-rate-limit/MsgRemoveUsdRateLimit", nil)
+	cdc.RegisterConcrete(&MsgReleaseQueuedTransfer{}, "neutron/ibc-rate-limit/MsgReleaseQueuedTransfer", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgAddRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgAddUsdRateLimit{},
+		&MsgRemoveUsdRateLimit{},
+		&MsgReleaseQueuedTransfer{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
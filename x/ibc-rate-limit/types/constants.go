@@ -1,6 +1,23 @@
 package types
 
+import (
+	"math"
+	"time"
+)
+
 const (
 	MaxSupportedIBCReceiverAddressLength = 4096
 	RateLimitExceededSubStr              = "rate limit exceeded"
+	// AnyChannel is used as the channel of a rate limit path to limit the flow of a denom over all channels
+	AnyChannel = "any"
+	// MaxQuotaPercent is the maximum percent of the channel value a quota can allow to send or receive
+	MaxQuotaPercent = 100
+	// MaxDurationSeconds is the maximum duration of a rate limit period that fits in time.Duration
+	MaxDurationSeconds = uint64(math.MaxInt64 / int64(time.Second))
+	// MaxDenomDecimals is the maximum number of decimals of a denom priced for the USD rate limits
+	MaxDenomDecimals = 18
+	// DefaultQueueRetrySeconds is the default interval between the attempts to release a queued transfer
+	DefaultQueueRetrySeconds = 3600
+	// MaxQueueReleasesPerBlock is the maximum number of queued transfers the module attempts to release per block
+	MaxQueueReleasesPerBlock = 100
 )
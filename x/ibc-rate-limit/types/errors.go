@@ -5,7 +5,13 @@ import (
 )
 
 var (
-	ErrRateLimitExceeded = errorsmod.Register(ModuleName, 2, "rate limit exceeded")
-	ErrBadMessage        = errorsmod.Register(ModuleName, 3, "bad message")
-	ErrContractError     = errorsmod.Register(ModuleName, 4, "contract error")
+	ErrRateLimitExceeded      = errorsmod.Register(ModuleName, 2, "rate limit exceeded")
+	ErrBadMessage             = errorsmod.Register(ModuleName, 3, "bad message")
+	ErrContractError          = errorsmod.Register(ModuleName, 4, "contract error")
+	ErrInvalidRateLimit       = errorsmod.Register(ModuleName, 5, "invalid rate limit")
+	ErrRateLimitNotFound      = errorsmod.Register(ModuleName, 6, "rate limit not found")
+	ErrPriceUnavailable       = errorsmod.Register(ModuleName, 7, "price unavailable")
+	ErrInvalidParams          = errorsmod.Register(ModuleName, 8, "invalid params")
+	ErrQueuedTransferNotFound = errorsmod.Register(ModuleName, 9, "queued transfer not found")
+	ErrUnauthorized           = errorsmod.Register(ModuleName, 10, "unauthorized")
 )
@@ -5,4 +5,13 @@ const (
 	AttributeKeyPacket      = "packet"
 	AttributeKeyAck         = "acknowledgement"
 	AttributeKeyFailureType = "failure_type"
+
+	EventTypeTransferQueued        = "transfer_queued"
+	EventTypeTransferReleased      = "transfer_released"
+	AttributeKeyQueuedTransferID   = "queued_transfer_id"
+	AttributeKeyReceiver           = "receiver"
+	AttributeKeyRecipient          = "recipient"
+	AttributeKeyAmount             = "amount"
+	AttributeKeyReleasedBy         = "released_by"
+	AttributeKeyNextReleaseAttempt = "next_release_attempt"
 )
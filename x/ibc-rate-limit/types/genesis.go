@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -13,5 +19,46 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	seen := make(map[string]bool, len(gs.RateLimits))
+	for _, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		key := string(GetRateLimitPathKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for denom %s and channel %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	seenChannels := make(map[string]bool, len(gs.UsdRateLimits))
+	for _, rateLimit := range gs.UsdRateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		if seenChannels[rateLimit.ChannelId] {
+			return fmt.Errorf("duplicate USD rate limit for channel %s", rateLimit.ChannelId)
+		}
+		seenChannels[rateLimit.ChannelId] = true
+	}
+
+	seenIDs := make(map[uint64]bool, len(gs.QueuedTransfers))
+	for _, queuedTransfer := range gs.QueuedTransfers {
+		if queuedTransfer.Id == 0 || queuedTransfer.Id > gs.LastQueuedTransferId {
+			return fmt.Errorf("queued transfer id %d must be positive and not greater than the last id %d", queuedTransfer.Id, gs.LastQueuedTransferId)
+		}
+		if seenIDs[queuedTransfer.Id] {
+			return fmt.Errorf("duplicate queued transfer id %d", queuedTransfer.Id)
+		}
+		seenIDs[queuedTransfer.Id] = true
+		if _, err := sdk.AccAddressFromBech32(queuedTransfer.Receiver); err != nil {
+			return fmt.Errorf("invalid receiver of queued transfer %d: %w", queuedTransfer.Id, err)
+		}
+		if err := queuedTransfer.Coin.Validate(); err != nil {
+			return fmt.Errorf("invalid coin of queued transfer %d: %w", queuedTransfer.Id, err)
+		}
+	}
+
 	return nil
 }
@@ -29,6 +29,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params are all the parameters of the module
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// rate_limits are the native rate limits added by governance
+	RateLimits []RateLimit `protobuf:"bytes,2,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// usd_rate_limits are the channel rate limits in USD added by governance
+	UsdRateLimits []UsdRateLimit `protobuf:"bytes,3,rep,name=usd_rate_limits,json=usdRateLimits,proto3" json:"usd_rate_limits"`
+	// queued_transfers are the incoming transfers waiting to be released
+	QueuedTransfers []QueuedTransfer `protobuf:"bytes,4,rep,name=queued_transfers,json=queuedTransfers,proto3" json:"queued_transfers"`
+	// last_queued_transfer_id is the id of the last queued transfer
+	LastQueuedTransferId uint64 `protobuf:"varint,5,opt,name=last_queued_transfer_id,json=lastQueuedTransferId,proto3" json:"last_queued_transfer_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,6 +79,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsdRateLimits() []UsdRateLimit {
+	if m != nil {
+		return m.UsdRateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedTransfers() []QueuedTransfer {
+	if m != nil {
+		return m.QueuedTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastQueuedTransferId() uint64 {
+	if m != nil {
+		return m.LastQueuedTransferId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.ibcratelimit.v1beta1.GenesisState")
 }
@@ -80,23 +116,31 @@ func init() {
 }
 
 var fileDescriptor_4a6a285b43c9c3fe = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x8e, 0xbf, 0x4a, 0xc4, 0x40,
-	0x10, 0x87, 0xb3, 0x20, 0x57, 0x44, 0xab, 0xc3, 0xc2, 0x3b, 0x64, 0x15, 0xb1, 0x50, 0x21, 0x3b,
-	0x44, 0x2b, 0xdb, 0x34, 0xb6, 0x72, 0xd7, 0xd9, 0xc8, 0x6e, 0x58, 0xd7, 0x85, 0x4b, 0x26, 0xec,
-	0x4e, 0x0e, 0xef, 0x2d, 0x7c, 0xac, 0x2b, 0xaf, 0xb4, 0x12, 0x49, 0x5e, 0x44, 0x92, 0x5d, 0xc1,
-	0x2a, 0xdd, 0xfc, 0xf9, 0xe6, 0x37, 0x5f, 0x7a, 0x57, 0xeb, 0x96, 0x1c, 0xd6, 0x60, 0x55, 0xe9,
-	0x24, 0xe9, 0x8d, 0xad, 0x2c, 0xc1, 0x36, 0x57, 0x9a, 0x64, 0x0e, 0x46, 0xd7, 0xda, 0x5b, 0x2f,
-	0x1a, 0x87, 0x84, 0xf3, 0xf3, 0xc8, 0x8a, 0xff, 0xac, 0x88, 0xec, 0x72, 0x51, 0xa2, 0xaf, 0xd0,
-	0xbf, 0x8e, 0x2c, 0x84, 0x26, 0x1c, 0x2e, 0x4f, 0x0d, 0x1a, 0x0c, 0xf3, 0xa1, 0x8a, 0xd3, 0x85,
-	0x41, 0x34, 0x1b, 0x0d, 0x63, 0xa7, 0xda, 0x37, 0x90, 0xf5, 0x2e, 0xae, 0x6e, 0x27, 0xad, 0x1a,
-	0xe9, 0x64, 0x15, 0xb3, 0xaf, 0x56, 0xe9, 0xc9, 0x53, 0xb0, 0x5c, 0x93, 0x24, 0x3d, 0x2f, 0xd2,
-	0x59, 0xd8, 0x9f, 0xb1, 0x4b, 0x76, 0x73, 0x7c, 0x7f, 0x2d, 0xa6, 0xac, 0xc5, 0xf3, 0xc8, 0x16,
-	0x47, 0xfb, 0xef, 0x8b, 0x64, 0x15, 0x2f, 0x8b, 0xf5, 0xbe, 0xe3, 0xec, 0xd0, 0x71, 0xf6, 0xd3,
-	0x71, 0xf6, 0xd9, 0xf3, 0xe4, 0xd0, 0xf3, 0xe4, 0xab, 0xe7, 0xc9, 0xcb, 0xa3, 0xb1, 0xf4, 0xde,
-	0x2a, 0x51, 0x62, 0x05, 0x31, 0x37, 0x43, 0x67, 0xfe, 0x6a, 0xd8, 0xe6, 0x39, 0x7c, 0x0c, 0xd6,
-	0xd9, 0xf0, 0x2a, 0x0b, 0xde, 0xb4, 0x6b, 0xb4, 0x57, 0xb3, 0xd1, 0xf7, 0xe1, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0xdf, 0xd6, 0x08, 0xf8, 0x72, 0x01, 0x00, 0x00,
+	// 374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0xc3, 0x30,
+	0x1c, 0xc5, 0x5b, 0x37, 0x77, 0xc8, 0x94, 0x49, 0x19, 0xd8, 0x0d, 0xa9, 0x43, 0x04, 0xe7, 0xb0,
+	0x0d, 0x9d, 0x78, 0xf0, 0xba, 0x8b, 0x08, 0x22, 0xba, 0x29, 0x88, 0x20, 0x25, 0x5d, 0xb3, 0x5a,
+	0x58, 0x9b, 0x2d, 0x49, 0x87, 0xfb, 0x16, 0xe2, 0xa7, 0xda, 0x71, 0x47, 0x4f, 0x22, 0xdb, 0x17,
+	0x91, 0x26, 0x19, 0x6e, 0x3b, 0xf4, 0x96, 0xbc, 0xbc, 0xf7, 0xcb, 0xfb, 0xf3, 0x07, 0xad, 0x04,
+	0xa7, 0x9c, 0x92, 0x04, 0x46, 0x7e, 0x9f, 0x22, 0x8e, 0x87, 0x51, 0x1c, 0x71, 0x38, 0x71, 0x7d,
+	0xcc, 0x91, 0x0b, 0x43, 0x9c, 0x60, 0x16, 0x31, 0x67, 0x44, 0x09, 0x27, 0xc6, 0x91, 0xf2, 0x3a,
+	0xeb, 0x5e, 0x47, 0x79, 0xeb, 0xb5, 0x3e, 0x61, 0x31, 0x61, 0x9e, 0xf0, 0x42, 0x79, 0x91, 0xc1,
+	0x7a, 0x35, 0x24, 0x21, 0x91, 0x7a, 0x76, 0x52, 0x6a, 0x2d, 0x24, 0x24, 0x1c, 0x62, 0x28, 0x6e,
+	0x7e, 0x3a, 0x80, 0x28, 0x99, 0xaa, 0xa7, 0xf3, 0xdc, 0x56, 0x23, 0x44, 0x51, 0xbc, 0x62, 0xdb,
+	0xb9, 0xd6, 0x4c, 0xf1, 0x64, 0x4f, 0x61, 0x3f, 0xf9, 0x2a, 0x80, 0xbd, 0x1b, 0x39, 0x55, 0x8f,
+	0x23, 0x8e, 0x8d, 0x0e, 0x28, 0x49, 0x9e, 0xa9, 0x37, 0xf4, 0x66, 0xb9, 0x7d, 0xea, 0xe4, 0x4d,
+	0xe9, 0x3c, 0x08, 0x6f, 0xa7, 0x38, 0xfb, 0x39, 0xd6, 0xba, 0x2a, 0x69, 0xdc, 0x83, 0xf2, 0xff,
+	0x47, 0xcc, 0xdc, 0x69, 0x14, 0x9a, 0xe5, 0xf6, 0x59, 0x3e, 0xa8, 0x8b, 0x38, 0xbe, 0xcb, 0x14,
+	0xc5, 0x02, 0x74, 0x25, 0x30, 0xe3, 0x05, 0x54, 0x52, 0x16, 0x78, 0xeb, 0xcc, 0x82, 0x60, 0xb6,
+	0xf2, 0x99, 0xcf, 0x2c, 0xd8, 0xc6, 0xee, 0xa7, 0x6b, 0x1a, 0x33, 0xde, 0xc0, 0xc1, 0x38, 0xc5,
+	0x29, 0x0e, 0x3c, 0x4e, 0x51, 0xc2, 0x06, 0x98, 0x32, 0xb3, 0x28, 0xd0, 0x17, 0xf9, 0xe8, 0x47,
+	0x91, 0x7a, 0x52, 0x21, 0x05, 0xaf, 0x8c, 0x37, 0x54, 0x66, 0x5c, 0x81, 0xc3, 0x21, 0x62, 0xdc,
+	0xdb, 0xfa, 0xc3, 0x8b, 0x02, 0x73, 0xb7, 0xa1, 0x37, 0x8b, 0xdd, 0x6a, 0xf6, 0xbc, 0xc9, 0xba,
+	0x0d, 0x3a, 0xbd, 0xd9, 0xc2, 0xd2, 0xe7, 0x0b, 0x4b, 0xff, 0x5d, 0x58, 0xfa, 0xe7, 0xd2, 0xd2,
+	0xe6, 0x4b, 0x4b, 0xfb, 0x5e, 0x5a, 0xda, 0xeb, 0x75, 0x18, 0xf1, 0xf7, 0xd4, 0x77, 0xfa, 0x24,
+	0x86, 0xaa, 0x9f, 0x4d, 0x68, 0xb8, 0x3a, 0xc3, 0x89, 0xeb, 0xc2, 0x8f, 0x6c, 0xf5, 0x76, 0x56,
+	0xd9, 0x96, 0xcb, 0xe7, 0xd3, 0x11, 0x66, 0x7e, 0x49, 0x2c, 0xfc, 0xf2, 0x6f, 0x00, 0xb9, 0xe3,
+	0x4b, 0x5d, 0xe2, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -119,6 +163,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastQueuedTransferId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastQueuedTransferId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.QueuedTransfers) > 0 {
+		for iNdEx := len(m.QueuedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UsdRateLimits) > 0 {
+		for iNdEx := len(m.UsdRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -151,6 +242,27 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsdRateLimits) > 0 {
+		for _, e := range m.UsdRateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedTransfers) > 0 {
+		for _, e := range m.QueuedTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastQueuedTransferId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastQueuedTransferId))
+	}
 	return n
 }
 
@@ -222,6 +334,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdRateLimits = append(m.UsdRateLimits, UsdRateLimit{})
+			if err := m.UsdRateLimits[len(m.UsdRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedTransfers = append(m.QueuedTransfers, QueuedTransfer{})
+			if err := m.QueuedTransfers[len(m.QueuedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastQueuedTransferId", wireType)
+			}
+			m.LastQueuedTransferId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastQueuedTransferId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
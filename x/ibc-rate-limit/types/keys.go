@@ -1,9 +1,22 @@
 package types
 
-import "strings"
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	prefixParamsKey = iota + 1
+	prefixRateLimitKey
+	prefixFlowKey
+	prefixUsdRateLimitKey
+	prefixUsdFlowKey
+	prefixQueuedTransferKey
+	prefixQueuedTransferReleaseKey
+	prefixLastQueuedTransferIDKey
 )
 
 const (
@@ -11,8 +24,67 @@ const (
 
 )
 
-var ParamsKey = []byte{prefixParamsKey}
+var (
+	ParamsKey       = []byte{prefixParamsKey}
+	RateLimitKey    = []byte{prefixRateLimitKey}
+	FlowKey         = []byte{prefixFlowKey}
+	UsdRateLimitKey = []byte{prefixUsdRateLimitKey}
+	UsdFlowKey      = []byte{prefixUsdFlowKey}
+
+	QueuedTransferKey        = []byte{prefixQueuedTransferKey}
+	QueuedTransferReleaseKey = []byte{prefixQueuedTransferReleaseKey}
+	LastQueuedTransferIDKey  = []byte{prefixLastQueuedTransferIDKey}
+)
+
+// QueueEscrowAddress is the address holding the tokens of the queued transfers.
+var QueueEscrowAddress = sdk.AccAddress(address.Module(ModuleName, []byte("queue")))
 
 // RouterKey is the message route. Can only contain
 // alphanumeric characters.
 var RouterKey = strings.ReplaceAll(ModuleName, "-", "")
+
+// GetRateLimitPathKey returns the part of the keys identifying the rate limit path.
+func GetRateLimitPathKey(denom, channelID string) []byte {
+	return append(append([]byte(denom), 0x00), []byte(channelID)...)
+}
+
+// GetRateLimitKey returns the key of the rate limit of the denom over the channel.
+func GetRateLimitKey(denom, channelID string) []byte {
+	return append(RateLimitKey, GetRateLimitPathKey(denom, channelID)...)
+}
+
+// GetFlowPrefix returns the prefix of the flows of the quotas of the rate limit.
+func GetFlowPrefix(denom, channelID string) []byte {
+	return append(append(FlowKey, GetRateLimitPathKey(denom, channelID)...), 0x00)
+}
+
+// GetFlowKey returns the key of the flow of the quota of the rate limit.
+func GetFlowKey(denom, channelID, quotaName string) []byte {
+	return append(GetFlowPrefix(denom, channelID), []byte(quotaName)...)
+}
+
+// GetUsdRateLimitKey returns the key of the USD rate limit of the channel.
+func GetUsdRateLimitKey(channelID string) []byte {
+	return append(UsdRateLimitKey, []byte(channelID)...)
+}
+
+// GetUsdFlowKey returns the key of the flow of the USD rate limit of the channel.
+func GetUsdFlowKey(channelID string) []byte {
+	return append(UsdFlowKey, []byte(channelID)...)
+}
+
+// GetQueuedTransferKey returns the key of the queued transfer.
+func GetQueuedTransferKey(id uint64) []byte {
+	return append(QueuedTransferKey, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetQueuedTransferReleasePrefix returns the prefix of the release index entries of the queued transfers
+// to be released at the time.
+func GetQueuedTransferReleasePrefix(releaseTime time.Time) []byte {
+	return append(QueuedTransferReleaseKey, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// GetQueuedTransferReleaseKey returns the key of the release index entry of the queued transfer.
+func GetQueuedTransferReleaseKey(releaseTime time.Time, id uint64) []byte {
+	return append(GetQueuedTransferReleasePrefix(releaseTime), sdk.Uint64ToBigEndian(id)...)
+}
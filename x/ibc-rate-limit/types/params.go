@@ -3,10 +3,15 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
 )
 
+// UsdTicker is the quote of the oracle currency pairs used to value the denoms for the USD rate limits.
+const UsdTicker = "USD"
+
 // Parameter store keys.
 var (
 	KeyContractAddress = []byte("contract")
@@ -27,7 +32,8 @@ func NewParams(contractAddress string) (Params, error) {
 // default gamm module parameters.
 func DefaultParams() Params {
 	return Params{
-		ContractAddress: "",
+		ContractAddress:   "",
+		QueueRetrySeconds: DefaultQueueRetrySeconds,
 	}
 }
 
@@ -37,6 +43,66 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	denoms := make(map[string]bool, len(p.DenomPricings))
+	for _, pricing := range p.DenomPricings {
+		if err := pricing.Validate(); err != nil {
+			return err
+		}
+		if denoms[pricing.Denom] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate pricing for denom %s", pricing.Denom)
+		}
+		denoms[pricing.Denom] = true
+	}
+
+	if p.MaxPriceAgeSeconds > MaxDurationSeconds {
+		return errorsmod.Wrap(ErrInvalidParams, "max price age is too long")
+	}
+
+	if p.SecurityAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(p.SecurityAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid security address: %s", err)
+		}
+	}
+
+	if p.QueueExceededTransfers && p.QueueRetrySeconds == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "queue retry interval must be positive when the transfers are queued")
+	}
+	if p.QueueRetrySeconds > MaxDurationSeconds {
+		return errorsmod.Wrap(ErrInvalidParams, "queue retry interval is too long")
+	}
+
+	if _, ok := StalePricePolicy_name[int32(p.StalePricePolicy)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown stale price policy %d", p.StalePricePolicy)
+	}
+
+	return nil
+}
+
+// GetDenomPricing returns the pricing of the denom for the USD rate limits.
+func (p Params) GetDenomPricing(denom string) (DenomPricing, bool) {
+	for _, pricing := range p.DenomPricings {
+		if pricing.Denom == denom {
+			return pricing, true
+		}
+	}
+	return DenomPricing{}, false
+}
+
+// Validate checks that the denom is valid and is priced by a currency pair quoted in USD.
+func (p DenomPricing) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	cp, err := slinkytypes.CurrencyPairFromString(p.CurrencyPair)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid currency pair of denom %s: %s", p.Denom, err)
+	}
+	if cp.Quote != UsdTicker {
+		return errorsmod.Wrapf(ErrInvalidParams, "currency pair of denom %s must be quoted in %s", p.Denom, UsdTicker)
+	}
+	if p.Decimals > MaxDenomDecimals {
+		return errorsmod.Wrapf(ErrInvalidParams, "decimals of denom %s cannot exceed %d", p.Denom, MaxDenomDecimals)
+	}
 	return nil
 }
 
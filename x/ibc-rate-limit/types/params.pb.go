@@ -23,9 +23,56 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// StalePricePolicy defines the fallback used when the oracle price of a denom is stale or missing.
+type StalePricePolicy int32
+
+const (
+	// the transfer is not counted towards the USD rate limits
+	STALE_PRICE_POLICY_SKIP StalePricePolicy = 0
+	// the last known price is used regardless of its age
+	STALE_PRICE_POLICY_USE_LAST_PRICE StalePricePolicy = 1
+	// the transfer is rejected
+	STALE_PRICE_POLICY_REJECT StalePricePolicy = 2
+)
+
+var StalePricePolicy_name = map[int32]string{
+	0: "STALE_PRICE_POLICY_SKIP",
+	1: "STALE_PRICE_POLICY_USE_LAST_PRICE",
+	2: "STALE_PRICE_POLICY_REJECT",
+}
+
+var StalePricePolicy_value = map[string]int32{
+	"STALE_PRICE_POLICY_SKIP":           0,
+	"STALE_PRICE_POLICY_USE_LAST_PRICE": 1,
+	"STALE_PRICE_POLICY_REJECT":         2,
+}
+
+func (x StalePricePolicy) String() string {
+	return proto.EnumName(StalePricePolicy_name, int32(x))
+}
+
+func (StalePricePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_96b2a3ecd8a27c06, []int{0}
+}
+
 // Params defines the parameters for the ibc-rate-limit module.
 type Params struct {
 	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	// if set, the rate limits are checked by the module using the rate limits added by governance
+	// instead of the contract
+	UseNativeRateLimits bool `protobuf:"varint,2,opt,name=use_native_rate_limits,json=useNativeRateLimits,proto3" json:"use_native_rate_limits,omitempty" yaml:"use_native_rate_limits"`
+	// the oracle currency pairs used to value the denoms for the USD rate limits
+	DenomPricings []DenomPricing `protobuf:"bytes,3,rep,name=denom_pricings,json=denomPricings,proto3" json:"denom_pricings" yaml:"denom_pricings"`
+	// the maximum age in seconds of an oracle price before it is considered stale, zero means prices never get stale
+	MaxPriceAgeSeconds uint64 `protobuf:"varint,4,opt,name=max_price_age_seconds,json=maxPriceAgeSeconds,proto3" json:"max_price_age_seconds,omitempty" yaml:"max_price_age_seconds"`
+	// what to do with the transfers of the denoms whose price is stale or missing
+	StalePricePolicy StalePricePolicy `protobuf:"varint,5,opt,name=stale_price_policy,json=stalePricePolicy,proto3,enum=neutron.ibcratelimit.v1beta1.StalePricePolicy" json:"stale_price_policy,omitempty" yaml:"stale_price_policy"`
+	// if set, the incoming transfers exceeding the rate limits are queued instead of being rejected
+	QueueExceededTransfers bool `protobuf:"varint,6,opt,name=queue_exceeded_transfers,json=queueExceededTransfers,proto3" json:"queue_exceeded_transfers,omitempty" yaml:"queue_exceeded_transfers"`
+	// the address allowed to release the queued transfers regardless of the rate limits
+	SecurityAddress string `protobuf:"bytes,7,opt,name=security_address,json=securityAddress,proto3" json:"security_address,omitempty" yaml:"security_address"`
+	// the interval in seconds between the attempts to release a queued transfer
+	QueueRetrySeconds uint64 `protobuf:"varint,8,opt,name=queue_retry_seconds,json=queueRetrySeconds,proto3" json:"queue_retry_seconds,omitempty" yaml:"queue_retry_seconds"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,8 +115,123 @@ func (m *Params) GetContractAddress() string {
 	return ""
 }
 
+func (m *Params) GetUseNativeRateLimits() bool {
+	if m != nil {
+		return m.UseNativeRateLimits
+	}
+	return false
+}
+
+func (m *Params) GetDenomPricings() []DenomPricing {
+	if m != nil {
+		return m.DenomPricings
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPriceAgeSeconds() uint64 {
+	if m != nil {
+		return m.MaxPriceAgeSeconds
+	}
+	return 0
+}
+
+func (m *Params) GetStalePricePolicy() StalePricePolicy {
+	if m != nil {
+		return m.StalePricePolicy
+	}
+	return STALE_PRICE_POLICY_SKIP
+}
+
+func (m *Params) GetQueueExceededTransfers() bool {
+	if m != nil {
+		return m.QueueExceededTransfers
+	}
+	return false
+}
+
+func (m *Params) GetSecurityAddress() string {
+	if m != nil {
+		return m.SecurityAddress
+	}
+	return ""
+}
+
+func (m *Params) GetQueueRetrySeconds() uint64 {
+	if m != nil {
+		return m.QueueRetrySeconds
+	}
+	return 0
+}
+
+// DenomPricing defines how a denom is valued in USD for the USD rate limits.
+type DenomPricing struct {
+	// the denom on Neutron, e.g. untrn or ibc/{hash}
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the oracle currency pair quoted in USD, e.g. NTRN/USD
+	CurrencyPair string `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// the number of decimals of the denom, e.g. 6 for untrn
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *DenomPricing) Reset()         { *m = DenomPricing{} }
+func (m *DenomPricing) String() string { return proto.CompactTextString(m) }
+func (*DenomPricing) ProtoMessage()    {}
+func (*DenomPricing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_96b2a3ecd8a27c06, []int{1}
+}
+func (m *DenomPricing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPricing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPricing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPricing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPricing.Merge(m, src)
+}
+func (m *DenomPricing) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPricing) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPricing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPricing proto.InternalMessageInfo
+
+func (m *DenomPricing) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomPricing) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *DenomPricing) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("neutron.ibcratelimit.v1beta1.StalePricePolicy", StalePricePolicy_name, StalePricePolicy_value)
 	proto.RegisterType((*Params)(nil), "neutron.ibcratelimit.v1beta1.Params")
+	proto.RegisterType((*DenomPricing)(nil), "neutron.ibcratelimit.v1beta1.DenomPricing")
 }
 
 func init() {
@@ -77,21 +239,48 @@ func init() {
 }
 
 var fileDescriptor_96b2a3ecd8a27c06 = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcc, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9, 0xcc, 0xcd, 0x2c,
-	0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0x2a, 0xd5, 0x43, 0x56, 0xaa, 0x07, 0x55, 0x2a,
-	0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x28, 0x85, 0x70, 0xb1,
-	0x05, 0x80, 0xcd, 0x10, 0xf2, 0xe2, 0x12, 0x48, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x89,
-	0x4f, 0x4c, 0x49, 0x29, 0x4a, 0x2d, 0x2e, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x74, 0x92, 0x3f,
-	0x71, 0x4f, 0x9e, 0xf1, 0xd3, 0x3d, 0x79, 0xf1, 0xca, 0xc4, 0xdc, 0x1c, 0x2b, 0x25, 0x74, 0x55,
-	0x4a, 0x41, 0xfc, 0x30, 0x21, 0x47, 0x88, 0x88, 0x53, 0xf0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x43, 0x9d, 0xab, 0x9b, 0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x19, 0x1a, 0xea, 0x57, 0x80, 0xfc,
-	0xaa, 0x0b, 0xf2, 0x81, 0x2e, 0xc4, 0xb7, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x17,
-	0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x73, 0x62, 0x96, 0x12, 0x01, 0x00, 0x00,
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcb, 0x6e, 0xd3, 0x40,
+	0x14, 0x86, 0xe3, 0xde, 0x68, 0x87, 0x5e, 0xc2, 0xf4, 0x96, 0xa6, 0x8d, 0x9d, 0xba, 0x42, 0x0a,
+	0x95, 0x9a, 0x28, 0x65, 0x05, 0xbb, 0xa4, 0x04, 0xa9, 0x25, 0x2a, 0xd1, 0x38, 0x20, 0x81, 0x84,
+	0xac, 0x89, 0x7d, 0x08, 0x96, 0x62, 0x3b, 0xcc, 0x8c, 0xab, 0x44, 0x7d, 0x01, 0x96, 0xbc, 0x03,
+	0x2f, 0xd3, 0x65, 0x97, 0xac, 0x2c, 0xd4, 0x2e, 0xd8, 0xe7, 0x09, 0x90, 0x67, 0x92, 0x92, 0x86,
+	0xd0, 0x9d, 0xfd, 0x9f, 0xef, 0xfc, 0x73, 0x66, 0xe6, 0xd7, 0xa0, 0x67, 0x01, 0x44, 0x82, 0x85,
+	0x41, 0xc9, 0x6b, 0x39, 0x8c, 0x0a, 0xe8, 0x78, 0xbe, 0x27, 0x4a, 0x17, 0xe5, 0x16, 0x08, 0x5a,
+	0x2e, 0x75, 0x29, 0xa3, 0x3e, 0x2f, 0x76, 0x59, 0x28, 0x42, 0xbc, 0x37, 0x44, 0x8b, 0xe3, 0x68,
+	0x71, 0x88, 0x66, 0x37, 0xda, 0x61, 0x3b, 0x94, 0x60, 0x29, 0xf9, 0x52, 0x3d, 0xe6, 0xef, 0x79,
+	0xb4, 0xd0, 0x90, 0x26, 0xf8, 0x0c, 0xa5, 0x9d, 0x30, 0x10, 0x8c, 0x3a, 0xc2, 0xa6, 0xae, 0xcb,
+	0x80, 0xf3, 0x8c, 0x96, 0xd7, 0x0a, 0x4b, 0x55, 0xe3, 0x2a, 0x36, 0xb4, 0x41, 0x6c, 0x6c, 0xf7,
+	0xa9, 0xdf, 0x79, 0x69, 0x4e, 0x52, 0x26, 0x59, 0x1b, 0x49, 0x15, 0xa5, 0xe0, 0xf7, 0x68, 0x2b,
+	0xe2, 0x60, 0x07, 0x54, 0x78, 0x17, 0x60, 0x27, 0xc3, 0xd8, 0x72, 0x1a, 0x9e, 0x99, 0xc9, 0x6b,
+	0x85, 0xc5, 0xea, 0xfe, 0x20, 0x36, 0x72, 0xca, 0x6d, 0x3a, 0x67, 0x92, 0xf5, 0x88, 0xc3, 0xb9,
+	0xd4, 0x09, 0x15, 0x50, 0x97, 0x2a, 0xee, 0xa2, 0x55, 0x17, 0x82, 0xd0, 0xb7, 0xbb, 0xcc, 0x73,
+	0xbc, 0xa0, 0xcd, 0x33, 0xb3, 0xf9, 0xd9, 0xc2, 0xe3, 0xe3, 0xc3, 0xe2, 0x43, 0x7b, 0x2f, 0xbe,
+	0x4a, 0x7a, 0x1a, 0xaa, 0xa5, 0x9a, 0xbb, 0x8a, 0x8d, 0xd4, 0x20, 0x36, 0x36, 0xd5, 0xfa, 0xf7,
+	0xfd, 0x4c, 0xb2, 0xe2, 0x8e, 0xc1, 0x1c, 0x5b, 0x68, 0xd3, 0xa7, 0x3d, 0x59, 0x07, 0x9b, 0xb6,
+	0xc1, 0xe6, 0xe0, 0x84, 0x81, 0xcb, 0x33, 0x73, 0x79, 0xad, 0x30, 0x57, 0xcd, 0x0f, 0x62, 0x63,
+	0x4f, 0x19, 0x4d, 0xc5, 0x4c, 0x82, 0x7d, 0xda, 0x4b, 0xdc, 0xa0, 0xd2, 0x06, 0x4b, 0x89, 0xf8,
+	0x12, 0x61, 0x2e, 0x68, 0x07, 0x86, 0x7c, 0x37, 0xec, 0x78, 0x4e, 0x3f, 0x33, 0x9f, 0xd7, 0x0a,
+	0xab, 0xc7, 0xc5, 0x87, 0xb7, 0x62, 0x25, 0x7d, 0xd2, 0xaf, 0x21, 0xbb, 0xaa, 0xb9, 0x41, 0x6c,
+	0xec, 0xa8, 0x09, 0xfe, 0xf5, 0x34, 0x49, 0x9a, 0x4f, 0x34, 0xe0, 0x4f, 0x28, 0xf3, 0x35, 0x82,
+	0x08, 0x6c, 0xe8, 0x39, 0x00, 0x2e, 0xb8, 0xb6, 0x60, 0x34, 0xe0, 0x9f, 0x81, 0xf1, 0xcc, 0x82,
+	0xbc, 0x9d, 0x83, 0x41, 0x6c, 0x18, 0xca, 0xf2, 0x7f, 0xa4, 0x49, 0xb6, 0x64, 0xa9, 0x36, 0xac,
+	0x34, 0x47, 0x05, 0xfc, 0x1a, 0xa5, 0x39, 0x38, 0x11, 0xf3, 0x44, 0xff, 0x2e, 0x46, 0x8f, 0x64,
+	0x8c, 0x76, 0xff, 0x46, 0x68, 0x92, 0x30, 0xc9, 0xda, 0x48, 0x1a, 0x45, 0xe8, 0x1c, 0xad, 0xab,
+	0xc5, 0x19, 0x08, 0xd6, 0xbf, 0x3b, 0xf6, 0x45, 0x79, 0xec, 0xfa, 0x20, 0x36, 0xb2, 0xe3, 0x13,
+	0xde, 0x83, 0x4c, 0xf2, 0x44, 0xaa, 0x24, 0x11, 0x87, 0x67, 0x6e, 0x02, 0x5a, 0x1e, 0x8f, 0x01,
+	0xde, 0x40, 0xf3, 0xf2, 0xa6, 0x55, 0xc6, 0x89, 0xfa, 0xc1, 0x07, 0x68, 0xc5, 0x89, 0x18, 0x83,
+	0xc0, 0xe9, 0xdb, 0x5d, 0xea, 0x31, 0x99, 0xd7, 0x25, 0xb2, 0x3c, 0x12, 0x1b, 0xd4, 0x63, 0x38,
+	0x8b, 0x16, 0x5d, 0x70, 0x3c, 0x9f, 0x76, 0x92, 0xfc, 0x69, 0x85, 0x15, 0x72, 0xf7, 0x7f, 0x78,
+	0x89, 0xd2, 0x93, 0x57, 0x84, 0x77, 0xd1, 0xb6, 0xd5, 0xac, 0xd4, 0x6b, 0x76, 0x83, 0x9c, 0x9e,
+	0xd4, 0xec, 0xc6, 0xdb, 0xfa, 0xe9, 0xc9, 0x07, 0xdb, 0x7a, 0x73, 0xda, 0x48, 0xa7, 0xf0, 0x53,
+	0xb4, 0x3f, 0xa5, 0xf8, 0xce, 0xaa, 0xd9, 0xf5, 0x8a, 0xd5, 0x54, 0x6a, 0x5a, 0xc3, 0x39, 0xb4,
+	0x33, 0x05, 0x23, 0xb5, 0xb3, 0xda, 0x49, 0x33, 0x3d, 0x93, 0x9d, 0xfb, 0xf6, 0x43, 0x4f, 0x55,
+	0xad, 0xab, 0x1b, 0x5d, 0xbb, 0xbe, 0xd1, 0xb5, 0x5f, 0x37, 0xba, 0xf6, 0xfd, 0x56, 0x4f, 0x5d,
+	0xdf, 0xea, 0xa9, 0x9f, 0xb7, 0x7a, 0xea, 0xe3, 0x8b, 0xb6, 0x27, 0xbe, 0x44, 0xad, 0xa2, 0x13,
+	0xfa, 0xa5, 0x61, 0xbe, 0x8e, 0x42, 0xd6, 0x1e, 0x7d, 0x97, 0x2e, 0xca, 0xe5, 0x52, 0x2f, 0x79,
+	0x63, 0x8e, 0x92, 0xc8, 0x1d, 0xa9, 0x57, 0x46, 0xf4, 0xbb, 0xc0, 0x5b, 0x0b, 0xf2, 0xa5, 0x78,
+	0xfe, 0x67, 0x00, 0xdc, 0xa8, 0xf4, 0x61, 0x8a, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -114,6 +303,62 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.QueueRetrySeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QueueRetrySeconds))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SecurityAddress) > 0 {
+		i -= len(m.SecurityAddress)
+		copy(dAtA[i:], m.SecurityAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.SecurityAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.QueueExceededTransfers {
+		i--
+		if m.QueueExceededTransfers {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StalePricePolicy != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StalePricePolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAgeSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.DenomPricings) > 0 {
+		for iNdEx := len(m.DenomPricings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPricings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.UseNativeRateLimits {
+		i--
+		if m.UseNativeRateLimits {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.ContractAddress) > 0 {
 		i -= len(m.ContractAddress)
 		copy(dAtA[i:], m.ContractAddress)
@@ -124,6 +369,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomPricing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPricing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPricing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -145,6 +432,51 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.UseNativeRateLimits {
+		n += 2
+	}
+	if len(m.DenomPricings) > 0 {
+		for _, e := range m.DenomPricings {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxPriceAgeSeconds != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAgeSeconds))
+	}
+	if m.StalePricePolicy != 0 {
+		n += 1 + sovParams(uint64(m.StalePricePolicy))
+	}
+	if m.QueueExceededTransfers {
+		n += 2
+	}
+	l = len(m.SecurityAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.QueueRetrySeconds != 0 {
+		n += 1 + sovParams(uint64(m.QueueRetrySeconds))
+	}
+	return n
+}
+
+func (m *DenomPricing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovParams(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -215,6 +547,302 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseNativeRateLimits", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseNativeRateLimits = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPricings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPricings = append(m.DenomPricings, DenomPricing{})
+			if err := m.DenomPricings[len(m.DenomPricings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAgeSeconds", wireType)
+			}
+			m.MaxPriceAgeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAgeSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalePricePolicy", wireType)
+			}
+			m.StalePricePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalePricePolicy |= StalePricePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueExceededTransfers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueueExceededTransfers = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueRetrySeconds", wireType)
+			}
+			m.QueueRetrySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueRetrySeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPricing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPricing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPricing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
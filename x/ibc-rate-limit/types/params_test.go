@@ -77,3 +77,89 @@ func TestValidateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDenomPricings(t *testing.T) {
+	testCases := map[string]struct {
+		params   Params
+		expected bool
+	}{
+		"valid": {
+			params: Params{
+				DenomPricings: []DenomPricing{{Denom: "untrn", CurrencyPair: "NTRN/USD", Decimals: 6}},
+			},
+			expected: true,
+		},
+		"not quoted in USD": {
+			params: Params{
+				DenomPricings: []DenomPricing{{Denom: "untrn", CurrencyPair: "NTRN/ATOM", Decimals: 6}},
+			},
+			expected: false,
+		},
+		"invalid currency pair": {
+			params: Params{
+				DenomPricings: []DenomPricing{{Denom: "untrn", CurrencyPair: "NTRN", Decimals: 6}},
+			},
+			expected: false,
+		},
+		"duplicate denom": {
+			params: Params{
+				DenomPricings: []DenomPricing{
+					{Denom: "untrn", CurrencyPair: "NTRN/USD", Decimals: 6},
+					{Denom: "untrn", CurrencyPair: "NTRN/USD", Decimals: 6},
+				},
+			},
+			expected: false,
+		},
+		"too many decimals": {
+			params: Params{
+				DenomPricings: []DenomPricing{{Denom: "untrn", CurrencyPair: "NTRN/USD", Decimals: 19}},
+			},
+			expected: false,
+		},
+		"unknown stale price policy": {
+			params: Params{
+				StalePricePolicy: 3,
+			},
+			expected: false,
+		},
+		"queued transfers": {
+			params: Params{
+				QueueExceededTransfers: true,
+				SecurityAddress:        "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+				QueueRetrySeconds:      3600,
+			},
+			expected: true,
+		},
+		"invalid security address": {
+			params: Params{
+				SecurityAddress: "cosmos1234",
+			},
+			expected: false,
+		},
+		"no queue retry interval": {
+			params: Params{
+				QueueExceededTransfers: true,
+			},
+			expected: false,
+		},
+		"too long queue retry interval": {
+			params: Params{
+				QueueRetrySeconds: MaxDurationSeconds + 1,
+			},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.params.Validate()
+
+			// Assertions.
+			if !tc.expected {
+				require.ErrorIs(t, err, ErrInvalidParams)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
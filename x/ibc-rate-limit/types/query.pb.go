@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -113,217 +113,2856 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryParamsResponse")
+// QueryRateLimitsRequest is the request type for the Query/RateLimits RPC method.
+type QueryRateLimitsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("neutron/ibcratelimit/v1beta1/query.proto", fileDescriptor_a6095f726b1d3aec)
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{2}
+}
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_a6095f726b1d3aec = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xbf, 0x4a, 0x7b, 0x31,
-	0x14, 0xc7, 0x6f, 0x7e, 0xfc, 0xec, 0x70, 0xdd, 0x62, 0x07, 0x29, 0x25, 0x4a, 0x11, 0xa9, 0x42,
-	0x13, 0x6f, 0x9d, 0x5c, 0xfb, 0x04, 0x5a, 0x27, 0xdd, 0x72, 0x4b, 0x88, 0x81, 0xde, 0x9c, 0x34,
-	0xc9, 0x2d, 0x76, 0xf5, 0x09, 0x04, 0x67, 0x67, 0x5f, 0xa5, 0x63, 0xc1, 0xc5, 0x49, 0xa4, 0xf5,
-	0x41, 0xa4, 0x37, 0xb1, 0xd4, 0x3f, 0x14, 0xdc, 0x0e, 0x27, 0xdf, 0xcf, 0x87, 0x6f, 0x4e, 0xda,
-	0xd6, 0xa2, 0xf4, 0x16, 0x34, 0x53, 0xf9, 0xc0, 0x72, 0x2f, 0x86, 0xaa, 0x50, 0x9e, 0x8d, 0xb3,
-	0x5c, 0x78, 0x9e, 0xb1, 0x51, 0x29, 0xec, 0x84, 0x1a, 0x0b, 0x1e, 0x70, 0x33, 0x26, 0xe9, 0x7a,
-	0x92, 0xc6, 0x64, 0xe3, 0x78, 0x00, 0xae, 0x00, 0xc7, 0x72, 0xee, 0x44, 0xc0, 0x56, 0x12, 0xc3,
-	0xa5, 0xd2, 0xdc, 0x2b, 0xd0, 0xc1, 0xd4, 0xa8, 0x4b, 0x90, 0x50, 0x8d, 0x6c, 0x39, 0xc5, 0x6d,
-	0x53, 0x02, 0xc8, 0xa1, 0x60, 0xdc, 0x28, 0xc6, 0xb5, 0x06, 0x5f, 0x21, 0x2e, 0xbe, 0x1e, 0x6d,
-	0xec, 0x69, 0xb8, 0xe5, 0x45, 0x8c, 0xb6, 0xea, 0x29, 0xbe, 0x58, 0x16, 0x38, 0xaf, 0x96, 0x7d,
-	0x31, 0x2a, 0x85, 0xf3, 0xad, 0xab, 0x74, 0xe7, 0xcb, 0xd6, 0x19, 0xd0, 0x4e, 0xe0, 0x5e, 0x5a,
-	0x0b, 0xf0, 0x2e, 0xda, 0x47, 0xed, 0xed, 0xee, 0x01, 0xdd, 0xf4, 0x4d, 0x1a, 0xe8, 0xde, 0xff,
-	0xe9, 0xeb, 0x5e, 0xd2, 0x8f, 0x64, 0xf7, 0x09, 0xa5, 0x5b, 0x95, 0x1b, 0x3f, 0xa2, 0xb4, 0x16,
-	0x22, 0xf8, 0x64, 0xb3, 0xe8, 0x67, 0xc3, 0x46, 0xf6, 0x07, 0x22, 0xb4, 0x6f, 0xd1, 0xbb, 0xe7,
-	0xf7, 0x87, 0x7f, 0x6d, 0x7c, 0xc8, 0xd6, 0xce, 0xd3, 0x59, 0xb2, 0x9d, 0xdf, 0x0e, 0xd4, 0xbb,
-	0x9c, 0xce, 0x09, 0x9a, 0xcd, 0x09, 0x7a, 0x9b, 0x13, 0x74, 0xbf, 0x20, 0xc9, 0x6c, 0x41, 0x92,
-	0x97, 0x05, 0x49, 0xae, 0xcf, 0xa4, 0xf2, 0x37, 0x65, 0x4e, 0x07, 0x50, 0x7c, 0xba, 0x3a, 0x60,
-	0xe5, 0xca, 0x3b, 0xce, 0x32, 0x76, 0xfb, 0xdd, 0xee, 0x27, 0x46, 0xb8, 0xbc, 0x56, 0x9d, 0xfd,
-	0xf4, 0x23, 0x00, 0x00, 0xff, 0xff, 0x28, 0xbf, 0x48, 0x2a, 0x4b, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QueryRateLimitsResponse is the response type for the Query/RateLimits RPC method.
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{3}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the ibc-rate-limit module's
-	// parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryRateLimitUsageRequest is the request type for the Query/RateLimitUsage RPC method.
+type QueryRateLimitUsageRequest struct {
+	Denom     string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitUsageRequest) Reset()         { *m = QueryRateLimitUsageRequest{} }
+func (m *QueryRateLimitUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsageRequest) ProtoMessage()    {}
+func (*QueryRateLimitUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{4}
+}
+func (m *QueryRateLimitUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRateLimitUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsageRequest.Merge(m, src)
+}
+func (m *QueryRateLimitUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsageRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the ibc-rate-limit module's
-	// parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryRateLimitUsageRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitUsageRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRateLimitUsageRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryRateLimitUsageResponse is the response type for the Query/RateLimitUsage RPC method.
+type QueryRateLimitUsageResponse struct {
+	Usages []QuotaUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages"`
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryRateLimitUsageResponse) Reset()         { *m = QueryRateLimitUsageResponse{} }
+func (m *QueryRateLimitUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsageResponse) ProtoMessage()    {}
+func (*QueryRateLimitUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{5}
+}
+func (m *QueryRateLimitUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsageResponse.Merge(m, src)
+}
+func (m *QueryRateLimitUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsageResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitUsageResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitUsageResponse) GetUsages() []QuotaUsage {
+	if m != nil {
+		return m.Usages
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return nil
+}
+
+// QueryUsdRateLimitsRequest is the request type for the Query/UsdRateLimits RPC method.
+type QueryUsdRateLimitsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUsdRateLimitsRequest) Reset()         { *m = QueryUsdRateLimitsRequest{} }
+func (m *QueryUsdRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUsdRateLimitsRequest) ProtoMessage()    {}
+func (*QueryUsdRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{6}
+}
+func (m *QueryUsdRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsdRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsdRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/Params",
+}
+func (m *QueryUsdRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsdRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryUsdRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsdRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsdRateLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUsdRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryUsdRateLimitsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QueryUsdRateLimitsResponse is the response type for the Query/UsdRateLimits RPC method.
+type QueryUsdRateLimitsResponse struct {
+	UsdRateLimits []UsdRateLimit      `protobuf:"bytes,1,rep,name=usd_rate_limits,json=usdRateLimits,proto3" json:"usd_rate_limits"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUsdRateLimitsResponse) Reset()         { *m = QueryUsdRateLimitsResponse{} }
+func (m *QueryUsdRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUsdRateLimitsResponse) ProtoMessage()    {}
+func (*QueryUsdRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{7}
+}
+func (m *QueryUsdRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsdRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsdRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUsdRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsdRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryUsdRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsdRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsdRateLimitsResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.ibcratelimit.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "neutron/ibcratelimit/v1beta1/query.proto",
+var xxx_messageInfo_QueryUsdRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryUsdRateLimitsResponse) GetUsdRateLimits() []UsdRateLimit {
+	if m != nil {
+		return m.UsdRateLimits
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUsdRateLimitsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUsdRateLimitUsageRequest is the request type for the Query/UsdRateLimitUsage RPC method.
+type QueryUsdRateLimitUsageRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryUsdRateLimitUsageRequest) Reset()         { *m = QueryUsdRateLimitUsageRequest{} }
+func (m *QueryUsdRateLimitUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUsdRateLimitUsageRequest) ProtoMessage()    {}
+func (*QueryUsdRateLimitUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{8}
+}
+func (m *QueryUsdRateLimitUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsdRateLimitUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsdRateLimitUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUsdRateLimitUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsdRateLimitUsageRequest.Merge(m, src)
+}
+func (m *QueryUsdRateLimitUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsdRateLimitUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsdRateLimitUsageRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUsdRateLimitUsageRequest proto.InternalMessageInfo
+
+func (m *QueryUsdRateLimitUsageRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryUsdRateLimitUsageResponse is the response type for the Query/UsdRateLimitUsage RPC method.
+type QueryUsdRateLimitUsageResponse struct {
+	UsdRateLimit UsdRateLimit `protobuf:"bytes,1,opt,name=usd_rate_limit,json=usdRateLimit,proto3" json:"usd_rate_limit"`
+	Flow         UsdFlow      `protobuf:"bytes,2,opt,name=flow,proto3" json:"flow"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryUsdRateLimitUsageResponse) Reset()         { *m = QueryUsdRateLimitUsageResponse{} }
+func (m *QueryUsdRateLimitUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUsdRateLimitUsageResponse) ProtoMessage()    {}
+func (*QueryUsdRateLimitUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{9}
+}
+func (m *QueryUsdRateLimitUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsdRateLimitUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsdRateLimitUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUsdRateLimitUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsdRateLimitUsageResponse.Merge(m, src)
+}
+func (m *QueryUsdRateLimitUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsdRateLimitUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsdRateLimitUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUsdRateLimitUsageResponse proto.InternalMessageInfo
+
+func (m *QueryUsdRateLimitUsageResponse) GetUsdRateLimit() UsdRateLimit {
+	if m != nil {
+		return m.UsdRateLimit
+	}
+	return UsdRateLimit{}
+}
+
+func (m *QueryUsdRateLimitUsageResponse) GetFlow() UsdFlow {
+	if m != nil {
+		return m.Flow
+	}
+	return UsdFlow{}
+}
+
+// QueryQueuedTransfersRequest is the request type for the Query/QueuedTransfers RPC method.
+type QueryQueuedTransfersRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedTransfersRequest) Reset()         { *m = QueryQueuedTransfersRequest{} }
+func (m *QueryQueuedTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTransfersRequest) ProtoMessage()    {}
+func (*QueryQueuedTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{10}
+}
+func (m *QueryQueuedTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTransfersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTransfersRequest.Merge(m, src)
+}
+func (m *QueryQueuedTransfersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTransfersRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedTransfersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedTransfersResponse is the response type for the Query/QueuedTransfers RPC method.
+type QueryQueuedTransfersResponse struct {
+	QueuedTransfers []QueuedTransfer    `protobuf:"bytes,1,rep,name=queued_transfers,json=queuedTransfers,proto3" json:"queued_transfers"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedTransfersResponse) Reset()         { *m = QueryQueuedTransfersResponse{} }
+func (m *QueryQueuedTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTransfersResponse) ProtoMessage()    {}
+func (*QueryQueuedTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{11}
+}
+func (m *QueryQueuedTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTransfersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTransfersResponse.Merge(m, src)
+}
+func (m *QueryQueuedTransfersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTransfersResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedTransfersResponse) GetQueuedTransfers() []QueuedTransfer {
+	if m != nil {
+		return m.QueuedTransfers
+	}
+	return nil
+}
+
+func (m *QueryQueuedTransfersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedTransferRequest is the request type for the Query/QueuedTransfer RPC method.
+type QueryQueuedTransferRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryQueuedTransferRequest) Reset()         { *m = QueryQueuedTransferRequest{} }
+func (m *QueryQueuedTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTransferRequest) ProtoMessage()    {}
+func (*QueryQueuedTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{12}
+}
+func (m *QueryQueuedTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTransferRequest.Merge(m, src)
+}
+func (m *QueryQueuedTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTransferRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedTransferRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryQueuedTransferResponse is the response type for the Query/QueuedTransfer RPC method.
+type QueryQueuedTransferResponse struct {
+	QueuedTransfer QueuedTransfer `protobuf:"bytes,1,opt,name=queued_transfer,json=queuedTransfer,proto3" json:"queued_transfer"`
+}
+
+func (m *QueryQueuedTransferResponse) Reset()         { *m = QueryQueuedTransferResponse{} }
+func (m *QueryQueuedTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTransferResponse) ProtoMessage()    {}
+func (*QueryQueuedTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6095f726b1d3aec, []int{13}
+}
+func (m *QueryQueuedTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTransferResponse.Merge(m, src)
+}
+func (m *QueryQueuedTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTransferResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedTransferResponse) GetQueuedTransfer() QueuedTransfer {
+	if m != nil {
+		return m.QueuedTransfer
+	}
+	return QueuedTransfer{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitsRequest")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitUsageRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitUsageRequest")
+	proto.RegisterType((*QueryRateLimitUsageResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryRateLimitUsageResponse")
+	proto.RegisterType((*QueryUsdRateLimitsRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryUsdRateLimitsRequest")
+	proto.RegisterType((*QueryUsdRateLimitsResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryUsdRateLimitsResponse")
+	proto.RegisterType((*QueryUsdRateLimitUsageRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryUsdRateLimitUsageRequest")
+	proto.RegisterType((*QueryUsdRateLimitUsageResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryUsdRateLimitUsageResponse")
+	proto.RegisterType((*QueryQueuedTransfersRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryQueuedTransfersRequest")
+	proto.RegisterType((*QueryQueuedTransfersResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryQueuedTransfersResponse")
+	proto.RegisterType((*QueryQueuedTransferRequest)(nil), "neutron.ibcratelimit.v1beta1.QueryQueuedTransferRequest")
+	proto.RegisterType((*QueryQueuedTransferResponse)(nil), "neutron.ibcratelimit.v1beta1.QueryQueuedTransferResponse")
+}
+
+func init() {
+	proto.RegisterFile("neutron/ibcratelimit/v1beta1/query.proto", fileDescriptor_a6095f726b1d3aec)
+}
+
+var fileDescriptor_a6095f726b1d3aec = []byte{
+	// 862 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4b, 0x4f, 0xdb, 0x58,
+	0x14, 0xce, 0xcd, 0x40, 0x24, 0x0e, 0x43, 0x32, 0x73, 0x07, 0xcd, 0xc3, 0x03, 0x99, 0x91, 0x35,
+	0x03, 0x19, 0x86, 0xd8, 0x24, 0x0c, 0x02, 0xe6, 0x55, 0x95, 0x4a, 0x54, 0x48, 0x55, 0xd5, 0xa4,
+	0xa5, 0xea, 0x43, 0x55, 0xea, 0xc4, 0x17, 0x63, 0x29, 0xf1, 0x4d, 0xfc, 0x80, 0x52, 0xc4, 0xa6,
+	0xbf, 0xa0, 0x52, 0xd7, 0xfd, 0x0b, 0x5d, 0x74, 0x57, 0x75, 0x41, 0x55, 0x55, 0x15, 0x4b, 0xa4,
+	0x6e, 0xda, 0x4d, 0x55, 0x41, 0x7f, 0x48, 0xe5, 0xeb, 0x9b, 0xc4, 0x4e, 0xdc, 0x24, 0x8e, 0xe8,
+	0x2e, 0xd8, 0xf7, 0x7c, 0xaf, 0x73, 0x7c, 0x2e, 0x90, 0x31, 0x88, 0x63, 0x9b, 0xd4, 0x90, 0xf5,
+	0x72, 0xc5, 0x54, 0x6c, 0x52, 0xd5, 0x6b, 0xba, 0x2d, 0xef, 0xe4, 0xca, 0xc4, 0x56, 0x72, 0x72,
+	0xc3, 0x21, 0xe6, 0x9e, 0x54, 0x37, 0xa9, 0x4d, 0xf1, 0x14, 0x3f, 0x29, 0xf9, 0x4f, 0x4a, 0xfc,
+	0xa4, 0x30, 0x57, 0xa1, 0x56, 0x8d, 0x5a, 0x72, 0x59, 0xb1, 0x88, 0x57, 0xd6, 0x02, 0xa9, 0x2b,
+	0x9a, 0x6e, 0x28, 0xb6, 0x4e, 0x0d, 0x0f, 0x49, 0x98, 0xd4, 0xa8, 0x46, 0xd9, 0x4f, 0xd9, 0xfd,
+	0xc5, 0x9f, 0x4e, 0x69, 0x94, 0x6a, 0x55, 0x22, 0x2b, 0x75, 0x5d, 0x56, 0x0c, 0x83, 0xda, 0xac,
+	0xc4, 0xe2, 0x6f, 0xff, 0xe8, 0xa9, 0xb3, 0xae, 0x98, 0x4a, 0xad, 0x79, 0x34, 0xdb, 0xf3, 0xa8,
+	0xfb, 0xa4, 0xe4, 0x69, 0x67, 0xc7, 0xc5, 0x49, 0xc0, 0x05, 0x57, 0xef, 0x15, 0x86, 0x51, 0x24,
+	0x0d, 0x87, 0x58, 0xb6, 0x78, 0x13, 0xbe, 0x0b, 0x3c, 0xb5, 0xea, 0xd4, 0xb0, 0x08, 0x5e, 0x83,
+	0x84, 0xc7, 0xf5, 0x23, 0xfa, 0x15, 0x65, 0xc6, 0xf3, 0xbf, 0x49, 0xbd, 0x52, 0x91, 0xbc, 0xea,
+	0xb5, 0x91, 0xa3, 0xf7, 0xbf, 0xc4, 0x8a, 0xbc, 0x52, 0xbc, 0x0b, 0xdf, 0x33, 0xe8, 0xa2, 0x62,
+	0x93, 0x4b, 0xee, 0xf1, 0x26, 0x29, 0x5e, 0x07, 0x68, 0x87, 0xc5, 0x19, 0x66, 0x24, 0x2f, 0x59,
+	0xc9, 0x4d, 0x56, 0xf2, 0x1a, 0xd2, 0x86, 0xd7, 0x08, 0xaf, 0x2d, 0xfa, 0x2a, 0xc5, 0xa7, 0x08,
+	0x7e, 0xe8, 0xa2, 0xe0, 0x0e, 0x2e, 0xc3, 0x78, 0x3b, 0x02, 0xd7, 0xc6, 0x57, 0x99, 0xf1, 0xfc,
+	0x6c, 0x6f, 0x1b, 0x2d, 0x18, 0xee, 0x04, 0xcc, 0x16, 0x2e, 0xbe, 0x18, 0xd0, 0x1c, 0x67, 0x9a,
+	0x67, 0xfb, 0x6a, 0xf6, 0xc4, 0x04, 0x44, 0x17, 0x40, 0x08, 0x6a, 0xde, 0xb4, 0xda, 0xf6, 0xf0,
+	0x24, 0x8c, 0xaa, 0xc4, 0xa0, 0x35, 0x96, 0xca, 0x58, 0xd1, 0xfb, 0x03, 0x4f, 0x03, 0x54, 0xb6,
+	0x15, 0xc3, 0x20, 0xd5, 0x92, 0xae, 0x32, 0xf2, 0xb1, 0xe2, 0x18, 0x7f, 0xb2, 0xa1, 0x8a, 0x04,
+	0x7e, 0x0e, 0x85, 0xe4, 0x51, 0xac, 0x43, 0xc2, 0x71, 0x1f, 0x34, 0x53, 0xc8, 0xf4, 0x4e, 0xa1,
+	0xe0, 0x50, 0x5b, 0x61, 0x08, 0xcd, 0x86, 0x7a, 0xd5, 0x62, 0x05, 0x7e, 0x62, 0x34, 0x9b, 0x96,
+	0xfa, 0xe5, 0x7a, 0x7a, 0x88, 0x40, 0x08, 0x63, 0xe1, 0x5e, 0x6e, 0x40, 0xca, 0xb1, 0xd4, 0x52,
+	0x77, 0x6b, 0xe7, 0x7a, 0x9b, 0xf2, 0xa3, 0x71, 0x5b, 0x13, 0x8e, 0x9f, 0xe1, 0xec, 0x1a, 0xfc,
+	0x3f, 0x4c, 0x77, 0x19, 0x08, 0xf4, 0x38, 0xd8, 0x4d, 0xd4, 0xd9, 0xcd, 0x67, 0x08, 0xd2, 0x9f,
+	0x03, 0xe0, 0x29, 0x5c, 0x87, 0x64, 0x30, 0x05, 0x1e, 0x78, 0xf4, 0x10, 0xbe, 0xf6, 0x87, 0x80,
+	0xcf, 0xc1, 0xc8, 0x56, 0x95, 0xee, 0x72, 0xf7, 0xbf, 0xf7, 0x45, 0x5b, 0xaf, 0xd2, 0x5d, 0x0e,
+	0xc4, 0x0a, 0x5b, 0x93, 0x58, 0x70, 0x88, 0x43, 0xd4, 0x6b, 0xa6, 0x62, 0x58, 0x5b, 0xc4, 0x3c,
+	0xf3, 0x21, 0x79, 0x8d, 0x60, 0x2a, 0x9c, 0x87, 0x07, 0x74, 0x07, 0xbe, 0x69, 0xb0, 0x57, 0x25,
+	0xbb, 0xf9, 0x8e, 0xcf, 0xc9, 0x7c, 0xbf, 0xe1, 0xf7, 0x03, 0x72, 0x6f, 0xa9, 0x46, 0x90, 0xe6,
+	0xec, 0x66, 0x65, 0x9e, 0x0f, 0x7b, 0x90, 0xb6, 0x19, 0x57, 0x12, 0xe2, 0x7c, 0x40, 0x46, 0x8a,
+	0x71, 0x5d, 0x15, 0xef, 0x87, 0xa6, 0xdb, 0x32, 0x7d, 0x1b, 0x52, 0x1d, 0xa6, 0x79, 0xc4, 0xc3,
+	0x78, 0x4e, 0x06, 0x3d, 0xe7, 0x5f, 0x00, 0x8c, 0x32, 0x72, 0xfc, 0x18, 0x41, 0xc2, 0x5b, 0xf8,
+	0x78, 0xa1, 0x2f, 0x70, 0xc7, 0x7d, 0x23, 0xe4, 0x22, 0x54, 0x78, 0xb6, 0x44, 0xe9, 0xc1, 0x9b,
+	0x8f, 0x8f, 0xe2, 0x19, 0x3c, 0x23, 0xfb, 0x2e, 0xbc, 0xac, 0x5b, 0x9b, 0x0d, 0xbb, 0x1d, 0xf1,
+	0x13, 0x04, 0xe0, 0xfb, 0xae, 0xff, 0x1a, 0x80, 0xb1, 0x6b, 0x9d, 0x09, 0x4b, 0x11, 0xab, 0xb8,
+	0xd6, 0x45, 0xa6, 0x35, 0x8b, 0xff, 0xec, 0xa7, 0xd5, 0xb7, 0xc0, 0xf0, 0x21, 0x82, 0x64, 0xf0,
+	0x43, 0xc7, 0x2b, 0x51, 0xe8, 0xfd, 0xcb, 0x45, 0x58, 0x1d, 0xa2, 0x92, 0x8b, 0x5f, 0x61, 0xe2,
+	0xf3, 0x78, 0x61, 0x70, 0xf1, 0x25, 0x76, 0x35, 0xe0, 0xe7, 0x08, 0x26, 0x02, 0xfb, 0x1a, 0x2f,
+	0x0f, 0x20, 0x23, 0xec, 0x1e, 0x11, 0x56, 0xa2, 0x17, 0x72, 0xf9, 0xcb, 0x4c, 0x7e, 0x0e, 0xcb,
+	0xfd, 0xe4, 0x77, 0x5c, 0x20, 0xf8, 0x1d, 0x82, 0x6f, 0xbb, 0x76, 0x2d, 0xfe, 0x27, 0xa2, 0x90,
+	0x40, 0x17, 0xfe, 0x1d, 0xae, 0x98, 0x3b, 0xd9, 0x60, 0x4e, 0x2e, 0xe0, 0xf3, 0xd1, 0x9c, 0x78,
+	0xcd, 0x90, 0xf7, 0xdb, 0x97, 0xcb, 0x01, 0x7e, 0x89, 0x20, 0xd5, 0xb1, 0x24, 0xf1, 0x20, 0x23,
+	0x12, 0xbe, 0xc0, 0x85, 0xbf, 0x87, 0x29, 0x8d, 0x3a, 0x5e, 0x9d, 0x9b, 0x1b, 0xbf, 0x42, 0x90,
+	0x0c, 0xa2, 0x0e, 0xf4, 0x81, 0x84, 0x2e, 0x55, 0x61, 0x75, 0x88, 0x4a, 0xee, 0xe0, 0x3f, 0xe6,
+	0x60, 0x19, 0x2f, 0x45, 0x75, 0x20, 0xef, 0xeb, 0xea, 0xc1, 0xda, 0xd5, 0xa3, 0x93, 0x34, 0x3a,
+	0x3e, 0x49, 0xa3, 0x0f, 0x27, 0x69, 0xf4, 0xf0, 0x34, 0x1d, 0x3b, 0x3e, 0x4d, 0xc7, 0xde, 0x9e,
+	0xa6, 0x63, 0xb7, 0x56, 0x35, 0xdd, 0xde, 0x76, 0xca, 0x52, 0x85, 0xd6, 0x9a, 0xd0, 0x59, 0x6a,
+	0x6a, 0x2d, 0x9a, 0x9d, 0x5c, 0x4e, 0xbe, 0xd7, 0x49, 0x66, 0xef, 0xd5, 0x89, 0x55, 0x4e, 0xb0,
+	0xff, 0xee, 0x17, 0x3f, 0x0d, 0x00, 0x23, 0xf8, 0xc5, 0x2b, 0xe1, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the ibc-rate-limit module's
+	// parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RateLimits returns the native rate limits.
+	RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	// RateLimitUsage returns the current flows of the quotas of a native rate limit.
+	RateLimitUsage(ctx context.Context, in *QueryRateLimitUsageRequest, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error)
+	// UsdRateLimits returns the channel rate limits in USD.
+	UsdRateLimits(ctx context.Context, in *QueryUsdRateLimitsRequest, opts ...grpc.CallOption) (*QueryUsdRateLimitsResponse, error)
+	// UsdRateLimitUsage returns the current flow of the channel rate limit in USD.
+	UsdRateLimitUsage(ctx context.Context, in *QueryUsdRateLimitUsageRequest, opts ...grpc.CallOption) (*QueryUsdRateLimitUsageResponse, error)
+	// QueuedTransfers returns the incoming transfers waiting to be released.
+	QueuedTransfers(ctx context.Context, in *QueryQueuedTransfersRequest, opts ...grpc.CallOption) (*QueryQueuedTransfersResponse, error)
+	// QueuedTransfer returns a queued incoming transfer by its id.
+	QueuedTransfer(ctx context.Context, in *QueryQueuedTransferRequest, opts ...grpc.CallOption) (*QueryQueuedTransferResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUsage(ctx context.Context, in *QueryRateLimitUsageRequest, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error) {
+	out := new(QueryRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/RateLimitUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UsdRateLimits(ctx context.Context, in *QueryUsdRateLimitsRequest, opts ...grpc.CallOption) (*QueryUsdRateLimitsResponse, error) {
+	out := new(QueryUsdRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/UsdRateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UsdRateLimitUsage(ctx context.Context, in *QueryUsdRateLimitUsageRequest, opts ...grpc.CallOption) (*QueryUsdRateLimitUsageResponse, error) {
+	out := new(QueryUsdRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/UsdRateLimitUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTransfers(ctx context.Context, in *QueryQueuedTransfersRequest, opts ...grpc.CallOption) (*QueryQueuedTransfersResponse, error) {
+	out := new(QueryQueuedTransfersResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/QueuedTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTransfer(ctx context.Context, in *QueryQueuedTransferRequest, opts ...grpc.CallOption) (*QueryQueuedTransferResponse, error) {
+	out := new(QueryQueuedTransferResponse)
+	err := c.cc.Invoke(ctx, "/neutron.ibcratelimit.v1beta1.Query/QueuedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the ibc-rate-limit module's
+	// parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RateLimits returns the native rate limits.
+	RateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	// RateLimitUsage returns the current flows of the quotas of a native rate limit.
+	RateLimitUsage(context.Context, *QueryRateLimitUsageRequest) (*QueryRateLimitUsageResponse, error)
+	// UsdRateLimits returns the channel rate limits in USD.
+	UsdRateLimits(context.Context, *QueryUsdRateLimitsRequest) (*QueryUsdRateLimitsResponse, error)
+	// UsdRateLimitUsage returns the current flow of the channel rate limit in USD.
+	UsdRateLimitUsage(context.Context, *QueryUsdRateLimitUsageRequest) (*QueryUsdRateLimitUsageResponse, error)
+	// QueuedTransfers returns the incoming transfers waiting to be released.
+	QueuedTransfers(context.Context, *QueryQueuedTransfersRequest) (*QueryQueuedTransfersResponse, error)
+	// QueuedTransfer returns a queued incoming transfer by its id.
+	QueuedTransfer(context.Context, *QueryQueuedTransferRequest) (*QueryQueuedTransferResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitUsage(ctx context.Context, req *QueryRateLimitUsageRequest) (*QueryRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUsage not implemented")
+}
+func (*UnimplementedQueryServer) UsdRateLimits(ctx context.Context, req *QueryUsdRateLimitsRequest) (*QueryUsdRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsdRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) UsdRateLimitUsage(ctx context.Context, req *QueryUsdRateLimitUsageRequest) (*QueryUsdRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsdRateLimitUsage not implemented")
+}
+func (*UnimplementedQueryServer) QueuedTransfers(ctx context.Context, req *QueryQueuedTransfersRequest) (*QueryQueuedTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTransfers not implemented")
+}
+func (*UnimplementedQueryServer) QueuedTransfer(ctx context.Context, req *QueryQueuedTransferRequest) (*QueryQueuedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTransfer not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/RateLimitUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUsage(ctx, req.(*QueryRateLimitUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UsdRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUsdRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UsdRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/UsdRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UsdRateLimits(ctx, req.(*QueryUsdRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UsdRateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUsdRateLimitUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UsdRateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/UsdRateLimitUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UsdRateLimitUsage(ctx, req.(*QueryUsdRateLimitUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/QueuedTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTransfers(ctx, req.(*QueryQueuedTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.ibcratelimit.v1beta1.Query/QueuedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTransfer(ctx, req.(*QueryQueuedTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.ibcratelimit.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitUsage",
+			Handler:    _Query_RateLimitUsage_Handler,
+		},
+		{
+			MethodName: "UsdRateLimits",
+			Handler:    _Query_UsdRateLimits_Handler,
+		},
+		{
+			MethodName: "UsdRateLimitUsage",
+			Handler:    _Query_UsdRateLimitUsage_Handler,
+		},
+		{
+			MethodName: "QueuedTransfers",
+			Handler:    _Query_QueuedTransfers_Handler,
+		},
+		{
+			MethodName: "QueuedTransfer",
+			Handler:    _Query_QueuedTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/ibcratelimit/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUsdRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUsdRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUsdRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUsdRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUsdRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUsdRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.UsdRateLimits) > 0 {
+		for iNdEx := len(m.UsdRateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdRateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUsdRateLimitUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUsdRateLimitUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUsdRateLimitUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUsdRateLimitUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUsdRateLimitUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUsdRateLimitUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UsdRateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTransfersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTransfersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTransfersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTransfersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTransfersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTransfersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedTransfers) > 0 {
+		for iNdEx := len(m.QueuedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedTransfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryUsdRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUsdRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UsdRateLimits) > 0 {
+		for _, e := range m.UsdRateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUsdRateLimitUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUsdRateLimitUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UsdRateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueuedTransfersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedTransfersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedTransfers) > 0 {
+		for _, e := range m.QueuedTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryQueuedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QueuedTransfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, QuotaUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUsdRateLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUsdRateLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUsdRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUsdRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUsdRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUsdRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdRateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdRateLimits = append(m.UsdRateLimits, UsdRateLimit{})
+			if err := m.UsdRateLimits[len(m.UsdRateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUsdRateLimitUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUsdRateLimitUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUsdRateLimitUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUsdRateLimitUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUsdRateLimitUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUsdRateLimitUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdRateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsdRateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryQueuedTransfersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedTransfersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedTransfersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryQueuedTransfersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedTransfersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedTransfersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedTransfers = append(m.QueuedTransfers, QueuedTransfer{})
+			if err := m.QueuedTransfers[len(m.QueuedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -346,12 +2985,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -373,7 +3031,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,15 +3054,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTransfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -431,7 +3089,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueuedTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
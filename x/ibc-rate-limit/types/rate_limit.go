@@ -0,0 +1,102 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// Validate checks that the path references a valid denom and either a valid channel or any channel.
+func (p RateLimitPath) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidRateLimit, err.Error())
+	}
+	if p.ChannelId == AnyChannel {
+		return nil
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid channel id: %s", err)
+	}
+	return nil
+}
+
+// Validate checks that the quota has a name, a positive duration and valid percentages.
+func (q Quota) Validate() error {
+	if q.Name == "" {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "quota name cannot be empty")
+	}
+	if q.DurationSeconds == 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "duration of quota %s must be positive", q.Name)
+	}
+	if q.DurationSeconds > MaxDurationSeconds {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "duration of quota %s is too long", q.Name)
+	}
+	if q.MaxPercentSend > MaxQuotaPercent || q.MaxPercentRecv > MaxQuotaPercent {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "percentages of quota %s cannot exceed %d", q.Name, MaxQuotaPercent)
+	}
+	return nil
+}
+
+// Validate checks the path and the quotas of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return err
+	}
+	if len(r.Quotas) == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "rate limit must have at least one quota")
+	}
+
+	names := make(map[string]bool, len(r.Quotas))
+	for _, quota := range r.Quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+		if names[quota.Name] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate quota name %s", quota.Name)
+		}
+		names[quota.Name] = true
+	}
+	return nil
+}
+
+// CheckOutflow returns true if sending the amount keeps the net outflow within the quota.
+func (f Flow) CheckOutflow(quota Quota, amount math.Int) bool {
+	return f.Outflow.Add(amount).Sub(f.Inflow).LTE(maxFlow(f.ChannelValue, quota.MaxPercentSend))
+}
+
+// CheckInflow returns true if receiving the amount keeps the net inflow within the quota.
+func (f Flow) CheckInflow(quota Quota, amount math.Int) bool {
+	return f.Inflow.Add(amount).Sub(f.Outflow).LTE(maxFlow(f.ChannelValue, quota.MaxPercentRecv))
+}
+
+func maxFlow(channelValue math.Int, percent uint32) math.Int {
+	return channelValue.MulRaw(int64(percent)).QuoRaw(MaxQuotaPercent)
+}
+
+// Validate checks that the USD rate limit has a valid channel, non-negative limits and a positive duration.
+func (r UsdRateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid channel id: %s", err)
+	}
+	if r.MaxSendUsd.IsNil() || r.MaxSendUsd.IsNegative() || r.MaxRecvUsd.IsNil() || r.MaxRecvUsd.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "USD limits of channel %s must be non-negative", r.ChannelId)
+	}
+	if r.DurationSeconds == 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "duration of USD rate limit of channel %s must be positive", r.ChannelId)
+	}
+	if r.DurationSeconds > MaxDurationSeconds {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "duration of USD rate limit of channel %s is too long", r.ChannelId)
+	}
+	return nil
+}
+
+// CheckOutflow returns true if sending the value keeps the net outflow within the USD rate limit.
+func (f UsdFlow) CheckOutflow(rateLimit UsdRateLimit, value math.LegacyDec) bool {
+	return f.Outflow.Add(value).Sub(f.Inflow).LTE(rateLimit.MaxSendUsd)
+}
+
+// CheckInflow returns true if receiving the value keeps the net inflow within the USD rate limit.
+func (f UsdFlow) CheckInflow(rateLimit UsdRateLimit, value math.LegacyDec) bool {
+	return f.Inflow.Add(value).Sub(f.Outflow).LTE(rateLimit.MaxRecvUsd)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRateLimit(t *testing.T) {
+	validQuota := Quota{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10, DurationSeconds: 86400}
+
+	testCases := map[string]struct {
+		rateLimit RateLimit
+		expected  bool
+	}{
+		"valid": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: "channel-0"}, Quotas: []Quota{validQuota}},
+			expected:  true,
+		},
+		"valid any channel": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: AnyChannel}, Quotas: []Quota{validQuota}},
+			expected:  true,
+		},
+		"invalid denom": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "", ChannelId: "channel-0"}, Quotas: []Quota{validQuota}},
+			expected:  false,
+		},
+		"invalid channel": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: "chan"}, Quotas: []Quota{validQuota}},
+			expected:  false,
+		},
+		"no quotas": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: "channel-0"}},
+			expected:  false,
+		},
+		"duplicate quotas": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: "channel-0"}, Quotas: []Quota{validQuota, validQuota}},
+			expected:  false,
+		},
+		"zero duration": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: "channel-0"}, Quotas: []Quota{
+				{Name: "daily", MaxPercentSend: 10, MaxPercentRecv: 10},
+			}},
+			expected: false,
+		},
+		"percent above 100": {
+			rateLimit: RateLimit{Path: RateLimitPath{Denom: "untrn", ChannelId: "channel-0"}, Quotas: []Quota{
+				{Name: "daily", MaxPercentSend: 101, MaxPercentRecv: 10, DurationSeconds: 86400},
+			}},
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.rateLimit.Validate()
+
+			// Assertions.
+			if !tc.expected {
+				require.ErrorIs(t, err, ErrInvalidRateLimit)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
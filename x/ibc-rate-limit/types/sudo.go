@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/json"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+)
+
+// MessageTransferQueued is passed to the receiving contract when its incoming transfer is queued.
+type MessageTransferQueued struct {
+	TransferQueued QueuedTransferDetails `json:"transfer_queued"`
+}
+
+// MessageTransferReleased is passed to the receiving contract when its queued transfer is released.
+type MessageTransferReleased struct {
+	TransferReleased QueuedTransferDetails `json:"transfer_released"`
+}
+
+// QueuedTransferDetails describes a queued transfer to the receiving contract.
+type QueuedTransferDetails struct {
+	ID        uint64 `json:"id"`
+	ChannelID string `json:"channel_id"`
+	Sequence  uint64 `json:"sequence"`
+	Sender    string `json:"sender"`
+	Receiver  string `json:"receiver"`
+	Denom     string `json:"denom"`
+	Amount    string `json:"amount"`
+	// the address the tokens are released to if it's not the receiver
+	Recipient string `json:"recipient,omitempty"`
+}
+
+// NewQueuedTransferDetails returns the details of the queued transfer passed to the receiving contract.
+func NewQueuedTransferDetails(queuedTransfer QueuedTransfer) QueuedTransferDetails {
+	var packetData transfertypes.FungibleTokenPacketData
+	// the packet was successfully received, so its data is known to be valid
+	_ = json.Unmarshal(queuedTransfer.Packet.GetData(), &packetData)
+
+	return QueuedTransferDetails{
+		ID:        queuedTransfer.Id,
+		ChannelID: queuedTransfer.Packet.DestinationChannel,
+		Sequence:  queuedTransfer.Packet.Sequence,
+		Sender:    packetData.Sender,
+		Receiver:  queuedTransfer.Receiver,
+		Denom:     queuedTransfer.Coin.Denom,
+		Amount:    queuedTransfer.Coin.Amount.String(),
+	}
+}
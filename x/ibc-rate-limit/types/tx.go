@@ -3,9 +3,17 @@ package types
 import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 )
 
-var _ sdk.Msg = &MsgUpdateParams{}
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgAddRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgAddUsdRateLimit{}
+	_ sdk.Msg = &MsgRemoveUsdRateLimit{}
+	_ sdk.Msg = &MsgReleaseQueuedTransfer{}
+)
 
 func (msg *MsgUpdateParams) Route() string {
 	return RouterKey
@@ -33,11 +41,160 @@ func (msg *MsgUpdateParams) Validate() error {
 	}
 
 	// we allow unsetting the contract
-	if msg.Params.ContractAddress == "" {
-		return nil
+	if msg.Params.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Params.ContractAddress); err != nil {
+			return errorsmod.Wrap(err, "contract_address is invalid")
+		}
+	}
+
+	return msg.Params.Validate()
+}
+
+func (msg *MsgAddRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddRateLimit) Type() string {
+	return "add-rate-limit"
+}
+
+func (msg *MsgAddRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgAddRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgAddRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return msg.RateLimit.Validate()
+}
+
+func (msg *MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRateLimit) Type() string {
+	return "remove-rate-limit"
+}
+
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	return msg.Path.Validate()
+}
+
+func (msg *MsgAddUsdRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddUsdRateLimit) Type() string {
+	return "add-usd-rate-limit"
+}
+
+func (msg *MsgAddUsdRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgAddUsdRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgAddUsdRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
 	}
-	if _, err := sdk.AccAddressFromBech32(msg.Params.ContractAddress); err != nil {
-		return errorsmod.Wrap(err, "contract_address is invalid")
+
+	return msg.UsdRateLimit.Validate()
+}
+
+func (msg *MsgRemoveUsdRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveUsdRateLimit) Type() string {
+	return "remove-usd-rate-limit"
+}
+
+func (msg *MsgRemoveUsdRateLimit) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveUsdRateLimit) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveUsdRateLimit) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "channel_id is invalid")
+	}
+
+	return nil
+}
+
+func (msg *MsgReleaseQueuedTransfer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgReleaseQueuedTransfer) Type() string {
+	return "release-queued-transfer"
+}
+
+func (msg *MsgReleaseQueuedTransfer) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{sender}
+}
+
+func (msg *MsgReleaseQueuedTransfer) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgReleaseQueuedTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "sender is invalid")
+	}
+
+	if msg.Recipient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Recipient); err != nil {
+			return errorsmod.Wrap(err, "recipient is invalid")
+		}
 	}
 
 	return nil
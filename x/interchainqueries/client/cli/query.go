@@ -33,6 +33,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryRegisteredQuery())
 	cmd.AddCommand(CmdQueryRegisteredQueryResult())
 	cmd.AddCommand(CmdQueryLastRemoteHeight())
+	cmd.AddCommand(CmdQueryRelayerStats())
+	cmd.AddCommand(CmdQueryAllRelayerStats())
 
 	return cmd
 }
@@ -155,3 +157,54 @@ func CmdQueryLastRemoteHeight() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relayer-stats [relayer]",
+		Short: "queries query results submission statistics of a relayer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RelayerStats(context.Background(), &types.QueryRelayerStatsRequest{Relayer: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllRelayerStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-relayer-stats",
+		Short: "queries query results submission statistics of all relayers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AllRelayerStats(context.Background(), &types.QueryAllRelayerStatsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "relayer stats")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/neutron-org/neutron/v11/x/interchainqueries/types"
@@ -25,7 +26,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(SubmitQueryResultCmd())
+	cmd.AddCommand(SubmitQueryResultsCmd())
 	cmd.AddCommand(RemoveInterchainQueryCmd())
+	cmd.AddCommand(FundQueryRewardCmd())
 
 	return cmd
 }
@@ -59,6 +62,39 @@ func RemoveInterchainQueryCmd() *cobra.Command {
 	return cmd
 }
 
+func FundQueryRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-query-reward [query-id] [amount]",
+		Short: "Top up reward escrow of interchain query",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			queryID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("failed to parse query id: %w", err)
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse amount: %w", err)
+			}
+
+			msg := types.NewMsgFundQueryReward(sender, queryID, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func SubmitQueryResultCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "submit-query-result [query-id] [result-file]",
@@ -97,3 +133,34 @@ func SubmitQueryResultCmd() *cobra.Command {
 
 	return cmd
 }
+
+func SubmitQueryResultsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-query-results [results-file]",
+		Short: "Submit a batch of KV query results proven against the same remote height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			results, err := os.ReadFile(args[0]) //nolint:gosec
+			if err != nil {
+				return fmt.Errorf("failed to read query results file: %w", err)
+			}
+
+			var msg types.MsgSubmitQueryResults
+			if err := json.Unmarshal(results, &msg); err != nil {
+				return fmt.Errorf("failed to unmarshal query results: %w", err)
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
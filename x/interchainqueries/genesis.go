@@ -25,6 +25,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	}
 
+	for _, elem := range genState.RelayerStats {
+		k.SetRelayerStats(ctx, elem)
+	}
+
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
 		panic(err)
@@ -37,6 +41,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Params = k.GetParams(ctx)
 
 	genesis.RegisteredQueries = k.GetAllRegisteredQueries(ctx)
+	genesis.RelayerStats = k.GetAllRelayerStats(ctx)
 
 	return genesis
 }
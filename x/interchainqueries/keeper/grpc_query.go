@@ -127,3 +127,42 @@ func (o ownersStore) Has(addr string) bool {
 
 	return o[addr]
 }
+
+func (k Keeper) RelayerStats(goCtx context.Context, req *types.QueryRelayerStatsRequest) (*types.QueryRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Relayer); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relayer address: %v", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryRelayerStatsResponse{Stats: k.GetRelayerStats(ctx, req.Relayer)}, nil
+}
+
+func (k Keeper) AllRelayerStats(goCtx context.Context, req *types.QueryAllRelayerStatsRequest) (*types.QueryAllRelayerStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var (
+		ctx   = sdk.UnwrapSDKContext(goCtx)
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerStatsKey)
+		stats []types.RelayerStats
+	)
+
+	pageRes, err := querytypes.Paginate(store, req.Pagination, func(_, value []byte) error {
+		relayerStats := types.RelayerStats{}
+		if err := k.cdc.Unmarshal(value, &relayerStats); err != nil {
+			return err
+		}
+		stats = append(stats, relayerStats)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "paginate: %v", err)
+	}
+
+	return &types.QueryAllRelayerStatsResponse{Stats: stats, Pagination: pageRes}, nil
+}
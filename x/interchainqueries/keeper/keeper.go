@@ -20,6 +20,7 @@ import (
 
 const (
 	LabelRegisterInterchainQuery = "register_interchain_query"
+	LabelSubmitQueryResults      = "submit_query_results"
 )
 
 type (
@@ -1730,6 +1730,382 @@ func (suite *KeeperTestSuite) TestRemoveFreshlyCreatedICQ() {
 	suite.ErrorContains(err, "only owner can remove a query within its service period")
 }
 
+func (suite *KeeperTestSuite) TestSubmitQueryResultRelayerReward() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		relayer       = suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+		reward        = sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1_000)))
+		bankKeeper    = suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+	)
+
+	// Store code and instantiate reflect contract.
+	codeID := suite.StoreTestCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateTestContract(ctx, contractOwner, codeID)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	// Top up contract address with native coins for deposit and reward escrow
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	clientKey := host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	res, err := msgSrv.RegisterInterchainQuery(ctx, &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys: []*iqtypes.KVKey{
+			{Path: ibchost.StoreKey, Key: clientKey},
+		},
+		QueryType:       string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod:    1,
+		Sender:          contractAddress.String(),
+		RewardPerUpdate: reward,
+		RewardEscrow:    reward,
+	})
+	suite.Require().NoError(err)
+
+	registeredQuery, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(reward, registeredQuery.RewardEscrow)
+
+	suite.NoError(suite.Path.EndpointB.UpdateClient())
+	suite.NoError(suite.Path.EndpointA.UpdateClient())
+
+	resp, err := suite.ChainB.App.Query(ctx, &abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", ibchost.StoreKey),
+		Height: suite.ChainB.LatestCommittedHeader.Header.Height - 1,
+		Data:   clientKey,
+		Prove:  true,
+	})
+	suite.Require().NoError(err)
+
+	relayerBalanceBefore := bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom)
+
+	_, err = msgSrv.SubmitQueryResult(ctx, &iqtypes.MsgSubmitQueryResult{
+		QueryId: res.Id,
+		Sender:  relayer.String(),
+		Result: &iqtypes.QueryResult{
+			KvResults: []*iqtypes.StorageValue{{
+				Key:           resp.Key,
+				Proof:         resp.ProofOps,
+				Value:         resp.Value,
+				StoragePrefix: ibchost.StoreKey,
+			}},
+			Block:    nil,
+			Height:   uint64(resp.Height), //nolint:gosec
+			Revision: suite.ChainA.LatestCommittedHeader.GetHeight().GetRevisionNumber(),
+		},
+	})
+	suite.Require().NoError(err)
+
+	// the relayer is paid the reward per update out of the query's reward escrow
+	relayerBalanceAfter := bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom)
+	suite.Require().Equal(relayerBalanceBefore.Add(reward[0]), relayerBalanceAfter)
+
+	registeredQuery, err = iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(registeredQuery.RewardEscrow.IsZero())
+
+	stats := iqkeeper.GetRelayerStats(ctx, relayer.String())
+	suite.Require().Equal(uint64(1), stats.Submissions)
+	suite.Require().Equal(reward, stats.RewardsEarned)
+
+	statsResp, err := iqkeeper.RelayerStats(ctx, &iqtypes.QueryRelayerStatsRequest{Relayer: relayer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(stats, statsResp.Stats)
+}
+
+func (suite *KeeperTestSuite) TestRelayerRewardPerUpdatePeriod() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		relayer       = suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+		reward        = sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1_000)))
+		bankKeeper    = suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+	)
+
+	codeID := suite.StoreTestCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateTestContract(ctx, contractOwner, codeID)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	res, err := msgSrv.RegisterInterchainQuery(ctx, &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:    suite.Path.EndpointA.ConnectionID,
+		Keys:            []*iqtypes.KVKey{{Path: ibchost.StoreKey, Key: host.FullClientStateKey(suite.Path.EndpointB.ClientID)}},
+		QueryType:       string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod:    10,
+		Sender:          contractAddress.String(),
+		RewardPerUpdate: reward,
+		RewardEscrow:    reward.Add(reward...),
+	})
+	suite.Require().NoError(err)
+
+	submit := func(ctx sdk.Context) {
+		query, err := iqkeeper.GetQueryByID(ctx, res.Id)
+		suite.Require().NoError(err)
+		suite.Require().NoError(iqkeeper.RecordQueryResultSubmission(ctx, query, relayer, false))
+	}
+
+	relayerBalanceBefore := bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom)
+
+	// only the first of two submissions within one update period is rewarded
+	submit(ctx)
+	submit(ctx.WithBlockHeight(ctx.BlockHeight() + 9))
+	suite.Require().Equal(relayerBalanceBefore.Add(reward[0]), bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom))
+
+	stats := iqkeeper.GetRelayerStats(ctx, relayer.String())
+	suite.Require().Equal(uint64(2), stats.Submissions)
+	suite.Require().Equal(reward, stats.RewardsEarned)
+
+	// the next submission is rewarded once the update period has passed
+	submit(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	suite.Require().Equal(relayerBalanceBefore.Add(reward[0]).Add(reward[0]), bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom))
+
+	query, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(query.RewardEscrow.IsZero())
+	suite.Require().Equal(uint64(ctx.BlockHeight()+10), query.LastRewardedLocalHeight) //nolint:gosec
+}
+
+func (suite *KeeperTestSuite) TestClearRewardPerUpdate() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		relayer       = suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+		reward        = sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1_000)))
+		bankKeeper    = suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+	)
+
+	codeID := suite.StoreTestCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateTestContract(ctx, contractOwner, codeID)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	res, err := msgSrv.RegisterInterchainQuery(ctx, &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId:    suite.Path.EndpointA.ConnectionID,
+		Keys:            []*iqtypes.KVKey{{Path: ibchost.StoreKey, Key: host.FullClientStateKey(suite.Path.EndpointB.ClientID)}},
+		QueryType:       string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod:    1,
+		Sender:          contractAddress.String(),
+		RewardPerUpdate: reward,
+		RewardEscrow:    reward,
+	})
+	suite.Require().NoError(err)
+
+	// a new reward and clearing the reward can't be requested at once
+	_, err = msgSrv.UpdateInterchainQuery(ctx, &iqtypes.MsgUpdateInterchainQueryRequest{
+		QueryId:              res.Id,
+		NewRewardPerUpdate:   reward,
+		ClearRewardPerUpdate: true,
+		Sender:               contractAddress.String(),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgSrv.UpdateInterchainQuery(ctx, &iqtypes.MsgUpdateInterchainQueryRequest{
+		QueryId:              res.Id,
+		ClearRewardPerUpdate: true,
+		Sender:               contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	query, err := iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(query.RewardPerUpdate.IsZero())
+
+	// the submission isn't rewarded anymore, and the reward escrow is kept
+	relayerBalanceBefore := bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom)
+	suite.Require().NoError(iqkeeper.RecordQueryResultSubmission(ctx, query, relayer, false))
+	suite.Require().Equal(relayerBalanceBefore, bankKeeper.GetBalance(ctx, relayer, params.DefaultDenom))
+
+	query, err = iqkeeper.GetQueryByID(ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(reward, query.RewardEscrow)
+}
+
+func (suite *KeeperTestSuite) TestSubmitQueryResults() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		relayer       = suite.ChainA.SenderAccounts[1].SenderAccount.GetAddress()
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+	)
+
+	// Store code and instantiate reflect contract.
+	codeID := suite.StoreTestCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateTestContract(ctx, contractOwner, codeID)
+	suite.Require().NotEmpty(contractAddress)
+
+	err := testutil.SetupICAPath(suite.Path, contractAddress.String())
+	suite.Require().NoError(err)
+
+	// Top up contract address with native coins for deposits of two queries
+	senderAddress := suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+	suite.TopUpWallet(ctx, senderAddress, contractAddress)
+
+	clientKey := host.FullClientStateKey(suite.Path.EndpointB.ClientID)
+	validRes, err := msgSrv.RegisterInterchainQuery(ctx, &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{{Path: ibchost.StoreKey, Key: clientKey}},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	// the result submitted for this query doesn't match its registered key
+	invalidRes, err := msgSrv.RegisterInterchainQuery(ctx, &iqtypes.MsgRegisterInterchainQuery{
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Keys:         []*iqtypes.KVKey{{Path: ibchost.StoreKey, Key: []byte("another_key")}},
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		UpdatePeriod: 1,
+		Sender:       contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	suite.NoError(suite.Path.EndpointB.UpdateClient())
+	suite.NoError(suite.Path.EndpointA.UpdateClient())
+
+	resp, err := suite.ChainB.App.Query(ctx, &abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", ibchost.StoreKey),
+		Height: suite.ChainB.LatestCommittedHeader.Header.Height - 1,
+		Data:   clientKey,
+		Prove:  true,
+	})
+	suite.Require().NoError(err)
+
+	kvResults := []*iqtypes.StorageValue{{
+		Key:           resp.Key,
+		Proof:         resp.ProofOps,
+		Value:         resp.Value,
+		StoragePrefix: ibchost.StoreKey,
+	}}
+
+	batchRes, err := msgSrv.SubmitQueryResults(ctx, &iqtypes.MsgSubmitQueryResults{
+		Sender:       relayer.String(),
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		Height:       uint64(resp.Height), //nolint:gosec
+		Revision:     suite.ChainA.LatestCommittedHeader.GetHeight().GetRevisionNumber(),
+		Results: []*iqtypes.BatchedQueryResult{
+			{QueryId: validRes.Id, KvResults: kvResults},
+			{QueryId: invalidRes.Id, KvResults: kvResults},
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(batchRes.Statuses, 2)
+
+	suite.Require().Equal(validRes.Id, batchRes.Statuses[0].QueryId)
+	suite.Require().True(batchRes.Statuses[0].Success)
+	suite.Require().Empty(batchRes.Statuses[0].Error)
+
+	suite.Require().Equal(invalidRes.Id, batchRes.Statuses[1].QueryId)
+	suite.Require().False(batchRes.Statuses[1].Success)
+	suite.Require().NotEmpty(batchRes.Statuses[1].Error)
+
+	// only the successfully processed result is saved
+	validQuery, err := iqkeeper.GetQueryByID(ctx, validRes.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(resp.Height), validQuery.LastSubmittedResultRemoteHeight.RevisionHeight) //nolint:gosec
+
+	invalidQuery, err := iqkeeper.GetQueryByID(ctx, invalidRes.Id)
+	suite.Require().NoError(err)
+	suite.Require().Nil(invalidQuery.LastSubmittedResultRemoteHeight)
+
+	stats := iqkeeper.GetRelayerStats(ctx, relayer.String())
+	suite.Require().Equal(uint64(1), stats.Submissions)
+}
+
+func (suite *KeeperTestSuite) TestFundQueryReward() {
+	suite.SetupTest()
+
+	var (
+		ctx           = suite.ChainA.GetContext()
+		contractOwner = wasmKeeper.RandomAccountAddress(suite.T())
+		funder        = suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress()
+		amount        = sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(5_000)))
+		bankKeeper    = suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+		iqkeeper      = suite.GetNeutronZoneApp(suite.ChainA).InterchainQueriesKeeper
+		msgSrv        = keeper.NewMsgServerImpl(iqkeeper)
+	)
+
+	// Store code and instantiate reflect contract.
+	codeID := suite.StoreTestCode(ctx, contractOwner, reflectContractPath)
+	contractAddress := suite.InstantiateTestContract(ctx, contractOwner, codeID)
+	suite.Require().NotEmpty(contractAddress)
+
+	// Top up contract address with native coins for deposit
+	suite.TopUpWallet(ctx, funder, contractAddress)
+
+	resRegister, err := msgSrv.RegisterInterchainQuery(ctx, &iqtypes.MsgRegisterInterchainQuery{
+		QueryType:    string(iqtypes.InterchainQueryTypeKV),
+		Keys:         []*iqtypes.KVKey{{Key: []byte("key1"), Path: "path1"}},
+		ConnectionId: suite.Path.EndpointA.ConnectionID,
+		UpdatePeriod: 1,
+		Sender:       contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+
+	_, err = msgSrv.FundQueryReward(ctx, &iqtypes.MsgFundQueryReward{
+		QueryId: resRegister.Id + 1,
+		Amount:  amount,
+		Sender:  funder.String(),
+	})
+	suite.Require().ErrorIs(err, iqtypes.ErrInvalidQueryID)
+
+	_, err = msgSrv.FundQueryReward(ctx, &iqtypes.MsgFundQueryReward{
+		QueryId: resRegister.Id,
+		Amount:  amount,
+		Sender:  funder.String(),
+	})
+	suite.Require().NoError(err)
+
+	registeredQuery, err := iqkeeper.GetQueryByID(ctx, resRegister.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount, registeredQuery.RewardEscrow)
+
+	// the remaining escrow is paid back to the query owner on removal
+	ownerBalanceBefore := bankKeeper.GetBalance(ctx, contractAddress, params.DefaultDenom)
+	_, err = msgSrv.RemoveInterchainQuery(ctx, &iqtypes.MsgRemoveInterchainQueryRequest{
+		QueryId: resRegister.Id,
+		Sender:  contractAddress.String(),
+	})
+	suite.Require().NoError(err)
+	ownerBalanceAfter := bankKeeper.GetBalance(ctx, contractAddress, params.DefaultDenom)
+	suite.Require().Equal(
+		ownerBalanceBefore.Add(amount[0]).Add(registeredQuery.Deposit[0]),
+		ownerBalanceAfter,
+	)
+}
+
 func (suite *KeeperTestSuite) TopUpWallet(ctx sdk.Context, sender, contractAddress sdk.AccAddress) {
 	coinsAmnt := sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(int64(1_000_000))))
 	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
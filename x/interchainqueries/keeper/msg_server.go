@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/03-connection/keeper"
 	ics23 "github.com/cosmos/ics23/go"
 
+	tmtypes "github.com/cometbft/cometbft/types"
+
 	"cosmossdk.io/errors"
 	tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
@@ -21,6 +23,7 @@ import (
 	ibccommitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 
 	"github.com/neutron-org/neutron/v11/utils/stateverification"
+	contractmanagerkeeper "github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
 	"github.com/neutron-org/neutron/v11/x/interchainqueries/types"
 )
 
@@ -77,6 +80,7 @@ func (m msgServer) RegisterInterchainQuery(goCtx context.Context, msg *types.Msg
 		Deposit:            params.QueryDeposit,
 		SubmitTimeout:      params.QuerySubmitTimeout,
 		RegisteredAtHeight: uint64(ctx.BlockHeader().Height), //nolint:gosec
+		RewardPerUpdate:    msg.RewardPerUpdate,
 	}
 
 	m.SetLastRegisteredQueryKey(ctx, lastID)
@@ -86,6 +90,11 @@ func (m msgServer) RegisterInterchainQuery(goCtx context.Context, msg *types.Msg
 		return nil, errors.Wrapf(err, "failed to collect deposit")
 	}
 
+	if err := m.CollectRewardEscrow(ctx, registeredQuery, senderAddr, msg.RewardEscrow); err != nil {
+		ctx.Logger().Debug("RegisterInterchainQuery: failed to collect reward escrow", "message", &msg, "error", err)
+		return nil, errors.Wrapf(err, "failed to collect reward escrow")
+	}
+
 	if err := m.SaveQuery(ctx, registeredQuery); err != nil {
 		ctx.Logger().Debug("RegisterInterchainQuery: failed to save query", "message", &msg, "error", err)
 		return nil, errors.Wrapf(err, "failed to save query: %v", err)
@@ -119,6 +128,14 @@ func (m msgServer) RemoveInterchainQuery(goCtx context.Context, msg *types.MsgRe
 
 	m.RemoveQuery(ctx, query)
 	m.MustPayOutDeposit(ctx, query.Deposit, msg.GetSigners()[0])
+	if !query.RewardEscrow.IsZero() {
+		owner, err := query.GetOwnerAddress()
+		if err != nil {
+			return nil, err
+		}
+		// the reward escrow always belongs to the query owner regardless of who removes the query
+		m.MustPayOutDeposit(ctx, query.RewardEscrow, owner)
+	}
 	ctx.EventManager().EmitEvents(getEventsQueryRemoved(query))
 	return &types.MsgRemoveInterchainQueryResponse{}, nil
 }
@@ -159,6 +176,12 @@ func (m msgServer) UpdateInterchainQuery(goCtx context.Context, msg *types.MsgUp
 	if msg.GetNewTransactionsFilter() != "" && types.InterchainQueryType(query.GetQueryType()).IsTX() {
 		query.TransactionsFilter = msg.GetNewTransactionsFilter()
 	}
+	if !msg.NewRewardPerUpdate.IsZero() {
+		query.RewardPerUpdate = msg.NewRewardPerUpdate
+	}
+	if msg.GetClearRewardPerUpdate() {
+		query.RewardPerUpdate = nil
+	}
 
 	if err := m.SaveQuery(ctx, query); err != nil {
 		ctx.Logger().Debug("UpdateInterchainQuery: failed to save query", "message", &msg, "error", err)
@@ -199,39 +222,17 @@ func (m msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 		return nil, errors.Wrapf(err, "failed to decode owner contract address (%s)", query.Owner)
 	}
 
-	if msg.Result.KvResults != nil {
-		if !types.InterchainQueryType(query.QueryType).IsKV() {
-			return nil, errors.Wrapf(types.ErrInvalidType, "invalid query result for query type: %s", query.QueryType)
-		}
-		if err := m.checkLastRemoteHeight(ctx, *query, ibcclienttypes.NewHeight(msg.Result.Revision, msg.Result.Height)); err != nil {
-			return nil, errors.Wrap(types.ErrInvalidHeight, err.Error())
-		}
-		if len(msg.Result.KvResults) != len(query.Keys) {
-			return nil, errors.Wrapf(types.ErrInvalidSubmittedResult, "KV keys length from result is not equal to registered query keys length: %v != %v", len(msg.Result.KvResults), len(query.Keys))
-		}
+	relayer := msg.GetSigners()[0]
+	// must be calculated before the query result is saved since it updates the query's last
+	// submission height
+	lateSubmission := query.IsSubmissionLate(ctx)
 
-		resp, err := keeper.NewQueryServer(m.ibcKeeper.ConnectionKeeper).ConnectionConsensusState(goCtx, &ibcconnectiontypes.QueryConnectionConsensusStateRequest{
-			ConnectionId:   query.ConnectionId,
-			RevisionNumber: msg.Result.Revision,
-			RevisionHeight: msg.Result.Height + 1,
-		})
-		if err != nil {
-			ctx.Logger().Debug("SubmitQueryResult: failed to get ConnectionConsensusState",
-				"error", err, "query", query, "message", msg)
-			return nil, errors.Wrapf(ibcclienttypes.ErrConsensusStateNotFound, "failed to get consensus state: %v", err)
-		}
-		consensusStateI, err := ibcclienttypes.UnpackConsensusState(resp.ConsensusState)
+	if msg.Result.KvResults != nil {
+		consensusState, err := m.getConsensusState(ctx, query.ConnectionId, msg.Result.Revision, msg.Result.Height)
 		if err != nil {
-			ctx.Logger().Error("SubmitQueryResult: failed to UnpackConsensusState",
+			ctx.Logger().Debug("SubmitQueryResult: failed to get consensus state",
 				"error", err, "query", query, "message", msg)
-			return nil, fmt.Errorf("failed marshal: %s, %w", consensusStateI.String(), err)
-		}
-
-		consensusState, ok := consensusStateI.(*tendermint.ConsensusState)
-		if !ok {
-			ctx.Logger().Error("SubmitQueryResult: failed to cast exported.ConsensusState to *tendermint.ConsensusState",
-				"error", err, "query", query, "message", msg)
-			return nil, errors.Wrapf(sdkerrors.ErrUnpackAny, "failed to cast interface exported.ConsensusState to type *tendermint.ConsensusState")
+			return nil, err
 		}
 
 		clientState, err := m.GetClientState(ctx, connection.ClientId)
@@ -239,53 +240,13 @@ func (m msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 			return nil, err
 		}
 
-		// an additional method of a verification of submitted storage values
-		// We need to check:
-		// * values are submitted only for the requested keys
-		// * values are submitted only for the requested module (StoragePrefix)
-		// * an additional sanity fix: if proof for a value has NonExist type, we need to nullify the value field itself
-		//		Otherwise, a malicious relayer can submit NonExist proof with non-null value which is not right (there can't be any value if key doesn't exist)
-		checkStorageValues := func(index int) error {
-			if !bytes.Equal(msg.Result.KvResults[index].Key, query.Keys[index].Key) {
-				return errors.Wrapf(types.ErrInvalidSubmittedResult, "KV key from result is not equal to registered query key: %v != %v", msg.Result.KvResults[index].Key, query.Keys[index].Key)
-			}
-
-			if msg.Result.KvResults[index].StoragePrefix != query.Keys[index].Path {
-				return errors.Wrapf(types.ErrInvalidSubmittedResult, "KV path from result is not equal to registered query storage prefix: %v != %v", msg.Result.KvResults[index].StoragePrefix, query.Keys[index].Path)
-			}
-
-			proof, err := ibccommitmenttypes.ConvertProofs(msg.Result.KvResults[index].Proof)
-			if err != nil {
-				return errors.Wrapf(stateverification.ErrInvalidType, "failed to convert crypto.ProofOps to MerkleProof: %v", err)
-			}
-
-			// if proof for a value has NonExist type, we need to nullify the value field itself
-			// Otherwise, a malicious relayer can submit NonExist proof with non-null value which is not right (there can't be any value if key doesn't exist)
-			if _, ok := proof.GetProofs()[0].GetProof().(*ics23.CommitmentProof_Nonexist); ok {
-				msg.Result.KvResults[index].Value = nil
-			}
-
-			return nil
-		}
-
-		if err := stateverification.VerifyStorageValues(msg.Result.KvResults, consensusState.GetRoot(), clientState.ProofSpecs, checkStorageValues); err != nil {
-			return nil, errors.Wrapf(types.ErrInvalidSubmittedResult, "failed to verify submitted result: %v", err)
-		}
-
-		if err = m.saveKVQueryResult(ctx, query, msg.Result); err != nil {
-			ctx.Logger().Error("SubmitQueryResult: failed to SaveKVQueryResult",
+		if err := m.processKVQueryResult(ctx, query, msg.Result, consensusState, clientState, relayer); err != nil {
+			ctx.Logger().Debug("SubmitQueryResult: failed to process KV query result",
 				"error", err, "query", query, "message", msg)
-			return nil, errors.Wrapf(err, "failed to SaveKVQueryResult: %v", err)
+			return nil, err
 		}
 
 		if msg.Result.GetAllowKvCallbacks() {
-			// Let the query owner contract process the query result.
-			if _, err := m.contractManagerKeeper.SudoKVQueryResult(ctx, queryOwner, query.Id); err != nil {
-				ctx.Logger().Debug("SubmitQueryResult: failed to SudoKVQueryResult",
-					"error", err, "query_id", query.GetId())
-				return nil, errors.Wrapf(err, "contract %s rejected KV query result (query_id: %d)",
-					queryOwner, query.GetId())
-			}
 			return &types.MsgSubmitQueryResultResponse{}, nil
 		}
 	}
@@ -295,6 +256,9 @@ func (m msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 			return nil, errors.Wrapf(types.ErrInvalidType, "invalid query result for query type: %s", query.QueryType)
 		}
 
+		// resubmission of an already processed transaction is a no-op and isn't rewarded
+		alreadyProcessed := m.CheckTransactionIsAlreadyProcessed(ctx, query.Id, tmtypes.Tx(msg.Result.Block.Tx.GetData()).Hash())
+
 		if err := m.ProcessBlock(ctx, queryOwner, msg.QueryId, connection.ClientId, msg.Result.Block); err != nil {
 			ctx.Logger().Debug("SubmitQueryResult: failed to ProcessBlock",
 				"error", err, "query", query, "message", msg)
@@ -305,11 +269,243 @@ func (m msgServer) SubmitQueryResult(goCtx context.Context, msg *types.MsgSubmit
 			return nil, errors.Wrapf(err,
 				"failed to update last local height for a result with id %d: %v", query.Id, err)
 		}
+
+		if !alreadyProcessed {
+			updatedQuery, err := m.GetQueryByID(ctx, query.Id)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get query by id: %v", err)
+			}
+			if err := m.RecordQueryResultSubmission(ctx, updatedQuery, relayer, lateSubmission); err != nil {
+				ctx.Logger().Error("SubmitQueryResult: failed to RecordQueryResultSubmission",
+					"error", err, "query", query, "message", msg)
+				return nil, errors.Wrapf(err, "failed to record query result submission: %v", err)
+			}
+		}
 	}
 
 	return &types.MsgSubmitQueryResultResponse{}, nil
 }
 
+func (m msgServer) SubmitQueryResults(goCtx context.Context, msg *types.MsgSubmitQueryResults) (*types.MsgSubmitQueryResultsResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), LabelSubmitQueryResults)
+
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSubmitQueryResults")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("SubmitQueryResults", "connection_id", msg.ConnectionId, "results", len(msg.Results))
+
+	connection, ok := m.ibcKeeper.ConnectionKeeper.GetConnection(ctx, msg.ConnectionId)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidConnectionID, "connection %s not found", msg.ConnectionId)
+	}
+
+	// all the results share the same remote height, so the consensus state and the client state
+	// are only looked up once for the whole batch
+	consensusState, err := m.getConsensusState(ctx, msg.ConnectionId, msg.Revision, msg.Height)
+	if err != nil {
+		ctx.Logger().Debug("SubmitQueryResults: failed to get consensus state",
+			"error", err, "message", msg)
+		return nil, err
+	}
+
+	clientState, err := m.GetClientState(ctx, connection.ClientId)
+	if err != nil {
+		return nil, err
+	}
+
+	relayer := msg.GetSigners()[0]
+	statuses := make([]types.BatchedQueryResultStatus, 0, len(msg.Results))
+	for _, result := range msg.Results {
+		// each result is processed in a separate cached context so a failure doesn't affect other
+		// results of the batch
+		cacheCtx, writeFn := ctx.CacheContext()
+		err := m.processBatchedQueryResult(cacheCtx, msg, result, consensusState, clientState, relayer)
+
+		status := types.BatchedQueryResultStatus{QueryId: result.QueryId, Success: err == nil}
+		if err != nil {
+			ctx.Logger().Debug("SubmitQueryResults: failed to process query result",
+				"error", err, "query_id", result.QueryId)
+			status.Error = contractmanagerkeeper.RedactError(err).Error()
+		} else {
+			writeFn()
+		}
+		statuses = append(statuses, status)
+	}
+
+	return &types.MsgSubmitQueryResultsResponse{Statuses: statuses}, nil
+}
+
+// processBatchedQueryResult processes a single KV query result submitted within a batch.
+func (m msgServer) processBatchedQueryResult(
+	ctx sdk.Context,
+	msg *types.MsgSubmitQueryResults,
+	result *types.BatchedQueryResult,
+	consensusState *tendermint.ConsensusState,
+	clientState *tendermint.ClientState,
+	relayer sdk.AccAddress,
+) error {
+	query, err := m.GetQueryByID(ctx, result.QueryId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get query by id: %v", err)
+	}
+
+	if query.ConnectionId != msg.ConnectionId {
+		return errors.Wrapf(types.ErrInvalidConnectionID, "query %d connection id %s doesn't match the batch connection id %s", query.Id, query.ConnectionId, msg.ConnectionId)
+	}
+
+	return m.processKVQueryResult(ctx, query, &types.QueryResult{
+		KvResults:        result.KvResults,
+		Height:           msg.Height,
+		Revision:         msg.Revision,
+		AllowKvCallbacks: result.AllowKvCallbacks,
+	}, consensusState, clientState, relayer)
+}
+
+// getConsensusState returns the consensus state of the IBC client behind the given connection
+// that is used to verify results of queries executed on the remote chain at the given height.
+func (m msgServer) getConsensusState(ctx sdk.Context, connectionID string, revision, height uint64) (*tendermint.ConsensusState, error) {
+	resp, err := keeper.NewQueryServer(m.ibcKeeper.ConnectionKeeper).ConnectionConsensusState(ctx, &ibcconnectiontypes.QueryConnectionConsensusStateRequest{
+		ConnectionId:   connectionID,
+		RevisionNumber: revision,
+		RevisionHeight: height + 1,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(ibcclienttypes.ErrConsensusStateNotFound, "failed to get consensus state: %v", err)
+	}
+
+	consensusStateI, err := ibcclienttypes.UnpackConsensusState(resp.ConsensusState)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrUnpackAny, "failed to unpack consensus state: %v", err)
+	}
+
+	consensusState, ok := consensusStateI.(*tendermint.ConsensusState)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrUnpackAny, "failed to cast interface exported.ConsensusState to type *tendermint.ConsensusState")
+	}
+
+	return consensusState, nil
+}
+
+// processKVQueryResult verifies the KV query result against the given consensus state, saves it,
+// records the submission for the relayer and, if requested, passes the result to the query owner.
+func (m msgServer) processKVQueryResult(
+	ctx sdk.Context,
+	query *types.RegisteredQuery,
+	result *types.QueryResult,
+	consensusState *tendermint.ConsensusState,
+	clientState *tendermint.ClientState,
+	relayer sdk.AccAddress,
+) error {
+	if !types.InterchainQueryType(query.QueryType).IsKV() {
+		return errors.Wrapf(types.ErrInvalidType, "invalid query result for query type: %s", query.QueryType)
+	}
+	if err := m.checkLastRemoteHeight(ctx, *query, ibcclienttypes.NewHeight(result.Revision, result.Height)); err != nil {
+		return errors.Wrap(types.ErrInvalidHeight, err.Error())
+	}
+	if len(result.KvResults) != len(query.Keys) {
+		return errors.Wrapf(types.ErrInvalidSubmittedResult, "KV keys length from result is not equal to registered query keys length: %v != %v", len(result.KvResults), len(query.Keys))
+	}
+
+	queryOwner, err := query.GetOwnerAddress()
+	if err != nil {
+		return err
+	}
+
+	// must be calculated before the query result is saved since it updates the query's last
+	// submission height
+	lateSubmission := query.IsSubmissionLate(ctx)
+
+	// an additional method of a verification of submitted storage values
+	// We need to check:
+	// * values are submitted only for the requested keys
+	// * values are submitted only for the requested module (StoragePrefix)
+	// * an additional sanity fix: if proof for a value has NonExist type, we need to nullify the value field itself
+	//		Otherwise, a malicious relayer can submit NonExist proof with non-null value which is not right (there can't be any value if key doesn't exist)
+	checkStorageValues := func(index int) error {
+		if !bytes.Equal(result.KvResults[index].Key, query.Keys[index].Key) {
+			return errors.Wrapf(types.ErrInvalidSubmittedResult, "KV key from result is not equal to registered query key: %v != %v", result.KvResults[index].Key, query.Keys[index].Key)
+		}
+
+		if result.KvResults[index].StoragePrefix != query.Keys[index].Path {
+			return errors.Wrapf(types.ErrInvalidSubmittedResult, "KV path from result is not equal to registered query storage prefix: %v != %v", result.KvResults[index].StoragePrefix, query.Keys[index].Path)
+		}
+
+		proof, err := ibccommitmenttypes.ConvertProofs(result.KvResults[index].Proof)
+		if err != nil {
+			return errors.Wrapf(stateverification.ErrInvalidType, "failed to convert crypto.ProofOps to MerkleProof: %v", err)
+		}
+
+		// if proof for a value has NonExist type, we need to nullify the value field itself
+		// Otherwise, a malicious relayer can submit NonExist proof with non-null value which is not right (there can't be any value if key doesn't exist)
+		if _, ok := proof.GetProofs()[0].GetProof().(*ics23.CommitmentProof_Nonexist); ok {
+			result.KvResults[index].Value = nil
+		}
+
+		return nil
+	}
+
+	if err := stateverification.VerifyStorageValues(result.KvResults, consensusState.GetRoot(), clientState.ProofSpecs, checkStorageValues); err != nil {
+		return errors.Wrapf(types.ErrInvalidSubmittedResult, "failed to verify submitted result: %v", err)
+	}
+
+	if err := m.saveKVQueryResult(ctx, query, result); err != nil {
+		ctx.Logger().Error("processKVQueryResult: failed to SaveKVQueryResult",
+			"error", err, "query", query)
+		return errors.Wrapf(err, "failed to SaveKVQueryResult: %v", err)
+	}
+
+	// the query owner can remove the query in the callback, so the relayer has to be paid first
+	if err := m.RecordQueryResultSubmission(ctx, query, relayer, lateSubmission); err != nil {
+		ctx.Logger().Error("processKVQueryResult: failed to RecordQueryResultSubmission",
+			"error", err, "query", query)
+		return errors.Wrapf(err, "failed to record query result submission: %v", err)
+	}
+
+	if result.GetAllowKvCallbacks() {
+		// Let the query owner contract process the query result.
+		if _, err := m.contractManagerKeeper.SudoKVQueryResult(ctx, queryOwner, query.Id); err != nil {
+			ctx.Logger().Debug("processKVQueryResult: failed to SudoKVQueryResult",
+				"error", err, "query_id", query.GetId())
+			return errors.Wrapf(err, "contract %s rejected KV query result (query_id: %d)",
+				queryOwner, query.GetId())
+		}
+	}
+
+	return nil
+}
+
+func (m msgServer) FundQueryReward(goCtx context.Context, msg *types.MsgFundQueryReward) (*types.MsgFundQueryRewardResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgFundQueryReward")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx.Logger().Debug("FundQueryReward", "msg", msg)
+
+	query, err := m.GetQueryByID(ctx, msg.GetQueryId())
+	if err != nil {
+		ctx.Logger().Debug("FundQueryReward: failed to GetQueryByID",
+			"error", err, "query_id", msg.QueryId)
+		return nil, errors.Wrapf(err, "failed to get query by query id: %v", err)
+	}
+
+	if err := m.CollectRewardEscrow(ctx, query, msg.GetSigners()[0], msg.Amount); err != nil {
+		ctx.Logger().Debug("FundQueryReward: failed to collect reward escrow", "message", &msg, "error", err)
+		return nil, errors.Wrapf(err, "failed to collect reward escrow")
+	}
+
+	if err := m.SaveQuery(ctx, query); err != nil {
+		ctx.Logger().Debug("FundQueryReward: failed to save query", "message", &msg, "error", err)
+		return nil, errors.Wrapf(err, "failed to save query by query id: %v", err)
+	}
+
+	ctx.EventManager().EmitEvents(getEventsQueryRewardFunded(query, msg))
+
+	return &types.MsgFundQueryRewardResponse{}, nil
+}
+
 // validateUpdateInterchainQueryParams checks whether the parameters to be updated corresponds
 // with the query type.
 func (m msgServer) validateUpdateInterchainQueryParams(
@@ -364,6 +560,19 @@ func getEventsQueryUpdated(query *types.RegisteredQuery) sdk.Events {
 	}
 }
 
+func getEventsQueryRewardFunded(query *types.RegisteredQuery, msg *types.MsgFundQueryReward) sdk.Events {
+	return sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNeutronMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQueryRewardFunded),
+			sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	}
+}
+
 func getEventsQueryRemoved(query *types.RegisteredQuery) sdk.Events {
 	return sdk.Events{
 		sdk.NewEvent(
@@ -3,7 +3,9 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	"github.com/stretchr/testify/require"
@@ -296,6 +298,113 @@ func TestMsgSubmitQueryResultValidate(t *testing.T) {
 	}
 }
 
+func TestMsgSubmitQueryResultsValidate(t *testing.T) {
+	k, ctx := testkeeper.InterchainQueriesKeeper(t, nil, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	kvResults := []*types.StorageValue{{
+		Key:           []byte{10},
+		Proof:         &crypto.ProofOps{Ops: []crypto.ProofOp{{Type: "type", Key: []byte{10}, Data: []byte{10}}}},
+		Value:         []byte{10},
+		StoragePrefix: ibchost.StoreKey,
+	}}
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSubmitQueryResults
+		expectedErr error
+	}{
+		{
+			"empty results",
+			types.MsgSubmitQueryResults{
+				Sender:       testutil.TestOwnerAddress,
+				ConnectionId: "connection-0",
+				Height:       100,
+				Revision:     1,
+				Results:      nil,
+			},
+			types.ErrEmptyResult,
+		},
+		{
+			"empty kv results",
+			types.MsgSubmitQueryResults{
+				Sender:       testutil.TestOwnerAddress,
+				ConnectionId: "connection-0",
+				Height:       100,
+				Revision:     1,
+				Results:      []*types.BatchedQueryResult{{QueryId: 1, KvResults: nil}},
+			},
+			types.ErrEmptyResult,
+		},
+		{
+			"zero query id",
+			types.MsgSubmitQueryResults{
+				Sender:       testutil.TestOwnerAddress,
+				ConnectionId: "connection-0",
+				Height:       100,
+				Revision:     1,
+				Results:      []*types.BatchedQueryResult{{QueryId: 0, KvResults: kvResults}},
+			},
+			types.ErrInvalidQueryID,
+		},
+		{
+			"duplicate query id",
+			types.MsgSubmitQueryResults{
+				Sender:       testutil.TestOwnerAddress,
+				ConnectionId: "connection-0",
+				Height:       100,
+				Revision:     1,
+				Results: []*types.BatchedQueryResult{
+					{QueryId: 1, KvResults: kvResults},
+					{QueryId: 1, KvResults: kvResults},
+				},
+			},
+			types.ErrInvalidQueryID,
+		},
+		{
+			"empty connection id",
+			types.MsgSubmitQueryResults{
+				Sender:       testutil.TestOwnerAddress,
+				ConnectionId: "",
+				Height:       100,
+				Revision:     1,
+				Results:      []*types.BatchedQueryResult{{QueryId: 1, KvResults: kvResults}},
+			},
+			types.ErrInvalidConnectionID,
+		},
+		{
+			"empty sender",
+			types.MsgSubmitQueryResults{
+				Sender:       "",
+				ConnectionId: "connection-0",
+				Height:       100,
+				Revision:     1,
+				Results:      []*types.BatchedQueryResult{{QueryId: 1, KvResults: kvResults}},
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid sender",
+			types.MsgSubmitQueryResults{
+				Sender:       "invalid sender",
+				ConnectionId: "connection-0",
+				Height:       100,
+				Revision:     1,
+				Results:      []*types.BatchedQueryResult{{QueryId: 1, KvResults: kvResults}},
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.SubmitQueryResults(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
 func TestMsgRemoveInterchainQueryRequestValidate(t *testing.T) {
 	k, ctx := testkeeper.InterchainQueriesKeeper(t, nil, nil, nil, nil)
 	msgServer := keeper.NewMsgServerImpl(*k)
@@ -340,6 +449,71 @@ func TestMsgRemoveInterchainQueryRequestValidate(t *testing.T) {
 	}
 }
 
+func TestMsgFundQueryRewardValidate(t *testing.T) {
+	k, ctx := testkeeper.InterchainQueriesKeeper(t, nil, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgFundQueryReward
+		expectedErr error
+	}{
+		{
+			"invalid query id",
+			types.MsgFundQueryReward{
+				QueryId: 0,
+				Amount:  sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(100))),
+				Sender:  testutil.TestOwnerAddress,
+			},
+			types.ErrInvalidQueryID,
+		},
+		{
+			"empty amount",
+			types.MsgFundQueryReward{
+				QueryId: 1,
+				Amount:  sdk.NewCoins(),
+				Sender:  testutil.TestOwnerAddress,
+			},
+			types.ErrInvalidReward,
+		},
+		{
+			"invalid amount",
+			types.MsgFundQueryReward{
+				QueryId: 1,
+				Amount:  sdk.Coins{{Denom: "untrn", Amount: math.NewInt(-1)}},
+				Sender:  testutil.TestOwnerAddress,
+			},
+			types.ErrInvalidReward,
+		},
+		{
+			"empty sender",
+			types.MsgFundQueryReward{
+				QueryId: 1,
+				Amount:  sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(100))),
+				Sender:  "",
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"invalid sender",
+			types.MsgFundQueryReward{
+				QueryId: 1,
+				Amount:  sdk.NewCoins(sdk.NewCoin("untrn", math.NewInt(100))),
+				Sender:  "invalid-sender",
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.FundQueryReward(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
 func TestMsgUpdateInterchainQueryRequestValidate(t *testing.T) {
 	k, ctx := testkeeper.InterchainQueriesKeeper(t, nil, nil, nil, nil)
 	msgServer := keeper.NewMsgServerImpl(*k)
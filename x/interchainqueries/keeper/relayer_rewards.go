@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"strconv"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/interchainqueries/types"
+)
+
+// GetRelayerStats returns the query results submission statistics of the given relayer. If the
+// relayer hasn't submitted any results yet, empty statistics are returned.
+func (k Keeper) GetRelayerStats(ctx sdk.Context, relayer string) types.RelayerStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRelayerStatsKey(relayer))
+	if bz == nil {
+		return types.RelayerStats{Relayer: relayer}
+	}
+
+	var stats types.RelayerStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// SetRelayerStats saves the query results submission statistics of a relayer.
+func (k Keeper) SetRelayerStats(ctx sdk.Context, stats types.RelayerStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRelayerStatsKey(stats.Relayer), k.cdc.MustMarshal(&stats))
+}
+
+// GetAllRelayerStats returns the query results submission statistics of all relayers.
+func (k Keeper) GetAllRelayerStats(ctx sdk.Context) []types.RelayerStats {
+	var (
+		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.RelayerStatsKey)
+		stats []types.RelayerStats
+	)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		relayerStats := types.RelayerStats{}
+		k.cdc.MustUnmarshal(iterator.Value(), &relayerStats)
+		stats = append(stats, relayerStats)
+	}
+
+	return stats
+}
+
+// CollectRewardEscrow transfers the given amount from the payer to the module account and adds it
+// to the query's reward escrow. The query is not saved by the function.
+func (k Keeper) CollectRewardEscrow(ctx sdk.Context, query *types.RegisteredQuery, payer sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	if err := k.bank.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	query.RewardEscrow = query.RewardEscrow.Add(amount...)
+	return nil
+}
+
+// RecordQueryResultSubmission updates the relayer's submission statistics and pays the relayer the
+// query's reward per update if the query's update period has passed since the last rewarded
+// submission and the query's reward escrow covers the reward in full. The submissions made within
+// the update period are only recorded in the statistics. The query is expected to be in its
+// pre-submission state when late is calculated, and is saved by the function if the reward is paid.
+func (k Keeper) RecordQueryResultSubmission(ctx sdk.Context, query *types.RegisteredQuery, relayer sdk.AccAddress, late bool) error {
+	stats := k.GetRelayerStats(ctx, relayer.String())
+	stats.Submissions++
+	if late {
+		stats.LateSubmissions++
+	}
+
+	reward := sdk.NewCoins()
+	if isRewardDue(ctx, query) && !query.RewardPerUpdate.IsZero() && query.RewardEscrow.IsAllGTE(query.RewardPerUpdate) {
+		reward = query.RewardPerUpdate
+		if err := k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, reward); err != nil {
+			return err
+		}
+
+		query.RewardEscrow = query.RewardEscrow.Sub(reward...)
+		query.LastRewardedLocalHeight = uint64(ctx.BlockHeight()) //nolint:gosec
+		if err := k.SaveQuery(ctx, query); err != nil {
+			return err
+		}
+		stats.RewardsEarned = stats.RewardsEarned.Add(reward...)
+	}
+
+	k.SetRelayerStats(ctx, stats)
+	ctx.EventManager().EmitEvents(getEventsQueryResultSubmitted(query, relayer, reward, late))
+	return nil
+}
+
+// isRewardDue returns true if the query has never been rewarded or its update period has passed
+// since the last rewarded submission.
+func isRewardDue(ctx sdk.Context, query *types.RegisteredQuery) bool {
+	return query.LastRewardedLocalHeight == 0 ||
+		uint64(ctx.BlockHeight()) >= query.LastRewardedLocalHeight+query.UpdatePeriod //nolint:gosec
+}
+
+func getEventsQueryResultSubmitted(query *types.RegisteredQuery, relayer sdk.AccAddress, reward sdk.Coins, late bool) sdk.Events {
+	return sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNeutronMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeyAction, types.AttributeValueQueryResultSubmitted),
+			sdk.NewAttribute(types.AttributeKeyQueryID, strconv.FormatUint(query.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+			sdk.NewAttribute(types.AttributeKeyLateSubmission, strconv.FormatBool(late)),
+		),
+	}
+}
@@ -17,8 +17,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgRegisterInterchainQuery{},
 		&MsgSubmitQueryResult{},
+		&MsgSubmitQueryResults{},
 		&MsgUpdateInterchainQueryRequest{},
 		&MsgRemoveInterchainQueryRequest{},
+		&MsgFundQueryReward{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
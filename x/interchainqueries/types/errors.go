@@ -28,4 +28,5 @@ var (
 	ErrEmptyKeyID                 = errors.Register(ModuleName, 1119, "key id is empty")
 	ErrTooManyKVQueryKeys         = errors.Register(ModuleName, 1120, "too many keys")
 	ErrUnexpectedQueryTypeGenesis = errors.Register(ModuleName, 1121, "unexpected query type")
+	ErrInvalidReward              = errors.Register(ModuleName, 1122, "invalid reward")
 )
@@ -3,6 +3,7 @@ package types
 import (
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -47,6 +48,31 @@ func (gs GenesisState) Validate() error {
 		default:
 			return errors.Wrapf(ErrUnexpectedQueryTypeGenesis, "Unexpected query type: %s", val.QueryType)
 		}
+
+		if err := val.RewardPerUpdate.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidReward, "invalid reward per update of query %d: %v", val.Id, err)
+		}
+		if err := val.RewardEscrow.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidReward, "invalid reward escrow of query %d: %v", val.Id, err)
+		}
+	}
+
+	seenRelayers := map[string]bool{}
+	for _, val := range gs.GetRelayerStats() {
+		if seenRelayers[val.Relayer] {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate relayer stats: %s", val.Relayer)
+		}
+		seenRelayers[val.Relayer] = true
+
+		if _, err := sdk.AccAddressFromBech32(val.Relayer); err != nil {
+			return errors.Wrapf(err, "Invalid relayer address (%s)", err)
+		}
+		if val.LateSubmissions > val.Submissions {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "late submissions exceed total submissions for relayer %s", val.Relayer)
+		}
+		if err := val.RewardsEarned.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidReward, "invalid rewards earned by relayer %s: %v", val.Relayer, err)
+		}
 	}
 	return nil
 }
@@ -61,6 +61,16 @@ type RegisteredQuery struct {
 	SubmitTimeout uint64 `protobuf:"varint,11,opt,name=submit_timeout,json=submitTimeout,proto3" json:"submit_timeout,omitempty"`
 	// The local chain block height of the Interchain Query registration.
 	RegisteredAtHeight uint64 `protobuf:"varint,12,opt,name=registered_at_height,json=registeredAtHeight,proto3" json:"registered_at_height,omitempty"`
+	// Amount of coins paid to the relayer for a successfully submitted query result, at most once per
+	// update_period. The reward is taken from the reward_escrow and only paid if the escrow covers it
+	// in full.
+	RewardPerUpdate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,13,rep,name=reward_per_update,json=rewardPerUpdate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_update"`
+	// Amount of coins escrowed in the module to pay relayer rewards. The remaining escrow is paid
+	// back to the query owner on the query removal.
+	RewardEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,14,rep,name=reward_escrow,json=rewardEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_escrow"`
+	// The local chain block height of the last rewarded query result submission. The next submission
+	// is rewarded once update_period blocks have passed since it.
+	LastRewardedLocalHeight uint64 `protobuf:"varint,15,opt,name=last_rewarded_local_height,json=lastRewardedLocalHeight,proto3" json:"last_rewarded_local_height,omitempty"`
 }
 
 func (m *RegisteredQuery) Reset()         { *m = RegisteredQuery{} }
@@ -180,6 +190,102 @@ func (m *RegisteredQuery) GetRegisteredAtHeight() uint64 {
 	return 0
 }
 
+func (m *RegisteredQuery) GetRewardPerUpdate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPerUpdate
+	}
+	return nil
+}
+
+func (m *RegisteredQuery) GetRewardEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardEscrow
+	}
+	return nil
+}
+
+func (m *RegisteredQuery) GetLastRewardedLocalHeight() uint64 {
+	if m != nil {
+		return m.LastRewardedLocalHeight
+	}
+	return 0
+}
+
+// Statistics of Interchain Query results submission by a single relayer.
+type RelayerStats struct {
+	// The address of the relayer.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// The total amount of successfully submitted query results.
+	Submissions uint64 `protobuf:"varint,2,opt,name=submissions,proto3" json:"submissions,omitempty"`
+	// The amount of successfully submitted query results that came later than the query's update
+	// period, i.e. more than `update_period` blocks after the query's last result submission (or
+	// the query's registration if no results have been submitted yet).
+	LateSubmissions uint64 `protobuf:"varint,3,opt,name=late_submissions,json=lateSubmissions,proto3" json:"late_submissions,omitempty"`
+	// The total amount of rewards paid to the relayer for query results submission.
+	RewardsEarned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=rewards_earned,json=rewardsEarned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards_earned"`
+}
+
+func (m *RelayerStats) Reset()         { *m = RelayerStats{} }
+func (m *RelayerStats) String() string { return proto.CompactTextString(m) }
+func (*RelayerStats) ProtoMessage()    {}
+func (*RelayerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed312d37df0260a6, []int{1}
+}
+func (m *RelayerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerStats.Merge(m, src)
+}
+func (m *RelayerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerStats proto.InternalMessageInfo
+
+func (m *RelayerStats) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *RelayerStats) GetSubmissions() uint64 {
+	if m != nil {
+		return m.Submissions
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetLateSubmissions() uint64 {
+	if m != nil {
+		return m.LateSubmissions
+	}
+	return 0
+}
+
+func (m *RelayerStats) GetRewardsEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardsEarned
+	}
+	return nil
+}
+
 // Represents a path to an IAVL storage node.
 type KVKey struct {
 	// The substore name used in an Interchain Query. Typically, this corresponds to the keeper's
@@ -193,7 +299,7 @@ func (m *KVKey) Reset()         { *m = KVKey{} }
 func (m *KVKey) String() string { return proto.CompactTextString(m) }
 func (*KVKey) ProtoMessage()    {}
 func (*KVKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed312d37df0260a6, []int{1}
+	return fileDescriptor_ed312d37df0260a6, []int{2}
 }
 func (m *KVKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,13 +348,15 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A list of registered Interchain Queries.
 	RegisteredQueries []*RegisteredQuery `protobuf:"bytes,2,rep,name=registered_queries,json=registeredQueries,proto3" json:"registered_queries,omitempty"`
+	// A list of relayers' query results submission statistics.
+	RelayerStats []RelayerStats `protobuf:"bytes,3,rep,name=relayer_stats,json=relayerStats,proto3" json:"relayer_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ed312d37df0260a6, []int{2}
+	return fileDescriptor_ed312d37df0260a6, []int{3}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,8 +399,16 @@ func (m *GenesisState) GetRegisteredQueries() []*RegisteredQuery {
 	return nil
 }
 
+func (m *GenesisState) GetRelayerStats() []RelayerStats {
+	if m != nil {
+		return m.RelayerStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RegisteredQuery)(nil), "neutron.interchainqueries.RegisteredQuery")
+	proto.RegisterType((*RelayerStats)(nil), "neutron.interchainqueries.RelayerStats")
 	proto.RegisterType((*KVKey)(nil), "neutron.interchainqueries.KVKey")
 	proto.RegisterType((*GenesisState)(nil), "neutron.interchainqueries.GenesisState")
 }
@@ -302,47 +418,58 @@ func init() {
 }
 
 var fileDescriptor_ed312d37df0260a6 = []byte{
-	// 640 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0xcf, 0x6e, 0xd3, 0x4e,
-	0x10, 0x8e, 0xd3, 0xb4, 0xfd, 0x65, 0x93, 0xf6, 0x07, 0x4b, 0x0f, 0x6e, 0x25, 0x9c, 0x90, 0x0a,
-	0x88, 0x90, 0xea, 0x6d, 0x0a, 0x47, 0x24, 0x44, 0x91, 0xf8, 0x57, 0x0e, 0xc5, 0xad, 0x2a, 0xc1,
-	0xc5, 0x72, 0xec, 0xc1, 0x59, 0x35, 0xf1, 0x9a, 0xdd, 0x71, 0xc0, 0x6f, 0xc1, 0x73, 0xf4, 0x49,
-	0x7a, 0xec, 0x91, 0x13, 0xa0, 0xf6, 0x39, 0x90, 0x90, 0xc7, 0x0e, 0x2d, 0xd0, 0xf6, 0xe4, 0xf1,
-	0xb7, 0xdf, 0x7c, 0x3b, 0x3b, 0xf3, 0x0d, 0xbb, 0x9f, 0x40, 0x86, 0x5a, 0x25, 0x42, 0x26, 0x08,
-	0x3a, 0x1c, 0x05, 0x32, 0xf9, 0x98, 0x81, 0x96, 0x60, 0x44, 0x0c, 0x09, 0x18, 0x69, 0xdc, 0x54,
-	0x2b, 0x54, 0x7c, 0xb5, 0x22, 0xba, 0xff, 0x10, 0xd7, 0x9c, 0x50, 0x99, 0x89, 0x32, 0x62, 0x18,
-	0x18, 0x10, 0xd3, 0xc1, 0x10, 0x30, 0x18, 0x88, 0x50, 0xc9, 0xa4, 0x4c, 0x5d, 0x5b, 0x89, 0x55,
-	0xac, 0x28, 0x14, 0x45, 0x54, 0xa1, 0x1d, 0x39, 0x0c, 0x45, 0xa8, 0x34, 0x88, 0x70, 0x2c, 0x21,
-	0x41, 0x31, 0x1d, 0x54, 0x51, 0x45, 0xb8, 0x77, 0x75, 0x69, 0x69, 0xa0, 0x83, 0x49, 0x55, 0x59,
-	0xef, 0x67, 0x83, 0xfd, 0xef, 0x41, 0x2c, 0x0d, 0x82, 0x86, 0xe8, 0x6d, 0x06, 0x3a, 0xe7, 0xcb,
-	0xac, 0x2e, 0x23, 0xdb, 0xea, 0x5a, 0xfd, 0x86, 0x57, 0x97, 0x11, 0x5f, 0x61, 0xf3, 0xea, 0x53,
-	0x02, 0xda, 0xae, 0x77, 0xad, 0x7e, 0xd3, 0x2b, 0x7f, 0xf8, 0x6d, 0xc6, 0x0a, 0xc5, 0xdc, 0xc7,
-	0x3c, 0x05, 0x7b, 0x8e, 0x8e, 0x9a, 0x84, 0xec, 0xe7, 0x29, 0xf0, 0x47, 0xac, 0x71, 0x08, 0xb9,
-	0xb1, 0x1b, 0xdd, 0xb9, 0x7e, 0x6b, 0xab, 0xeb, 0x5e, 0xd9, 0x01, 0x77, 0xe7, 0x60, 0x07, 0x72,
-	0x8f, 0xd8, 0x5c, 0xb0, 0x5b, 0xa8, 0x83, 0xc4, 0x04, 0x21, 0x4a, 0x95, 0x18, 0xff, 0x83, 0x1c,
-	0x23, 0x68, 0x7b, 0x9e, 0xd4, 0xf9, 0xc5, 0xa3, 0xe7, 0x74, 0xc2, 0xd7, 0xd9, 0x52, 0xa8, 0x92,
-	0x04, 0x08, 0xf4, 0x65, 0x64, 0x2f, 0x10, 0xb5, 0x7d, 0x0e, 0xbe, 0x8a, 0x0a, 0x52, 0x96, 0x46,
-	0x01, 0x82, 0x9f, 0x82, 0x96, 0x2a, 0xb2, 0x17, 0xe9, 0x6d, 0xed, 0x12, 0xdc, 0x25, 0x8c, 0xbf,
-	0x66, 0xbd, 0x71, 0x60, 0xd0, 0x37, 0xd9, 0x70, 0x22, 0x11, 0x21, 0xf2, 0x35, 0x98, 0x6c, 0x8c,
-	0xfe, 0x58, 0x85, 0xc1, 0xd8, 0x1f, 0x81, 0x8c, 0x47, 0x68, 0xff, 0x47, 0x99, 0x4e, 0xc1, 0xdc,
-	0x9b, 0x11, 0x3d, 0xe2, 0xbd, 0x29, 0x68, 0x2f, 0x89, 0xc5, 0x47, 0x6c, 0xfd, 0x72, 0x2d, 0x0d,
-	0x13, 0x85, 0x30, 0x13, 0x6b, 0x76, 0xad, 0x7e, 0x6b, 0x6b, 0xcd, 0x95, 0xc3, 0xd0, 0x2d, 0x86,
-	0xe9, 0x56, 0x23, 0x9c, 0x0e, 0xdc, 0x52, 0xc8, 0xeb, 0x5c, 0x72, 0x91, 0x47, 0x1a, 0xd5, 0x4d,
-	0xc0, 0x16, 0x23, 0x48, 0x95, 0x91, 0x68, 0x33, 0xea, 0xf4, 0xaa, 0x5b, 0x1a, 0xca, 0x2d, 0x0c,
-	0xe5, 0x56, 0x86, 0x72, 0x9f, 0x29, 0x99, 0x6c, 0x6f, 0x1e, 0x7f, 0xeb, 0xd4, 0x8e, 0xbe, 0x77,
-	0xfa, 0xb1, 0xc4, 0x51, 0x36, 0x74, 0x43, 0x35, 0x11, 0x95, 0xfb, 0xca, 0xcf, 0x86, 0x89, 0x0e,
-	0x45, 0x31, 0x4e, 0x43, 0x09, 0xc6, 0x9b, 0x69, 0xf3, 0xbb, 0x6c, 0xb9, 0x7c, 0x8b, 0x8f, 0x72,
-	0x02, 0x2a, 0x43, 0xbb, 0x45, 0x8d, 0x58, 0x2a, 0xd1, 0xfd, 0x12, 0xe4, 0x9b, 0x6c, 0x45, 0xff,
-	0x36, 0x93, 0x1f, 0xe0, 0xec, 0xa1, 0x6d, 0x22, 0xf3, 0xf3, 0xb3, 0xa7, 0x58, 0xd6, 0xdf, 0xdb,
-	0x60, 0xf3, 0x34, 0x7f, 0xce, 0x59, 0x23, 0x0d, 0x70, 0x44, 0xb6, 0x6b, 0x7a, 0x14, 0xf3, 0x1b,
-	0x6c, 0xee, 0x10, 0x72, 0xb2, 0x5d, 0xdb, 0x2b, 0xc2, 0xde, 0x91, 0xc5, 0xda, 0x2f, 0xca, 0xd5,
-	0xda, 0xc3, 0x00, 0x81, 0x3f, 0x61, 0x0b, 0xa5, 0x9f, 0x29, 0xb1, 0xb5, 0x75, 0xe7, 0x1a, 0xa3,
-	0xed, 0x12, 0x71, 0xbb, 0x51, 0xb4, 0xc1, 0xab, 0xd2, 0xf8, 0x3b, 0x76, 0xa1, 0x2c, 0xbf, 0xa2,
-	0xda, 0x75, 0xea, 0xe5, 0x83, 0x6b, 0xc4, 0xfe, 0x5a, 0x1a, 0xef, 0xa6, 0xfe, 0x03, 0x90, 0x60,
-	0xb6, 0x0f, 0x8e, 0x4f, 0x1d, 0xeb, 0xe4, 0xd4, 0xb1, 0x7e, 0x9c, 0x3a, 0xd6, 0x97, 0x33, 0xa7,
-	0x76, 0x72, 0xe6, 0xd4, 0xbe, 0x9e, 0x39, 0xb5, 0xf7, 0x8f, 0x2f, 0x4c, 0xa0, 0xba, 0x62, 0x43,
-	0xe9, 0x78, 0x16, 0x8b, 0xe9, 0x60, 0x20, 0x3e, 0x5f, 0xb2, 0xba, 0x34, 0x9b, 0xe1, 0x02, 0xad,
-	0xee, 0xc3, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xee, 0x13, 0xe5, 0x97, 0x7f, 0x04, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0x1c, 0x35,
+	0x14, 0xcf, 0x64, 0x37, 0x09, 0xf1, 0xee, 0x26, 0xad, 0x89, 0x84, 0x1b, 0x89, 0xcd, 0xb2, 0x15,
+	0x74, 0x41, 0xca, 0x4c, 0x37, 0x70, 0x03, 0x09, 0x51, 0x54, 0xfe, 0x95, 0x43, 0x70, 0x4a, 0x25,
+	0xb8, 0x8c, 0xbc, 0x33, 0x8f, 0x5d, 0x2b, 0xb3, 0xe3, 0xc1, 0xf6, 0x24, 0xcc, 0xb7, 0xe0, 0x4b,
+	0x70, 0xe1, 0x93, 0xf4, 0xd8, 0x23, 0x27, 0x40, 0xc9, 0x89, 0x2f, 0x81, 0x90, 0x9f, 0xbd, 0x64,
+	0x0a, 0x69, 0x4e, 0x39, 0xcd, 0xf3, 0xcf, 0x3f, 0xbf, 0x7f, 0xbf, 0x67, 0x0f, 0x79, 0x50, 0x42,
+	0x6d, 0xb5, 0x2a, 0x13, 0x59, 0x5a, 0xd0, 0xd9, 0x42, 0xc8, 0xf2, 0xc7, 0x1a, 0xb4, 0x04, 0x93,
+	0xcc, 0xa1, 0x04, 0x23, 0x4d, 0x5c, 0x69, 0x65, 0x15, 0xbd, 0x17, 0x88, 0xf1, 0xff, 0x88, 0xfb,
+	0xc3, 0x4c, 0x99, 0xa5, 0x32, 0xc9, 0x4c, 0x18, 0x48, 0xce, 0xa6, 0x33, 0xb0, 0x62, 0x9a, 0x64,
+	0x4a, 0x96, 0xfe, 0xe8, 0xfe, 0xde, 0x5c, 0xcd, 0x15, 0x9a, 0x89, 0xb3, 0x02, 0x7a, 0x20, 0x67,
+	0x59, 0x92, 0x29, 0x0d, 0x49, 0x56, 0x48, 0x28, 0x6d, 0x72, 0x36, 0x0d, 0x56, 0x20, 0xbc, 0xf3,
+	0xea, 0xd4, 0x2a, 0xa1, 0xc5, 0x32, 0x64, 0x36, 0xfe, 0x65, 0x8b, 0xec, 0x72, 0x98, 0x4b, 0x63,
+	0x41, 0x43, 0xfe, 0x4d, 0x0d, 0xba, 0xa1, 0x3b, 0x64, 0x5d, 0xe6, 0x2c, 0x1a, 0x45, 0x93, 0x2e,
+	0x5f, 0x97, 0x39, 0xdd, 0x23, 0x1b, 0xea, 0xbc, 0x04, 0xcd, 0xd6, 0x47, 0xd1, 0x64, 0x9b, 0xfb,
+	0x05, 0x7d, 0x93, 0x10, 0xe7, 0xb1, 0x49, 0x6d, 0x53, 0x01, 0xeb, 0xe0, 0xd6, 0x36, 0x22, 0x4f,
+	0x9b, 0x0a, 0xe8, 0x07, 0xa4, 0x7b, 0x0a, 0x8d, 0x61, 0xdd, 0x51, 0x67, 0xd2, 0x3b, 0x1a, 0xc5,
+	0xaf, 0xec, 0x40, 0xfc, 0xe4, 0xd9, 0x13, 0x68, 0x38, 0xb2, 0x69, 0x42, 0x5e, 0xb7, 0x5a, 0x94,
+	0x46, 0x64, 0x56, 0xaa, 0xd2, 0xa4, 0x3f, 0xc8, 0xc2, 0x82, 0x66, 0x1b, 0xe8, 0x9d, 0xb6, 0xb7,
+	0x3e, 0xc3, 0x1d, 0x7a, 0x9f, 0x0c, 0x32, 0x55, 0x96, 0x80, 0x60, 0x2a, 0x73, 0xb6, 0x89, 0xd4,
+	0xfe, 0x15, 0xf8, 0x65, 0xee, 0x48, 0x75, 0x95, 0x0b, 0x0b, 0x69, 0x05, 0x5a, 0xaa, 0x9c, 0x6d,
+	0x61, 0x6d, 0x7d, 0x0f, 0x1e, 0x23, 0x46, 0xbf, 0x22, 0xe3, 0x42, 0x18, 0x9b, 0x9a, 0x7a, 0xb6,
+	0x94, 0xd6, 0x42, 0x9e, 0x6a, 0x30, 0x75, 0x61, 0xd3, 0x42, 0x65, 0xa2, 0x48, 0x17, 0x20, 0xe7,
+	0x0b, 0xcb, 0x5e, 0xc3, 0x93, 0x43, 0xc7, 0x3c, 0x59, 0x11, 0x39, 0xf2, 0xbe, 0x76, 0xb4, 0x2f,
+	0x90, 0x45, 0x17, 0xe4, 0xfe, 0xf5, 0xbe, 0x34, 0x2c, 0x95, 0x85, 0x95, 0xb3, 0xed, 0x51, 0x34,
+	0xe9, 0x1d, 0xed, 0xc7, 0x72, 0x96, 0xc5, 0x4e, 0xcc, 0x38, 0x48, 0x78, 0x36, 0x8d, 0xbd, 0x23,
+	0x7e, 0x70, 0x4d, 0x20, 0x8e, 0x3e, 0x42, 0x24, 0x20, 0x5b, 0x39, 0x54, 0xca, 0x48, 0xcb, 0x08,
+	0x76, 0xfa, 0x5e, 0xec, 0x07, 0x2a, 0x76, 0x03, 0x15, 0x87, 0x81, 0x8a, 0x3f, 0x55, 0xb2, 0x7c,
+	0xf4, 0xf0, 0xf9, 0xef, 0x07, 0x6b, 0xbf, 0xfe, 0x71, 0x30, 0x99, 0x4b, 0xbb, 0xa8, 0x67, 0x71,
+	0xa6, 0x96, 0x49, 0x98, 0x3e, 0xff, 0x39, 0x34, 0xf9, 0x69, 0xe2, 0xe4, 0x34, 0x78, 0xc0, 0xf0,
+	0x95, 0x6f, 0xfa, 0x36, 0xd9, 0xf1, 0xb5, 0xa4, 0x56, 0x2e, 0x41, 0xd5, 0x96, 0xf5, 0xb0, 0x11,
+	0x03, 0x8f, 0x3e, 0xf5, 0x20, 0x7d, 0x48, 0xf6, 0xf4, 0xbf, 0xc3, 0x94, 0x0a, 0xbb, 0x2a, 0xb4,
+	0x8f, 0x64, 0x7a, 0xb5, 0xf7, 0x89, 0x0d, 0xf9, 0x9f, 0x93, 0xbb, 0x1a, 0xce, 0x85, 0xce, 0x9d,
+	0x34, 0xa9, 0x17, 0x84, 0x0d, 0x6e, 0xbf, 0x92, 0x5d, 0x1f, 0xe5, 0x18, 0xf4, 0xb7, 0x18, 0x83,
+	0x56, 0x64, 0x10, 0x02, 0x83, 0xc9, 0xb4, 0x3a, 0x67, 0x3b, 0xb7, 0x1f, 0xb4, 0xef, 0x23, 0x3c,
+	0xc6, 0x00, 0xf4, 0x43, 0xb2, 0x8f, 0x43, 0xe1, 0x41, 0xc8, 0x5f, 0x1e, 0xac, 0x5d, 0x6c, 0xd1,
+	0x1b, 0x8e, 0xc1, 0x03, 0xa1, 0x35, 0x51, 0xe3, 0xbf, 0x22, 0xd2, 0xe7, 0x50, 0x88, 0x06, 0xf4,
+	0x89, 0x15, 0xd6, 0x50, 0x46, 0xb6, 0xb4, 0x5f, 0xe3, 0x4d, 0xdd, 0xe6, 0xab, 0x25, 0x1d, 0x91,
+	0x1e, 0xaa, 0x62, 0x8c, 0xbb, 0x27, 0x78, 0x69, 0xbb, 0xbc, 0x0d, 0xd1, 0x77, 0xc9, 0x9d, 0xc2,
+	0xdd, 0x86, 0x36, 0xad, 0x83, 0xb4, 0x5d, 0x87, 0x9f, 0xb4, 0xa8, 0x9a, 0xec, 0xf8, 0x7c, 0x4d,
+	0x0a, 0x42, 0x97, 0x90, 0xb3, 0xee, 0xed, 0xf7, 0x29, 0x28, 0x61, 0x1e, 0x63, 0x84, 0xf1, 0x21,
+	0xd9, 0xc0, 0x37, 0x81, 0x52, 0xd2, 0xad, 0x84, 0x5d, 0x84, 0x02, 0xd1, 0xa6, 0x77, 0x48, 0xe7,
+	0x14, 0x1a, 0xac, 0xaa, 0xcf, 0x9d, 0x39, 0xfe, 0x3b, 0x22, 0xfd, 0xcf, 0xfd, 0x73, 0xeb, 0x5a,
+	0x03, 0xf4, 0x63, 0xb2, 0xe9, 0xdf, 0x38, 0x3c, 0xd8, 0x3b, 0x7a, 0xeb, 0x86, 0xc7, 0xe7, 0x18,
+	0x89, 0x8f, 0xba, 0x2e, 0x67, 0x1e, 0x8e, 0xd1, 0xef, 0x48, 0x6b, 0x54, 0xd3, 0x40, 0x65, 0xeb,
+	0x58, 0xf8, 0x7b, 0x37, 0x38, 0xfb, 0xcf, 0x43, 0xca, 0xef, 0xea, 0x97, 0x00, 0x09, 0x86, 0x72,
+	0x32, 0x08, 0x3a, 0xa5, 0xc6, 0xe9, 0xc8, 0x3a, 0xe8, 0xf5, 0xc1, 0x8d, 0x5e, 0xaf, 0x64, 0x0f,
+	0x89, 0xf6, 0x75, 0x1b, 0x7b, 0xf6, 0xfc, 0x62, 0x18, 0xbd, 0xb8, 0x18, 0x46, 0x7f, 0x5e, 0x0c,
+	0xa3, 0x9f, 0x2f, 0x87, 0x6b, 0x2f, 0x2e, 0x87, 0x6b, 0xbf, 0x5d, 0x0e, 0xd7, 0xbe, 0xff, 0xa8,
+	0x25, 0x41, 0x08, 0x70, 0xa8, 0xf4, 0x7c, 0x65, 0x27, 0x67, 0xd3, 0x69, 0xf2, 0xd3, 0x35, 0xbf,
+	0x08, 0x14, 0x67, 0xb6, 0x89, 0xbf, 0x88, 0xf7, 0xff, 0x19, 0x00, 0xd7, 0x40, 0x6d, 0x01, 0xe7,
+	0x06, 0x00, 0x00,
 }
 
 func (m *RegisteredQuery) Marshal() (dAtA []byte, err error) {
@@ -365,6 +492,39 @@ func (m *RegisteredQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastRewardedLocalHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastRewardedLocalHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RewardEscrow) > 0 {
+		for iNdEx := len(m.RewardEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.RewardPerUpdate) > 0 {
+		for iNdEx := len(m.RewardPerUpdate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerUpdate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	if m.RegisteredAtHeight != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.RegisteredAtHeight))
 		i--
@@ -461,6 +621,60 @@ func (m *RegisteredQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RelayerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsEarned) > 0 {
+		for iNdEx := len(m.RewardsEarned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsEarned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.LateSubmissions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LateSubmissions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Submissions != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Submissions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *KVKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -518,6 +732,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RelayerStats) > 0 {
+		for iNdEx := len(m.RelayerStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RelayerStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RegisteredQueries) > 0 {
 		for iNdEx := len(m.RegisteredQueries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -609,6 +837,46 @@ func (m *RegisteredQuery) Size() (n int) {
 	if m.RegisteredAtHeight != 0 {
 		n += 1 + sovGenesis(uint64(m.RegisteredAtHeight))
 	}
+	if len(m.RewardPerUpdate) > 0 {
+		for _, e := range m.RewardPerUpdate {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardEscrow) > 0 {
+		for _, e := range m.RewardEscrow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastRewardedLocalHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastRewardedLocalHeight))
+	}
+	return n
+}
+
+func (m *RelayerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Submissions != 0 {
+		n += 1 + sovGenesis(uint64(m.Submissions))
+	}
+	if m.LateSubmissions != 0 {
+		n += 1 + sovGenesis(uint64(m.LateSubmissions))
+	}
+	if len(m.RewardsEarned) > 0 {
+		for _, e := range m.RewardsEarned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -643,6 +911,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RelayerStats) > 0 {
+		for _, e := range m.RelayerStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1008,6 +1282,247 @@ func (m *RegisteredQuery) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerUpdate = append(m.RewardPerUpdate, types1.Coin{})
+			if err := m.RewardPerUpdate[len(m.RewardPerUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEscrow = append(m.RewardEscrow, types1.Coin{})
+			if err := m.RewardEscrow[len(m.RewardEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardedLocalHeight", wireType)
+			}
+			m.LastRewardedLocalHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardedLocalHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			m.Submissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Submissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LateSubmissions", wireType)
+			}
+			m.LateSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LateSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsEarned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsEarned = append(m.RewardsEarned, types1.Coin{})
+			if err := m.RewardsEarned[len(m.RewardsEarned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1241,6 +1756,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerStats = append(m.RelayerStats, RelayerStats{})
+			if err := m.RelayerStats[len(m.RelayerStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -24,6 +24,45 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid relayer stats",
+			genState: &types.GenesisState{
+				RelayerStats: []types.RelayerStats{{
+					Relayer:         TestAddress,
+					Submissions:     2,
+					LateSubmissions: 1,
+				}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate relayer stats",
+			genState: &types.GenesisState{
+				RelayerStats: []types.RelayerStats{
+					{Relayer: TestAddress},
+					{Relayer: TestAddress},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid relayer address",
+			genState: &types.GenesisState{
+				RelayerStats: []types.RelayerStats{{Relayer: "invalid"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "late submissions exceed submissions",
+			genState: &types.GenesisState{
+				RelayerStats: []types.RelayerStats{{
+					Relayer:         TestAddress,
+					Submissions:     1,
+					LateSubmissions: 2,
+				}},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
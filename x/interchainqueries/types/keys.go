@@ -27,6 +27,7 @@ const (
 	prefixSubmittedTx
 	prefixTxQueryToRemove
 	prefixParamsKey
+	prefixRelayerStats
 )
 
 var (
@@ -40,6 +41,8 @@ var (
 	TxQueryToRemoveKey = []byte{prefixTxQueryToRemove}
 	// ParamsKey is the store key for the module params
 	ParamsKey = []byte{prefixParamsKey}
+	// RelayerStatsKey is the store key for relayers' query results submission statistics.
+	RelayerStatsKey = []byte{prefixRelayerStats}
 	// LastRegisteredQueryIDKey is the store key for last registered query ID.
 	LastRegisteredQueryIDKey = []byte{0x64}
 )
@@ -69,3 +72,9 @@ func GetRegisteredQueryResultByIDKey(id uint64) []byte {
 func GetTxQueryToRemoveByIDKey(id uint64) []byte {
 	return append(TxQueryToRemoveKey, sdk.Uint64ToBigEndian(id)...)
 }
+
+// GetRelayerStatsKey builds a store key to access query results submission statistics by relayer
+// address.
+func GetRelayerStatsKey(relayer string) []byte {
+	return append(RelayerStatsKey, []byte(relayer)...)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgFundQueryReward{}
+
+func NewMsgFundQueryReward(sender string, queryID uint64, amount sdk.Coins) MsgFundQueryReward {
+	return MsgFundQueryReward{
+		QueryId: queryID,
+		Amount:  amount,
+		Sender:  sender,
+	}
+}
+
+func (msg MsgFundQueryReward) Route() string {
+	return RouterKey
+}
+
+func (msg MsgFundQueryReward) Type() string {
+	return "fund-query-reward"
+}
+
+func (msg MsgFundQueryReward) Validate() error {
+	if msg.GetQueryId() == 0 {
+		return errors.Wrap(ErrInvalidQueryID, "query_id cannot be empty or equal to 0")
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidReward, "invalid amount: %v", err)
+	}
+
+	if msg.Amount.IsZero() {
+		return errors.Wrap(ErrInvalidReward, "amount cannot be empty")
+	}
+
+	if strings.TrimSpace(msg.Sender) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+
+	return nil
+}
+
+func (msg MsgFundQueryReward) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&msg)
+}
+
+func (msg MsgFundQueryReward) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
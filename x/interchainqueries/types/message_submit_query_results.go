@@ -0,0 +1,68 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgSubmitQueryResults{}
+
+func (msg MsgSubmitQueryResults) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSubmitQueryResults) Type() string {
+	return "submit-query-results"
+}
+
+func (msg MsgSubmitQueryResults) Validate() error {
+	if len(msg.Results) == 0 {
+		return errors.Wrap(ErrEmptyResult, "query results can't be empty")
+	}
+
+	if strings.TrimSpace(msg.Sender) == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse address: %s", msg.Sender)
+	}
+
+	if strings.TrimSpace(msg.ConnectionId) == "" {
+		return errors.Wrap(ErrInvalidConnectionID, "connection id cannot be empty")
+	}
+
+	seen := make(map[uint64]struct{}, len(msg.Results))
+	for _, result := range msg.Results {
+		if result == nil || len(result.KvResults) == 0 {
+			return errors.Wrap(ErrEmptyResult, "query result can't be empty")
+		}
+
+		if result.QueryId == 0 {
+			return errors.Wrap(ErrInvalidQueryID, "query id cannot be equal zero")
+		}
+
+		if _, ok := seen[result.QueryId]; ok {
+			return errors.Wrapf(ErrInvalidQueryID, "duplicate result for query id %d", result.QueryId)
+		}
+		seen[result.QueryId] = struct{}{}
+	}
+
+	return nil
+}
+
+func (msg MsgSubmitQueryResults) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&msg)
+}
+
+func (msg MsgSubmitQueryResults) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{senderAddr}
+}
@@ -584,6 +584,199 @@ func (m *QueryLastRemoteHeightResponse) GetRevision() uint64 {
 	return 0
 }
 
+// Request type for the Query/RelayerStats RPC method.
+type QueryRelayerStatsRequest struct {
+	// The address of the relayer.
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *QueryRelayerStatsRequest) Reset()         { *m = QueryRelayerStatsRequest{} }
+func (m *QueryRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2254be23ba3ff3b4, []int{11}
+}
+func (m *QueryRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsRequest) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+// Response type for the Query/RelayerStats RPC method.
+type QueryRelayerStatsResponse struct {
+	// The query results submission statistics of the relayer.
+	Stats RelayerStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryRelayerStatsResponse) Reset()         { *m = QueryRelayerStatsResponse{} }
+func (m *QueryRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2254be23ba3ff3b4, []int{12}
+}
+func (m *QueryRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerStatsResponse) GetStats() RelayerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return RelayerStats{}
+}
+
+// Request type for the Query/AllRelayerStats RPC method.
+type QueryAllRelayerStatsRequest struct {
+	// Pagination parameters for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRelayerStatsRequest) Reset()         { *m = QueryAllRelayerStatsRequest{} }
+func (m *QueryAllRelayerStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerStatsRequest) ProtoMessage()    {}
+func (*QueryAllRelayerStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2254be23ba3ff3b4, []int{13}
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRelayerStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerStatsRequest.Merge(m, src)
+}
+func (m *QueryAllRelayerStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRelayerStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRelayerStatsRequest proto.InternalMessageInfo
+
+func (m *QueryAllRelayerStatsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// Response type for the Query/AllRelayerStats RPC method.
+type QueryAllRelayerStatsResponse struct {
+	// The query results submission statistics of the relayers.
+	Stats []RelayerStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+	// Current page information.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllRelayerStatsResponse) Reset()         { *m = QueryAllRelayerStatsResponse{} }
+func (m *QueryAllRelayerStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRelayerStatsResponse) ProtoMessage()    {}
+func (*QueryAllRelayerStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2254be23ba3ff3b4, []int{14}
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllRelayerStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRelayerStatsResponse.Merge(m, src)
+}
+func (m *QueryAllRelayerStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllRelayerStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRelayerStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllRelayerStatsResponse proto.InternalMessageInfo
+
+func (m *QueryAllRelayerStatsResponse) GetStats() []RelayerStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *QueryAllRelayerStatsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.interchainqueries.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.interchainqueries.QueryParamsResponse")
@@ -596,6 +789,10 @@ func init() {
 	proto.RegisterType((*Transaction)(nil), "neutron.interchainqueries.Transaction")
 	proto.RegisterType((*QueryLastRemoteHeight)(nil), "neutron.interchainqueries.QueryLastRemoteHeight")
 	proto.RegisterType((*QueryLastRemoteHeightResponse)(nil), "neutron.interchainqueries.QueryLastRemoteHeightResponse")
+	proto.RegisterType((*QueryRelayerStatsRequest)(nil), "neutron.interchainqueries.QueryRelayerStatsRequest")
+	proto.RegisterType((*QueryRelayerStatsResponse)(nil), "neutron.interchainqueries.QueryRelayerStatsResponse")
+	proto.RegisterType((*QueryAllRelayerStatsRequest)(nil), "neutron.interchainqueries.QueryAllRelayerStatsRequest")
+	proto.RegisterType((*QueryAllRelayerStatsResponse)(nil), "neutron.interchainqueries.QueryAllRelayerStatsResponse")
 }
 
 func init() {
@@ -603,56 +800,64 @@ func init() {
 }
 
 var fileDescriptor_2254be23ba3ff3b4 = []byte{
-	// 770 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x94, 0x7e, 0x0b, 0xbc, 0xf2, 0x15, 0x18, 0xd1, 0xc0, 0x8a, 0x15, 0x96, 0x08, 0x05,
-	0xd3, 0x5d, 0x0a, 0x51, 0x31, 0x41, 0x4c, 0x38, 0xa8, 0x24, 0x1e, 0x60, 0x55, 0x0e, 0x5e, 0x9a,
-	0x69, 0x3b, 0xd9, 0x6e, 0x42, 0x77, 0xca, 0xce, 0x14, 0xe9, 0xd5, 0x9b, 0x37, 0xa3, 0xff, 0x82,
-	0x7f, 0x80, 0x37, 0x0f, 0x1e, 0xbc, 0x12, 0x4f, 0x24, 0x5e, 0x3c, 0x19, 0x03, 0xfe, 0x21, 0x66,
-	0x67, 0xa6, 0x2d, 0xfd, 0x4d, 0x39, 0x75, 0x66, 0xf6, 0x7d, 0xde, 0xfb, 0xbc, 0xcf, 0x7b, 0x6f,
-	0xa6, 0x70, 0xd7, 0xa7, 0x15, 0x11, 0x30, 0xdf, 0xf6, 0x7c, 0x41, 0x83, 0x7c, 0x91, 0x78, 0xfe,
-	0x61, 0x85, 0x06, 0x1e, 0xe5, 0x76, 0xf8, 0x5b, 0xb5, 0xca, 0x01, 0x13, 0x0c, 0xcf, 0x68, 0x33,
-	0xab, 0xcd, 0xcc, 0x58, 0xc9, 0x33, 0x5e, 0x62, 0xdc, 0xce, 0x11, 0x4e, 0x15, 0xc6, 0x3e, 0xca,
-	0xe4, 0xa8, 0x20, 0x19, 0xbb, 0x4c, 0x5c, 0xcf, 0x27, 0xc2, 0x63, 0xbe, 0x72, 0x63, 0x4c, 0xb9,
-	0xcc, 0x65, 0x72, 0x69, 0x87, 0x2b, 0x7d, 0x3a, 0xeb, 0x32, 0xe6, 0x1e, 0x50, 0x9b, 0x94, 0x3d,
-	0x9b, 0xf8, 0x3e, 0x13, 0x12, 0xc2, 0xf5, 0xd7, 0xa5, 0xee, 0x0c, 0x5d, 0xea, 0x53, 0xee, 0xd5,
-	0x0c, 0x17, 0xbb, 0x1b, 0x96, 0x49, 0x40, 0x4a, 0x35, 0x3b, 0xb3, 0xbb, 0x9d, 0x38, 0x56, 0x36,
-	0xe6, 0x14, 0xe0, 0xbd, 0x30, 0x95, 0x5d, 0x09, 0x74, 0xe8, 0x61, 0x85, 0x72, 0x61, 0xee, 0xc3,
-	0xf5, 0xa6, 0x53, 0x5e, 0x66, 0x3e, 0xa7, 0xf8, 0x09, 0xc4, 0x55, 0x80, 0x69, 0x34, 0x87, 0x52,
-	0x89, 0xb5, 0x79, 0xab, 0xab, 0x5a, 0x96, 0x82, 0x6e, 0xc7, 0x4e, 0x7e, 0xdf, 0x89, 0x38, 0x1a,
-	0x66, 0x7e, 0x46, 0x70, 0x5b, 0x3a, 0x76, 0xa8, 0xeb, 0x71, 0x41, 0x03, 0x5a, 0xd8, 0x53, 0xf6,
-	0x3a, 0x32, 0xbe, 0x09, 0x71, 0xf6, 0xd6, 0xa7, 0x41, 0x18, 0x62, 0x28, 0x35, 0xea, 0xe8, 0x1d,
-	0x5e, 0x80, 0xff, 0xf3, 0xcc, 0xf7, 0x69, 0x3e, 0x54, 0x2c, 0xeb, 0x15, 0xa6, 0xa3, 0x73, 0x28,
-	0x35, 0xea, 0x8c, 0x35, 0x0e, 0x77, 0x0a, 0xf8, 0x29, 0x40, 0xa3, 0x12, 0xd3, 0x43, 0x92, 0xe3,
-	0xa2, 0xa5, 0xca, 0x66, 0x85, 0x65, 0xb3, 0x54, 0xa9, 0x75, 0xd9, 0xac, 0x5d, 0xe2, 0x52, 0x1d,
-	0xd8, 0xb9, 0x80, 0x34, 0x7f, 0x20, 0x48, 0x76, 0xa3, 0xa9, 0xa5, 0xc8, 0x02, 0x0e, 0xea, 0x1f,
-	0xb3, 0x3a, 0x69, 0xc9, 0x39, 0xb1, 0xb6, 0xd2, 0x43, 0x96, 0x66, 0x8f, 0x55, 0xad, 0xcf, 0x64,
-	0xd0, 0x1a, 0x08, 0x3f, 0x6b, 0xca, 0x25, 0x2a, 0x73, 0x59, 0xea, 0x9b, 0x8b, 0x62, 0xd7, 0x94,
-	0xcc, 0x06, 0xdc, 0xea, 0x90, 0x4b, 0xb5, 0x26, 0xf8, 0x0c, 0x8c, 0x48, 0x47, 0xa1, 0xa6, 0x61,
-	0x55, 0x63, 0xce, 0xb0, 0xdc, 0xef, 0x14, 0xcc, 0x0a, 0xcc, 0x76, 0x46, 0x6a, 0x0d, 0x5e, 0xc3,
-	0x44, 0x8b, 0x06, 0x55, 0xdd, 0x18, 0x03, 0x28, 0xe0, 0x8c, 0x37, 0xe7, 0x5e, 0x35, 0xb7, 0x60,
-	0xbe, 0x4b, 0xd8, 0xca, 0x81, 0xb8, 0x04, 0xed, 0x02, 0x98, 0xbd, 0xf0, 0x9a, 0xfc, 0x16, 0xc4,
-	0x03, 0x79, 0xa2, 0x29, 0x2f, 0xf6, 0xa0, 0x7c, 0x11, 0xaf, 0x51, 0xe6, 0x0e, 0x24, 0x5e, 0x05,
-	0xc4, 0xe7, 0x44, 0x36, 0x1f, 0xbe, 0x06, 0xd1, 0x3a, 0x93, 0xa8, 0x57, 0x08, 0xfb, 0xb8, 0x48,
-	0x3d, 0xb7, 0x28, 0x64, 0xe9, 0x62, 0x8e, 0xde, 0x61, 0x0c, 0xb1, 0x02, 0x11, 0x44, 0x36, 0xe7,
-	0x98, 0x23, 0xd7, 0xe6, 0x26, 0xdc, 0x90, 0x11, 0x5e, 0x10, 0x2e, 0x1c, 0x5a, 0x62, 0x82, 0x3e,
-	0x57, 0xc6, 0x6d, 0x4d, 0x8f, 0xda, 0x9b, 0xde, 0x7c, 0xa9, 0x47, 0xaa, 0x15, 0x5d, 0xcf, 0xb4,
-	0x41, 0x05, 0x35, 0x51, 0x31, 0x60, 0x24, 0xa0, 0x47, 0x1e, 0xaf, 0xf5, 0x57, 0xcc, 0xa9, 0xef,
-	0xd7, 0xde, 0x0f, 0xc3, 0x7f, 0xd2, 0x2b, 0xfe, 0x88, 0x20, 0xae, 0x66, 0x19, 0xa7, 0xfb, 0x49,
-	0xd4, 0x74, 0x89, 0x18, 0xd6, 0x65, 0xcd, 0x15, 0x4f, 0x73, 0xf9, 0xdd, 0xcf, 0xbf, 0x9f, 0xa2,
-	0x0b, 0x78, 0xde, 0xee, 0x77, 0xbf, 0xe1, 0xef, 0x08, 0x26, 0xdb, 0x66, 0x13, 0x6f, 0xf4, 0x2f,
-	0x61, 0xe7, 0x5b, 0xc7, 0x78, 0x74, 0x05, 0xa4, 0x66, 0x7d, 0x5f, 0xb2, 0xb6, 0x71, 0xba, 0x07,
-	0xeb, 0xf6, 0x9b, 0x02, 0x7f, 0x45, 0x30, 0xde, 0xd2, 0xa0, 0xf8, 0xc1, 0x60, 0x2c, 0x6a, 0x23,
-	0x6c, 0x3c, 0x1c, 0x18, 0xa7, 0xb9, 0xaf, 0x4b, 0xee, 0x69, 0x7c, 0xef, 0xf2, 0xdc, 0xab, 0xf8,
-	0x1b, 0x82, 0xc4, 0x85, 0x81, 0xc0, 0x9b, 0x83, 0x47, 0x6f, 0xcc, 0xb1, 0xf1, 0xf8, 0x8a, 0x68,
-	0x9d, 0x81, 0x2d, 0x33, 0x58, 0xc6, 0x4b, 0x76, 0x9f, 0xe7, 0x3d, 0xab, 0xc6, 0x16, 0x7f, 0x41,
-	0x30, 0xd1, 0x36, 0x67, 0xab, 0xfd, 0x48, 0xb4, 0x22, 0x8c, 0x8d, 0x41, 0x11, 0x75, 0xc6, 0xab,
-	0x92, 0xf1, 0x0a, 0x4e, 0xf5, 0xd4, 0x3c, 0x04, 0x66, 0xd5, 0x9c, 0x6e, 0xef, 0x9f, 0x9c, 0x25,
-	0xd1, 0xe9, 0x59, 0x12, 0xfd, 0x39, 0x4b, 0xa2, 0x0f, 0xe7, 0xc9, 0xc8, 0xe9, 0x79, 0x32, 0xf2,
-	0xeb, 0x3c, 0x19, 0x79, 0xb3, 0xe9, 0x7a, 0xa2, 0x58, 0xc9, 0x59, 0x79, 0x56, 0xaa, 0x79, 0x4b,
-	0xb3, 0xc0, 0xad, 0x7b, 0x3e, 0xca, 0x64, 0xec, 0xe3, 0x4e, 0xaf, 0x7f, 0xb5, 0x4c, 0x79, 0x2e,
-	0x2e, 0xff, 0x01, 0xac, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x31, 0xbc, 0xf7, 0x39, 0x1a, 0x09,
-	0x00, 0x00,
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xce, 0xa4, 0xd9, 0xec, 0xf6, 0xa5, 0xd0, 0xdd, 0x61, 0x41, 0xa9, 0xe9, 0x86, 0xad, 0x57,
+	0x34, 0xd9, 0xa2, 0xd8, 0x9b, 0xb4, 0xb0, 0x05, 0x95, 0x45, 0x14, 0x09, 0xa8, 0xc4, 0xa1, 0x75,
+	0xa1, 0x07, 0x2e, 0x61, 0x92, 0x8c, 0x1c, 0x4b, 0x89, 0x27, 0xb5, 0x27, 0xa5, 0x16, 0xe2, 0xc2,
+	0x5f, 0x80, 0xe0, 0x5f, 0xe0, 0xc8, 0x81, 0x03, 0x88, 0x03, 0x07, 0xae, 0x15, 0xa7, 0x4a, 0x5c,
+	0x38, 0x21, 0xd4, 0xf2, 0x47, 0x70, 0x44, 0x1e, 0x4f, 0x7e, 0x38, 0x76, 0xe2, 0x3a, 0xda, 0x53,
+	0x3c, 0x93, 0xf7, 0xcd, 0xfb, 0xbe, 0xf7, 0xde, 0x7c, 0x36, 0xbc, 0x6e, 0xd3, 0x01, 0x77, 0x98,
+	0xad, 0x5b, 0x36, 0xa7, 0x4e, 0xab, 0x43, 0x2c, 0xfb, 0x74, 0x40, 0x1d, 0x8b, 0xba, 0xba, 0xff,
+	0xeb, 0x69, 0x7d, 0x87, 0x71, 0x86, 0xd7, 0x64, 0x98, 0x16, 0x09, 0x53, 0xb6, 0x5a, 0xcc, 0xed,
+	0x31, 0x57, 0x6f, 0x12, 0x97, 0x06, 0x18, 0xfd, 0xac, 0xd6, 0xa4, 0x9c, 0xd4, 0xf4, 0x3e, 0x31,
+	0x2d, 0x9b, 0x70, 0x8b, 0xd9, 0xc1, 0x31, 0xca, 0x7d, 0x93, 0x99, 0x4c, 0x3c, 0xea, 0xfe, 0x93,
+	0xdc, 0x5d, 0x37, 0x19, 0x33, 0xbb, 0x54, 0x27, 0x7d, 0x4b, 0x27, 0xb6, 0xcd, 0xb8, 0x80, 0xb8,
+	0xf2, 0xdf, 0xf2, 0x6c, 0x86, 0x26, 0xb5, 0xa9, 0x6b, 0x0d, 0x03, 0x37, 0x67, 0x07, 0xf6, 0x89,
+	0x43, 0x7a, 0xc3, 0x38, 0x75, 0x76, 0x1c, 0x3f, 0x0f, 0x62, 0xd4, 0xfb, 0x80, 0x8f, 0x7c, 0x29,
+	0x87, 0x02, 0x68, 0xd0, 0xd3, 0x01, 0x75, 0xb9, 0x7a, 0x02, 0x2f, 0x85, 0x76, 0xdd, 0x3e, 0xb3,
+	0x5d, 0x8a, 0xdf, 0x83, 0x7c, 0x90, 0xa0, 0x88, 0x1e, 0xa2, 0x4a, 0xa1, 0xbe, 0xa1, 0xcd, 0xac,
+	0x96, 0x16, 0x40, 0xf7, 0x73, 0x17, 0x7f, 0xbf, 0x96, 0x31, 0x24, 0x4c, 0xfd, 0x01, 0xc1, 0x03,
+	0x71, 0xb0, 0x41, 0x4d, 0xcb, 0xe5, 0xd4, 0xa1, 0xed, 0xa3, 0x20, 0x5e, 0x66, 0xc6, 0xaf, 0x40,
+	0x9e, 0x7d, 0x69, 0x53, 0xc7, 0x4f, 0xb1, 0x54, 0x59, 0x36, 0xe4, 0x0a, 0x3f, 0x82, 0x17, 0x5a,
+	0xcc, 0xb6, 0x69, 0xcb, 0xaf, 0x58, 0xc3, 0x6a, 0x17, 0xb3, 0x0f, 0x51, 0x65, 0xd9, 0x58, 0x19,
+	0x6f, 0x1e, 0xb4, 0xf1, 0x87, 0x00, 0xe3, 0x4e, 0x14, 0x97, 0x04, 0xc7, 0x4d, 0x2d, 0x68, 0x9b,
+	0xe6, 0xb7, 0x4d, 0x0b, 0x5a, 0x2d, 0xdb, 0xa6, 0x1d, 0x12, 0x93, 0xca, 0xc4, 0xc6, 0x04, 0x52,
+	0xfd, 0x03, 0x41, 0x69, 0x16, 0x4d, 0x59, 0x8a, 0x06, 0x60, 0x67, 0xf4, 0x67, 0x43, 0x8a, 0x16,
+	0x9c, 0x0b, 0xf5, 0xad, 0x39, 0x65, 0x09, 0x9f, 0xe8, 0xc9, 0xfa, 0xdc, 0x73, 0xa6, 0x13, 0xe1,
+	0x8f, 0x42, 0x5a, 0xb2, 0x42, 0x4b, 0x39, 0x51, 0x4b, 0xc0, 0x2e, 0x24, 0x66, 0x17, 0x5e, 0x8d,
+	0xd1, 0xe2, 0x0d, 0x0b, 0xbe, 0x06, 0x77, 0xc4, 0x41, 0x7e, 0x4d, 0xfd, 0xae, 0xe6, 0x8c, 0xdb,
+	0x62, 0x7d, 0xd0, 0x56, 0x07, 0xb0, 0x1e, 0x8f, 0x94, 0x35, 0xf8, 0x0c, 0xee, 0x4e, 0xd5, 0xc0,
+	0x93, 0x83, 0x91, 0xa2, 0x02, 0xc6, 0x6a, 0x58, 0xbb, 0xa7, 0x3e, 0x83, 0x8d, 0x19, 0x69, 0x07,
+	0x5d, 0x7e, 0x03, 0xda, 0x6d, 0x50, 0xe7, 0xe1, 0x25, 0xf9, 0x67, 0x90, 0x77, 0xc4, 0x8e, 0xa4,
+	0xbc, 0x39, 0x87, 0xf2, 0x24, 0x5e, 0xa2, 0xd4, 0x03, 0x28, 0x7c, 0xea, 0x10, 0xdb, 0x25, 0x62,
+	0xf8, 0xf0, 0x8b, 0x90, 0x1d, 0x31, 0xc9, 0x5a, 0x6d, 0x7f, 0x8e, 0x3b, 0xd4, 0x32, 0x3b, 0x5c,
+	0xb4, 0x2e, 0x67, 0xc8, 0x15, 0xc6, 0x90, 0x6b, 0x13, 0x4e, 0xc4, 0x70, 0xae, 0x18, 0xe2, 0x59,
+	0xdd, 0x83, 0x97, 0x45, 0x86, 0x4f, 0x88, 0xcb, 0x0d, 0xda, 0x63, 0x9c, 0x7e, 0x1c, 0x04, 0x47,
+	0x86, 0x1e, 0x45, 0x87, 0x5e, 0x3d, 0x86, 0x07, 0xb1, 0xe8, 0x91, 0xd2, 0x31, 0x15, 0x14, 0xa2,
+	0xa2, 0xc0, 0x1d, 0x87, 0x9e, 0x59, 0xee, 0x70, 0xbe, 0x72, 0xc6, 0x68, 0xad, 0xee, 0x40, 0x51,
+	0x8a, 0xee, 0x12, 0x8f, 0x3a, 0xc7, 0x9c, 0xf0, 0xd1, 0x15, 0x2d, 0xc2, 0x6d, 0x27, 0xd8, 0x96,
+	0x7c, 0x86, 0x4b, 0xf5, 0x0b, 0x58, 0x8b, 0x41, 0x49, 0x1a, 0x1f, 0xc0, 0x2d, 0xd7, 0xdf, 0x90,
+	0xf5, 0x2e, 0xcf, 0x1d, 0x91, 0x31, 0x5e, 0xde, 0x90, 0x00, 0xab, 0x52, 0x39, 0xcc, 0xef, 0x77,
+	0xbb, 0x71, 0xd4, 0xc2, 0x06, 0x80, 0x16, 0x36, 0x80, 0x1f, 0x11, 0xac, 0xc7, 0xe7, 0x89, 0x8a,
+	0x59, 0x5a, 0x54, 0xcc, 0x73, 0xbb, 0xe2, 0xf5, 0xff, 0x96, 0xe1, 0x96, 0xa0, 0x8b, 0xbf, 0x43,
+	0x90, 0x0f, 0x9c, 0x17, 0x57, 0x93, 0x06, 0x3a, 0x64, 0xf9, 0x8a, 0x76, 0xd3, 0xf0, 0x20, 0xbf,
+	0xfa, 0xf8, 0x9b, 0x3f, 0xff, 0xfd, 0x3e, 0xfb, 0x08, 0x6f, 0xe8, 0x49, 0x6f, 0x23, 0xfc, 0x3b,
+	0x82, 0x7b, 0x11, 0x27, 0xc5, 0xbb, 0xc9, 0x17, 0x2e, 0xfe, 0x1d, 0xa1, 0xbc, 0xbd, 0x00, 0x52,
+	0xb2, 0x7e, 0x53, 0xb0, 0xd6, 0x71, 0x75, 0x0e, 0xeb, 0xa8, 0xaf, 0xe3, 0x5f, 0x11, 0xac, 0x4e,
+	0xd9, 0x09, 0x7e, 0x2b, 0x1d, 0x8b, 0xa1, 0xe1, 0x2a, 0x4f, 0x53, 0xe3, 0x24, 0xf7, 0x6d, 0xc1,
+	0xbd, 0x8a, 0xdf, 0xb8, 0x39, 0x77, 0x0f, 0xff, 0x86, 0xa0, 0x30, 0x61, 0x5f, 0x78, 0x2f, 0x7d,
+	0xf6, 0xb1, 0xeb, 0x2a, 0xef, 0x2e, 0x88, 0x96, 0x0a, 0x74, 0xa1, 0xe0, 0x31, 0x2e, 0xeb, 0x09,
+	0x1f, 0x63, 0x8d, 0xc0, 0x64, 0xf1, 0x4f, 0x08, 0xee, 0x46, 0x5c, 0xf1, 0x49, 0x12, 0x89, 0x69,
+	0x84, 0xb2, 0x9b, 0x16, 0x31, 0x62, 0xfc, 0x44, 0x30, 0xde, 0xc2, 0x95, 0xb9, 0x35, 0xf7, 0x81,
+	0x0d, 0xe9, 0xaa, 0xbf, 0x20, 0x58, 0x99, 0xbc, 0xf2, 0x78, 0x3b, 0xb9, 0x66, 0x11, 0x23, 0x53,
+	0x76, 0xd2, 0x81, 0x24, 0xdb, 0x77, 0x04, 0xdb, 0x1d, 0x5c, 0x9f, 0xcb, 0x56, 0x00, 0x1b, 0xc2,
+	0x82, 0xf4, 0xaf, 0xe4, 0xf2, 0x6b, 0xfc, 0x33, 0x82, 0xd5, 0x29, 0xb7, 0x4b, 0x1e, 0xf1, 0x78,
+	0x1b, 0x56, 0x9e, 0xa6, 0xc6, 0xa5, 0x2a, 0xf7, 0x84, 0x80, 0xfd, 0x93, 0x8b, 0xab, 0x12, 0xba,
+	0xbc, 0x2a, 0xa1, 0x7f, 0xae, 0x4a, 0xe8, 0xdb, 0xeb, 0x52, 0xe6, 0xf2, 0xba, 0x94, 0xf9, 0xeb,
+	0xba, 0x94, 0xf9, 0x7c, 0xcf, 0xb4, 0x78, 0x67, 0xd0, 0xd4, 0x5a, 0xac, 0x37, 0x3c, 0xad, 0xca,
+	0x1c, 0x73, 0x74, 0xf2, 0x59, 0xad, 0xa6, 0x9f, 0xc7, 0x9c, 0xcf, 0xbd, 0x3e, 0x75, 0x9b, 0x79,
+	0xf1, 0x79, 0xbc, 0xfd, 0xff, 0x00, 0xe1, 0x25, 0x66, 0x43, 0x37, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -680,6 +885,10 @@ type QueryClient interface {
 	// Retrieves the most recent height of a remote chain as known by the IBC client associated with
 	// a given connection ID.
 	LastRemoteHeight(ctx context.Context, in *QueryLastRemoteHeight, opts ...grpc.CallOption) (*QueryLastRemoteHeightResponse, error)
+	// Retrieves the query results submission statistics of a relayer.
+	RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error)
+	// Retrieves the query results submission statistics of all relayers.
+	AllRelayerStats(ctx context.Context, in *QueryAllRelayerStatsRequest, opts ...grpc.CallOption) (*QueryAllRelayerStatsResponse, error)
 }
 
 type queryClient struct {
@@ -735,6 +944,24 @@ func (c *queryClient) LastRemoteHeight(ctx context.Context, in *QueryLastRemoteH
 	return out, nil
 }
 
+func (c *queryClient) RelayerStats(ctx context.Context, in *QueryRelayerStatsRequest, opts ...grpc.CallOption) (*QueryRelayerStatsResponse, error) {
+	out := new(QueryRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Query/RelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllRelayerStats(ctx context.Context, in *QueryAllRelayerStatsRequest, opts ...grpc.CallOption) (*QueryAllRelayerStatsResponse, error) {
+	out := new(QueryAllRelayerStatsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Query/AllRelayerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Fetches the current parameters of the interchainqueries module.
@@ -750,6 +977,10 @@ type QueryServer interface {
 	// Retrieves the most recent height of a remote chain as known by the IBC client associated with
 	// a given connection ID.
 	LastRemoteHeight(context.Context, *QueryLastRemoteHeight) (*QueryLastRemoteHeightResponse, error)
+	// Retrieves the query results submission statistics of a relayer.
+	RelayerStats(context.Context, *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error)
+	// Retrieves the query results submission statistics of all relayers.
+	AllRelayerStats(context.Context, *QueryAllRelayerStatsRequest) (*QueryAllRelayerStatsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -771,6 +1002,12 @@ func (*UnimplementedQueryServer) QueryResult(ctx context.Context, req *QueryRegi
 func (*UnimplementedQueryServer) LastRemoteHeight(ctx context.Context, req *QueryLastRemoteHeight) (*QueryLastRemoteHeightResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastRemoteHeight not implemented")
 }
+func (*UnimplementedQueryServer) RelayerStats(ctx context.Context, req *QueryRelayerStatsRequest) (*QueryRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerStats not implemented")
+}
+func (*UnimplementedQueryServer) AllRelayerStats(ctx context.Context, req *QueryAllRelayerStatsRequest) (*QueryAllRelayerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRelayerStats not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -866,6 +1103,43 @@ func _Query_LastRemoteHeight_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainqueries.Query/RelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerStats(ctx, req.(*QueryRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRelayerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRelayerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRelayerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainqueries.Query/AllRelayerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRelayerStats(ctx, req.(*QueryAllRelayerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.interchainqueries.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -890,6 +1164,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastRemoteHeight",
 			Handler:    _Query_LastRemoteHeight_Handler,
 		},
+		{
+			MethodName: "RelayerStats",
+			Handler:    _Query_RelayerStats_Handler,
+		},
+		{
+			MethodName: "AllRelayerStats",
+			Handler:    _Query_AllRelayerStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/interchainqueries/query.proto",
@@ -1280,75 +1562,222 @@ func (m *QueryLastRemoteHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRegisteredQueriesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Owners) > 0 {
-		for _, s := range m.Owners {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRegisteredQueriesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllRelayerStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRelayerStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRelayerStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RegisteredQueries) > 0 {
-		for _, e := range m.RegisteredQueries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRelayerStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRelayerStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRelayerStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegisteredQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegisteredQueries) > 0 {
+		for _, e := range m.RegisteredQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
@@ -1450,6 +1879,62 @@ func (m *QueryLastRemoteHeightResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllRelayerStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRelayerStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2461,6 +2946,377 @@ func (m *QueryLastRemoteHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryRelayerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRelayerStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRelayerStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRelayerStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllRelayerStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllRelayerStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllRelayerStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, RelayerStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
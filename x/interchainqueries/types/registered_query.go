@@ -36,3 +36,15 @@ func (q *RegisteredQuery) ValidateRemoval(ctx sdk.Context, caller string) error
 	}
 	return nil
 }
+
+// IsSubmissionLate checks whether a query result submitted in the current block violates the
+// query's update period, i.e. more than q.UpdatePeriod blocks have passed since the last result
+// submission or, if no results have been submitted yet, since the query registration.
+func (q *RegisteredQuery) IsSubmissionLate(ctx sdk.Context) bool {
+	lastUpdateBlock := q.RegisteredAtHeight
+	if q.LastSubmittedResultLocalHeight > lastUpdateBlock {
+		lastUpdateBlock = q.LastSubmittedResultLocalHeight
+	}
+	currentBlock := uint64(ctx.BlockHeader().Height) //nolint:gosec
+	return currentBlock > lastUpdateBlock+q.UpdatePeriod
+}
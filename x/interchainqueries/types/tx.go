@@ -117,6 +117,13 @@ func (msg MsgRegisterInterchainQuery) Validate(params Params) error {
 			return errors.Wrap(ErrInvalidTransactionsFilter, err.Error())
 		}
 	}
+
+	if err := msg.RewardPerUpdate.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidReward, "invalid reward per update: %v", err)
+	}
+	if err := msg.RewardEscrow.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidReward, "invalid reward escrow: %v", err)
+	}
 	return nil
 }
 
@@ -144,10 +151,17 @@ func (msg MsgUpdateInterchainQueryRequest) Validate(params Params) error {
 	newKeys := msg.GetNewKeys()
 	newTxFilter := msg.GetNewTransactionsFilter()
 
-	if len(newKeys) == 0 && newTxFilter == "" && msg.GetNewUpdatePeriod() == 0 {
+	if len(newKeys) == 0 && newTxFilter == "" && msg.GetNewUpdatePeriod() == 0 && msg.NewRewardPerUpdate.IsZero() && !msg.GetClearRewardPerUpdate() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"one of new_keys, new_transactions_filter, new_update_period, new_reward_per_update or clear_reward_per_update should be set",
+		)
+	}
+
+	if !msg.NewRewardPerUpdate.IsZero() && msg.GetClearRewardPerUpdate() {
 		return errors.Wrap(
 			sdkerrors.ErrInvalidRequest,
-			"one of new_keys, new_transactions_filter or new_update_period should be set",
+			"either new_reward_per_update or clear_reward_per_update should be set",
 		)
 	}
 
@@ -170,6 +184,10 @@ func (msg MsgUpdateInterchainQueryRequest) Validate(params Params) error {
 		}
 	}
 
+	if err := msg.NewRewardPerUpdate.Validate(); err != nil {
+		return errors.Wrapf(ErrInvalidReward, "invalid new reward per update: %v", err)
+	}
+
 	if strings.TrimSpace(msg.Sender) == "" {
 		return errors.Wrap(sdkerrors.ErrInvalidAddress, "missing sender address")
 	}
@@ -5,13 +5,15 @@ package types
 
 import (
 	context "context"
+	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
-	types1 "github.com/cometbft/cometbft/abci/types"
+	types2 "github.com/cometbft/cometbft/abci/types"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
-	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -55,6 +57,12 @@ type MsgRegisterInterchainQuery struct {
 	UpdatePeriod uint64 `protobuf:"varint,5,opt,name=update_period,json=updatePeriod,proto3" json:"update_period,omitempty"`
 	// The signer of the message.
 	Sender string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Amount of coins paid to the relayer for a successfully submitted query result, at most once per
+	// update period. Optional.
+	RewardPerUpdate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=reward_per_update,json=rewardPerUpdate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_per_update"`
+	// Amount of coins to put in the query's reward escrow on registration. The calling contract is
+	// charged the escrow in addition to the query registration deposit. Optional.
+	RewardEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=reward_escrow,json=rewardEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward_escrow"`
 }
 
 func (m *MsgRegisterInterchainQuery) Reset()         { *m = MsgRegisterInterchainQuery{} }
@@ -132,6 +140,20 @@ func (m *MsgRegisterInterchainQuery) GetSender() string {
 	return ""
 }
 
+func (m *MsgRegisterInterchainQuery) GetRewardPerUpdate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardPerUpdate
+	}
+	return nil
+}
+
+func (m *MsgRegisterInterchainQuery) GetRewardEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RewardEscrow
+	}
+	return nil
+}
+
 // Response type for the Msg/RegisterInterchainQuery RPC method.
 type MsgRegisterInterchainQueryResponse struct {
 	// The ID assigned to the registered Interchain Query by the module.
@@ -427,10 +449,10 @@ type Block struct {
 	// The header of the block next to the block the transaction is included in. It is needed to know
 	// block X+1 header to verify response of transaction for block X since LastResultsHash is root
 	// hash of all results of the txs from the previous block.
-	NextBlockHeader *types.Any `protobuf:"bytes,1,opt,name=next_block_header,json=nextBlockHeader,proto3" json:"next_block_header,omitempty"`
+	NextBlockHeader *types1.Any `protobuf:"bytes,1,opt,name=next_block_header,json=nextBlockHeader,proto3" json:"next_block_header,omitempty"`
 	// The header of the block the transaction is included in. It is needed to know block header to
 	// verify inclusion of the transaction.
-	Header *types.Any `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Header *types1.Any `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
 	// The transaction matched by the Interchain Query's transaction filter.
 	Tx *TxValue `protobuf:"bytes,3,opt,name=tx,proto3" json:"tx,omitempty"`
 }
@@ -468,14 +490,14 @@ func (m *Block) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Block proto.InternalMessageInfo
 
-func (m *Block) GetNextBlockHeader() *types.Any {
+func (m *Block) GetNextBlockHeader() *types1.Any {
 	if m != nil {
 		return m.NextBlockHeader
 	}
 	return nil
 }
 
-func (m *Block) GetHeader() *types.Any {
+func (m *Block) GetHeader() *types1.Any {
 	if m != nil {
 		return m.Header
 	}
@@ -492,7 +514,7 @@ func (m *Block) GetTx() *TxValue {
 // Contains transaction body, response, and proofs of inclusion and delivery.
 type TxValue struct {
 	// The result of the transaction execution.
-	Response *types1.ExecTxResult `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	Response *types2.ExecTxResult `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
 	// The Merkle Proof which proves existence of response in the block next to the block the
 	// transaction is included in.
 	DeliveryProof *crypto.Proof `protobuf:"bytes,2,opt,name=delivery_proof,json=deliveryProof,proto3" json:"delivery_proof,omitempty"`
@@ -535,7 +557,7 @@ func (m *TxValue) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TxValue proto.InternalMessageInfo
 
-func (m *TxValue) GetResponse() *types1.ExecTxResult {
+func (m *TxValue) GetResponse() *types2.ExecTxResult {
 	if m != nil {
 		return m.Response
 	}
@@ -600,6 +622,263 @@ func (m *MsgSubmitQueryResultResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitQueryResultResponse proto.InternalMessageInfo
 
+// Request type for the Msg/SubmitQueryResults RPC method.
+type MsgSubmitQueryResults struct {
+	// The signer of the message.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The IBC connection ID to the remote chain shared by all the submitted queries.
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The height of the remote chain at the moment of the Interchain Queries execution.
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// The revision number of the remote chain at the moment of the Interchain Queries execution.
+	Revision uint64 `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The results of the KV Interchain Queries execution.
+	Results []*BatchedQueryResult `protobuf:"bytes,5,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgSubmitQueryResults) Reset()         { *m = MsgSubmitQueryResults{} }
+func (m *MsgSubmitQueryResults) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQueryResults) ProtoMessage()    {}
+func (*MsgSubmitQueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4793837a316491e, []int{8}
+}
+func (m *MsgSubmitQueryResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitQueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitQueryResults.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitQueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQueryResults.Merge(m, src)
+}
+func (m *MsgSubmitQueryResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitQueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQueryResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitQueryResults proto.InternalMessageInfo
+
+func (m *MsgSubmitQueryResults) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitQueryResults) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MsgSubmitQueryResults) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MsgSubmitQueryResults) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *MsgSubmitQueryResults) GetResults() []*BatchedQueryResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// The result of a single KV Interchain Query execution submitted within a batch.
+type BatchedQueryResult struct {
+	// The ID of the Interchain Query.
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// A list of the KV Interchain Query execution results. Each result contains query parameters, a
+	// response value and a proof.
+	KvResults []*StorageValue `protobuf:"bytes,2,rep,name=kv_results,json=kvResults,proto3" json:"kv_results,omitempty"`
+	// Whether to send the query result to the owner contract as a sudo message.
+	AllowKvCallbacks bool `protobuf:"varint,3,opt,name=allow_kv_callbacks,json=allowKvCallbacks,proto3" json:"allow_kv_callbacks,omitempty"`
+}
+
+func (m *BatchedQueryResult) Reset()         { *m = BatchedQueryResult{} }
+func (m *BatchedQueryResult) String() string { return proto.CompactTextString(m) }
+func (*BatchedQueryResult) ProtoMessage()    {}
+func (*BatchedQueryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4793837a316491e, []int{9}
+}
+func (m *BatchedQueryResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchedQueryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchedQueryResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchedQueryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedQueryResult.Merge(m, src)
+}
+func (m *BatchedQueryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchedQueryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedQueryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchedQueryResult proto.InternalMessageInfo
+
+func (m *BatchedQueryResult) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *BatchedQueryResult) GetKvResults() []*StorageValue {
+	if m != nil {
+		return m.KvResults
+	}
+	return nil
+}
+
+func (m *BatchedQueryResult) GetAllowKvCallbacks() bool {
+	if m != nil {
+		return m.AllowKvCallbacks
+	}
+	return false
+}
+
+// Response type for the Msg/SubmitQueryResults RPC method.
+type MsgSubmitQueryResultsResponse struct {
+	// The processing statuses of the submitted results in the order of submission.
+	Statuses []BatchedQueryResultStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses"`
+}
+
+func (m *MsgSubmitQueryResultsResponse) Reset()         { *m = MsgSubmitQueryResultsResponse{} }
+func (m *MsgSubmitQueryResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitQueryResultsResponse) ProtoMessage()    {}
+func (*MsgSubmitQueryResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4793837a316491e, []int{10}
+}
+func (m *MsgSubmitQueryResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitQueryResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitQueryResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitQueryResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitQueryResultsResponse.Merge(m, src)
+}
+func (m *MsgSubmitQueryResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitQueryResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitQueryResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitQueryResultsResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitQueryResultsResponse) GetStatuses() []BatchedQueryResultStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// The processing status of a single Interchain Query result submitted within a batch.
+type BatchedQueryResultStatus struct {
+	// The ID of the Interchain Query.
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// Whether the result has been successfully processed.
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Redacted error of the result processing. Empty if the result has been successfully processed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BatchedQueryResultStatus) Reset()         { *m = BatchedQueryResultStatus{} }
+func (m *BatchedQueryResultStatus) String() string { return proto.CompactTextString(m) }
+func (*BatchedQueryResultStatus) ProtoMessage()    {}
+func (*BatchedQueryResultStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4793837a316491e, []int{11}
+}
+func (m *BatchedQueryResultStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchedQueryResultStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchedQueryResultStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchedQueryResultStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchedQueryResultStatus.Merge(m, src)
+}
+func (m *BatchedQueryResultStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchedQueryResultStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchedQueryResultStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchedQueryResultStatus proto.InternalMessageInfo
+
+func (m *BatchedQueryResultStatus) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *BatchedQueryResultStatus) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *BatchedQueryResultStatus) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // Request type for the Msg/RemoveInterchainQuery RPC method.
 type MsgRemoveInterchainQueryRequest struct {
 	// The ID of the query to remove.
@@ -612,7 +891,7 @@ func (m *MsgRemoveInterchainQueryRequest) Reset()         { *m = MsgRemoveInterc
 func (m *MsgRemoveInterchainQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveInterchainQueryRequest) ProtoMessage()    {}
 func (*MsgRemoveInterchainQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4793837a316491e, []int{8}
+	return fileDescriptor_d4793837a316491e, []int{12}
 }
 func (m *MsgRemoveInterchainQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +942,7 @@ func (m *MsgRemoveInterchainQueryResponse) Reset()         { *m = MsgRemoveInter
 func (m *MsgRemoveInterchainQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveInterchainQueryResponse) ProtoMessage()    {}
 func (*MsgRemoveInterchainQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4793837a316491e, []int{9}
+	return fileDescriptor_d4793837a316491e, []int{13}
 }
 func (m *MsgRemoveInterchainQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -709,13 +988,19 @@ type MsgUpdateInterchainQueryRequest struct {
 	NewTransactionsFilter string `protobuf:"bytes,4,opt,name=new_transactions_filter,json=newTransactionsFilter,proto3" json:"new_transactions_filter,omitempty"`
 	// The signer of the message.
 	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	// A new amount of coins paid to the relayer for a successfully submitted query result, at most
+	// once per update period.
+	NewRewardPerUpdate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=new_reward_per_update,json=newRewardPerUpdate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"new_reward_per_update"`
+	// Whether to stop paying relayers for the query results. Can't be set together with
+	// new_reward_per_update. The reward escrow of the query is kept.
+	ClearRewardPerUpdate bool `protobuf:"varint,7,opt,name=clear_reward_per_update,json=clearRewardPerUpdate,proto3" json:"clear_reward_per_update,omitempty"`
 }
 
 func (m *MsgUpdateInterchainQueryRequest) Reset()         { *m = MsgUpdateInterchainQueryRequest{} }
 func (m *MsgUpdateInterchainQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateInterchainQueryRequest) ProtoMessage()    {}
 func (*MsgUpdateInterchainQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4793837a316491e, []int{10}
+	return fileDescriptor_d4793837a316491e, []int{14}
 }
 func (m *MsgUpdateInterchainQueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,6 +1064,20 @@ func (m *MsgUpdateInterchainQueryRequest) GetSender() string {
 	return ""
 }
 
+func (m *MsgUpdateInterchainQueryRequest) GetNewRewardPerUpdate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.NewRewardPerUpdate
+	}
+	return nil
+}
+
+func (m *MsgUpdateInterchainQueryRequest) GetClearRewardPerUpdate() bool {
+	if m != nil {
+		return m.ClearRewardPerUpdate
+	}
+	return false
+}
+
 // Response type for the Msg/UpdateInterchainQuery RPC method.
 type MsgUpdateInterchainQueryResponse struct {
 }
@@ -787,7 +1086,7 @@ func (m *MsgUpdateInterchainQueryResponse) Reset()         { *m = MsgUpdateInter
 func (m *MsgUpdateInterchainQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateInterchainQueryResponse) ProtoMessage()    {}
 func (*MsgUpdateInterchainQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4793837a316491e, []int{11}
+	return fileDescriptor_d4793837a316491e, []int{15}
 }
 func (m *MsgUpdateInterchainQueryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,6 +1115,107 @@ func (m *MsgUpdateInterchainQueryResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateInterchainQueryResponse proto.InternalMessageInfo
 
+// Request type for the Msg/FundQueryReward RPC method.
+type MsgFundQueryReward struct {
+	// The ID of the query to fund the reward escrow of.
+	QueryId uint64 `protobuf:"varint,1,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	// Amount of coins to add to the query's reward escrow.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// The signer of the message.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgFundQueryReward) Reset()         { *m = MsgFundQueryReward{} }
+func (m *MsgFundQueryReward) String() string { return proto.CompactTextString(m) }
+func (*MsgFundQueryReward) ProtoMessage()    {}
+func (*MsgFundQueryReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4793837a316491e, []int{16}
+}
+func (m *MsgFundQueryReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundQueryReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundQueryReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundQueryReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundQueryReward.Merge(m, src)
+}
+func (m *MsgFundQueryReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundQueryReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundQueryReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundQueryReward proto.InternalMessageInfo
+
+func (m *MsgFundQueryReward) GetQueryId() uint64 {
+	if m != nil {
+		return m.QueryId
+	}
+	return 0
+}
+
+func (m *MsgFundQueryReward) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *MsgFundQueryReward) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// Response type for the Msg/FundQueryReward RPC method.
+type MsgFundQueryRewardResponse struct {
+}
+
+func (m *MsgFundQueryRewardResponse) Reset()         { *m = MsgFundQueryRewardResponse{} }
+func (m *MsgFundQueryRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundQueryRewardResponse) ProtoMessage()    {}
+func (*MsgFundQueryRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4793837a316491e, []int{17}
+}
+func (m *MsgFundQueryRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundQueryRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundQueryRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundQueryRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundQueryRewardResponse.Merge(m, src)
+}
+func (m *MsgFundQueryRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundQueryRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundQueryRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundQueryRewardResponse proto.InternalMessageInfo
+
 // Request type for the Msg/UpdateParams RPC method.
 type MsgUpdateParams struct {
 	// The address of the authority of the module.
@@ -828,7 +1228,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4793837a316491e, []int{12}
+	return fileDescriptor_d4793837a316491e, []int{18}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1279,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4793837a316491e, []int{13}
+	return fileDescriptor_d4793837a316491e, []int{19}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,10 +1317,16 @@ func init() {
 	proto.RegisterType((*Block)(nil), "neutron.interchainqueries.Block")
 	proto.RegisterType((*TxValue)(nil), "neutron.interchainqueries.TxValue")
 	proto.RegisterType((*MsgSubmitQueryResultResponse)(nil), "neutron.interchainqueries.MsgSubmitQueryResultResponse")
+	proto.RegisterType((*MsgSubmitQueryResults)(nil), "neutron.interchainqueries.MsgSubmitQueryResults")
+	proto.RegisterType((*BatchedQueryResult)(nil), "neutron.interchainqueries.BatchedQueryResult")
+	proto.RegisterType((*MsgSubmitQueryResultsResponse)(nil), "neutron.interchainqueries.MsgSubmitQueryResultsResponse")
+	proto.RegisterType((*BatchedQueryResultStatus)(nil), "neutron.interchainqueries.BatchedQueryResultStatus")
 	proto.RegisterType((*MsgRemoveInterchainQueryRequest)(nil), "neutron.interchainqueries.MsgRemoveInterchainQueryRequest")
 	proto.RegisterType((*MsgRemoveInterchainQueryResponse)(nil), "neutron.interchainqueries.MsgRemoveInterchainQueryResponse")
 	proto.RegisterType((*MsgUpdateInterchainQueryRequest)(nil), "neutron.interchainqueries.MsgUpdateInterchainQueryRequest")
 	proto.RegisterType((*MsgUpdateInterchainQueryResponse)(nil), "neutron.interchainqueries.MsgUpdateInterchainQueryResponse")
+	proto.RegisterType((*MsgFundQueryReward)(nil), "neutron.interchainqueries.MsgFundQueryReward")
+	proto.RegisterType((*MsgFundQueryRewardResponse)(nil), "neutron.interchainqueries.MsgFundQueryRewardResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.interchainqueries.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.interchainqueries.MsgUpdateParamsResponse")
 }
@@ -930,81 +1336,102 @@ func init() {
 }
 
 var fileDescriptor_d4793837a316491e = []byte{
-	// 1171 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x4f, 0x1b, 0xc7,
-	0x17, 0x67, 0x8d, 0x4d, 0xf0, 0xe0, 0x40, 0x32, 0x5f, 0xf2, 0xc5, 0x38, 0x8d, 0x43, 0xb6, 0x6a,
-	0x12, 0xa1, 0x64, 0x57, 0xb8, 0x94, 0xaa, 0xa4, 0xbf, 0xa0, 0x6d, 0x54, 0x84, 0x50, 0xe9, 0x42,
-	0x38, 0xf4, 0xb2, 0x5a, 0xef, 0x0e, 0xeb, 0x91, 0xd7, 0x33, 0xce, 0xce, 0xac, 0x7f, 0x1c, 0x2a,
-	0x55, 0x39, 0xf6, 0x52, 0xfa, 0x5f, 0x54, 0xea, 0x05, 0xa9, 0x95, 0xfa, 0x17, 0x54, 0xca, 0x31,
-	0xea, 0xa9, 0x87, 0xaa, 0xaa, 0xe0, 0xc0, 0xb1, 0xff, 0x42, 0x35, 0x3f, 0xd6, 0x98, 0x18, 0x9b,
-	0xc0, 0x05, 0xf6, 0xbd, 0xf7, 0x79, 0x6f, 0x3e, 0xf3, 0xe6, 0xfd, 0x30, 0x30, 0x09, 0x4a, 0x78,
-	0x4c, 0x89, 0x8d, 0x09, 0x47, 0xb1, 0x5f, 0xf3, 0x30, 0x79, 0x9e, 0xa0, 0x18, 0x23, 0x66, 0xf3,
-	0x8e, 0xd5, 0x8c, 0x29, 0xa7, 0x70, 0x5e, 0x63, 0xac, 0x01, 0x4c, 0xe9, 0xa6, 0xd7, 0xc0, 0x84,
-	0xda, 0xf2, 0xaf, 0x42, 0x97, 0xe6, 0x7c, 0xca, 0x1a, 0x94, 0xd9, 0x0d, 0x16, 0xda, 0xad, 0x25,
-	0xf1, 0x4f, 0x1b, 0xe6, 0x95, 0xc1, 0x95, 0x92, 0xad, 0x04, 0x6d, 0x9a, 0x0d, 0x69, 0x48, 0x95,
-	0x5e, 0x7c, 0xa5, 0x0e, 0x21, 0xa5, 0x61, 0x84, 0x6c, 0x29, 0x55, 0x93, 0x7d, 0xdb, 0x23, 0x5d,
-	0x6d, 0x7a, 0x30, 0x9c, 0x76, 0x88, 0x08, 0x62, 0x38, 0x8d, 0x7c, 0x7f, 0x38, 0xb0, 0xe9, 0xc5,
-	0x5e, 0x23, 0xc5, 0xdd, 0xe6, 0x88, 0x04, 0x28, 0x6e, 0x60, 0xc2, 0x6d, 0xaf, 0xea, 0x63, 0x9b,
-	0x77, 0x9b, 0x28, 0x35, 0xde, 0xe9, 0x33, 0xfa, 0x71, 0xb7, 0xc9, 0xa9, 0xe0, 0x44, 0xf7, 0x95,
-	0xd9, 0xfc, 0x31, 0x03, 0x4a, 0x5b, 0x2c, 0x74, 0x50, 0x88, 0x19, 0x47, 0xf1, 0x46, 0xef, 0xa4,
-	0xaf, 0x13, 0x14, 0x77, 0xe1, 0x1d, 0x00, 0xc4, 0x91, 0x5d, 0x57, 0x84, 0x2c, 0x1a, 0x0b, 0xc6,
-	0xc3, 0xbc, 0x93, 0x97, 0x9a, 0xdd, 0x6e, 0x13, 0xc1, 0x65, 0x90, 0xad, 0xa3, 0x2e, 0x2b, 0x66,
-	0x16, 0xc6, 0x1f, 0x4e, 0x55, 0x16, 0xac, 0xa1, 0xc9, 0xb6, 0x36, 0xf7, 0x36, 0x51, 0xd7, 0x91,
-	0x68, 0x68, 0x83, 0xff, 0xf1, 0xd8, 0x23, 0xcc, 0xf3, 0x39, 0xa6, 0x84, 0xb9, 0xfb, 0x38, 0xe2,
-	0x28, 0x2e, 0x8e, 0xcb, 0xe8, 0xb0, 0xdf, 0xf4, 0x54, 0x5a, 0xe0, 0xdb, 0xe0, 0xba, 0x4f, 0x09,
-	0x41, 0x52, 0xe9, 0xe2, 0xa0, 0x98, 0x95, 0xd0, 0xc2, 0xa9, 0x72, 0x23, 0x10, 0xa0, 0xa4, 0x19,
-	0x78, 0x1c, 0xb9, 0x4d, 0x14, 0x63, 0x1a, 0x14, 0x73, 0x0b, 0xc6, 0xc3, 0xac, 0x53, 0x50, 0xca,
-	0x6d, 0xa9, 0x83, 0xff, 0x07, 0x13, 0x4c, 0xe6, 0xa3, 0x38, 0x21, 0x43, 0x68, 0x69, 0x75, 0xea,
-	0xc5, 0xc9, 0xe1, 0xa2, 0x16, 0xcc, 0x65, 0x60, 0x0e, 0x4f, 0x89, 0x83, 0x58, 0x93, 0x12, 0x86,
-	0xe0, 0x34, 0xc8, 0xe0, 0x40, 0xa6, 0x24, 0xeb, 0x64, 0x70, 0x60, 0xfe, 0x66, 0x80, 0xd9, 0x2d,
-	0x16, 0xee, 0x24, 0xd5, 0x06, 0xe6, 0x29, 0x34, 0x89, 0x38, 0x9c, 0x07, 0x93, 0x2a, 0x87, 0x3d,
-	0xf8, 0x35, 0x29, 0x6f, 0xf4, 0xd3, 0xc9, 0xf4, 0xd3, 0x81, 0x77, 0x41, 0xde, 0x8f, 0x30, 0x22,
-	0x5c, 0xf8, 0xc8, 0xbc, 0xac, 0x67, 0x8a, 0x86, 0x33, 0xa9, 0x94, 0x1b, 0x01, 0xfc, 0x18, 0x4c,
-	0xc4, 0x32, 0xba, 0x4c, 0xc5, 0x54, 0xe5, 0xfe, 0x88, 0xd4, 0xf7, 0x71, 0x71, 0xb4, 0xd7, 0xd9,
-	0xfb, 0xfe, 0x6b, 0x80, 0xa9, 0x7e, 0xc2, 0x4f, 0x01, 0xa8, 0xb7, 0x5c, 0x85, 0x64, 0x45, 0x43,
-	0xbe, 0xed, 0x83, 0x11, 0x07, 0xec, 0x70, 0x1a, 0x7b, 0x21, 0xda, 0xf3, 0xa2, 0x04, 0x39, 0xf9,
-	0x7a, 0x4b, 0x85, 0x61, 0x70, 0x05, 0xe4, 0xaa, 0x11, 0xf5, 0xeb, 0xf2, 0x72, 0xa3, 0xcb, 0x63,
-	0x5d, 0xe0, 0x1c, 0x05, 0x17, 0x59, 0xa9, 0x21, 0x1c, 0xd6, 0xb8, 0xbc, 0x7a, 0xd6, 0xd1, 0x12,
-	0x2c, 0x81, 0xc9, 0x18, 0xb5, 0x30, 0xc3, 0x94, 0xc8, 0x6b, 0x67, 0x9d, 0x9e, 0x0c, 0x1f, 0x01,
-	0xe8, 0x45, 0x11, 0x6d, 0xbb, 0xf5, 0x96, 0xeb, 0x7b, 0x51, 0x54, 0xf5, 0xfc, 0x3a, 0x93, 0x25,
-	0x30, 0xe9, 0xdc, 0x90, 0x96, 0xcd, 0xd6, 0x67, 0xa9, 0xde, 0x3c, 0x30, 0x40, 0xa1, 0x9f, 0x35,
-	0x7c, 0x07, 0x4c, 0x33, 0x25, 0xbb, 0xcd, 0x18, 0xed, 0xe3, 0x8e, 0xae, 0xf5, 0xeb, 0x5a, 0xbb,
-	0x2d, 0x95, 0xf0, 0x06, 0x18, 0xaf, 0xa3, 0xae, 0xbc, 0x4f, 0xc1, 0x11, 0x9f, 0x70, 0x16, 0xe4,
-	0x5a, 0x22, 0x82, 0xa4, 0x5a, 0x70, 0x94, 0x00, 0x97, 0x40, 0x6e, 0x5b, 0x34, 0x99, 0x7e, 0x9d,
-	0xdb, 0xd6, 0x69, 0x13, 0x5a, 0xaa, 0x09, 0x2d, 0x69, 0xff, 0xaa, 0xc9, 0x1c, 0x85, 0x34, 0x7f,
-	0x36, 0x40, 0x4e, 0x66, 0x01, 0x7e, 0x0a, 0x6e, 0x12, 0xd4, 0xe1, 0xae, 0x4c, 0x86, 0x5b, 0x43,
-	0x9e, 0xa8, 0x0f, 0x43, 0x06, 0x9a, 0xb5, 0xd4, 0x58, 0xb1, 0xd2, 0xb1, 0x62, 0xad, 0x91, 0xae,
-	0x33, 0x23, 0xe0, 0xd2, 0xf7, 0x4b, 0x09, 0x86, 0x8f, 0x44, 0x02, 0xbd, 0xb4, 0xac, 0x86, 0xb9,
-	0x69, 0x0c, 0xac, 0x80, 0x0c, 0xef, 0x48, 0xfe, 0x53, 0x15, 0x73, 0xc4, 0x1b, 0xed, 0x76, 0xd4,
-	0x0b, 0x67, 0x78, 0xc7, 0xfc, 0xcb, 0x00, 0xd7, 0xb4, 0x0c, 0x3f, 0x10, 0xcf, 0xa2, 0x9a, 0x42,
-	0xd3, 0xbc, 0xd3, 0x7f, 0x5f, 0x31, 0x91, 0xac, 0x2f, 0x3a, 0xc8, 0xdf, 0xed, 0xe8, 0x22, 0xec,
-	0xc1, 0xe1, 0x27, 0x60, 0x3a, 0x40, 0x11, 0x6e, 0x89, 0xee, 0x90, 0x53, 0x49, 0x13, 0x2e, 0x0e,
-	0x4b, 0x98, 0x73, 0x3d, 0xc5, 0x4b, 0x11, 0xae, 0x81, 0x19, 0x4c, 0xfc, 0x28, 0x11, 0x35, 0xa0,
-	0x23, 0x8c, 0x5f, 0x10, 0x61, 0xba, 0xe7, 0xa0, 0x42, 0x40, 0x90, 0x0d, 0x3c, 0xee, 0xc9, 0xa7,
-	0x2a, 0x38, 0xf2, 0xdb, 0x2c, 0x83, 0xb7, 0xce, 0x6b, 0xe5, 0xb4, 0xf7, 0x4d, 0x0f, 0xdc, 0x95,
-	0x13, 0xa2, 0x41, 0x5b, 0x68, 0x60, 0x3e, 0x3c, 0x4f, 0x10, 0xbb, 0x4a, 0xd7, 0x9f, 0x6d, 0x4a,
-	0x13, 0x2c, 0x0c, 0x3f, 0x42, 0xd3, 0x78, 0x91, 0x91, 0x3c, 0x9e, 0xc9, 0x09, 0x77, 0x79, 0x1e,
-	0x4f, 0xc0, 0x24, 0x41, 0x6d, 0xf7, 0x52, 0x13, 0xfc, 0x1a, 0x41, 0xed, 0x4d, 0x31, 0xc4, 0x17,
-	0x45, 0x95, 0xb6, 0xdd, 0xb3, 0x23, 0x57, 0xf5, 0xeb, 0x0c, 0x41, 0xed, 0x67, 0xfd, 0x53, 0x77,
-	0x05, 0xcc, 0x09, 0xec, 0x79, 0x43, 0x5f, 0x4d, 0xf2, 0x5b, 0x04, 0xb5, 0x77, 0x07, 0xe7, 0xfe,
-	0x69, 0xa2, 0x72, 0x17, 0x25, 0x6a, 0x48, 0x0e, 0x74, 0xa2, 0x7e, 0x37, 0xc0, 0x4c, 0x0f, 0xb4,
-	0x2d, 0x77, 0x27, 0x5c, 0x01, 0x79, 0x2f, 0xe1, 0x35, 0x1a, 0x63, 0xde, 0x55, 0xdd, 0xbe, 0x5e,
-	0xfc, 0xe3, 0xd7, 0xc7, 0xb3, 0x7a, 0xb9, 0xaf, 0x05, 0x41, 0x8c, 0x18, 0xdb, 0xe1, 0x31, 0x26,
-	0xa1, 0x73, 0x0a, 0x85, 0x9f, 0x83, 0x09, 0xb5, 0x7d, 0x75, 0xad, 0xde, 0x1b, 0x91, 0x33, 0x75,
-	0xd4, 0x7a, 0xfe, 0xe5, 0xdf, 0x77, 0xc7, 0x7e, 0x3a, 0x39, 0x5c, 0x34, 0x1c, 0xed, 0xbb, 0xba,
-	0x2c, 0xae, 0x70, 0x1a, 0xf5, 0xfb, 0x93, 0xc3, 0xc5, 0x7b, 0x83, 0x6b, 0xfe, 0x35, 0xce, 0xe6,
-	0x3c, 0x98, 0x7b, 0x4d, 0x95, 0x5e, 0xb1, 0xf2, 0x4b, 0x0e, 0x8c, 0x6f, 0xb1, 0x10, 0xfe, 0x60,
-	0x80, 0xb9, 0x61, 0xdb, 0xfc, 0xbd, 0x11, 0x54, 0x87, 0x6f, 0xbc, 0xd2, 0x47, 0x57, 0x72, 0xeb,
-	0x2d, 0xca, 0x6f, 0xc1, 0xcd, 0xc1, 0xa5, 0x68, 0x8f, 0x8e, 0x39, 0xe0, 0x50, 0x7a, 0xff, 0x92,
-	0x0e, 0xbd, 0xe3, 0x0f, 0x0c, 0x70, 0xeb, 0xdc, 0x36, 0x82, 0xab, 0x17, 0xdd, 0x6b, 0x78, 0x7b,
-	0x97, 0x9e, 0x5c, 0xc9, 0xb7, 0x8f, 0xd2, 0xb9, 0x05, 0x7b, 0x11, 0xa5, 0x51, 0x9d, 0x7e, 0x11,
-	0xa5, 0x91, 0x1d, 0x02, 0x09, 0x28, 0x9c, 0xe9, 0x8e, 0xc5, 0x37, 0x09, 0xa6, 0xb0, 0xa5, 0xca,
-	0x9b, 0x63, 0xd3, 0xf3, 0x4a, 0xb9, 0xef, 0x44, 0x3b, 0xac, 0xef, 0xbd, 0x3c, 0x2a, 0x1b, 0xaf,
-	0x8e, 0xca, 0xc6, 0x3f, 0x47, 0x65, 0xe3, 0xe0, 0xb8, 0x3c, 0xf6, 0xea, 0xb8, 0x3c, 0xf6, 0xe7,
-	0x71, 0x79, 0xec, 0x9b, 0x0f, 0x43, 0xcc, 0x6b, 0x49, 0xd5, 0xf2, 0x69, 0xc3, 0xd6, 0xe1, 0x1f,
-	0xd3, 0x38, 0x4c, 0xbf, 0xed, 0xd6, 0xd2, 0x92, 0xdd, 0x39, 0xef, 0x97, 0xbf, 0xf8, 0xed, 0x5b,
-	0x9d, 0x90, 0x9b, 0xee, 0xdd, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x62, 0x46, 0x83, 0x23,
-	0x0c, 0x00, 0x00,
+	// 1509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcb, 0x6f, 0x1b, 0x45,
+	0x18, 0xcf, 0xfa, 0x91, 0x38, 0x5f, 0x9c, 0xa4, 0x19, 0x52, 0xe2, 0xb8, 0x8d, 0x93, 0x1a, 0xd1,
+	0x46, 0x51, 0xb3, 0xdb, 0xa4, 0x0f, 0xa0, 0xe5, 0xd5, 0x94, 0x16, 0xa2, 0x28, 0x22, 0x6c, 0xd2,
+	0x1e, 0xb8, 0xac, 0xd6, 0xbb, 0x93, 0xcd, 0xca, 0xf6, 0xac, 0xbb, 0x33, 0xeb, 0xc7, 0x01, 0x54,
+	0x71, 0xe4, 0x42, 0xff, 0x09, 0x24, 0x04, 0x07, 0x7a, 0x40, 0x42, 0xfc, 0x01, 0x48, 0x3d, 0x56,
+	0x9c, 0x38, 0xa0, 0x82, 0xda, 0x43, 0x8f, 0xfc, 0x03, 0x1c, 0xd0, 0x3c, 0xd6, 0xb1, 0xe3, 0x47,
+	0x9b, 0xa8, 0x97, 0xc6, 0x33, 0xf3, 0xfb, 0xbe, 0xf9, 0x9e, 0xbf, 0xf9, 0xb6, 0x50, 0x24, 0x38,
+	0x62, 0x61, 0x40, 0x0c, 0x9f, 0x30, 0x1c, 0x3a, 0x07, 0xb6, 0x4f, 0xee, 0x47, 0x38, 0xf4, 0x31,
+	0x35, 0x58, 0x53, 0xaf, 0x85, 0x01, 0x0b, 0xd0, 0xbc, 0xc2, 0xe8, 0x3d, 0x98, 0xfc, 0x8c, 0x5d,
+	0xf5, 0x49, 0x60, 0x88, 0x7f, 0x25, 0x3a, 0x5f, 0x70, 0x02, 0x5a, 0x0d, 0xa8, 0x51, 0xb2, 0x29,
+	0x36, 0xea, 0x6b, 0x25, 0xcc, 0xec, 0x35, 0xc3, 0x09, 0x7c, 0xa2, 0xce, 0xe7, 0xd4, 0x79, 0x95,
+	0x7a, 0x46, 0x7d, 0x8d, 0xff, 0x51, 0x07, 0xf3, 0xf2, 0xc0, 0x12, 0x2b, 0x43, 0x2e, 0xd4, 0xd1,
+	0xac, 0x17, 0x78, 0x81, 0xdc, 0xe7, 0xbf, 0x62, 0x01, 0x2f, 0x08, 0xbc, 0x0a, 0x36, 0xc4, 0xaa,
+	0x14, 0xed, 0x1b, 0x36, 0x69, 0xa9, 0xa3, 0x0b, 0x83, 0xdd, 0xf2, 0x30, 0xc1, 0xd4, 0x8f, 0x35,
+	0x9f, 0x1f, 0x0c, 0xac, 0xd9, 0xa1, 0x5d, 0x8d, 0x71, 0x67, 0x18, 0x26, 0x2e, 0x0e, 0xab, 0x3e,
+	0x61, 0x86, 0x5d, 0x72, 0x7c, 0x83, 0xb5, 0x6a, 0x38, 0x3e, 0x5c, 0xe8, 0x38, 0x74, 0xc2, 0x56,
+	0x8d, 0x05, 0xdc, 0xa6, 0x60, 0x5f, 0x1e, 0x17, 0xff, 0x4b, 0x42, 0x7e, 0x9b, 0x7a, 0x26, 0xf6,
+	0x7c, 0xca, 0x70, 0xb8, 0xd9, 0xbe, 0xe9, 0x8b, 0x08, 0x87, 0x2d, 0xb4, 0x00, 0xc0, 0xaf, 0x6c,
+	0x59, 0x5c, 0x65, 0x4e, 0x5b, 0xd2, 0x96, 0xc7, 0xcd, 0x71, 0xb1, 0xb3, 0xd7, 0xaa, 0x61, 0x74,
+	0x05, 0x52, 0x65, 0xdc, 0xa2, 0xb9, 0xc4, 0x52, 0x72, 0x79, 0x62, 0x7d, 0x49, 0x1f, 0x98, 0x0c,
+	0x7d, 0xeb, 0xde, 0x16, 0x6e, 0x99, 0x02, 0x8d, 0x0c, 0x78, 0x83, 0x85, 0x36, 0xa1, 0xb6, 0xc3,
+	0xfc, 0x80, 0x50, 0x6b, 0xdf, 0xaf, 0x30, 0x1c, 0xe6, 0x92, 0x42, 0x3b, 0xea, 0x3c, 0xba, 0x23,
+	0x4e, 0xd0, 0x5b, 0x30, 0xe9, 0x04, 0x84, 0x60, 0xb1, 0x69, 0xf9, 0x6e, 0x2e, 0x25, 0xa0, 0xd9,
+	0xc3, 0xcd, 0x4d, 0x97, 0x83, 0xa2, 0x9a, 0x6b, 0x33, 0x6c, 0xd5, 0x70, 0xe8, 0x07, 0x6e, 0x2e,
+	0xbd, 0xa4, 0x2d, 0xa7, 0xcc, 0xac, 0xdc, 0xdc, 0x11, 0x7b, 0xe8, 0x4d, 0x18, 0xa5, 0x22, 0x1e,
+	0xb9, 0x51, 0xa1, 0x42, 0xad, 0x50, 0x03, 0x66, 0x42, 0xdc, 0xb0, 0x43, 0x97, 0x0b, 0x5b, 0x52,
+	0x24, 0x37, 0x26, 0xbc, 0x9a, 0xd7, 0x55, 0xba, 0x79, 0xd1, 0xe8, 0xaa, 0x68, 0xf4, 0x5b, 0x81,
+	0x4f, 0x36, 0x2e, 0x3d, 0x7e, 0xba, 0x38, 0xf2, 0xe3, 0xdf, 0x8b, 0xcb, 0x9e, 0xcf, 0x0e, 0xa2,
+	0x92, 0xee, 0x04, 0x55, 0x55, 0x1b, 0xea, 0xcf, 0x2a, 0x75, 0xcb, 0x2a, 0x1b, 0x5c, 0x80, 0x9a,
+	0xd3, 0xf2, 0x96, 0x1d, 0x1c, 0xde, 0x15, 0x77, 0xa0, 0x1a, 0x4c, 0xaa, 0x8b, 0x31, 0x75, 0xc2,
+	0xa0, 0x91, 0xcb, 0xbc, 0xfe, 0x4b, 0xb3, 0xf2, 0x86, 0xdb, 0xe2, 0x82, 0xeb, 0x13, 0xdf, 0xbc,
+	0x78, 0xb4, 0xa2, 0xfc, 0x2e, 0x5e, 0x81, 0xe2, 0xe0, 0xec, 0x9b, 0x98, 0xd6, 0x02, 0x42, 0x31,
+	0x9a, 0x82, 0x84, 0xef, 0x8a, 0xec, 0xa7, 0xcc, 0x84, 0xef, 0x16, 0x7f, 0xd5, 0x60, 0x76, 0x9b,
+	0x7a, 0xbb, 0x51, 0xa9, 0xea, 0xb3, 0x18, 0x1a, 0x55, 0x18, 0x9a, 0x87, 0x8c, 0x2c, 0x97, 0x36,
+	0x7c, 0x4c, 0xac, 0x37, 0x3b, 0x23, 0x9f, 0xe8, 0x8a, 0xfc, 0x22, 0x8c, 0x3b, 0x15, 0x1f, 0x13,
+	0xc6, 0x65, 0x44, 0x09, 0x6c, 0x24, 0x72, 0x9a, 0x99, 0x91, 0x9b, 0x9b, 0x2e, 0xfa, 0x10, 0x46,
+	0x43, 0xa1, 0x5d, 0x64, 0x7d, 0x62, 0xfd, 0xfc, 0x90, 0x2a, 0xeb, 0xb0, 0xc5, 0x54, 0x52, 0xdd,
+	0xfe, 0xfe, 0xab, 0xc1, 0x44, 0xa7, 0xc1, 0x77, 0x00, 0xca, 0x75, 0x4b, 0x22, 0x69, 0x4e, 0x13,
+	0xb1, 0xbf, 0x30, 0xe4, 0x82, 0x5d, 0x16, 0x84, 0xb6, 0x87, 0xef, 0xd9, 0x95, 0x08, 0x9b, 0xe3,
+	0xe5, 0xba, 0x54, 0x43, 0xd1, 0x35, 0x48, 0x97, 0x2a, 0x81, 0x53, 0x16, 0xce, 0x0d, 0xef, 0x84,
+	0x0d, 0x8e, 0x33, 0x25, 0x9c, 0x47, 0xe5, 0x00, 0xfb, 0xde, 0x01, 0x13, 0xae, 0xa7, 0x4c, 0xb5,
+	0x42, 0x79, 0xc8, 0x84, 0xb8, 0xee, 0x53, 0x3f, 0x20, 0xc2, 0xed, 0x94, 0xd9, 0x5e, 0xa3, 0x8b,
+	0x80, 0xec, 0x4a, 0x25, 0x68, 0x58, 0xe5, 0xba, 0xe5, 0xd8, 0x95, 0x4a, 0xc9, 0x76, 0xca, 0x54,
+	0x54, 0x7b, 0xc6, 0x3c, 0x25, 0x4e, 0xb6, 0xea, 0xb7, 0xe2, 0xfd, 0xe2, 0x43, 0x0d, 0xb2, 0x9d,
+	0x56, 0xa3, 0xb7, 0x61, 0x8a, 0xca, 0xb5, 0x55, 0x0b, 0xf1, 0xbe, 0xdf, 0x54, 0x6d, 0x3d, 0xa9,
+	0x76, 0x77, 0xc4, 0x26, 0x3a, 0x05, 0xc9, 0x32, 0x6e, 0x09, 0x7f, 0xb2, 0x26, 0xff, 0x89, 0x66,
+	0x21, 0x5d, 0xe7, 0x1a, 0x84, 0xa9, 0x59, 0x53, 0x2e, 0xd0, 0x1a, 0xa4, 0x77, 0x38, 0x9f, 0xa8,
+	0xec, 0x9c, 0xd1, 0x0f, 0xf9, 0x46, 0x97, 0x7c, 0xa3, 0x8b, 0xf3, 0xcf, 0x6b, 0xd4, 0x94, 0xc8,
+	0xe2, 0x4f, 0x1a, 0xa4, 0x45, 0x14, 0xd0, 0xc7, 0x30, 0x43, 0x70, 0x93, 0x59, 0x22, 0x18, 0xd6,
+	0x01, 0xb6, 0x79, 0x7d, 0x68, 0x42, 0xd1, 0xac, 0x2e, 0x19, 0x54, 0x8f, 0x19, 0x54, 0xbf, 0x49,
+	0x5a, 0xe6, 0x34, 0x87, 0x0b, 0xd9, 0xcf, 0x04, 0x18, 0x5d, 0xe4, 0x01, 0xb4, 0xe3, 0xb2, 0x1a,
+	0x24, 0xa6, 0x30, 0x68, 0x1d, 0x12, 0xac, 0x29, 0xec, 0x9f, 0x58, 0x2f, 0x0e, 0xc9, 0xd1, 0x5e,
+	0x53, 0x66, 0x38, 0xc1, 0x9a, 0xc5, 0xbf, 0x34, 0x18, 0x53, 0x6b, 0xf4, 0x1e, 0x4f, 0x8b, 0x6c,
+	0x0a, 0x65, 0xe6, 0x42, 0xa7, 0xbf, 0x9c, 0x7c, 0xf5, 0xdb, 0x4d, 0xec, 0xec, 0x35, 0x55, 0x11,
+	0xb6, 0xe1, 0xe8, 0x23, 0x98, 0x72, 0x71, 0xc5, 0xaf, 0xf3, 0xee, 0x10, 0x04, 0xac, 0x0c, 0xce,
+	0x0d, 0x0a, 0x98, 0x39, 0x19, 0xe3, 0xc5, 0x12, 0xdd, 0x84, 0x69, 0x9f, 0x38, 0x95, 0x88, 0xd7,
+	0x80, 0xd2, 0x90, 0x7c, 0x89, 0x86, 0xa9, 0xb6, 0x80, 0x54, 0x81, 0x20, 0xe5, 0xda, 0xcc, 0x16,
+	0xa9, 0xca, 0x9a, 0xe2, 0x77, 0xb1, 0x00, 0x67, 0xfb, 0xb5, 0x72, 0xdc, 0xfb, 0xc5, 0xa7, 0x1a,
+	0x9c, 0xee, 0x07, 0xa0, 0x1d, 0x1d, 0xad, 0x75, 0x75, 0x74, 0x0f, 0x5b, 0x27, 0xfa, 0xb0, 0xf5,
+	0x49, 0x0a, 0xff, 0x53, 0x18, 0x8b, 0x3b, 0x35, 0x2d, 0x3a, 0x75, 0x75, 0x58, 0x9b, 0xd9, 0xcc,
+	0x39, 0xc0, 0x6e, 0xa7, 0x4b, 0xb1, 0x74, 0x37, 0x25, 0x7c, 0xaf, 0x01, 0xea, 0x05, 0x0f, 0xa3,
+	0xb2, 0x6e, 0xd2, 0x48, 0x9c, 0x98, 0x34, 0xfa, 0x37, 0x72, 0x72, 0x40, 0x23, 0xd7, 0x61, 0xa1,
+	0x6f, 0x1e, 0xda, 0x2c, 0x7d, 0x17, 0x32, 0x94, 0xd9, 0x2c, 0xa2, 0x38, 0x66, 0xb2, 0xcb, 0xc7,
+	0x8a, 0xcf, 0xae, 0x10, 0xde, 0x48, 0xf1, 0xf7, 0xc5, 0x6c, 0xab, 0x2a, 0x62, 0xc8, 0x0d, 0xc2,
+	0x0e, 0x0b, 0x52, 0x0e, 0xc6, 0x68, 0xe4, 0x38, 0x98, 0x52, 0x91, 0xff, 0x8c, 0x19, 0x2f, 0x39,
+	0x8f, 0xe0, 0x30, 0x0c, 0xe2, 0x07, 0x5f, 0x2e, 0x8a, 0x36, 0x2c, 0x8a, 0x97, 0xa8, 0x1a, 0xd4,
+	0x71, 0xcf, 0x3b, 0x74, 0x3f, 0xc2, 0xf4, 0x24, 0xaf, 0x4b, 0x77, 0xa6, 0x8b, 0xb0, 0x34, 0xf8,
+	0x0a, 0x55, 0xee, 0xbf, 0x25, 0x85, 0x1d, 0xf2, 0x75, 0x3e, 0xbe, 0x1d, 0x37, 0x20, 0x43, 0x70,
+	0xc3, 0x3a, 0xd6, 0x50, 0x34, 0x46, 0x70, 0x63, 0x8b, 0xcf, 0x45, 0x2b, 0x9c, 0x0d, 0x1b, 0x56,
+	0xf7, 0x14, 0x23, 0xdb, 0x63, 0x9a, 0xe0, 0xc6, 0xdd, 0xce, 0x41, 0xe6, 0x1a, 0xcc, 0x71, 0x6c,
+	0xbf, 0x39, 0x4a, 0x0e, 0x47, 0xa7, 0x09, 0x6e, 0xec, 0xf5, 0x8e, 0x52, 0x87, 0x81, 0x4a, 0x77,
+	0x35, 0xed, 0xd7, 0xc0, 0x05, 0xac, 0xde, 0x21, 0x68, 0xf4, 0xf5, 0xcf, 0x23, 0x88, 0xe0, 0x86,
+	0x79, 0x64, 0x0e, 0xba, 0x0a, 0x73, 0x4e, 0x05, 0xdb, 0xa1, 0xd5, 0x6f, 0x0c, 0xe3, 0xe5, 0x33,
+	0x2b, 0x8e, 0x8f, 0x88, 0xf5, 0xcb, 0xef, 0x80, 0xd4, 0xc5, 0xf9, 0xd5, 0x00, 0x6d, 0x53, 0xef,
+	0x4e, 0x44, 0xe2, 0x72, 0xe6, 0x0a, 0x87, 0xa5, 0xd4, 0x81, 0x51, 0xbb, 0x1a, 0x44, 0x84, 0xe5,
+	0x12, 0xaf, 0x3f, 0x14, 0x4a, 0x75, 0x47, 0x5a, 0x92, 0x83, 0xeb, 0xf7, 0x2c, 0xe4, 0x7b, 0x4d,
+	0x6f, 0x7b, 0xf6, 0xbb, 0x06, 0xd3, 0x6d, 0xf7, 0x77, 0xc4, 0xf7, 0x01, 0xba, 0x06, 0xe3, 0x76,
+	0xc4, 0x0e, 0x82, 0xd0, 0x67, 0x2d, 0xc9, 0xd2, 0x1b, 0xb9, 0x3f, 0x7e, 0x59, 0x9d, 0x55, 0x1e,
+	0xdc, 0x74, 0xdd, 0x10, 0x53, 0xba, 0xcb, 0x42, 0x9f, 0x78, 0xe6, 0x21, 0x14, 0x7d, 0x02, 0xa3,
+	0xf2, 0x0b, 0x43, 0x3d, 0x52, 0xe7, 0x86, 0x14, 0xb1, 0xbc, 0x6a, 0x63, 0x9c, 0xfb, 0xfe, 0xc3,
+	0x8b, 0x47, 0x2b, 0x9a, 0xa9, 0x64, 0xaf, 0x5f, 0xe1, 0xc6, 0x1f, 0x6a, 0xfd, 0xf6, 0xc5, 0xa3,
+	0x95, 0x73, 0xbd, 0x9f, 0x32, 0x47, 0x6c, 0x2e, 0xce, 0xc3, 0xdc, 0x91, 0xad, 0xd8, 0xc5, 0xf5,
+	0x9f, 0xc7, 0x20, 0xb9, 0x4d, 0x3d, 0xf4, 0x9d, 0x06, 0x73, 0x83, 0xbe, 0x58, 0xae, 0x0e, 0x31,
+	0x75, 0xf0, 0xa8, 0x9b, 0xff, 0xe0, 0x44, 0x62, 0x6d, 0xee, 0xfd, 0x0a, 0x66, 0x7a, 0xa7, 0x61,
+	0x63, 0xb8, 0xce, 0x1e, 0x81, 0xfc, 0x3b, 0xc7, 0x14, 0x68, 0x5f, 0xff, 0x40, 0x03, 0xd4, 0xe7,
+	0x85, 0xbe, 0x74, 0x4c, 0x7d, 0x34, 0xff, 0xee, 0x71, 0x25, 0xda, 0x26, 0x3c, 0xd4, 0xe0, 0x74,
+	0x5f, 0x6a, 0x45, 0xd7, 0x5f, 0x16, 0xda, 0xc1, 0x94, 0x9f, 0xbf, 0x71, 0x22, 0xd9, 0x0e, 0x93,
+	0xfa, 0xb2, 0xc1, 0xcb, 0x4c, 0x1a, 0xc6, 0xfe, 0xf9, 0x1b, 0x27, 0x92, 0x55, 0x26, 0x35, 0x60,
+	0xfa, 0x28, 0xf5, 0xac, 0x0e, 0xd7, 0x77, 0x04, 0x9e, 0xbf, 0x7a, 0x2c, 0x78, 0xfb, 0x62, 0x02,
+	0xd9, 0x2e, 0x66, 0x58, 0x79, 0x15, 0x2f, 0x24, 0x36, 0xbf, 0xfe, 0xea, 0xd8, 0xf8, 0xbe, 0x7c,
+	0xfa, 0x01, 0xa7, 0x82, 0x8d, 0x7b, 0x8f, 0x9f, 0x15, 0xb4, 0x27, 0xcf, 0x0a, 0xda, 0x3f, 0xcf,
+	0x0a, 0xda, 0xc3, 0xe7, 0x85, 0x91, 0x27, 0xcf, 0x0b, 0x23, 0x7f, 0x3e, 0x2f, 0x8c, 0x7c, 0xf9,
+	0x7e, 0x07, 0x47, 0x2a, 0xf5, 0xab, 0x41, 0xe8, 0xc5, 0xbf, 0x8d, 0xfa, 0xda, 0x9a, 0xd1, 0xec,
+	0xf7, 0x3f, 0x3f, 0x9c, 0x3d, 0x4b, 0xa3, 0x62, 0xbc, 0xbf, 0xfc, 0xff, 0x00, 0xc6, 0xbd, 0xdb,
+	0x9b, 0x23, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1032,6 +1459,11 @@ type MsgClient interface {
 	// involve forwarding the result to the smart contract that owns the query for processing, which
 	// could require significant gas usage.
 	SubmitQueryResult(ctx context.Context, in *MsgSubmitQueryResult, opts ...grpc.CallOption) (*MsgSubmitQueryResultResponse, error)
+	// Submits results of multiple KV Interchain Queries sharing the same IBC connection and remote
+	// chain height. The proofs of all results are verified against a single consensus state. Each
+	// result is processed independently: a failure to process one of them doesn't affect the others,
+	// and the status of each result is reported in the response.
+	SubmitQueryResults(ctx context.Context, in *MsgSubmitQueryResults, opts ...grpc.CallOption) (*MsgSubmitQueryResultsResponse, error)
 	// Removes a specific Interchain Query and its results from the module. The query can only be
 	// removed by its owner during the query's submit timeout. After the timeout, anyone can remove
 	// it. Upon successful removal, the query deposit is refunded to the caller.
@@ -1039,6 +1471,10 @@ type MsgClient interface {
 	// Updates the parameters of a registered Interchain Query. This action can only be performed by
 	// the query's owner.
 	UpdateInterchainQuery(ctx context.Context, in *MsgUpdateInterchainQueryRequest, opts ...grpc.CallOption) (*MsgUpdateInterchainQueryResponse, error)
+	// Tops up the reward escrow of a registered Interchain Query. The escrow is used to pay relayers
+	// the query's reward per update on each successful query result submission. Anyone can fund the
+	// escrow, but the remaining escrow is paid back to the query owner on the query removal.
+	FundQueryReward(ctx context.Context, in *MsgFundQueryReward, opts ...grpc.CallOption) (*MsgFundQueryRewardResponse, error)
 	// Updates the parameters of the `interchainqueries` module. This action can only be performed
 	// by the module's authority.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -1070,9 +1506,18 @@ func (c *msgClient) SubmitQueryResult(ctx context.Context, in *MsgSubmitQueryRes
 	return out, nil
 }
 
-func (c *msgClient) RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQueryRequest, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error) {
-	out := new(MsgRemoveInterchainQueryResponse)
-	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Msg/RemoveInterchainQuery", in, out, opts...)
+func (c *msgClient) SubmitQueryResults(ctx context.Context, in *MsgSubmitQueryResults, opts ...grpc.CallOption) (*MsgSubmitQueryResultsResponse, error) {
+	out := new(MsgSubmitQueryResultsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Msg/SubmitQueryResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveInterchainQuery(ctx context.Context, in *MsgRemoveInterchainQueryRequest, opts ...grpc.CallOption) (*MsgRemoveInterchainQueryResponse, error) {
+	out := new(MsgRemoveInterchainQueryResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Msg/RemoveInterchainQuery", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1088,6 +1533,15 @@ func (c *msgClient) UpdateInterchainQuery(ctx context.Context, in *MsgUpdateInte
 	return out, nil
 }
 
+func (c *msgClient) FundQueryReward(ctx context.Context, in *MsgFundQueryReward, opts ...grpc.CallOption) (*MsgFundQueryRewardResponse, error) {
+	out := new(MsgFundQueryRewardResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Msg/FundQueryReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/neutron.interchainqueries.Msg/UpdateParams", in, out, opts...)
@@ -1112,6 +1566,11 @@ type MsgServer interface {
 	// involve forwarding the result to the smart contract that owns the query for processing, which
 	// could require significant gas usage.
 	SubmitQueryResult(context.Context, *MsgSubmitQueryResult) (*MsgSubmitQueryResultResponse, error)
+	// Submits results of multiple KV Interchain Queries sharing the same IBC connection and remote
+	// chain height. The proofs of all results are verified against a single consensus state. Each
+	// result is processed independently: a failure to process one of them doesn't affect the others,
+	// and the status of each result is reported in the response.
+	SubmitQueryResults(context.Context, *MsgSubmitQueryResults) (*MsgSubmitQueryResultsResponse, error)
 	// Removes a specific Interchain Query and its results from the module. The query can only be
 	// removed by its owner during the query's submit timeout. After the timeout, anyone can remove
 	// it. Upon successful removal, the query deposit is refunded to the caller.
@@ -1119,6 +1578,10 @@ type MsgServer interface {
 	// Updates the parameters of a registered Interchain Query. This action can only be performed by
 	// the query's owner.
 	UpdateInterchainQuery(context.Context, *MsgUpdateInterchainQueryRequest) (*MsgUpdateInterchainQueryResponse, error)
+	// Tops up the reward escrow of a registered Interchain Query. The escrow is used to pay relayers
+	// the query's reward per update on each successful query result submission. Anyone can fund the
+	// escrow, but the remaining escrow is paid back to the query owner on the query removal.
+	FundQueryReward(context.Context, *MsgFundQueryReward) (*MsgFundQueryRewardResponse, error)
 	// Updates the parameters of the `interchainqueries` module. This action can only be performed
 	// by the module's authority.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -1134,12 +1597,18 @@ func (*UnimplementedMsgServer) RegisterInterchainQuery(ctx context.Context, req
 func (*UnimplementedMsgServer) SubmitQueryResult(ctx context.Context, req *MsgSubmitQueryResult) (*MsgSubmitQueryResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResult not implemented")
 }
+func (*UnimplementedMsgServer) SubmitQueryResults(ctx context.Context, req *MsgSubmitQueryResults) (*MsgSubmitQueryResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQueryResults not implemented")
+}
 func (*UnimplementedMsgServer) RemoveInterchainQuery(ctx context.Context, req *MsgRemoveInterchainQueryRequest) (*MsgRemoveInterchainQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveInterchainQuery not implemented")
 }
 func (*UnimplementedMsgServer) UpdateInterchainQuery(ctx context.Context, req *MsgUpdateInterchainQueryRequest) (*MsgUpdateInterchainQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterchainQuery not implemented")
 }
+func (*UnimplementedMsgServer) FundQueryReward(ctx context.Context, req *MsgFundQueryReward) (*MsgFundQueryRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundQueryReward not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1184,6 +1653,24 @@ func _Msg_SubmitQueryResult_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitQueryResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitQueryResults)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitQueryResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainqueries.Msg/SubmitQueryResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitQueryResults(ctx, req.(*MsgSubmitQueryResults))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_RemoveInterchainQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgRemoveInterchainQueryRequest)
 	if err := dec(in); err != nil {
@@ -1220,6 +1707,24 @@ func _Msg_UpdateInterchainQuery_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FundQueryReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundQueryReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundQueryReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchainqueries.Msg/FundQueryReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundQueryReward(ctx, req.(*MsgFundQueryReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1238,6 +1743,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.interchainqueries.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1250,6 +1756,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitQueryResult",
 			Handler:    _Msg_SubmitQueryResult_Handler,
 		},
+		{
+			MethodName: "SubmitQueryResults",
+			Handler:    _Msg_SubmitQueryResults_Handler,
+		},
 		{
 			MethodName: "RemoveInterchainQuery",
 			Handler:    _Msg_RemoveInterchainQuery_Handler,
@@ -1258,6 +1768,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateInterchainQuery",
 			Handler:    _Msg_UpdateInterchainQuery_Handler,
 		},
+		{
+			MethodName: "FundQueryReward",
+			Handler:    _Msg_FundQueryReward_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1287,6 +1801,34 @@ func (m *MsgRegisterInterchainQuery) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardEscrow) > 0 {
+		for iNdEx := len(m.RewardEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RewardPerUpdate) > 0 {
+		for iNdEx := len(m.RewardPerUpdate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerUpdate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1692,6 +2234,201 @@ func (m *MsgSubmitQueryResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitQueryResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitQueryResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitQueryResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Revision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedQueryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedQueryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedQueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AllowKvCallbacks {
+		i--
+		if m.AllowKvCallbacks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.KvResults) > 0 {
+		for iNdEx := len(m.KvResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KvResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitQueryResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitQueryResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitQueryResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchedQueryResultStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchedQueryResultStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchedQueryResultStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgRemoveInterchainQueryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1770,6 +2507,30 @@ func (m *MsgUpdateInterchainQueryRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if m.ClearRewardPerUpdate {
+		i--
+		if m.ClearRewardPerUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NewRewardPerUpdate) > 0 {
+		for iNdEx := len(m.NewRewardPerUpdate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewRewardPerUpdate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -1834,7 +2595,7 @@ func (m *MsgUpdateInterchainQueryResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgFundQueryReward) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1844,12 +2605,84 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFundQueryReward) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFundQueryReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.QueryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.QueryId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundQueryRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundQueryRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundQueryRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1939,6 +2772,18 @@ func (m *MsgRegisterInterchainQuery) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.RewardPerUpdate) > 0 {
+		for _, e := range m.RewardPerUpdate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RewardEscrow) > 0 {
+		for _, e := range m.RewardEscrow {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2086,6 +2931,90 @@ func (m *MsgSubmitQueryResultResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitQueryResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovTx(uint64(m.Revision))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchedQueryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	if len(m.KvResults) > 0 {
+		for _, e := range m.KvResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.AllowKvCallbacks {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitQueryResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchedQueryResultStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgRemoveInterchainQueryRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2137,6 +3066,15 @@ func (m *MsgUpdateInterchainQueryRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if len(m.NewRewardPerUpdate) > 0 {
+		for _, e := range m.NewRewardPerUpdate {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ClearRewardPerUpdate {
+		n += 2
+	}
 	return n
 }
 
@@ -2149,6 +3087,37 @@ func (m *MsgUpdateInterchainQueryResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFundQueryReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryId != 0 {
+		n += 1 + sovTx(uint64(m.QueryId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFundQueryRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2389,6 +3358,74 @@ func (m *MsgRegisterInterchainQuery) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerUpdate = append(m.RewardPerUpdate, types.Coin{})
+			if err := m.RewardPerUpdate[len(m.RewardPerUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardEscrow = append(m.RewardEscrow, types.Coin{})
+			if err := m.RewardEscrow[len(m.RewardEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3071,7 +4108,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.NextBlockHeader == nil {
-				m.NextBlockHeader = &types.Any{}
+				m.NextBlockHeader = &types1.Any{}
 			}
 			if err := m.NextBlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3107,7 +4144,7 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Header == nil {
-				m.Header = &types.Any{}
+				m.Header = &types1.Any{}
 			}
 			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3229,7 +4266,7 @@ func (m *TxValue) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Response == nil {
-				m.Response = &types1.ExecTxResult{}
+				m.Response = &types2.ExecTxResult{}
 			}
 			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3412,7 +4449,7 @@ func (m *MsgSubmitQueryResultResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitQueryResults) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3435,17 +4472,17 @@ func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitQueryResults: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitQueryResults: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.QueryId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3455,15 +4492,529 @@ func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueryId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, &BatchedQueryResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedQueryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedQueryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedQueryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KvResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KvResults = append(m.KvResults, &StorageValue{})
+			if err := m.KvResults[len(m.KvResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowKvCallbacks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowKvCallbacks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitQueryResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitQueryResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitQueryResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, BatchedQueryResultStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchedQueryResultStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchedQueryResultStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchedQueryResultStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveInterchainQueryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveInterchainQueryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -3728,6 +5279,60 @@ func (m *MsgUpdateInterchainQueryRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRewardPerUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewRewardPerUpdate = append(m.NewRewardPerUpdate, types.Coin{})
+			if err := m.NewRewardPerUpdate[len(m.NewRewardPerUpdate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearRewardPerUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearRewardPerUpdate = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3799,6 +5404,191 @@ func (m *MsgUpdateInterchainQueryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFundQueryReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundQueryReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundQueryReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryId", wireType)
+			}
+			m.QueryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundQueryRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundQueryRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundQueryRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
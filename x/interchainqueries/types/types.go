@@ -35,6 +35,18 @@ const (
 	// of an interchain query.
 	AttributeTransactionsFilterQuery = "tx_filter"
 
+	// AttributeKeyRelayer represents the key for event attribute delivering the address of the
+	// relayer that submitted an interchain query result.
+	AttributeKeyRelayer = "relayer"
+
+	// AttributeKeyReward represents the key for event attribute delivering the amount of coins
+	// paid to a relayer for an interchain query result submission.
+	AttributeKeyReward = "reward"
+
+	// AttributeKeyLateSubmission represents the key for event attribute delivering whether an
+	// interchain query result has been submitted later than the query's update period.
+	AttributeKeyLateSubmission = "late_submission"
+
 	// AttributeValueCategory represents the value for the 'module' event attribute.
 	AttributeValueCategory = ModuleName
 
@@ -43,6 +55,12 @@ const (
 
 	// AttributeValueQueryRemoved represents the value for the 'action' event attribute.
 	AttributeValueQueryRemoved = "query_removed"
+
+	// AttributeValueQueryResultSubmitted represents the value for the 'action' event attribute.
+	AttributeValueQueryResultSubmitted = "query_result_submitted"
+
+	// AttributeValueQueryRewardFunded represents the value for the 'action' event attribute.
+	AttributeValueQueryRewardFunded = "query_reward_funded"
 )
 
 const (
@@ -22,6 +22,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdInterchainAccountCmd())
+	cmd.AddCommand(CmdInterchainAccountStatusCmd())
+	cmd.AddCommand(CmdHostAllowMessagesCmd())
 
 	return cmd
 }
@@ -37,3 +37,61 @@ func CmdInterchainAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+func CmdInterchainAccountStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interchain-account-status [owner-address] [connection-id] [interchain-account-id]",
+		Short: "get the channel status of the interchain account for a specific combination of owner-address, connection-id and interchain-account-id",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterchainAccountStatus(cmd.Context(), &types.QueryInterchainAccountStatusRequest{
+				OwnerAddress:        args[0],
+				ConnectionId:        args[1],
+				InterchainAccountId: args[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHostAllowMessagesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "host-allow-messages [connection-id]",
+		Short: "get the cached allow_messages param of the ICA host module of the chain on the other side of the connection",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.HostAllowMessages(cmd.Context(), &types.QueryHostAllowMessagesRequest{
+				ConnectionId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
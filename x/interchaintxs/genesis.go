@@ -14,12 +14,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		panic(err)
 	}
+
+	for _, channel := range genState.InterchainAccountChannels {
+		k.SetInterchainAccountChannel(ctx, channel)
+	}
+
+	for _, hostAllowMessages := range genState.HostAllowMessages {
+		k.SaveHostAllowMessages(ctx, hostAllowMessages)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.InterchainAccountChannels = k.GetAllInterchainAccountChannels(ctx)
+	genesis.HostAllowMessages = k.GetAllHostAllowMessages(ctx)
 
 	return genesis
 }
@@ -83,13 +83,14 @@ func (im IBCModule) OnChanCloseInit(
 	return nil
 }
 
-// OnChanCloseConfirm implements the IBCModule interface. We don't need to implement this handler.
+// OnChanCloseConfirm implements the IBCModule interface. This handler is called when the counterparty
+// closes the channel of an interchain account.
 func (im IBCModule) OnChanCloseConfirm(
-	_ sdk.Context,
-	_,
-	_ string,
+	ctx sdk.Context,
+	portID,
+	channelID string,
 ) error {
-	return nil
+	return im.keeper.HandleChanCloseConfirm(ctx, portID, channelID)
 }
 
 // OnRecvPacket implements the IBCModule interface. A successful acknowledgement
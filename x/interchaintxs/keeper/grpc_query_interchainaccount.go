@@ -35,3 +35,41 @@ func (k Keeper) InterchainAccountAddress(c context.Context, req *types.QueryInte
 
 	return &types.QueryInterchainAccountAddressResponse{InterchainAccountAddress: addr}, nil
 }
+
+func (k Keeper) InterchainAccountStatus(c context.Context, req *types.QueryInterchainAccountStatusRequest) (*types.QueryInterchainAccountStatusResponse, error) {
+	if req == nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	icaOwner, err := types.NewICAOwner(req.OwnerAddress, req.InterchainAccountId)
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to create ica owner: %s", err)
+	}
+
+	portID, err := icatypes.NewControllerPortID(icaOwner.String())
+	if err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to get controller portID: %s", err)
+	}
+
+	channel, found := k.GetInterchainAccountChannel(ctx, req.ConnectionId, portID)
+	if !found {
+		return nil, errors.Wrapf(types.ErrInterchainAccountNotFound, "no interchain account channel found for portID %s", portID)
+	}
+
+	return &types.QueryInterchainAccountStatusResponse{Channel: channel}, nil
+}
+
+func (k Keeper) HostAllowMessages(c context.Context, req *types.QueryHostAllowMessagesRequest) (*types.QueryHostAllowMessagesResponse, error) {
+	if req == nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	hostAllowMessages, found := k.GetHostAllowMessages(ctx, req.ConnectionId)
+	if !found {
+		return nil, errors.Wrapf(types.ErrHostAllowMessagesNotFound, "no host allow messages found for connection %s", req.ConnectionId)
+	}
+
+	return &types.QueryHostAllowMessagesResponse{HostAllowMessages: hostAllowMessages}, nil
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/interchaintxs/types"
+)
+
+// GetHostAllowMessages returns the cached allow_messages param of the ICA host module of the chain
+// on the other side of the connection.
+func (k Keeper) GetHostAllowMessages(ctx sdk.Context, connectionID string) (types.HostAllowMessages, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHostAllowMessagesKey(connectionID))
+	if bz == nil {
+		return types.HostAllowMessages{}, false
+	}
+
+	var hostAllowMessages types.HostAllowMessages
+	k.Codec.MustUnmarshal(bz, &hostAllowMessages)
+	return hostAllowMessages, true
+}
+
+// SaveHostAllowMessages saves the cached allow_messages param of an ICA host module.
+func (k Keeper) SaveHostAllowMessages(ctx sdk.Context, hostAllowMessages types.HostAllowMessages) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHostAllowMessagesKey(hostAllowMessages.ConnectionId), k.Codec.MustMarshal(&hostAllowMessages))
+}
+
+// DeleteHostAllowMessages removes the cached allow_messages param of the ICA host module of the
+// chain on the other side of the connection.
+func (k Keeper) DeleteHostAllowMessages(ctx sdk.Context, connectionID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHostAllowMessagesKey(connectionID))
+}
+
+// GetAllHostAllowMessages returns the cached allow_messages params of all ICA host modules.
+func (k Keeper) GetAllHostAllowMessages(ctx sdk.Context) []types.HostAllowMessages {
+	var (
+		store  = prefix.NewStore(ctx.KVStore(k.storeKey), types.HostAllowMessagesKey)
+		result []types.HostAllowMessages
+	)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		hostAllowMessages := types.HostAllowMessages{}
+		k.Codec.MustUnmarshal(iterator.Value(), &hostAllowMessages)
+		result = append(result, hostAllowMessages)
+	}
+
+	return result
+}
+
+// checkHostAllowMessages checks the messages against the cached allow_messages param of the ICA
+// host module of the chain on the other side of the connection. A message not allowed on the host
+// chain either fails the check or results in a warning event, depending on the cached record. If
+// there is no record for the connection, the messages aren't checked.
+func (k Keeper) checkHostAllowMessages(ctx sdk.Context, connectionID string, msgs []*codectypes.Any) error {
+	hostAllowMessages, found := k.GetHostAllowMessages(ctx, connectionID)
+	if !found {
+		return nil
+	}
+
+	for _, msg := range msgs {
+		typeURL := ""
+		if msg != nil {
+			typeURL = msg.TypeUrl
+		}
+		if hostAllowMessages.IsAllowed(typeURL) {
+			continue
+		}
+
+		if hostAllowMessages.RejectDisallowed {
+			return errors.Wrapf(types.ErrMessageNotAllowedOnHost, "message %s is not allowed on the host chain of connection %s", typeURL, connectionID)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeMessageNotAllowedOnHost,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyConnectionID, connectionID),
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, typeURL),
+		))
+	}
+
+	return nil
+}
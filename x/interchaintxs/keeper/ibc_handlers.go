@@ -32,13 +32,24 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, _ string, packet channel
 		k.Logger(ctx).Error("HandleAcknowledgement: cannot unmarshal ICS-27 packet acknowledgement", "error", err)
 		return errors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
 	}
-	msg, err := keeper.PrepareSudoCallbackMessage(packet, &ack)
+
+	k.feeKeeper.DistributeAcknowledgementFee(ctx, relayer, feetypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+
+	// packets of a split transaction are passed to the contract all at once by a single callback
+	if k.resolveBatchedPacket(ctx, packet, &ack) {
+		return nil
+	}
+
+	var msgResponses []contractmanagertypes.MsgResponse
+	if ack.Success() {
+		msgResponses = k.decodeMsgResponses(ctx, ack.GetResult())
+	}
+
+	msg, err := keeper.PrepareSudoCallbackMessageWithMsgResponses(packet, &ack, msgResponses)
 	if err != nil {
 		return errors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal Packet/Acknowledgment: %v", err)
 	}
 
-	k.feeKeeper.DistributeAcknowledgementFee(ctx, relayer, feetypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
-
 	// Actually we have only one kind of error returned from acknowledgement
 	// maybe later we'll retrieve actual errors from events
 	_, err = k.sudoKeeper.Sudo(ctx, icaOwner.GetContract(), msg)
@@ -50,7 +61,7 @@ func (k *Keeper) HandleAcknowledgement(ctx sdk.Context, _ string, packet channel
 }
 
 // HandleTimeout passes the timeout data to the appropriate contract via a sudo call.
-// Since all ICA channels are ORDERED, a single timeout shuts down a channel.
+// A single timeout shuts down an ORDERED channel.
 func (k *Keeper) HandleTimeout(ctx sdk.Context, _ string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), LabelHandleTimeout)
 	k.Logger(ctx).Debug("HandleTimeout")
@@ -60,19 +71,21 @@ func (k *Keeper) HandleTimeout(ctx sdk.Context, _ string, packet channeltypes.Pa
 		return errors.Wrap(err, "failed to get ica owner from port")
 	}
 
-	msg, err := keeper.PrepareSudoCallbackMessage(packet, nil)
-	if err != nil {
-		return errors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal Packet: %v", err)
-	}
-
 	k.feeKeeper.DistributeTimeoutFee(ctx, relayer, feetypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
 
-	_, err = k.sudoKeeper.Sudo(ctx, icaOwner.GetContract(), msg)
-	if err != nil {
-		k.Logger(ctx).Debug("HandleTimeout: failed to Sudo contract on packet timeout", "error", err)
+	if !k.resolveBatchedPacket(ctx, packet, nil) {
+		msg, err := keeper.PrepareSudoCallbackMessage(packet, nil)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal Packet: %v", err)
+		}
+
+		_, err = k.sudoKeeper.Sudo(ctx, icaOwner.GetContract(), msg)
+		if err != nil {
+			k.Logger(ctx).Debug("HandleTimeout: failed to Sudo contract on packet timeout", "error", err)
+		}
 	}
 
-	return nil
+	return k.handleInterchainAccountChannelTimeout(ctx, icaOwner, packet.SourcePort, packet.SourceChannel)
 }
 
 // HandleChanOpenAck passes the data about a successfully created channel to the appropriate contract
@@ -95,6 +108,8 @@ func (k *Keeper) HandleChanOpenAck(
 		return errors.Wrap(err, "failed to get ica owner from port")
 	}
 
+	k.markInterchainAccountChannelOpen(ctx, portID, channelID)
+
 	payload, err := keeper.PrepareOpenAckCallbackMessage(contractmanagertypes.OpenAckDetails{
 		PortID:                portID,
 		ChannelID:             channelID,
@@ -112,3 +127,24 @@ func (k *Keeper) HandleChanOpenAck(
 
 	return nil
 }
+
+// HandleChanCloseConfirm marks the interchain account's channel closed by the counterparty as closed,
+// resolves the transaction batches pending on it and notifies the owner contract about the closure.
+func (k *Keeper) HandleChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), LabelHandleChanCloseConfirm)
+
+	k.Logger(ctx).Debug("HandleChanCloseConfirm", "port_id", portID, "channel_id", channelID)
+	icaOwner, err := types.ICAOwnerFromPort(portID)
+	if err != nil {
+		k.Logger(ctx).Error("HandleChanCloseConfirm: failed to get ica owner from port", "error", err)
+		return errors.Wrap(err, "failed to get ica owner from port")
+	}
+
+	channel, found := k.getInterchainAccountChannelByChannelID(ctx, portID, channelID)
+	if !found {
+		k.resolveChannelTxBatches(ctx, portID, channelID)
+		return nil
+	}
+
+	return k.closeInterchainAccountChannel(ctx, icaOwner, channel)
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
@@ -19,6 +21,7 @@ import (
 	mock_types "github.com/neutron-org/neutron/v11/testutil/mocks/interchaintxs/types"
 	"github.com/neutron-org/neutron/v11/x/contractmanager/types"
 	feetypes "github.com/neutron-org/neutron/v11/x/feerefunder/types"
+	ictxtypes "github.com/neutron-org/neutron/v11/x/interchaintxs/types"
 )
 
 const ICAId = ".ica0"
@@ -148,3 +151,303 @@ func TestHandleChanOpenAck(t *testing.T) {
 	err = icak.HandleChanOpenAck(ctx, portID, channelID, counterpartyChannelID, "1")
 	require.NoError(t, err)
 }
+
+func TestHandleAcknowledgementMsgResponses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	feeKeeper := mock_types.NewMockFeeRefunderKeeper(ctrl)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	icak, infCtx := testkeeper.InterchainTxsKeeper(t, wmKeeper, feeKeeper, nil, nil, nil, bankKeeper, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+	ctx := infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
+
+	txMsgData := sdk.TxMsgData{MsgResponses: []*codectypes.Any{
+		{TypeUrl: "/cosmos.bank.v1beta1.MsgSendResponse", Value: []byte{1}},
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegateResponse", Value: []byte{1, 2, 3}},
+	}}
+	result, err := txMsgData.Marshal()
+	require.NoError(t, err)
+
+	resACK := channeltypes.NewResultAcknowledgement(result)
+	resAckData, err := channeltypes.SubModuleCdc.MarshalJSON(&resACK)
+	require.NoError(t, err)
+	p := channeltypes.Packet{
+		Sequence:      100,
+		SourcePort:    icatypes.ControllerPortPrefix + testutil.TestOwnerAddress + ICAId,
+		SourceChannel: "channel-0",
+	}
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	relayerAddress := sdk.MustAccAddressFromBech32("neutron1fxudpred77a0grgh69u0j7y84yks5ev4n5050z45kecz792jnd6scqu98z")
+
+	msgAck, err := keeper.PrepareSudoCallbackMessageWithMsgResponses(p, &resACK, []types.MsgResponse{
+		{TypeURL: "/cosmos.bank.v1beta1.MsgSendResponse", Data: []byte{1}},
+		{TypeURL: "/cosmos.staking.v1beta1.MsgDelegateResponse", Data: []byte{1, 2, 3}},
+	})
+	require.NoError(t, err)
+
+	feeKeeper.EXPECT().DistributeAcknowledgementFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, msgAck)
+	err = icak.HandleAcknowledgement(ctx, "ica-v1", p, resAckData, relayerAddress)
+	require.NoError(t, err)
+}
+
+func TestHandleBatchedPackets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	feeKeeper := mock_types.NewMockFeeRefunderKeeper(ctrl)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	icak, infCtx := testkeeper.InterchainTxsKeeper(t, wmKeeper, feeKeeper, nil, nil, nil, bankKeeper, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+	ctx := infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
+
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	relayerAddress := sdk.MustAccAddressFromBech32("neutron1fxudpred77a0grgh69u0j7y84yks5ev4n5050z45kecz792jnd6scqu98z")
+	portID := icatypes.ControllerPortPrefix + testutil.TestOwnerAddress + ICAId
+	const channelID = "channel-0"
+
+	icak.SaveTxBatch(ctx, ictxtypes.TxBatch{
+		Id:        1,
+		Contract:  testutil.TestOwnerAddress,
+		PortId:    portID,
+		ChannelId: channelID,
+		Packets:   []ictxtypes.TxBatchPacket{{Sequence: 100}, {Sequence: 101}, {Sequence: 102}},
+	})
+
+	txMsgData := sdk.TxMsgData{MsgResponses: []*codectypes.Any{{TypeUrl: "/cosmos.bank.v1beta1.MsgSendResponse", Value: []byte{1}}}}
+	result, err := txMsgData.Marshal()
+	require.NoError(t, err)
+
+	// the first two packets are resolved without calling the contract
+	p := channeltypes.Packet{Sequence: 100, SourcePort: portID, SourceChannel: channelID}
+	resACK := channeltypes.NewResultAcknowledgement(result)
+	resAckData, err := channeltypes.SubModuleCdc.MarshalJSON(&resACK)
+	require.NoError(t, err)
+	feeKeeper.EXPECT().DistributeAcknowledgementFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	err = icak.HandleAcknowledgement(ctx, "ica-v1", p, resAckData, relayerAddress)
+	require.NoError(t, err)
+
+	p = channeltypes.Packet{Sequence: 101, SourcePort: portID, SourceChannel: channelID}
+	errACK := channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Error{Error: "error"}}
+	errAckData, err := channeltypes.SubModuleCdc.MarshalJSON(&errACK)
+	require.NoError(t, err)
+	feeKeeper.EXPECT().DistributeAcknowledgementFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	err = icak.HandleAcknowledgement(ctx, "ica-v1", p, errAckData, relayerAddress)
+	require.NoError(t, err)
+
+	batch, err := icak.GetTxBatch(ctx, 1)
+	require.NoError(t, err)
+	require.True(t, batch.Packets[0].Resolved)
+	require.True(t, batch.Packets[1].Resolved)
+	require.False(t, batch.Packets[2].Resolved)
+
+	// the last packet resolves the batch and the contract gets the aggregate result
+	msg, err := keeper.PrepareBatchResultCallbackMessage(types.BatchResultPayload{
+		BatchID:   1,
+		PortID:    portID,
+		ChannelID: channelID,
+		Packets: []types.BatchPacketResult{
+			{Sequence: 100, MsgResponses: []types.MsgResponse{{TypeURL: "/cosmos.bank.v1beta1.MsgSendResponse", Data: []byte{1}}}},
+			{Sequence: 101, Error: "error"},
+			{Sequence: 102, Timeout: true},
+		},
+	})
+	require.NoError(t, err)
+
+	p = channeltypes.Packet{Sequence: 102, SourcePort: portID, SourceChannel: channelID}
+	feeKeeper.EXPECT().DistributeTimeoutFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, msg)
+	err = icak.HandleTimeout(ctx, "ica-v1", p, relayerAddress)
+	require.NoError(t, err)
+
+	_, err = icak.GetTxBatch(ctx, 1)
+	require.ErrorIs(t, err, ictxtypes.ErrTxBatchNotFound)
+	_, found := icak.GetPacketTxBatchID(ctx, portID, channelID, 100)
+	require.False(t, found)
+}
+
+func TestInterchainAccountChannelLifecycle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	feeKeeper := mock_types.NewMockFeeRefunderKeeper(ctrl)
+	icaMsgServer := mock_types.NewMockICAControllerMsgServer(ctrl)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	icak, infCtx := testkeeper.InterchainTxsKeeper(t, wmKeeper, feeKeeper, nil, icaMsgServer, channelKeeper, bankKeeper, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+	ctx := infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
+
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	relayerAddress := sdk.MustAccAddressFromBech32("neutron1fxudpred77a0grgh69u0j7y84yks5ev4n5050z45kecz792jnd6scqu98z")
+	icaOwner := ictxtypes.NewICAOwnerFromAddress(contractAddress, "ica0")
+	portID := icatypes.ControllerPortPrefix + testutil.TestOwnerAddress + ICAId
+	const connectionID = "connection-0"
+
+	icak.SetInterchainAccountChannel(ctx, ictxtypes.InterchainAccountChannel{
+		PortId:       portID,
+		ConnectionId: connectionID,
+		ChannelId:    "channel-0",
+		Ordering:     channeltypes.ORDERED,
+		Status:       ictxtypes.ChannelStatus_CHANNEL_STATUS_OPENING,
+		AutoReopen:   true,
+	})
+
+	// the channel is opened
+	openAckMsg, err := keeper.PrepareOpenAckCallbackMessage(types.OpenAckDetails{
+		PortID:                portID,
+		ChannelID:             "channel-0",
+		CounterpartyChannelID: "channel-1",
+		CounterpartyVersion:   "1",
+	})
+	require.NoError(t, err)
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, openAckMsg)
+	err = icak.HandleChanOpenAck(ctx, portID, "channel-0", "channel-1", "1")
+	require.NoError(t, err)
+
+	channel, found := icak.GetInterchainAccountChannel(ctx, connectionID, portID)
+	require.True(t, found)
+	require.Equal(t, ictxtypes.ChannelStatus_CHANNEL_STATUS_OPEN, channel.Status)
+
+	// a packet timeout closes the channel, and a new one is opened automatically
+	p := channeltypes.Packet{Sequence: 100, SourcePort: portID, SourceChannel: "channel-0"}
+	timeoutMsg, err := keeper.PrepareSudoCallbackMessage(p, nil)
+	require.NoError(t, err)
+	chanCloseMsg, err := keeper.PrepareChanCloseCallbackMessage(types.ChanCloseDetails{
+		PortID:       portID,
+		ChannelID:    "channel-0",
+		ConnectionID: connectionID,
+		Reopening:    true,
+	})
+	require.NoError(t, err)
+
+	feeKeeper.EXPECT().DistributeTimeoutFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, timeoutMsg)
+	icaMsgServer.EXPECT().RegisterInterchainAccount(gomock.Any(), &icacontrollertypes.MsgRegisterInterchainAccount{
+		Owner:        icaOwner.String(),
+		ConnectionId: connectionID,
+		Version:      "",
+		Ordering:     channeltypes.ORDERED,
+	}).Return(&icacontrollertypes.MsgRegisterInterchainAccountResponse{ChannelId: "channel-2", PortId: portID}, nil)
+	channelKeeper.EXPECT().GetChannel(ctx, portID, "channel-2").Return(channeltypes.Channel{Ordering: channeltypes.ORDERED}, true)
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, chanCloseMsg)
+	err = icak.HandleTimeout(ctx, "ica-v1", p, relayerAddress)
+	require.NoError(t, err)
+
+	channel, found = icak.GetInterchainAccountChannel(ctx, connectionID, portID)
+	require.True(t, found)
+	require.Equal(t, ictxtypes.ChannelStatus_CHANNEL_STATUS_REOPENING, channel.Status)
+	require.Equal(t, "channel-2", channel.ChannelId)
+
+	resp, err := icak.InterchainAccountStatus(ctx, &ictxtypes.QueryInterchainAccountStatusRequest{
+		OwnerAddress:        testutil.TestOwnerAddress,
+		InterchainAccountId: "ica0",
+		ConnectionId:        connectionID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, channel, resp.Channel)
+
+	// timeouts of other packets of the closed channel don't affect the new one
+	p = channeltypes.Packet{Sequence: 101, SourcePort: portID, SourceChannel: "channel-0"}
+	timeoutMsg, err = keeper.PrepareSudoCallbackMessage(p, nil)
+	require.NoError(t, err)
+	feeKeeper.EXPECT().DistributeTimeoutFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, timeoutMsg)
+	err = icak.HandleTimeout(ctx, "ica-v1", p, relayerAddress)
+	require.NoError(t, err)
+
+	channel, found = icak.GetInterchainAccountChannel(ctx, connectionID, portID)
+	require.True(t, found)
+	require.Equal(t, ictxtypes.ChannelStatus_CHANNEL_STATUS_REOPENING, channel.Status)
+}
+
+func TestHandleChanCloseConfirm(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	feeKeeper := mock_types.NewMockFeeRefunderKeeper(ctrl)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	icak, infCtx := testkeeper.InterchainTxsKeeper(t, wmKeeper, feeKeeper, nil, nil, nil, bankKeeper, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+	ctx := infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
+
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	relayerAddress := sdk.MustAccAddressFromBech32("neutron1fxudpred77a0grgh69u0j7y84yks5ev4n5050z45kecz792jnd6scqu98z")
+	portID := icatypes.ControllerPortPrefix + testutil.TestOwnerAddress + ICAId
+	const connectionID = "connection-0"
+
+	err := icak.HandleChanCloseConfirm(ctx, "", "channel-0")
+	require.ErrorContains(t, err, "failed to get ica owner from port")
+
+	icak.SetInterchainAccountChannel(ctx, ictxtypes.InterchainAccountChannel{
+		PortId:       portID,
+		ConnectionId: connectionID,
+		ChannelId:    "channel-0",
+		Ordering:     channeltypes.UNORDERED,
+		Status:       ictxtypes.ChannelStatus_CHANNEL_STATUS_OPEN,
+	})
+
+	// a timeout doesn't close an unordered channel
+	p := channeltypes.Packet{Sequence: 100, SourcePort: portID, SourceChannel: "channel-0"}
+	timeoutMsg, err := keeper.PrepareSudoCallbackMessage(p, nil)
+	require.NoError(t, err)
+	feeKeeper.EXPECT().DistributeTimeoutFee(ctx, relayerAddress, feetypes.NewPacketID(p.SourcePort, p.SourceChannel, p.Sequence))
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, timeoutMsg)
+	err = icak.HandleTimeout(ctx, "ica-v1", p, relayerAddress)
+	require.NoError(t, err)
+
+	channel, found := icak.GetInterchainAccountChannel(ctx, connectionID, portID)
+	require.True(t, found)
+	require.Equal(t, ictxtypes.ChannelStatus_CHANNEL_STATUS_OPEN, channel.Status)
+
+	// the batch pending on the channel is resolved before the closure
+	icak.SaveTxBatch(ctx, ictxtypes.TxBatch{
+		Id:        1,
+		Contract:  testutil.TestOwnerAddress,
+		PortId:    portID,
+		ChannelId: "channel-0",
+		Packets:   []ictxtypes.TxBatchPacket{{Sequence: 101, Resolved: true, Error: "error"}, {Sequence: 102}},
+	})
+	batchMsg, err := keeper.PrepareBatchResultCallbackMessage(types.BatchResultPayload{
+		BatchID:   1,
+		PortID:    portID,
+		ChannelID: "channel-0",
+		Packets: []types.BatchPacketResult{
+			{Sequence: 101, Error: "error"},
+			{Sequence: 102, Timeout: true},
+		},
+	})
+	require.NoError(t, err)
+
+	// the channel closed by the counterparty is marked as closed and the contract is notified
+	chanCloseMsg, err := keeper.PrepareChanCloseCallbackMessage(types.ChanCloseDetails{
+		PortID:       portID,
+		ChannelID:    "channel-0",
+		ConnectionID: connectionID,
+	})
+	require.NoError(t, err)
+	gomock.InOrder(
+		wmKeeper.EXPECT().Sudo(ctx, contractAddress, batchMsg),
+		wmKeeper.EXPECT().Sudo(ctx, contractAddress, chanCloseMsg),
+	)
+	err = icak.HandleChanCloseConfirm(ctx, portID, "channel-0")
+	require.NoError(t, err)
+
+	_, err = icak.GetTxBatch(ctx, 1)
+	require.ErrorIs(t, err, ictxtypes.ErrTxBatchNotFound)
+	_, found = icak.GetPacketTxBatchID(ctx, portID, "channel-0", 102)
+	require.False(t, found)
+
+	channel, found = icak.GetInterchainAccountChannel(ctx, connectionID, portID)
+	require.True(t, found)
+	require.Equal(t, ictxtypes.ChannelStatus_CHANNEL_STATUS_CLOSED, channel.Status)
+
+	// the closure is notified once
+	err = icak.HandleChanCloseConfirm(ctx, portID, "channel-0")
+	require.NoError(t, err)
+}
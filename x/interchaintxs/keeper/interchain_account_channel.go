@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	contractmanagerkeeper "github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
+	contractmanagertypes "github.com/neutron-org/neutron/v11/x/contractmanager/types"
+	"github.com/neutron-org/neutron/v11/x/interchaintxs/types"
+)
+
+// GetInterchainAccountChannel returns the channel state of the interchain account with the given
+// controller port on the given connection.
+func (k Keeper) GetInterchainAccountChannel(ctx sdk.Context, connectionID, portID string) (types.InterchainAccountChannel, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetInterchainAccountChannelKey(connectionID, portID))
+	if bz == nil {
+		return types.InterchainAccountChannel{}, false
+	}
+
+	var channel types.InterchainAccountChannel
+	k.Codec.MustUnmarshal(bz, &channel)
+	return channel, true
+}
+
+// SetInterchainAccountChannel saves the channel state of an interchain account and links its
+// current channel to it.
+func (k Keeper) SetInterchainAccountChannel(ctx sdk.Context, channel types.InterchainAccountChannel) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetInterchainAccountChannelKey(channel.ConnectionId, channel.PortId), k.Codec.MustMarshal(&channel))
+	store.Set(types.GetChannelConnectionIDKey(channel.PortId, channel.ChannelId), []byte(channel.ConnectionId))
+}
+
+// GetAllInterchainAccountChannels returns the channel states of all the tracked interchain accounts.
+func (k Keeper) GetAllInterchainAccountChannels(ctx sdk.Context) []types.InterchainAccountChannel {
+	var (
+		store    = prefix.NewStore(ctx.KVStore(k.storeKey), types.InterchainAccountChannelKey)
+		channels []types.InterchainAccountChannel
+	)
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close() //nolint:errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		channel := types.InterchainAccountChannel{}
+		k.Codec.MustUnmarshal(iterator.Value(), &channel)
+		channels = append(channels, channel)
+	}
+
+	return channels
+}
+
+// getInterchainAccountChannelByChannelID returns the channel state of the interchain account which
+// current channel is the given one.
+func (k Keeper) getInterchainAccountChannelByChannelID(ctx sdk.Context, portID, channelID string) (types.InterchainAccountChannel, bool) {
+	store := ctx.KVStore(k.storeKey)
+	connectionID := store.Get(types.GetChannelConnectionIDKey(portID, channelID))
+	if connectionID == nil {
+		return types.InterchainAccountChannel{}, false
+	}
+
+	channel, found := k.GetInterchainAccountChannel(ctx, string(connectionID), portID)
+	if !found || channel.ChannelId != channelID {
+		return types.InterchainAccountChannel{}, false
+	}
+
+	return channel, true
+}
+
+// trackInterchainAccountChannel starts tracking of the channel being opened for an interchain
+// account.
+func (k Keeper) trackInterchainAccountChannel(ctx sdk.Context, portID, connectionID, channelID string, ordering channeltypes.Order, autoReopen bool) {
+	status := types.ChannelStatus_CHANNEL_STATUS_OPENING
+	if prev, found := k.GetInterchainAccountChannel(ctx, connectionID, portID); found {
+		if prev.ChannelId == channelID {
+			// the same channel is still being opened, nothing to update but the reopen setting
+			prev.AutoReopen = autoReopen
+			k.SetInterchainAccountChannel(ctx, prev)
+			return
+		}
+
+		status = types.ChannelStatus_CHANNEL_STATUS_REOPENING
+		ctx.KVStore(k.storeKey).Delete(types.GetChannelConnectionIDKey(prev.PortId, prev.ChannelId))
+	}
+
+	// the channel is in the INIT state after the handshake start, so its actual ordering is known.
+	// The underlying controller opens an ORDER_UNORDERED channel in case the ordering is NONE
+	if ch, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+		ordering = ch.Ordering
+	} else if ordering == channeltypes.NONE {
+		ordering = channeltypes.UNORDERED
+	}
+
+	k.SetInterchainAccountChannel(ctx, types.InterchainAccountChannel{
+		PortId:       portID,
+		ConnectionId: connectionID,
+		ChannelId:    channelID,
+		Ordering:     ordering,
+		Status:       status,
+		AutoReopen:   autoReopen,
+	})
+}
+
+// markInterchainAccountChannelOpen sets the status of the interchain account's channel to open.
+// Channels of the interchain accounts registered before the channels tracking was introduced are
+// ignored.
+func (k Keeper) markInterchainAccountChannelOpen(ctx sdk.Context, portID, channelID string) {
+	channel, found := k.getInterchainAccountChannelByChannelID(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	channel.Status = types.ChannelStatus_CHANNEL_STATUS_OPEN
+	k.SetInterchainAccountChannel(ctx, channel)
+}
+
+// handleInterchainAccountChannelTimeout marks the interchain account's channel as closed if the
+// channel is ordered, since an ordered channel is closed on a packet timeout.
+func (k *Keeper) handleInterchainAccountChannelTimeout(ctx sdk.Context, icaOwner types.ICAOwner, portID, channelID string) error {
+	channel, found := k.getInterchainAccountChannelByChannelID(ctx, portID, channelID)
+	if !found || channel.Ordering != channeltypes.ORDERED {
+		return nil
+	}
+
+	return k.closeInterchainAccountChannel(ctx, icaOwner, channel)
+}
+
+// closeInterchainAccountChannel marks the interchain account's channel as closed and resolves the
+// transaction batches pending on it. The owner contract is notified about the closure and, if the
+// account has automatic reopening enabled, a new channel with the same port and ordering is opened.
+func (k *Keeper) closeInterchainAccountChannel(ctx sdk.Context, icaOwner types.ICAOwner, channel types.InterchainAccountChannel) error {
+	if channel.Status == types.ChannelStatus_CHANNEL_STATUS_CLOSED {
+		return nil
+	}
+
+	portID, channelID := channel.PortId, channel.ChannelId
+	channel.Status = types.ChannelStatus_CHANNEL_STATUS_CLOSED
+	k.SetInterchainAccountChannel(ctx, channel)
+	k.resolveChannelTxBatches(ctx, portID, channelID)
+
+	reopening := false
+	if channel.AutoReopen {
+		cacheCtx, writeFn := ctx.CacheContext()
+		resp, err := k.icaControllerMsgServer.RegisterInterchainAccount(cacheCtx, &icacontrollertypes.MsgRegisterInterchainAccount{
+			Owner:        icaOwner.String(),
+			ConnectionId: channel.ConnectionId,
+			Version:      "",
+			Ordering:     channel.Ordering,
+		})
+		if err != nil {
+			k.Logger(ctx).Debug("closeInterchainAccountChannel: failed to reopen interchain account channel", "error", err, "port_id", portID, "connection_id", channel.ConnectionId)
+		} else {
+			writeFn()
+			k.trackInterchainAccountChannel(ctx, portID, channel.ConnectionId, resp.ChannelId, channel.Ordering, channel.AutoReopen)
+			reopening = true
+		}
+	}
+
+	payload, err := contractmanagerkeeper.PrepareChanCloseCallbackMessage(contractmanagertypes.ChanCloseDetails{
+		PortID:       portID,
+		ChannelID:    channelID,
+		ConnectionID: channel.ConnectionId,
+		Reopening:    reopening,
+	})
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrJSONMarshal, "failed to marshal ChanCloseDetails: %v", err)
+	}
+
+	if _, err := k.sudoKeeper.Sudo(ctx, icaOwner.GetContract(), payload); err != nil {
+		k.Logger(ctx).Debug("closeInterchainAccountChannel: failed to sudo contract on channel close", "error", err)
+	}
+
+	return nil
+}
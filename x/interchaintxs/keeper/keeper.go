@@ -21,6 +21,7 @@ const (
 	LabelLabelHandleChanOpenAck    = "handle_chan_open_ack"
 	LabelRegisterInterchainAccount = "register_interchain_account"
 	LabelHandleTimeout             = "handle_timeout"
+	LabelHandleChanCloseConfirm    = "handle_chan_close_confirm"
 )
 
 type (
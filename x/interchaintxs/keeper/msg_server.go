@@ -66,7 +66,7 @@ func (k Keeper) RegisterInterchainAccount(goCtx context.Context, msg *ictxtypes.
 		Owner:        icaOwner,
 		ConnectionId: msg.ConnectionId,
 		Version:      "", // FIXME: empty version string doesn't look good
-		// underlying controller uses ORDER_ORDERED as default in case msg's ordering is NONE
+		// underlying controller uses ORDER_UNORDERED as default in case msg's ordering is NONE
 		Ordering: msg.Ordering,
 	})
 	if err != nil {
@@ -75,6 +75,7 @@ func (k Keeper) RegisterInterchainAccount(goCtx context.Context, msg *ictxtypes.
 	}
 
 	k.icaControllerKeeper.SetMiddlewareEnabled(ctx, resp.PortId, msg.ConnectionId)
+	k.trackInterchainAccountChannel(ctx, resp.PortId, msg.ConnectionId, resp.ChannelId, msg.Ordering, msg.AutoReopen)
 
 	return &ictxtypes.MsgRegisterInterchainAccountResponse{
 		ChannelId: resp.ChannelId,
@@ -108,16 +109,31 @@ func (k Keeper) SubmitTx(goCtx context.Context, msg *ictxtypes.MsgSubmitTx) (*ic
 	}
 
 	params := k.GetParams(ctx)
-	if uint64(len(msg.Msgs)) > params.GetMsgSubmitTxMaxMessages() {
+	maxMessages := params.GetMsgSubmitTxMaxMessages()
+	if uint64(len(msg.Msgs)) > maxMessages && (!msg.SplitOversized || maxMessages == 0) {
 		k.Logger(ctx).Debug("SubmitTx: provided MsgSubmitTx contains more messages than allowed",
 			"msg", msg,
 			"has", len(msg.Msgs),
-			"max", params.GetMsgSubmitTxMaxMessages(),
+			"max", maxMessages,
 		)
 		return nil, fmt.Errorf(
 			"MsgSubmitTx contains more messages than allowed, has=%d, max=%d",
 			len(msg.Msgs),
-			params.GetMsgSubmitTxMaxMessages(),
+			maxMessages,
+		)
+	}
+
+	if err := k.checkHostAllowMessages(ctx, msg.ConnectionId, msg.Msgs); err != nil {
+		k.Logger(ctx).Debug("SubmitTx: message is not allowed on the host chain", "error", err, "connection_id", msg.ConnectionId)
+		return nil, err
+	}
+
+	packetsMsgs := splitMsgs(msg.Msgs, maxMessages)
+	if len(packetsMsgs) > ictxtypes.MaxTxBatchPackets {
+		return nil, errors.Wrapf(ictxtypes.ErrTooManyMessages,
+			"MsgSubmitTx is split into more packets than allowed, has=%d, max=%d",
+			len(packetsMsgs),
+			ictxtypes.MaxTxBatchPackets,
 		)
 	}
 
@@ -135,10 +151,57 @@ func (k Keeper) SubmitTx(goCtx context.Context, msg *ictxtypes.MsgSubmitTx) (*ic
 		return nil, errors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to GetActiveChannelID for port %s", portID)
 	}
 
-	data, err := SerializeCosmosTx(k.Codec, msg.Msgs)
+	sequences := make([]uint64, 0, len(packetsMsgs))
+	for _, msgs := range packetsMsgs {
+		sequence, err := k.sendTx(ctx, senderAddr, icaOwner, portID, channelID, msg, msgs)
+		if err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+	}
+
+	resp := &ictxtypes.MsgSubmitTxResponse{
+		SequenceId: sequences[0],
+		Channel:    channelID,
+	}
+
+	if len(sequences) > 1 {
+		batch := ictxtypes.TxBatch{
+			Id:        k.GetLastTxBatchID(ctx) + 1,
+			Contract:  msg.FromAddress,
+			PortId:    portID,
+			ChannelId: channelID,
+			Packets:   make([]ictxtypes.TxBatchPacket, 0, len(sequences)),
+		}
+		for _, sequence := range sequences {
+			batch.Packets = append(batch.Packets, ictxtypes.TxBatchPacket{Sequence: sequence})
+		}
+
+		k.SetLastTxBatchID(ctx, batch.Id)
+		k.SaveTxBatch(ctx, batch)
+
+		resp.BatchId = batch.Id
+		resp.SequenceIds = sequences
+	}
+
+	return resp, nil
+}
+
+// sendTx sends the given messages of the MsgSubmitTx in a single ICA packet and locks the fee for
+// it. Returns the sequence of the sent packet.
+func (k Keeper) sendTx(
+	ctx sdk.Context,
+	senderAddr sdk.AccAddress,
+	icaOwner,
+	portID,
+	channelID string,
+	msg *ictxtypes.MsgSubmitTx,
+	msgs []*codectypes.Any,
+) (uint64, error) {
+	data, err := SerializeCosmosTx(k.Codec, msgs)
 	if err != nil {
 		k.Logger(ctx).Debug("SubmitTx: failed to SerializeCosmosTx", "error", err, "connection_id", msg.ConnectionId, "port_id", portID, "channel_id", channelID)
-		return nil, errors.Wrap(err, "failed to SerializeCosmosTx")
+		return 0, errors.Wrap(err, "failed to SerializeCosmosTx")
 	}
 
 	packetData := icatypes.InterchainAccountPacketData{
@@ -149,14 +212,14 @@ func (k Keeper) SubmitTx(goCtx context.Context, msg *ictxtypes.MsgSubmitTx) (*ic
 
 	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, portID, channelID)
 	if !found {
-		return nil, errors.Wrapf(
+		return 0, errors.Wrapf(
 			channeltypes.ErrSequenceSendNotFound,
 			"source port: %s, source channel: %s", portID, channelID,
 		)
 	}
 
 	if err := k.feeKeeper.LockFees(ctx, senderAddr, feetypes.NewPacketID(portID, channelID, sequence), msg.Fee); err != nil {
-		return nil, errors.Wrapf(err, "failed to lock fees to pay for SubmitTx msg: %s", msg)
+		return 0, errors.Wrapf(err, "failed to lock fees to pay for SubmitTx msg: %s", msg)
 	}
 
 	resp, err := k.icaControllerMsgServer.SendTx(ctx, &icacontrollertypes.MsgSendTx{
@@ -168,13 +231,25 @@ func (k Keeper) SubmitTx(goCtx context.Context, msg *ictxtypes.MsgSubmitTx) (*ic
 	if err != nil {
 		// usually we use DEBUG level for such errors, but in this case we have checked full input before running SendTX, so error here may be critical
 		k.Logger(ctx).Error("SubmitTx", "error", err, "owner", icaOwner, "connection_id", msg.ConnectionId, "channel_id", channelID)
-		return nil, errors.Wrap(err, "failed to SendTx")
+		return 0, errors.Wrap(err, "failed to SendTx")
 	}
 
-	return &ictxtypes.MsgSubmitTxResponse{
-		SequenceId: resp.Sequence,
-		Channel:    channelID,
-	}, nil
+	return resp.Sequence, nil
+}
+
+// splitMsgs splits the messages into chunks of at most maxMessages messages each.
+func splitMsgs(msgs []*codectypes.Any, maxMessages uint64) [][]*codectypes.Any {
+	if uint64(len(msgs)) <= maxMessages {
+		return [][]*codectypes.Any{msgs}
+	}
+
+	chunks := make([][]*codectypes.Any, 0, (uint64(len(msgs))+maxMessages-1)/maxMessages)
+	for start := uint64(0); start < uint64(len(msgs)); start += maxMessages {
+		end := min(start+maxMessages, uint64(len(msgs)))
+		chunks = append(chunks, msgs[start:end])
+	}
+
+	return chunks
 }
 
 // SerializeCosmosTx serializes a slice of *types.Any messages using the CosmosTx type. The proto marshaled CosmosTx
@@ -217,3 +292,42 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *ictxtypes.MsgUpdatePara
 
 	return &ictxtypes.MsgUpdateParamsResponse{}, nil
 }
+
+// SetHostAllowMessages sets the cached allow_messages param of the ICA host module of the chain on
+// the other side of a connection
+func (k Keeper) SetHostAllowMessages(goCtx context.Context, req *ictxtypes.MsgSetHostAllowMessages) (*ictxtypes.MsgSetHostAllowMessagesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetHostAllowMessages")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SaveHostAllowMessages(ctx, req.HostAllowMessages)
+
+	return &ictxtypes.MsgSetHostAllowMessagesResponse{}, nil
+}
+
+// RemoveHostAllowMessages removes the cached allow_messages param of the ICA host module of the
+// chain on the other side of a connection
+func (k Keeper) RemoveHostAllowMessages(goCtx context.Context, req *ictxtypes.MsgRemoveHostAllowMessages) (*ictxtypes.MsgRemoveHostAllowMessagesResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRemoveHostAllowMessages")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetHostAllowMessages(ctx, req.ConnectionId); !found {
+		return nil, errors.Wrapf(ictxtypes.ErrHostAllowMessagesNotFound, "no host allow messages found for connection %s", req.ConnectionId)
+	}
+	k.DeleteHostAllowMessages(ctx, req.ConnectionId)
+
+	return &ictxtypes.MsgRemoveHostAllowMessagesResponse{}, nil
+}
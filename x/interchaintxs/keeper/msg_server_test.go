@@ -109,8 +109,9 @@ func TestRegisterInterchainAccount(t *testing.T) {
 	icaKeeper := mock_types.NewMockICAControllerKeeper(ctrl)
 	icaMsgServer := mock_types.NewMockICAControllerMsgServer(ctrl)
 	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
 	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
-	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, nil, icaKeeper, icaMsgServer, nil, bankKeeper, func(_ sdk.Context) string {
+	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, nil, icaKeeper, icaMsgServer, channelKeeper, bankKeeper, func(_ sdk.Context) string {
 		return TestFeeCollectorAddr
 	})
 
@@ -168,6 +169,7 @@ func TestRegisterInterchainAccount(t *testing.T) {
 		PortId:    portID,
 	}, nil)
 	icaKeeper.EXPECT().SetMiddlewareEnabled(ctx, portID, msgRegAcc.ConnectionId)
+	channelKeeper.EXPECT().GetChannel(ctx, portID, channelID).Return(channeltypes.Channel{Ordering: channeltypes.ORDERED}, true)
 	resp, err = icak.RegisterInterchainAccount(ctx, &msgRegAcc)
 	require.NoError(t, err)
 	require.Equal(t, types.MsgRegisterInterchainAccountResponse{
@@ -182,8 +184,9 @@ func TestRegisterInterchainAccountUnordered(t *testing.T) {
 	icaKeeper := mock_types.NewMockICAControllerKeeper(ctrl)
 	icaMsgServer := mock_types.NewMockICAControllerMsgServer(ctrl)
 	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
 	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
-	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, nil, icaKeeper, icaMsgServer, nil, bankKeeper, func(_ sdk.Context) string {
+	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, nil, icaKeeper, icaMsgServer, channelKeeper, bankKeeper, func(_ sdk.Context) string {
 		return TestFeeCollectorAddr
 	})
 
@@ -224,6 +227,7 @@ func TestRegisterInterchainAccountUnordered(t *testing.T) {
 		PortId:    portID,
 	}, nil)
 	icaKeeper.EXPECT().SetMiddlewareEnabled(ctx, portID, msgRegAcc.ConnectionId)
+	channelKeeper.EXPECT().GetChannel(ctx, portID, channelID).Return(channeltypes.Channel{Ordering: channeltypes.UNORDERED}, true)
 	resp, err = icak.RegisterInterchainAccount(ctx, &msgRegAcc)
 	require.NoError(t, err)
 	require.Equal(t, types.MsgRegisterInterchainAccountResponse{
@@ -232,6 +236,50 @@ func TestRegisterInterchainAccountUnordered(t *testing.T) {
 	}, *resp)
 }
 
+func TestRegisterInterchainAccountDefaultOrdering(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	icaKeeper := mock_types.NewMockICAControllerKeeper(ctrl)
+	icaMsgServer := mock_types.NewMockICAControllerMsgServer(ctrl)
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, nil, icaKeeper, icaMsgServer, channelKeeper, bankKeeper, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+
+	msgRegAcc := types.MsgRegisterInterchainAccount{
+		FromAddress:         testutil.TestOwnerAddress,
+		ConnectionId:        "connection-0",
+		InterchainAccountId: "ica0",
+		RegisterFee:         sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(1_000_000))),
+	}
+	contractAddress := sdk.MustAccAddressFromBech32(msgRegAcc.FromAddress)
+	icaOwner := types.NewICAOwnerFromAddress(contractAddress, msgRegAcc.InterchainAccountId)
+
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	wmKeeper.EXPECT().GetContractInfo(ctx, contractAddress).Return(&wasmtypes.ContractInfo{CodeID: 1})
+	bankKeeper.EXPECT().SendCoins(ctx, contractAddress, sdk.MustAccAddressFromBech32(TestFeeCollectorAddr), msgRegAcc.RegisterFee)
+	icaMsgServer.EXPECT().RegisterInterchainAccount(ctx, &icacontrollertypes.MsgRegisterInterchainAccount{
+		Owner:        icaOwner.String(),
+		ConnectionId: msgRegAcc.ConnectionId,
+		Version:      "",
+		Ordering:     channeltypes.NONE,
+	}).Return(&icacontrollertypes.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+		PortId:    portID,
+	}, nil)
+	icaKeeper.EXPECT().SetMiddlewareEnabled(ctx, portID, msgRegAcc.ConnectionId)
+	// the controller opens an unordered channel by default
+	channelKeeper.EXPECT().GetChannel(ctx, portID, channelID).Return(channeltypes.Channel{Ordering: channeltypes.UNORDERED}, true)
+	_, err := icak.RegisterInterchainAccount(ctx, &msgRegAcc)
+	require.NoError(t, err)
+
+	channel, found := icak.GetInterchainAccountChannel(ctx, msgRegAcc.ConnectionId, portID)
+	require.True(t, found)
+	require.Equal(t, channeltypes.UNORDERED, channel.Ordering)
+}
+
 func TestMsgSubmitTXValidate(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -537,6 +585,98 @@ func TestSubmitTx(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSubmitTxSplitOversized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	icaKeeper := mock_types.NewMockICAControllerKeeper(ctrl)
+	icaMsgServer := mock_types.NewMockICAControllerMsgServer(ctrl)
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	refundKeeper := mock_types.NewMockFeeRefunderKeeper(ctrl)
+	channelKeeper := mock_types.NewMockChannelKeeper(ctrl)
+	bankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, refundKeeper, icaKeeper, icaMsgServer, channelKeeper, bankKeeper, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+
+	moduleParams := icak.GetParams(ctx)
+	moduleParams.MsgSubmitTxMaxMessages = 2
+	require.NoError(t, icak.SetParams(ctx, moduleParams))
+
+	cosmosMsg := codectypes.Any{
+		TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate",
+		Value:   []byte{26, 10, 10, 5, 115, 116, 97, 107, 101, 18, 1, 48},
+	}
+	submitMsg := types.MsgSubmitTx{
+		FromAddress:         testutil.TestOwnerAddress,
+		InterchainAccountId: "ica0",
+		ConnectionId:        "connection-0",
+		Msgs:                []*codectypes.Any{&cosmosMsg, &cosmosMsg, &cosmosMsg},
+		Memo:                "memo",
+		Timeout:             100,
+		Fee: feerefundertypes.Fee{
+			RecvFee:    sdk.NewCoins(),
+			AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(100))),
+			TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(100))),
+		},
+		SplitOversized: true,
+	}
+
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	icaOwner := types.NewICAOwnerFromAddress(contractAddress, submitMsg.InterchainAccountId)
+	activeChannel := "channel-0"
+
+	// too many packets
+	submitMsg.Msgs = make([]*codectypes.Any, 2*types.MaxTxBatchPackets+1)
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	resp, err := icak.SubmitTx(ctx, &submitMsg)
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, types.ErrTooManyMessages)
+	submitMsg.Msgs = []*codectypes.Any{&cosmosMsg, &cosmosMsg, &cosmosMsg}
+
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	icaKeeper.EXPECT().GetActiveChannelID(ctx, "connection-0", portID).Return(activeChannel, true)
+	for i, msgs := range [][]*codectypes.Any{submitMsg.Msgs[:2], submitMsg.Msgs[2:]} {
+		sequence := uint64(100 + i) //nolint:gosec
+		data, err := keeper.SerializeCosmosTx(icak.Codec, msgs)
+		require.NoError(t, err)
+
+		channelKeeper.EXPECT().GetNextSequenceSend(ctx, portID, activeChannel).Return(sequence, true)
+		refundKeeper.EXPECT().LockFees(ctx, contractAddress, feerefundertypes.NewPacketID(portID, activeChannel, sequence), submitMsg.Fee).Return(nil)
+		icaMsgServer.EXPECT().SendTx(ctx, &icacontrollertypes.MsgSendTx{
+			Owner:        icaOwner.String(),
+			ConnectionId: submitMsg.ConnectionId,
+			PacketData: icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+				Memo: submitMsg.Memo,
+			},
+			RelativeTimeout: uint64(time.Duration(submitMsg.Timeout) * time.Second), //nolint:gosec
+		}).Return(&icacontrollertypes.MsgSendTxResponse{Sequence: sequence}, nil)
+	}
+	resp, err = icak.SubmitTx(ctx, &submitMsg)
+	require.NoError(t, err)
+	require.Equal(t, types.MsgSubmitTxResponse{
+		SequenceId:  100,
+		Channel:     activeChannel,
+		BatchId:     1,
+		SequenceIds: []uint64{100, 101},
+	}, *resp)
+
+	batch, err := icak.GetTxBatch(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, types.TxBatch{
+		Id:        1,
+		Contract:  testutil.TestOwnerAddress,
+		PortId:    portID,
+		ChannelId: activeChannel,
+		Packets:   []types.TxBatchPacket{{Sequence: 100}, {Sequence: 101}},
+	}, batch)
+
+	batchID, found := icak.GetPacketTxBatchID(ctx, portID, activeChannel, 101)
+	require.True(t, found)
+	require.Equal(t, uint64(1), batchID)
+}
+
 func TestMsgUpdateParamsValidate(t *testing.T) {
 	icak, ctx := testkeeper.InterchainTxsKeeper(t, nil, nil, nil, nil, nil, nil, func(_ sdk.Context) string {
 		return TestFeeCollectorAddr
@@ -571,3 +711,73 @@ func TestMsgUpdateParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestHostAllowMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	icak, ctx := testkeeper.InterchainTxsKeeper(t, wmKeeper, nil, nil, nil, nil, nil, func(_ sdk.Context) string {
+		return TestFeeCollectorAddr
+	})
+
+	hostAllowMessages := types.HostAllowMessages{
+		ConnectionId:     "connection-0",
+		AllowMessages:    []string{"/cosmos.bank.v1beta1.MsgSend"},
+		RejectDisallowed: true,
+	}
+
+	resp, err := icak.SetHostAllowMessages(ctx, &types.MsgSetHostAllowMessages{
+		Authority:         testutil.TestOwnerAddress,
+		HostAllowMessages: hostAllowMessages,
+	})
+	require.Nil(t, resp)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = icak.SetHostAllowMessages(ctx, &types.MsgSetHostAllowMessages{
+		Authority:         icak.GetAuthority(),
+		HostAllowMessages: hostAllowMessages,
+	})
+	require.NoError(t, err)
+
+	stored, found := icak.GetHostAllowMessages(ctx, "connection-0")
+	require.True(t, found)
+	require.Equal(t, hostAllowMessages, stored)
+
+	cosmosMsg := codectypes.Any{
+		TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate",
+		Value:   []byte{26, 10, 10, 5, 115, 116, 97, 107, 101, 18, 1, 48},
+	}
+	submitMsg := types.MsgSubmitTx{
+		FromAddress:         testutil.TestOwnerAddress,
+		InterchainAccountId: "ica0",
+		ConnectionId:        "connection-0",
+		Msgs:                []*codectypes.Any{&cosmosMsg},
+		Memo:                "memo",
+		Timeout:             100,
+		Fee: feerefundertypes.Fee{
+			RecvFee:    sdk.NewCoins(),
+			AckFee:     sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(100))),
+			TimeoutFee: sdk.NewCoins(sdk.NewCoin(params.DefaultDenom, math.NewInt(100))),
+		},
+	}
+
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	submitResp, err := icak.SubmitTx(ctx, &submitMsg)
+	require.Nil(t, submitResp)
+	require.ErrorIs(t, err, types.ErrMessageNotAllowedOnHost)
+
+	_, err = icak.RemoveHostAllowMessages(ctx, &types.MsgRemoveHostAllowMessages{
+		Authority:    icak.GetAuthority(),
+		ConnectionId: "connection-0",
+	})
+	require.NoError(t, err)
+	_, found = icak.GetHostAllowMessages(ctx, "connection-0")
+	require.False(t, found)
+
+	_, err = icak.RemoveHostAllowMessages(ctx, &types.MsgRemoveHostAllowMessages{
+		Authority:    icak.GetAuthority(),
+		ConnectionId: "connection-0",
+	})
+	require.ErrorIs(t, err, types.ErrHostAllowMessagesNotFound)
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	contractmanagerkeeper "github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
+	contractmanagertypes "github.com/neutron-org/neutron/v11/x/contractmanager/types"
+	"github.com/neutron-org/neutron/v11/x/interchaintxs/types"
+)
+
+// GetTxBatch returns the transaction batch with the given id.
+func (k Keeper) GetTxBatch(ctx sdk.Context, batchID uint64) (types.TxBatch, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetTxBatchKey(batchID))
+	if bz == nil {
+		return types.TxBatch{}, errors.Wrapf(types.ErrTxBatchNotFound, "there is no transaction batch with id %d", batchID)
+	}
+
+	var batch types.TxBatch
+	k.Codec.MustUnmarshal(bz, &batch)
+	return batch, nil
+}
+
+// SaveTxBatch saves the transaction batch and links all its packets to it.
+func (k Keeper) SaveTxBatch(ctx sdk.Context, batch types.TxBatch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTxBatchKey(batch.Id), k.Codec.MustMarshal(&batch))
+	for _, packet := range batch.Packets {
+		store.Set(types.GetPacketTxBatchIDKey(batch.PortId, batch.ChannelId, packet.Sequence), sdk.Uint64ToBigEndian(batch.Id))
+	}
+}
+
+// RemoveTxBatch removes the transaction batch and the links of its packets.
+func (k Keeper) RemoveTxBatch(ctx sdk.Context, batch types.TxBatch) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetTxBatchKey(batch.Id))
+	for _, packet := range batch.Packets {
+		store.Delete(types.GetPacketTxBatchIDKey(batch.PortId, batch.ChannelId, packet.Sequence))
+	}
+}
+
+// GetPacketTxBatchID returns the id of the transaction batch the packet belongs to.
+func (k Keeper) GetPacketTxBatchID(ctx sdk.Context, portID, channelID string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPacketTxBatchIDKey(portID, channelID, sequence))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// GetLastTxBatchID returns the id of the last created transaction batch.
+func (k Keeper) GetLastTxBatchID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTxBatchIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastTxBatchID saves the id of the last created transaction batch.
+func (k Keeper) SetLastTxBatchID(ctx sdk.Context, batchID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastTxBatchIDKey, sdk.Uint64ToBigEndian(batchID))
+}
+
+// resolveBatchedPacket records the outcome of a packet if it belongs to a transaction batch. A nil
+// acknowledgement means the packet timed out. Once all the packets of the batch are resolved, the
+// aggregate result is passed to the contract and the batch is removed. Returns false if the packet
+// doesn't belong to any batch.
+func (k *Keeper) resolveBatchedPacket(ctx sdk.Context, packet channeltypes.Packet, ack *channeltypes.Acknowledgement) bool {
+	batchID, found := k.GetPacketTxBatchID(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return false
+	}
+
+	batch, err := k.GetTxBatch(ctx, batchID)
+	if err != nil {
+		k.Logger(ctx).Error("resolveBatchedPacket: failed to get transaction batch", "error", err, "batch_id", batchID)
+		return true
+	}
+
+	resolved := true
+	for i := range batch.Packets {
+		batchPacket := &batch.Packets[i]
+		if batchPacket.Sequence == packet.Sequence {
+			batchPacket.Resolved = true
+			switch {
+			case ack == nil:
+				batchPacket.Timeout = true
+			case ack.GetError() != "":
+				batchPacket.Error = ack.GetError()
+			default:
+				batchPacket.Result = ack.GetResult()
+			}
+		}
+		resolved = resolved && batchPacket.Resolved
+	}
+
+	if !resolved {
+		k.SaveTxBatch(ctx, batch)
+		return true
+	}
+
+	k.completeTxBatch(ctx, batch)
+	return true
+}
+
+// resolveChannelTxBatches resolves the pending packets of all the transaction batches sent over the
+// closed channel as timed out, since they are never going to be acknowledged, and completes the
+// batches. A packet timed out on close later on is then passed to the contract as a standalone one.
+func (k *Keeper) resolveChannelTxBatches(ctx sdk.Context, portID, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetChannelPacketTxBatchIDsPrefix(portID, channelID))
+	iterator := store.Iterator(nil, nil)
+
+	var batchIDs []uint64
+	seen := make(map[uint64]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		batchID := sdk.BigEndianToUint64(iterator.Value())
+		if !seen[batchID] {
+			seen[batchID] = true
+			batchIDs = append(batchIDs, batchID)
+		}
+	}
+	iterator.Close() //nolint:errcheck
+
+	for _, batchID := range batchIDs {
+		batch, err := k.GetTxBatch(ctx, batchID)
+		if err != nil {
+			k.Logger(ctx).Error("resolveChannelTxBatches: failed to get transaction batch", "error", err, "batch_id", batchID)
+			continue
+		}
+
+		for i := range batch.Packets {
+			if !batch.Packets[i].Resolved {
+				batch.Packets[i].Resolved = true
+				batch.Packets[i].Timeout = true
+			}
+		}
+		k.completeTxBatch(ctx, batch)
+	}
+}
+
+// completeTxBatch removes the resolved transaction batch and passes its aggregate result to the
+// contract.
+func (k *Keeper) completeTxBatch(ctx sdk.Context, batch types.TxBatch) {
+	k.RemoveTxBatch(ctx, batch)
+
+	result := contractmanagertypes.BatchResultPayload{
+		BatchID:   batch.Id,
+		PortID:    batch.PortId,
+		ChannelID: batch.ChannelId,
+		Packets:   make([]contractmanagertypes.BatchPacketResult, 0, len(batch.Packets)),
+	}
+	for _, batchPacket := range batch.Packets {
+		packetResult := contractmanagertypes.BatchPacketResult{
+			Sequence: batchPacket.Sequence,
+			Error:    batchPacket.Error,
+			Timeout:  batchPacket.Timeout,
+		}
+		if !batchPacket.Timeout && batchPacket.Error == "" {
+			packetResult.MsgResponses = k.decodeMsgResponses(ctx, batchPacket.Result)
+		}
+		result.Packets = append(result.Packets, packetResult)
+	}
+
+	msg, err := contractmanagerkeeper.PrepareBatchResultCallbackMessage(result)
+	if err != nil {
+		k.Logger(ctx).Error("completeTxBatch: failed to marshal batch result", "error", err, "batch_id", batch.Id)
+		return
+	}
+
+	contractAddress, err := sdk.AccAddressFromBech32(batch.Contract)
+	if err != nil {
+		k.Logger(ctx).Error("completeTxBatch: failed to decode contract address", "error", err, "batch_id", batch.Id)
+		return
+	}
+
+	if _, err := k.sudoKeeper.Sudo(ctx, contractAddress, msg); err != nil {
+		k.Logger(ctx).Debug("completeTxBatch: failed to Sudo contract on batch result", "error", err, "batch_id", batch.Id)
+	}
+}
+
+// decodeMsgResponses decodes the result of a successful ICA acknowledgement into the responses of
+// the executed messages. Hosts running older versions of the SDK don't fill in the message
+// responses, so an undecodable result results in no message responses rather than an error.
+func (k *Keeper) decodeMsgResponses(ctx sdk.Context, result []byte) []contractmanagertypes.MsgResponse {
+	var txMsgData sdk.TxMsgData
+	if err := txMsgData.Unmarshal(result); err != nil {
+		k.Logger(ctx).Debug("decodeMsgResponses: failed to unmarshal ICA acknowledgement result", "error", err)
+		return nil
+	}
+
+	if len(txMsgData.MsgResponses) == 0 {
+		return nil
+	}
+
+	msgResponses := make([]contractmanagertypes.MsgResponse, 0, len(txMsgData.MsgResponses))
+	for _, msgResponse := range txMsgData.MsgResponses {
+		msgResponses = append(msgResponses, contractmanagertypes.MsgResponse{
+			TypeURL: msgResponse.TypeUrl,
+			Data:    msgResponse.Value,
+		})
+	}
+
+	return msgResponses
+}
@@ -15,6 +15,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterInterchainAccount{}, "/neutron.interchaintxs.v1.MsgRegisterInterchainAccount", nil)
 	cdc.RegisterConcrete(&MsgSubmitTx{}, "/neutron.interchaintxs.v1.MsgSubmitTx", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "/neutron.interchaintxs.v1.MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetHostAllowMessages{}, "/neutron.interchaintxs.v1.MsgSetHostAllowMessages", nil)
+	cdc.RegisterConcrete(&MsgRemoveHostAllowMessages{}, "/neutron.interchaintxs.v1.MsgRemoveHostAllowMessages", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -22,6 +24,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRegisterInterchainAccount{},
 		&MsgSubmitTx{},
 		&MsgUpdateParams{},
+		&MsgSetHostAllowMessages{},
+		&MsgRemoveHostAllowMessages{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,3 +1,6 @@
 package types
 
 const ConsensusVersion = 2
+
+// MaxTxBatchPackets is the maximum number of packets an oversized MsgSubmitTx can be split into.
+const MaxTxBatchPackets = 10
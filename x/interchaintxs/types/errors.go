@@ -17,4 +17,9 @@ var (
 	ErrInvalidPayerFee           = errors.Register(ModuleName, 1108, "invalid payer feerefunder")
 	ErrLongInterchainAccountID   = errors.Register(ModuleName, 1109, "interchain account id is too long")
 	ErrInvalidType               = errors.Register(ModuleName, 1110, "invalid type")
+	ErrTooManyMessages           = errors.Register(ModuleName, 1111, "too many messages")
+	ErrTxBatchNotFound           = errors.Register(ModuleName, 1112, "transaction batch not found")
+	ErrMessageNotAllowedOnHost   = errors.Register(ModuleName, 1113, "message is not allowed on the host chain")
+	ErrInvalidHostAllowMessages  = errors.Register(ModuleName, 1114, "invalid host allow messages")
+	ErrHostAllowMessagesNotFound = errors.Register(ModuleName, 1115, "host allow messages not found")
 )
@@ -0,0 +1,9 @@
+package types
+
+// interchaintxs module event types
+const (
+	EventTypeMessageNotAllowedOnHost = "message_not_allowed_on_host"
+
+	AttributeKeyConnectionID = "connection_id"
+	AttributeKeyMsgTypeURL   = "msg_type_url"
+)
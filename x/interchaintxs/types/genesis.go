@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +16,36 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.InterchainAccountChannels))
+	for _, channel := range gs.InterchainAccountChannels {
+		if err := host.PortIdentifierValidator(channel.PortId); err != nil {
+			return fmt.Errorf("invalid interchain account channel port id %s: %w", channel.PortId, err)
+		}
+		if err := host.ConnectionIdentifierValidator(channel.ConnectionId); err != nil {
+			return fmt.Errorf("invalid interchain account channel connection id %s: %w", channel.ConnectionId, err)
+		}
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return fmt.Errorf("invalid interchain account channel id %s: %w", channel.ChannelId, err)
+		}
+
+		key := string(GetInterchainAccountChannelKey(channel.ConnectionId, channel.PortId))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate interchain account channel for port %s and connection %s", channel.PortId, channel.ConnectionId)
+		}
+		seen[key] = struct{}{}
+	}
+
+	seenConnections := make(map[string]struct{}, len(gs.HostAllowMessages))
+	for _, hostAllowMessages := range gs.HostAllowMessages {
+		if err := hostAllowMessages.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := seenConnections[hostAllowMessages.ConnectionId]; ok {
+			return fmt.Errorf("duplicate host allow messages for connection %s", hostAllowMessages.ConnectionId)
+		}
+		seenConnections[hostAllowMessages.ConnectionId] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
@@ -25,7 +25,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the interchaintxs module's genesis state.
 type GenesisState struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                    Params                     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	InterchainAccountChannels []InterchainAccountChannel `protobuf:"bytes,2,rep,name=interchain_account_channels,json=interchainAccountChannels,proto3" json:"interchain_account_channels"`
+	HostAllowMessages         []HostAllowMessages        `protobuf:"bytes,3,rep,name=host_allow_messages,json=hostAllowMessages,proto3" json:"host_allow_messages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +70,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetInterchainAccountChannels() []InterchainAccountChannel {
+	if m != nil {
+		return m.InterchainAccountChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHostAllowMessages() []HostAllowMessages {
+	if m != nil {
+		return m.HostAllowMessages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.interchaintxs.v1.GenesisState")
 }
@@ -77,20 +93,27 @@ func init() {
 }
 
 var fileDescriptor_d16558b72a810826 = []byte{
-	// 208 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x2b, 0xa9,
-	0x28, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xaa, 0xd3, 0x43, 0x51, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x56, 0xa4, 0x0f, 0x62, 0x41, 0xd4, 0x4b, 0xa9, 0xe2, 0x34, 0xb7, 0x20, 0xb1,
-	0x28, 0x31, 0x17, 0x6a, 0xac, 0x92, 0x1f, 0x17, 0x8f, 0x3b, 0xc4, 0x9e, 0xe0, 0x92, 0xc4, 0x92,
-	0x54, 0x21, 0x3b, 0x2e, 0x36, 0x88, 0xbc, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x82, 0x1e,
-	0x2e, 0x7b, 0xf5, 0x02, 0xc0, 0xea, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x72,
-	0x0a, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x8b, 0xf4, 0xcc, 0x92,
-	0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xa8, 0x99, 0xba, 0xf9, 0x45, 0xe9, 0x30, 0xb6,
-	0x7e, 0x99, 0xa1, 0xa1, 0x7e, 0x05, 0x9a, 0x6b, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0,
-	0x4e, 0x35, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb8, 0x6b, 0xf8, 0xcf, 0x2b, 0x01, 0x00, 0x00,
+	// 316 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x31, 0x4b, 0xc4, 0x30,
+	0x14, 0xc7, 0xdb, 0x3b, 0xb9, 0xa1, 0xe7, 0x62, 0x75, 0xa8, 0x15, 0x62, 0x11, 0x94, 0x03, 0x31,
+	0xa1, 0x75, 0x71, 0x12, 0x4e, 0x07, 0x75, 0x10, 0xe4, 0xdc, 0x5c, 0x4a, 0xae, 0x84, 0xb4, 0xd0,
+	0x26, 0xa5, 0x49, 0x6b, 0xfd, 0x16, 0x7e, 0x0f, 0xbf, 0xc8, 0x8d, 0x37, 0x3a, 0x89, 0xb4, 0x5f,
+	0x44, 0xae, 0x8d, 0x8a, 0xde, 0x65, 0x7b, 0xbc, 0xf7, 0xff, 0xfd, 0xff, 0x79, 0x79, 0xd6, 0x09,
+	0x23, 0xa5, 0x2c, 0x38, 0x43, 0x09, 0x93, 0xa4, 0x88, 0x62, 0x9c, 0x30, 0x59, 0x0b, 0x54, 0xf9,
+	0x88, 0x12, 0x46, 0x44, 0x22, 0x60, 0x5e, 0x70, 0xc9, 0x6d, 0x47, 0xe9, 0xe0, 0x1f, 0x1d, 0xac,
+	0x7c, 0x77, 0x8f, 0x72, 0xca, 0x3b, 0x11, 0x5a, 0x55, 0xbd, 0xde, 0x0d, 0xb4, 0xbe, 0x31, 0x17,
+	0x32, 0xc4, 0x69, 0xca, 0x9f, 0xc3, 0x8c, 0x08, 0x81, 0x29, 0x51, 0x19, 0xae, 0xaf, 0x65, 0x7e,
+	0x1b, 0x21, 0x8e, 0x22, 0x5e, 0x32, 0xa9, 0x90, 0x63, 0x2d, 0x92, 0xe3, 0x02, 0x67, 0xca, 0xf9,
+	0xe8, 0x6d, 0x60, 0x6d, 0xdf, 0xf4, 0xfb, 0x3c, 0x4a, 0x2c, 0x89, 0x7d, 0x69, 0x8d, 0x7a, 0x81,
+	0x63, 0x7a, 0xe6, 0x64, 0x1c, 0x78, 0x50, 0xb7, 0x1f, 0x7c, 0xe8, 0x74, 0x57, 0x5b, 0x8b, 0x8f,
+	0x43, 0x63, 0xa6, 0x28, 0xbb, 0xb6, 0x0e, 0xd6, 0xdf, 0x14, 0x46, 0x31, 0x66, 0x8c, 0xa4, 0xc2,
+	0x19, 0x78, 0xc3, 0xc9, 0x38, 0x08, 0xf4, 0xa6, 0x77, 0x3f, 0x8d, 0x69, 0xcf, 0x5e, 0xf7, 0xa8,
+	0x8a, 0xd9, 0x4f, 0x34, 0x73, 0x61, 0x63, 0x6b, 0x77, 0xc3, 0x0f, 0x3a, 0xc3, 0x2e, 0xf1, 0x54,
+	0x9f, 0x78, 0xcb, 0x85, 0x9c, 0xae, 0x98, 0x7b, 0x85, 0xa8, 0xa8, 0x9d, 0x78, 0x6d, 0x30, 0x5b,
+	0x34, 0xc0, 0x5c, 0x36, 0xc0, 0xfc, 0x6c, 0x80, 0xf9, 0xda, 0x02, 0x63, 0xd9, 0x02, 0xe3, 0xbd,
+	0x05, 0xc6, 0xd3, 0x05, 0x4d, 0x64, 0x5c, 0xce, 0x61, 0xc4, 0x33, 0xa4, 0x92, 0xce, 0x78, 0x41,
+	0xbf, 0x6b, 0x54, 0xf9, 0x3e, 0xaa, 0xff, 0xdd, 0x42, 0xbe, 0xe4, 0x44, 0xcc, 0x47, 0xdd, 0x21,
+	0xce, 0xbf, 0x06, 0x00, 0xa1, 0x06, 0xa3, 0xe6, 0x70, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +136,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HostAllowMessages) > 0 {
+		for iNdEx := len(m.HostAllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HostAllowMessages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InterchainAccountChannels) > 0 {
+		for iNdEx := len(m.InterchainAccountChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InterchainAccountChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +196,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.InterchainAccountChannels) > 0 {
+		for _, e := range m.InterchainAccountChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HostAllowMessages) > 0 {
+		for _, e := range m.HostAllowMessages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +279,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterchainAccountChannels = append(m.InterchainAccountChannels, InterchainAccountChannel{})
+			if err := m.InterchainAccountChannels[len(m.InterchainAccountChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAllowMessages = append(m.HostAllowMessages, HostAllowMessages{})
+			if err := m.HostAllowMessages[len(m.HostAllowMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -37,6 +37,82 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid interchain account channels",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MsgSubmitTxMaxMessages: 10,
+				},
+				InterchainAccountChannels: []types.InterchainAccountChannel{
+					{PortId: "icacontroller-owner.ica0", ConnectionId: "connection-0", ChannelId: "channel-0", Status: types.ChannelStatus_CHANNEL_STATUS_OPEN},
+					{PortId: "icacontroller-owner.ica0", ConnectionId: "connection-1", ChannelId: "channel-1", Status: types.ChannelStatus_CHANNEL_STATUS_CLOSED},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate interchain account channel",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MsgSubmitTxMaxMessages: 10,
+				},
+				InterchainAccountChannels: []types.InterchainAccountChannel{
+					{PortId: "icacontroller-owner.ica0", ConnectionId: "connection-0", ChannelId: "channel-0"},
+					{PortId: "icacontroller-owner.ica0", ConnectionId: "connection-0", ChannelId: "channel-1"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid interchain account channel connection id",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MsgSubmitTxMaxMessages: 10,
+				},
+				InterchainAccountChannels: []types.InterchainAccountChannel{
+					{PortId: "icacontroller-owner.ica0", ConnectionId: "", ChannelId: "channel-0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid host allow messages",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MsgSubmitTxMaxMessages: 10,
+				},
+				HostAllowMessages: []types.HostAllowMessages{
+					{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}, RejectDisallowed: true},
+					{ConnectionId: "connection-1", AllowMessages: []string{types.AllowAllHostMsgs}},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate host allow messages",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MsgSubmitTxMaxMessages: 10,
+				},
+				HostAllowMessages: []types.HostAllowMessages{
+					{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+					{ConnectionId: "connection-0"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty host allow message type URL",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MsgSubmitTxMaxMessages: 10,
+				},
+				HostAllowMessages: []types.HostAllowMessages{
+					{ConnectionId: "connection-0", AllowMessages: []string{" "}},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
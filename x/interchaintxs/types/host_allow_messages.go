@@ -0,0 +1,37 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// AllowAllHostMsgs is the wildcard of the ICA host allow_messages param that allows any message.
+const AllowAllHostMsgs = "*"
+
+// Validate performs basic validation of the host allow messages.
+func (h HostAllowMessages) Validate() error {
+	if err := host.ConnectionIdentifierValidator(h.ConnectionId); err != nil {
+		return errors.Wrapf(ErrInvalidHostAllowMessages, "invalid connection id %s: %v", h.ConnectionId, err)
+	}
+
+	for _, typeURL := range h.AllowMessages {
+		if strings.TrimSpace(typeURL) == "" {
+			return errors.Wrap(ErrInvalidHostAllowMessages, "allow messages can't contain empty type URLs")
+		}
+	}
+
+	return nil
+}
+
+// IsAllowed returns whether a message of the given type URL is allowed on the host chain.
+func (h HostAllowMessages) IsAllowed(typeURL string) bool {
+	for _, allowed := range h.AllowMessages {
+		if allowed == AllowAllHostMsgs || allowed == typeURL {
+			return true
+		}
+	}
+
+	return false
+}
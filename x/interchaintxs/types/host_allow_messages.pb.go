@@ -0,0 +1,425 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/interchaintxs/v1/host_allow_messages.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HostAllowMessages is the cached copy of the allow_messages param of the ICA
+// host module of the chain on the other side of a connection. It is used to
+// check the messages of MsgSubmitTx before they are sent to the host.
+type HostAllowMessages struct {
+	// connection to the host chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// type URLs of the messages allowed on the host chain, "*" allows any message
+	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// if set, MsgSubmitTx with messages not allowed on the host chain is
+	// rejected, otherwise only a warning event is emitted
+	RejectDisallowed bool `protobuf:"varint,3,opt,name=reject_disallowed,json=rejectDisallowed,proto3" json:"reject_disallowed,omitempty"`
+}
+
+func (m *HostAllowMessages) Reset()         { *m = HostAllowMessages{} }
+func (m *HostAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*HostAllowMessages) ProtoMessage()    {}
+func (*HostAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b279b33b7b56b7c, []int{0}
+}
+func (m *HostAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HostAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HostAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HostAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostAllowMessages.Merge(m, src)
+}
+func (m *HostAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *HostAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostAllowMessages proto.InternalMessageInfo
+
+func (m *HostAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *HostAllowMessages) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+func (m *HostAllowMessages) GetRejectDisallowed() bool {
+	if m != nil {
+		return m.RejectDisallowed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*HostAllowMessages)(nil), "neutron.interchaintxs.v1.HostAllowMessages")
+}
+
+func init() {
+	proto.RegisterFile("neutron/interchaintxs/v1/host_allow_messages.proto", fileDescriptor_4b279b33b7b56b7c)
+}
+
+var fileDescriptor_4b279b33b7b56b7c = []byte{
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xca, 0x4b, 0x2d, 0x2d,
+	0x29, 0xca, 0xcf, 0xd3, 0xcf, 0xcc, 0x2b, 0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x2b, 0xa9,
+	0x28, 0xd6, 0x2f, 0x33, 0xd4, 0xcf, 0xc8, 0x2f, 0x2e, 0x89, 0x4f, 0xcc, 0xc9, 0xc9, 0x2f, 0x8f,
+	0xcf, 0x4d, 0x2d, 0x2e, 0x4e, 0x4c, 0x4f, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
+	0x80, 0xea, 0xd1, 0x43, 0xd1, 0xa3, 0x57, 0x66, 0xa8, 0xd4, 0xc3, 0xc8, 0x25, 0xe8, 0x91, 0x5f,
+	0x5c, 0xe2, 0x08, 0xd2, 0xe6, 0x0b, 0xd5, 0x25, 0xa4, 0xcc, 0xc5, 0x9b, 0x9c, 0x9f, 0x97, 0x97,
+	0x9a, 0x5c, 0x92, 0x99, 0x9f, 0x17, 0x9f, 0x99, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4,
+	0x83, 0x10, 0xf4, 0x4c, 0x11, 0x52, 0xe5, 0xe2, 0x43, 0xb5, 0x4c, 0x82, 0x49, 0x81, 0x59, 0x83,
+	0x33, 0x88, 0x37, 0x11, 0xc5, 0x2c, 0x6d, 0x2e, 0xc1, 0xa2, 0xd4, 0xac, 0xd4, 0xe4, 0x92, 0xf8,
+	0x94, 0xcc, 0x62, 0xb0, 0x54, 0x6a, 0x8a, 0x04, 0xb3, 0x02, 0xa3, 0x06, 0x47, 0x90, 0x00, 0x44,
+	0xc2, 0x05, 0x2e, 0xee, 0x14, 0x74, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
+	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
+	0x16, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0xdf, 0xe8, 0xe6,
+	0x17, 0xa5, 0xc3, 0xd8, 0xfa, 0x65, 0x86, 0x86, 0xfa, 0x15, 0x68, 0x61, 0x52, 0x52, 0x59, 0x90,
+	0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x03, 0x63, 0xc0, 0x00, 0x11, 0x82, 0xfd, 0x12, 0x39, 0x01, 0x00,
+	0x00,
+}
+
+func (m *HostAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HostAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HostAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectDisallowed {
+		i--
+		if m.RejectDisallowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHostAllowMessages(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHostAllowMessages(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHostAllowMessages(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHostAllowMessages(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HostAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHostAllowMessages(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHostAllowMessages(uint64(l))
+		}
+	}
+	if m.RejectDisallowed {
+		n += 2
+	}
+	return n
+}
+
+func sovHostAllowMessages(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHostAllowMessages(x uint64) (n int) {
+	return sovHostAllowMessages(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HostAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHostAllowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostAllowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostAllowMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostAllowMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostAllowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHostAllowMessages
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHostAllowMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectDisallowed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHostAllowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectDisallowed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHostAllowMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHostAllowMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHostAllowMessages(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHostAllowMessages
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHostAllowMessages
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHostAllowMessages
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHostAllowMessages
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHostAllowMessages
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHostAllowMessages
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHostAllowMessages        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHostAllowMessages          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHostAllowMessages = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,597 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/interchaintxs/v1/interchain_account.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelStatus is the status of the channel of an interchain account.
+type ChannelStatus int32
+
+const (
+	// The status is unknown
+	ChannelStatus_CHANNEL_STATUS_UNSPECIFIED ChannelStatus = 0
+	// The channel is being opened for the first time
+	ChannelStatus_CHANNEL_STATUS_OPENING ChannelStatus = 1
+	// The channel is open and can be used to submit transactions
+	ChannelStatus_CHANNEL_STATUS_OPEN ChannelStatus = 2
+	// The channel is closed, e.g. an ordered channel after a packet timeout
+	ChannelStatus_CHANNEL_STATUS_CLOSED ChannelStatus = 3
+	// A new channel is being opened for the account after the previous one was
+	// closed
+	ChannelStatus_CHANNEL_STATUS_REOPENING ChannelStatus = 4
+)
+
+var ChannelStatus_name = map[int32]string{
+	0: "CHANNEL_STATUS_UNSPECIFIED",
+	1: "CHANNEL_STATUS_OPENING",
+	2: "CHANNEL_STATUS_OPEN",
+	3: "CHANNEL_STATUS_CLOSED",
+	4: "CHANNEL_STATUS_REOPENING",
+}
+
+var ChannelStatus_value = map[string]int32{
+	"CHANNEL_STATUS_UNSPECIFIED": 0,
+	"CHANNEL_STATUS_OPENING":     1,
+	"CHANNEL_STATUS_OPEN":        2,
+	"CHANNEL_STATUS_CLOSED":      3,
+	"CHANNEL_STATUS_REOPENING":   4,
+}
+
+func (x ChannelStatus) String() string {
+	return proto.EnumName(ChannelStatus_name, int32(x))
+}
+
+func (ChannelStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_aa8a44f1953098d9, []int{0}
+}
+
+// InterchainAccountChannel tracks the state of the channel of an interchain
+// account.
+type InterchainAccountChannel struct {
+	// controller port of the interchain account
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// connection the interchain account is registered on
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// current channel of the interchain account
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// ordering of the channel, reused when the channel is reopened
+	Ordering types.Order `protobuf:"varint,4,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// status of the channel
+	Status ChannelStatus `protobuf:"varint,5,opt,name=status,proto3,enum=neutron.interchaintxs.v1.ChannelStatus" json:"status,omitempty"`
+	// if set, a new channel with the same port and ordering is opened
+	// automatically once the channel is closed
+	AutoReopen bool `protobuf:"varint,6,opt,name=auto_reopen,json=autoReopen,proto3" json:"auto_reopen,omitempty"`
+}
+
+func (m *InterchainAccountChannel) Reset()         { *m = InterchainAccountChannel{} }
+func (m *InterchainAccountChannel) String() string { return proto.CompactTextString(m) }
+func (*InterchainAccountChannel) ProtoMessage()    {}
+func (*InterchainAccountChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa8a44f1953098d9, []int{0}
+}
+func (m *InterchainAccountChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InterchainAccountChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InterchainAccountChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InterchainAccountChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterchainAccountChannel.Merge(m, src)
+}
+func (m *InterchainAccountChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *InterchainAccountChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterchainAccountChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterchainAccountChannel proto.InternalMessageInfo
+
+func (m *InterchainAccountChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *InterchainAccountChannel) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *InterchainAccountChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *InterchainAccountChannel) GetOrdering() types.Order {
+	if m != nil {
+		return m.Ordering
+	}
+	return types.NONE
+}
+
+func (m *InterchainAccountChannel) GetStatus() ChannelStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ChannelStatus_CHANNEL_STATUS_UNSPECIFIED
+}
+
+func (m *InterchainAccountChannel) GetAutoReopen() bool {
+	if m != nil {
+		return m.AutoReopen
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("neutron.interchaintxs.v1.ChannelStatus", ChannelStatus_name, ChannelStatus_value)
+	proto.RegisterType((*InterchainAccountChannel)(nil), "neutron.interchaintxs.v1.InterchainAccountChannel")
+}
+
+func init() {
+	proto.RegisterFile("neutron/interchaintxs/v1/interchain_account.proto", fileDescriptor_aa8a44f1953098d9)
+}
+
+var fileDescriptor_aa8a44f1953098d9 = []byte{
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6f, 0x94, 0x40,
+	0x14, 0xc7, 0x77, 0xb6, 0x15, 0xdb, 0xa7, 0x35, 0x64, 0x8c, 0x16, 0x89, 0xe2, 0xaa, 0x07, 0x37,
+	0x26, 0x0e, 0x41, 0x13, 0xe3, 0xcd, 0xac, 0x14, 0x95, 0xa4, 0x61, 0x1b, 0x68, 0x2f, 0x5e, 0x08,
+	0x0c, 0x93, 0xdd, 0x49, 0x74, 0x86, 0x0c, 0x03, 0xa9, 0xdf, 0x42, 0x3f, 0x80, 0xdf, 0xc7, 0x63,
+	0x8f, 0x1e, 0xcd, 0xee, 0x17, 0x31, 0xb0, 0x6c, 0xb5, 0x44, 0x6f, 0x8f, 0xff, 0xff, 0xff, 0x7b,
+	0xbc, 0x37, 0x79, 0xe0, 0x09, 0x56, 0x6b, 0x25, 0x85, 0xcb, 0x85, 0x66, 0x8a, 0x2e, 0x33, 0x2e,
+	0xf4, 0x79, 0xe5, 0x36, 0xde, 0x5f, 0x42, 0x9a, 0x51, 0x2a, 0x6b, 0xa1, 0x49, 0xa9, 0xa4, 0x96,
+	0xd8, 0xea, 0x11, 0x72, 0x05, 0x21, 0x8d, 0x67, 0x3f, 0xe2, 0x39, 0x75, 0xa9, 0x54, 0xcc, 0xa5,
+	0xcb, 0x4c, 0x08, 0xf6, 0xa9, 0xed, 0xd3, 0x97, 0x1b, 0xf8, 0xf1, 0xb7, 0x31, 0x58, 0xe1, 0x25,
+	0x37, 0xdb, 0x34, 0xf6, 0x37, 0x11, 0x7c, 0x08, 0xd7, 0x4b, 0xa9, 0x74, 0xca, 0x0b, 0x0b, 0x4d,
+	0xd0, 0x74, 0x3f, 0x36, 0xda, 0xcf, 0xb0, 0xc0, 0x4f, 0xe0, 0x80, 0x4a, 0x21, 0x18, 0xd5, 0x5c,
+	0x8a, 0xd6, 0x1e, 0x77, 0xf6, 0xcd, 0x3f, 0x62, 0x58, 0xe0, 0x07, 0x00, 0xfd, 0xbf, 0xda, 0xc4,
+	0x4e, 0x97, 0xd8, 0xef, 0x95, 0xb0, 0xc0, 0xaf, 0x60, 0x4f, 0xaa, 0x82, 0x29, 0x2e, 0x16, 0xd6,
+	0xee, 0x04, 0x4d, 0x6f, 0xbd, 0xb0, 0x09, 0xcf, 0x29, 0x69, 0xe7, 0x25, 0xdb, 0x21, 0x1b, 0x8f,
+	0xcc, 0xdb, 0x50, 0x7c, 0x99, 0xc5, 0x6f, 0xc0, 0xa8, 0x74, 0xa6, 0xeb, 0xca, 0xba, 0xd6, 0x51,
+	0x4f, 0xc9, 0xff, 0xf6, 0x27, 0xfd, 0x1e, 0x49, 0x17, 0x8f, 0x7b, 0x0c, 0x3f, 0x84, 0x1b, 0x59,
+	0xad, 0x65, 0xaa, 0x98, 0x2c, 0x99, 0xb0, 0x8c, 0x09, 0x9a, 0xee, 0xc5, 0xd0, 0x4a, 0x71, 0xa7,
+	0x3c, 0xfb, 0x8e, 0xe0, 0xe0, 0x0a, 0x8a, 0x1d, 0xb0, 0xfd, 0x0f, 0xb3, 0x28, 0x0a, 0x8e, 0xd3,
+	0xe4, 0x74, 0x76, 0x7a, 0x96, 0xa4, 0x67, 0x51, 0x72, 0x12, 0xf8, 0xe1, 0xbb, 0x30, 0x38, 0x32,
+	0x47, 0xd8, 0x86, 0xbb, 0x03, 0x7f, 0x7e, 0x12, 0x44, 0x61, 0xf4, 0xde, 0x44, 0xf8, 0x10, 0x6e,
+	0xff, 0xc3, 0x33, 0xc7, 0xf8, 0x1e, 0xdc, 0x19, 0x18, 0xfe, 0xf1, 0x3c, 0x09, 0x8e, 0xcc, 0x1d,
+	0x7c, 0x1f, 0xac, 0x81, 0x15, 0x07, 0xdb, 0x8e, 0xbb, 0x6f, 0xe3, 0x1f, 0x2b, 0x07, 0x5d, 0xac,
+	0x1c, 0xf4, 0x6b, 0xe5, 0xa0, 0xaf, 0x6b, 0x67, 0x74, 0xb1, 0x76, 0x46, 0x3f, 0xd7, 0xce, 0xe8,
+	0xe3, 0xeb, 0x05, 0xd7, 0xcb, 0x3a, 0x27, 0x54, 0x7e, 0x76, 0xfb, 0x57, 0x79, 0x2e, 0xd5, 0x62,
+	0x5b, 0xbb, 0x8d, 0xe7, 0xb9, 0xe7, 0x83, 0xd3, 0xd2, 0x5f, 0x4a, 0x56, 0xe5, 0x46, 0x77, 0x0e,
+	0x2f, 0x7f, 0x0f, 0x00, 0x49, 0xd3, 0x82, 0x34, 0x80, 0x02, 0x00, 0x00,
+}
+
+func (m *InterchainAccountChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InterchainAccountChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InterchainAccountChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoReopen {
+		i--
+		if m.AutoReopen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintInterchainAccount(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Ordering != 0 {
+		i = encodeVarintInterchainAccount(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintInterchainAccount(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintInterchainAccount(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintInterchainAccount(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInterchainAccount(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInterchainAccount(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InterchainAccountChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovInterchainAccount(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovInterchainAccount(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovInterchainAccount(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovInterchainAccount(uint64(m.Ordering))
+	}
+	if m.Status != 0 {
+		n += 1 + sovInterchainAccount(uint64(m.Status))
+	}
+	if m.AutoReopen {
+		n += 2
+	}
+	return n
+}
+
+func sovInterchainAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInterchainAccount(x uint64) (n int) {
+	return sovInterchainAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InterchainAccountChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInterchainAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InterchainAccountChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InterchainAccountChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= types.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChannelStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReopen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReopen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInterchainAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInterchainAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInterchainAccount(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInterchainAccount
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInterchainAccount
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInterchainAccount
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInterchainAccount
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInterchainAccount
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInterchainAccount        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInterchainAccount          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInterchainAccount = fmt.Errorf("proto: unexpected end of group")
+)
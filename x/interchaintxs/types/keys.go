@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "interchaintxs"
@@ -22,9 +24,53 @@ const (
 	prefixParamsKey = iota + 1
 	// prefix of code id, starting from which we charge fee for ICA registration
 	prefixICARegistrationFeeFirstCodeID = iota + 2
+	// prefix of the transaction batches of split MsgSubmitTx
+	prefixTxBatch = iota + 2
+	// key of the last transaction batch id
+	prefixLastTxBatchID = iota + 2
+	// prefix of the packet to transaction batch id mapping
+	prefixPacketTxBatchID = iota + 2
+	// prefix of the interchain account channels
+	prefixInterchainAccountChannel = iota + 2
+	// prefix of the channel to connection id mapping of the interchain account channels
+	prefixChannelConnectionID = iota + 2
+	// prefix of the cached allow_messages params of the ICA host modules
+	prefixHostAllowMessages = iota + 2
+
+	Separator = ";"
 )
 
 var (
 	ParamsKey                     = []byte{prefixParamsKey}
 	ICARegistrationFeeFirstCodeID = []byte{prefixICARegistrationFeeFirstCodeID}
+	TxBatchKey                    = []byte{prefixTxBatch}
+	LastTxBatchIDKey              = []byte{prefixLastTxBatchID}
+	PacketTxBatchIDKey            = []byte{prefixPacketTxBatchID}
+	InterchainAccountChannelKey   = []byte{prefixInterchainAccountChannel}
+	ChannelConnectionIDKey        = []byte{prefixChannelConnectionID}
+	HostAllowMessagesKey          = []byte{prefixHostAllowMessages}
 )
+
+func GetTxBatchKey(batchID uint64) []byte {
+	return append(TxBatchKey, sdk.Uint64ToBigEndian(batchID)...)
+}
+
+func GetPacketTxBatchIDKey(portID, channelID string, sequence uint64) []byte {
+	return append(GetChannelPacketTxBatchIDsPrefix(portID, channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+func GetChannelPacketTxBatchIDsPrefix(portID, channelID string) []byte {
+	return append(PacketTxBatchIDKey, []byte(channelID+Separator+portID+Separator)...)
+}
+
+func GetInterchainAccountChannelKey(connectionID, portID string) []byte {
+	return append(InterchainAccountChannelKey, []byte(connectionID+Separator+portID)...)
+}
+
+func GetChannelConnectionIDKey(portID, channelID string) []byte {
+	return append(ChannelConnectionIDKey, []byte(channelID+Separator+portID)...)
+}
+
+func GetHostAllowMessagesKey(connectionID string) []byte {
+	return append(HostAllowMessagesKey, []byte(connectionID)...)
+}
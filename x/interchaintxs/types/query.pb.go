@@ -204,11 +204,194 @@ func (m *QueryInterchainAccountAddressResponse) GetInterchainAccountAddress() st
 	return ""
 }
 
+type QueryInterchainAccountStatusRequest struct {
+	// owner_address is the owner of the interchain account on the controller
+	// chain
+	OwnerAddress string `protobuf:"bytes,1,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
+	// interchain_account_id is an identifier of the interchain account
+	InterchainAccountId string `protobuf:"bytes,2,opt,name=interchain_account_id,json=interchainAccountId,proto3" json:"interchain_account_id,omitempty"`
+	// connection_id is an IBC connection identifier between Neutron and remote
+	// chain
+	ConnectionId string `protobuf:"bytes,3,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryInterchainAccountStatusRequest) Reset()         { *m = QueryInterchainAccountStatusRequest{} }
+func (m *QueryInterchainAccountStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountStatusRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6130c5f6c54e2428, []int{4}
+}
+func (m *QueryInterchainAccountStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountStatusRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountStatusRequest proto.InternalMessageInfo
+
+// Query response for the state of the channel of an interchain account
+type QueryInterchainAccountStatusResponse struct {
+	Channel InterchainAccountChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel"`
+}
+
+func (m *QueryInterchainAccountStatusResponse) Reset()         { *m = QueryInterchainAccountStatusResponse{} }
+func (m *QueryInterchainAccountStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountStatusResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6130c5f6c54e2428, []int{5}
+}
+func (m *QueryInterchainAccountStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountStatusResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountStatusResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountStatusResponse) GetChannel() InterchainAccountChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return InterchainAccountChannel{}
+}
+
+type QueryHostAllowMessagesRequest struct {
+	// connection_id is an IBC connection identifier between Neutron and remote
+	// chain
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryHostAllowMessagesRequest) Reset()         { *m = QueryHostAllowMessagesRequest{} }
+func (m *QueryHostAllowMessagesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHostAllowMessagesRequest) ProtoMessage()    {}
+func (*QueryHostAllowMessagesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6130c5f6c54e2428, []int{6}
+}
+func (m *QueryHostAllowMessagesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostAllowMessagesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostAllowMessagesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostAllowMessagesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostAllowMessagesRequest.Merge(m, src)
+}
+func (m *QueryHostAllowMessagesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostAllowMessagesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostAllowMessagesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostAllowMessagesRequest proto.InternalMessageInfo
+
+func (m *QueryHostAllowMessagesRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+type QueryHostAllowMessagesResponse struct {
+	HostAllowMessages HostAllowMessages `protobuf:"bytes,1,opt,name=host_allow_messages,json=hostAllowMessages,proto3" json:"host_allow_messages"`
+}
+
+func (m *QueryHostAllowMessagesResponse) Reset()         { *m = QueryHostAllowMessagesResponse{} }
+func (m *QueryHostAllowMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHostAllowMessagesResponse) ProtoMessage()    {}
+func (*QueryHostAllowMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6130c5f6c54e2428, []int{7}
+}
+func (m *QueryHostAllowMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHostAllowMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHostAllowMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHostAllowMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHostAllowMessagesResponse.Merge(m, src)
+}
+func (m *QueryHostAllowMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHostAllowMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHostAllowMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHostAllowMessagesResponse proto.InternalMessageInfo
+
+func (m *QueryHostAllowMessagesResponse) GetHostAllowMessages() HostAllowMessages {
+	if m != nil {
+		return m.HostAllowMessages
+	}
+	return HostAllowMessages{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "neutron.interchaintxs.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "neutron.interchaintxs.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryInterchainAccountAddressRequest)(nil), "neutron.interchaintxs.v1.QueryInterchainAccountAddressRequest")
 	proto.RegisterType((*QueryInterchainAccountAddressResponse)(nil), "neutron.interchaintxs.v1.QueryInterchainAccountAddressResponse")
+	proto.RegisterType((*QueryInterchainAccountStatusRequest)(nil), "neutron.interchaintxs.v1.QueryInterchainAccountStatusRequest")
+	proto.RegisterType((*QueryInterchainAccountStatusResponse)(nil), "neutron.interchaintxs.v1.QueryInterchainAccountStatusResponse")
+	proto.RegisterType((*QueryHostAllowMessagesRequest)(nil), "neutron.interchaintxs.v1.QueryHostAllowMessagesRequest")
+	proto.RegisterType((*QueryHostAllowMessagesResponse)(nil), "neutron.interchaintxs.v1.QueryHostAllowMessagesResponse")
 }
 
 func init() {
@@ -216,38 +399,49 @@ func init() {
 }
 
 var fileDescriptor_6130c5f6c54e2428 = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcf, 0x6a, 0xd4, 0x40,
-	0x18, 0x4f, 0xb6, 0xba, 0xe8, 0xa8, 0x97, 0x69, 0x85, 0x10, 0x34, 0x5b, 0x62, 0x17, 0x44, 0x6c,
-	0x86, 0xac, 0x17, 0x11, 0xa9, 0xb4, 0xb7, 0xbd, 0xe9, 0x82, 0x17, 0x2f, 0xcb, 0x6c, 0x32, 0xa4,
-	0x83, 0xee, 0x7c, 0xd9, 0xcc, 0x24, 0xb6, 0x2c, 0x7b, 0xf1, 0xe4, 0x41, 0x44, 0xf0, 0x05, 0x7a,
-	0xf3, 0xe6, 0x73, 0xf4, 0x58, 0xf0, 0xe2, 0x49, 0x64, 0xd7, 0x83, 0x8f, 0x21, 0x99, 0x49, 0x5b,
-	0xb2, 0x6e, 0x58, 0xf1, 0x36, 0x7c, 0xf9, 0xfd, 0xfb, 0xfe, 0x04, 0xed, 0x08, 0x96, 0xab, 0x0c,
-	0x04, 0xe1, 0x42, 0xb1, 0x2c, 0x3a, 0xa4, 0x5c, 0xa8, 0x23, 0x49, 0x8a, 0x90, 0x4c, 0x72, 0x96,
-	0x1d, 0x07, 0x69, 0x06, 0x0a, 0xb0, 0x53, 0xa1, 0x82, 0x1a, 0x2a, 0x28, 0x42, 0xf7, 0x41, 0x04,
-	0x72, 0x0c, 0x92, 0x8c, 0xa8, 0x64, 0x86, 0x42, 0x8a, 0x70, 0xc4, 0x14, 0x0d, 0x49, 0x4a, 0x13,
-	0x2e, 0xa8, 0xe2, 0x20, 0x8c, 0x8a, 0xbb, 0x95, 0x40, 0x02, 0xfa, 0x49, 0xca, 0x57, 0x55, 0xbd,
-	0x93, 0x00, 0x24, 0x6f, 0x18, 0xa1, 0x29, 0x27, 0x54, 0x08, 0x50, 0x9a, 0x22, 0xab, 0xaf, 0xdd,
-	0xc6, 0x7c, 0x29, 0xcd, 0xe8, 0xb8, 0x82, 0xf9, 0x5b, 0x08, 0xbf, 0x28, 0xcd, 0x9f, 0xeb, 0xe2,
-	0x80, 0x4d, 0x72, 0x26, 0x95, 0xff, 0x12, 0x6d, 0xd6, 0xaa, 0x32, 0x05, 0x21, 0x19, 0xde, 0x43,
-	0x6d, 0x43, 0x76, 0xec, 0x6d, 0xfb, 0xfe, 0x8d, 0xde, 0x76, 0xd0, 0xd4, 0x5e, 0x60, 0x98, 0x07,
-	0x57, 0x4e, 0x7f, 0x74, 0xac, 0x41, 0xc5, 0xf2, 0xbf, 0xda, 0x68, 0x47, 0xeb, 0xf6, 0x2f, 0xe0,
-	0xfb, 0x51, 0x04, 0xb9, 0x50, 0xfb, 0x71, 0x9c, 0x31, 0x79, 0xee, 0x8f, 0xef, 0xa1, 0x5b, 0xf0,
-	0x56, 0xb0, 0x6c, 0x48, 0x4d, 0x5d, 0xfb, 0x5d, 0x1f, 0xdc, 0xd4, 0xc5, 0x0a, 0x8b, 0x7b, 0xe8,
-	0xf6, 0xa5, 0xed, 0x90, 0x1a, 0xa1, 0x21, 0x8f, 0x9d, 0x96, 0x06, 0x6f, 0xf2, 0x65, 0x93, 0x7e,
-	0x5c, 0x0a, 0x47, 0x20, 0x04, 0x8b, 0xca, 0x51, 0x95, 0xd8, 0x0d, 0x23, 0x7c, 0x59, 0xec, 0xc7,
-	0x4f, 0xae, 0xbd, 0x3f, 0xe9, 0x58, 0xbf, 0x4f, 0x3a, 0x96, 0xcf, 0x50, 0x77, 0x4d, 0xde, 0x6a,
-	0x32, 0x4f, 0x91, 0xbb, 0x22, 0x4b, 0x3d, 0xbd, 0xc3, 0x1b, 0x54, 0x7a, 0x5f, 0x36, 0xd0, 0x55,
-	0xed, 0x83, 0x3f, 0xd8, 0xa8, 0x6d, 0x46, 0x87, 0x1f, 0x36, 0x0f, 0xf7, 0xef, 0x8d, 0xb9, 0xbb,
-	0xff, 0x88, 0x36, 0x79, 0xfd, 0xee, 0xbb, 0x6f, 0xbf, 0x3e, 0xb7, 0x3a, 0xf8, 0x2e, 0x59, 0x7d,
-	0x26, 0x66, 0x61, 0xf8, 0x63, 0x0b, 0x39, 0x4d, 0xbd, 0xe3, 0xbd, 0x35, 0x96, 0x6b, 0x96, 0xec,
-	0x3e, 0xfb, 0x6f, 0x7e, 0xd5, 0xc4, 0x44, 0x37, 0xf1, 0x1a, 0xf3, 0x86, 0x26, 0xa6, 0xb5, 0x1b,
-	0x9a, 0x91, 0xe9, 0xca, 0x73, 0x99, 0x91, 0x69, 0xed, 0x24, 0x66, 0xa4, 0x79, 0x93, 0x07, 0x83,
-	0xd3, 0xb9, 0x67, 0x9f, 0xcd, 0x3d, 0xfb, 0xe7, 0xdc, 0xb3, 0x3f, 0x2d, 0x3c, 0xeb, 0x6c, 0xe1,
-	0x59, 0xdf, 0x17, 0x9e, 0xf5, 0xea, 0x71, 0xc2, 0xd5, 0x61, 0x3e, 0x0a, 0x22, 0x18, 0x9f, 0xc7,
-	0xd9, 0x85, 0x2c, 0xb9, 0x88, 0x56, 0x84, 0x21, 0x39, 0x5a, 0x0a, 0xa8, 0x8e, 0x53, 0x26, 0x47,
-	0x6d, 0xfd, 0x27, 0x3e, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x18, 0xe5, 0xd5, 0x52, 0x04,
-	0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0x41, 0x6b, 0x13, 0x4d,
+	0x18, 0xce, 0xe6, 0xfb, 0x6c, 0x75, 0xd4, 0x43, 0xa7, 0x15, 0xc3, 0x62, 0x37, 0x65, 0xdb, 0x82,
+	0xa8, 0xcd, 0xb0, 0xf1, 0x60, 0x29, 0xb5, 0xd2, 0xea, 0xc1, 0x1e, 0x04, 0x8d, 0x78, 0xf1, 0x12,
+	0x26, 0x9b, 0x61, 0xb3, 0x9a, 0xcc, 0x6c, 0x77, 0x26, 0x69, 0x6b, 0xe9, 0x41, 0xbd, 0x88, 0x88,
+	0x08, 0xfe, 0x81, 0xfe, 0x01, 0xfd, 0x1d, 0xc5, 0x53, 0xc1, 0x8b, 0x27, 0x91, 0xd6, 0x83, 0x3f,
+	0x43, 0x76, 0x66, 0xd2, 0xba, 0xd9, 0x1d, 0x63, 0x0b, 0x82, 0xb7, 0xe5, 0xdd, 0xf7, 0x79, 0xde,
+	0xf7, 0x79, 0xf2, 0x3e, 0x59, 0x30, 0x43, 0x49, 0x57, 0xc4, 0x8c, 0xa2, 0x90, 0x0a, 0x12, 0xfb,
+	0x2d, 0x1c, 0x52, 0xb1, 0xc1, 0x51, 0xcf, 0x43, 0x6b, 0x5d, 0x12, 0x6f, 0x56, 0xa2, 0x98, 0x09,
+	0x06, 0x4b, 0xba, 0xab, 0x92, 0xea, 0xaa, 0xf4, 0x3c, 0xfb, 0x8a, 0xcf, 0x78, 0x87, 0x71, 0xd4,
+	0xc0, 0x9c, 0x28, 0x08, 0xea, 0x79, 0x0d, 0x22, 0xb0, 0x87, 0x22, 0x1c, 0x84, 0x14, 0x8b, 0x90,
+	0x51, 0xc5, 0x62, 0x4f, 0x04, 0x2c, 0x60, 0xf2, 0x11, 0x25, 0x4f, 0xba, 0x7a, 0x29, 0x60, 0x2c,
+	0x68, 0x13, 0x84, 0xa3, 0x10, 0x61, 0x4a, 0x99, 0x90, 0x10, 0xae, 0xdf, 0x56, 0x8d, 0xfb, 0xb5,
+	0x18, 0x17, 0x75, 0xdc, 0x6e, 0xb3, 0xf5, 0x7a, 0x87, 0x70, 0x8e, 0x03, 0xd2, 0xc7, 0x78, 0x46,
+	0xcc, 0x51, 0xa1, 0x8e, 0x7d, 0x9f, 0x75, 0xa9, 0xd0, 0x90, 0x59, 0x23, 0x24, 0xc2, 0x31, 0xee,
+	0x68, 0x66, 0x77, 0x02, 0xc0, 0x07, 0x89, 0xc6, 0xfb, 0xb2, 0x58, 0x23, 0x6b, 0x5d, 0xc2, 0x85,
+	0xfb, 0x08, 0x8c, 0xa7, 0xaa, 0x3c, 0x62, 0x94, 0x13, 0xb8, 0x04, 0x46, 0x14, 0xb8, 0x64, 0x4d,
+	0x59, 0x97, 0xcf, 0x56, 0xa7, 0x2a, 0x26, 0x17, 0x2b, 0x0a, 0xb9, 0xf2, 0xff, 0xee, 0xd7, 0x72,
+	0xa1, 0xa6, 0x51, 0xee, 0x47, 0x0b, 0xcc, 0x48, 0xde, 0xd5, 0xc3, 0xf6, 0x65, 0xb5, 0xf4, 0x72,
+	0xb3, 0x19, 0x13, 0xde, 0x9f, 0x0f, 0xa7, 0xc1, 0x79, 0xb6, 0x4e, 0x49, 0x5c, 0xc7, 0xaa, 0x2e,
+	0xe7, 0x9d, 0xa9, 0x9d, 0x93, 0x45, 0xdd, 0x0b, 0xab, 0xe0, 0x42, 0x56, 0x7d, 0x3d, 0x6c, 0x96,
+	0x8a, 0xb2, 0x79, 0x3c, 0x1c, 0x1c, 0xb2, 0xda, 0x4c, 0x88, 0x7d, 0x46, 0x29, 0xf1, 0x93, 0x5f,
+	0x24, 0xe9, 0xfd, 0x4f, 0x11, 0x1f, 0x15, 0x57, 0x9b, 0x0b, 0xa7, 0x5f, 0xed, 0x94, 0x0b, 0x3f,
+	0x76, 0xca, 0x05, 0x97, 0x80, 0xd9, 0x21, 0xfb, 0x6a, 0x67, 0x16, 0x81, 0x9d, 0xb3, 0x4b, 0x7a,
+	0xfb, 0x52, 0x68, 0x60, 0x71, 0x3f, 0x58, 0x60, 0x3a, 0x7f, 0xce, 0x43, 0x81, 0x45, 0xf7, 0x9f,
+	0xb3, 0xe5, 0x19, 0x98, 0xf9, 0xfd, 0xba, 0xda, 0x95, 0x1a, 0x18, 0xf5, 0x5b, 0x98, 0x52, 0xd2,
+	0xd6, 0x07, 0x53, 0x35, 0x1f, 0x4c, 0x86, 0xeb, 0xb6, 0x42, 0xea, 0x13, 0xea, 0x13, 0xb9, 0x77,
+	0xc0, 0xa4, 0x9c, 0x7d, 0x97, 0x71, 0xb1, 0x9c, 0x64, 0xe5, 0x9e, 0x8e, 0xca, 0x2f, 0x26, 0xa5,
+	0xb5, 0x58, 0x59, 0x2d, 0xee, 0x4b, 0x0b, 0x38, 0x26, 0x1a, 0xbd, 0x3c, 0x06, 0xe3, 0x39, 0x81,
+	0xd4, 0x42, 0xae, 0x9a, 0x85, 0x64, 0x18, 0xb5, 0x82, 0xb1, 0xd6, 0xe0, 0x8b, 0xea, 0xf3, 0x51,
+	0x70, 0x4a, 0x6e, 0x01, 0xdf, 0x58, 0x60, 0x44, 0x45, 0x06, 0x5e, 0x33, 0x53, 0x67, 0x93, 0x6a,
+	0xcf, 0xfd, 0x61, 0xb7, 0x12, 0xe5, 0xce, 0xbe, 0xf8, 0xfc, 0xfd, 0x7d, 0xb1, 0x0c, 0x27, 0x51,
+	0xfe, 0xdf, 0x83, 0x0a, 0x2a, 0x7c, 0x5b, 0x04, 0x25, 0xd3, 0xcd, 0xc3, 0xa5, 0x21, 0x23, 0x87,
+	0x84, 0xdb, 0xbe, 0x75, 0x62, 0xbc, 0x16, 0xb1, 0x26, 0x45, 0x3c, 0x85, 0xa1, 0x41, 0xc4, 0x56,
+	0x2a, 0x24, 0xdb, 0x68, 0x2b, 0x37, 0x0f, 0xdb, 0x68, 0x2b, 0x75, 0x27, 0xdb, 0xc8, 0x9c, 0x60,
+	0xf8, 0xba, 0x08, 0x2e, 0x1a, 0xae, 0x1d, 0xde, 0x3c, 0xae, 0x9e, 0x54, 0xa8, 0xed, 0xa5, 0x93,
+	0xc2, 0xb5, 0x1b, 0x91, 0x74, 0xe3, 0x09, 0x6c, 0xfd, 0x7d, 0x37, 0xb8, 0x12, 0xfc, 0xc9, 0x02,
+	0x63, 0x99, 0x2b, 0x87, 0x37, 0x86, 0xe8, 0x30, 0x05, 0xd6, 0x9e, 0x3f, 0x3e, 0x50, 0x4b, 0x5f,
+	0x91, 0xd2, 0x17, 0xe1, 0x82, 0x41, 0x7a, 0x4e, 0x7e, 0x07, 0x65, 0xae, 0xd4, 0x76, 0xf7, 0x1d,
+	0x6b, 0x6f, 0xdf, 0xb1, 0xbe, 0xed, 0x3b, 0xd6, 0xbb, 0x03, 0xa7, 0xb0, 0x77, 0xe0, 0x14, 0xbe,
+	0x1c, 0x38, 0x85, 0xc7, 0xf3, 0x41, 0x28, 0x5a, 0xdd, 0x46, 0xc5, 0x67, 0x9d, 0x3e, 0xff, 0x1c,
+	0x8b, 0x83, 0xc3, 0x59, 0x3d, 0xcf, 0x43, 0x1b, 0x03, 0x13, 0xc5, 0x66, 0x44, 0x78, 0x63, 0x44,
+	0x7e, 0x5b, 0xaf, 0xff, 0x1c, 0x00, 0x6e, 0xe9, 0xe9, 0xe4, 0x8b, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -265,6 +459,12 @@ type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	InterchainAccountAddress(ctx context.Context, in *QueryInterchainAccountAddressRequest, opts ...grpc.CallOption) (*QueryInterchainAccountAddressResponse, error)
+	// InterchainAccountStatus queries the state of the channel of an interchain
+	// account.
+	InterchainAccountStatus(ctx context.Context, in *QueryInterchainAccountStatusRequest, opts ...grpc.CallOption) (*QueryInterchainAccountStatusResponse, error)
+	// HostAllowMessages queries the cached allow_messages param of the ICA host
+	// module of the chain on the other side of a connection.
+	HostAllowMessages(ctx context.Context, in *QueryHostAllowMessagesRequest, opts ...grpc.CallOption) (*QueryHostAllowMessagesResponse, error)
 }
 
 type queryClient struct {
@@ -293,11 +493,35 @@ func (c *queryClient) InterchainAccountAddress(ctx context.Context, in *QueryInt
 	return out, nil
 }
 
+func (c *queryClient) InterchainAccountStatus(ctx context.Context, in *QueryInterchainAccountStatusRequest, opts ...grpc.CallOption) (*QueryInterchainAccountStatusResponse, error) {
+	out := new(QueryInterchainAccountStatusResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchaintxs.v1.Query/InterchainAccountStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HostAllowMessages(ctx context.Context, in *QueryHostAllowMessagesRequest, opts ...grpc.CallOption) (*QueryHostAllowMessagesResponse, error) {
+	out := new(QueryHostAllowMessagesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchaintxs.v1.Query/HostAllowMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	InterchainAccountAddress(context.Context, *QueryInterchainAccountAddressRequest) (*QueryInterchainAccountAddressResponse, error)
+	// InterchainAccountStatus queries the state of the channel of an interchain
+	// account.
+	InterchainAccountStatus(context.Context, *QueryInterchainAccountStatusRequest) (*QueryInterchainAccountStatusResponse, error)
+	// HostAllowMessages queries the cached allow_messages param of the ICA host
+	// module of the chain on the other side of a connection.
+	HostAllowMessages(context.Context, *QueryHostAllowMessagesRequest) (*QueryHostAllowMessagesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -310,6 +534,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) InterchainAccountAddress(ctx context.Context, req *QueryInterchainAccountAddressRequest) (*QueryInterchainAccountAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountAddress not implemented")
 }
+func (*UnimplementedQueryServer) InterchainAccountStatus(ctx context.Context, req *QueryInterchainAccountStatusRequest) (*QueryInterchainAccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountStatus not implemented")
+}
+func (*UnimplementedQueryServer) HostAllowMessages(ctx context.Context, req *QueryHostAllowMessagesRequest) (*QueryHostAllowMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostAllowMessages not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -351,6 +581,43 @@ func _Query_InterchainAccountAddress_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_InterchainAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchaintxs.v1.Query/InterchainAccountStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountStatus(ctx, req.(*QueryInterchainAccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HostAllowMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHostAllowMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HostAllowMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchaintxs.v1.Query/HostAllowMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HostAllowMessages(ctx, req.(*QueryHostAllowMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.interchaintxs.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -363,6 +630,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InterchainAccountAddress",
 			Handler:    _Query_InterchainAccountAddress_Handler,
 		},
+		{
+			MethodName: "InterchainAccountStatus",
+			Handler:    _Query_InterchainAccountStatus_Handler,
+		},
+		{
+			MethodName: "HostAllowMessages",
+			Handler:    _Query_HostAllowMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/interchaintxs/v1/query.proto",
@@ -498,78 +773,324 @@ func (m *QueryInterchainAccountAddressResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterchainAccountStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryInterchainAccountStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInterchainAccountAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInterchainAccountStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.InterchainAccountId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InterchainAccountId) > 0 {
+		i -= len(m.InterchainAccountId)
+		copy(dAtA[i:], m.InterchainAccountId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterchainAccountId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInterchainAccountAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInterchainAccountStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InterchainAccountAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Channel.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHostAllowMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAllowMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAllowMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostAllowMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostAllowMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostAllowMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HostAllowMessages.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInterchainAccountAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterchainAccountId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InterchainAccountAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterchainAccountId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Channel.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHostAllowMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostAllowMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.HostAllowMessages.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -579,25 +1100,204 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InterchainAccountId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -619,7 +1319,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterchainAccountAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -642,17 +1342,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterchainAccountAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterchainAccountAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -662,24 +1362,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -702,7 +1401,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterchainAccountAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryInterchainAccountStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -725,10 +1424,10 @@ func (m *QueryInterchainAccountAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterchainAccountStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterchainAccountStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -848,7 +1547,7 @@ func (m *QueryInterchainAccountAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInterchainAccountAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryInterchainAccountStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -871,15 +1570,98 @@ func (m *QueryInterchainAccountAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInterchainAccountAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryInterchainAccountStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInterchainAccountAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryInterchainAccountStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterchainAccountAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostAllowMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostAllowMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostAllowMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -907,7 +1689,90 @@ func (m *QueryInterchainAccountAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterchainAccountAddress = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostAllowMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostAllowMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostAllowMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostAllowMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -147,3 +147,68 @@ func (msg *MsgUpdateParams) Validate() error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgSetHostAllowMessages{}
+
+func (msg *MsgSetHostAllowMessages) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetHostAllowMessages) Type() string {
+	return "set-host-allow-messages"
+}
+
+func (msg *MsgSetHostAllowMessages) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetHostAllowMessages) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgSetHostAllowMessages) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "authority is invalid")
+	}
+	return msg.HostAllowMessages.Validate()
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgRemoveHostAllowMessages{}
+
+func (msg *MsgRemoveHostAllowMessages) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveHostAllowMessages) Type() string {
+	return "remove-host-allow-messages"
+}
+
+func (msg *MsgRemoveHostAllowMessages) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgRemoveHostAllowMessages) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRemoveHostAllowMessages) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "authority is invalid")
+	}
+	if len(msg.ConnectionId) == 0 {
+		return ErrEmptyConnectionID
+	}
+	return nil
+}
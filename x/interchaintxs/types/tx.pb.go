@@ -5,13 +5,13 @@ package types
 
 import (
 	context "context"
+	_ "cosmossdk.io/api/amino"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
-	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -44,6 +44,9 @@ type MsgRegisterInterchainAccount struct {
 	InterchainAccountId string                                   `protobuf:"bytes,3,opt,name=interchain_account_id,json=interchainAccountId,proto3" json:"interchain_account_id,omitempty" yaml:"interchain_account_id"`
 	RegisterFee         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=register_fee,json=registerFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"register_fee"`
 	Ordering            types1.Order                             `protobuf:"varint,5,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
+	// auto_reopen makes the module open a new channel with the same port and
+	// ordering automatically once the account's channel is closed
+	AutoReopen bool `protobuf:"varint,6,opt,name=auto_reopen,json=autoReopen,proto3" json:"auto_reopen,omitempty"`
 }
 
 func (m *MsgRegisterInterchainAccount) Reset()         { *m = MsgRegisterInterchainAccount{} }
@@ -133,6 +136,12 @@ type MsgSubmitTx struct {
 	// timeout in seconds after which the packet times out
 	Timeout uint64     `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
 	Fee     types3.Fee `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	// split_oversized allows to submit more messages than msg_submit_tx_max_messages.
+	// The messages are split into several sequenced packets of at most
+	// msg_submit_tx_max_messages messages each, and the fee is locked for every
+	// packet. The contract gets a single aggregate callback once all the packets
+	// are acknowledged or timed out.
+	SplitOversized bool `protobuf:"varint,8,opt,name=split_oversized,json=splitOversized,proto3" json:"split_oversized,omitempty"`
 }
 
 func (m *MsgSubmitTx) Reset()         { *m = MsgSubmitTx{} }
@@ -174,6 +183,12 @@ type MsgSubmitTxResponse struct {
 	SequenceId uint64 `protobuf:"varint,1,opt,name=sequence_id,json=sequenceId,proto3" json:"sequence_id,omitempty"`
 	// channel src channel on neutron side transaction was submitted from
 	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// id of the batch the submitted messages were split into. Zero if the
+	// messages were sent in a single packet.
+	BatchId uint64 `protobuf:"varint,3,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+	// sequence ids of all the packets the submitted messages were split into.
+	// Empty if the messages were sent in a single packet.
+	SequenceIds []uint64 `protobuf:"varint,4,rep,packed,name=sequence_ids,json=sequenceIds,proto3" json:"sequence_ids,omitempty"`
 }
 
 func (m *MsgSubmitTxResponse) Reset()         { *m = MsgSubmitTxResponse{} }
@@ -223,6 +238,20 @@ func (m *MsgSubmitTxResponse) GetChannel() string {
 	return ""
 }
 
+func (m *MsgSubmitTxResponse) GetBatchId() uint64 {
+	if m != nil {
+		return m.BatchId
+	}
+	return 0
+}
+
+func (m *MsgSubmitTxResponse) GetSequenceIds() []uint64 {
+	if m != nil {
+		return m.SequenceIds
+	}
+	return nil
+}
+
 // MsgUpdateParams is the MsgUpdateParams request type.
 //
 // Since: 0.47
@@ -322,6 +351,193 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetHostAllowMessages sets the cached allow_messages param of the ICA host
+// module of the chain on the other side of a connection.
+type MsgSetHostAllowMessages struct {
+	// Authority is the address of the governance account.
+	Authority         string            `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	HostAllowMessages HostAllowMessages `protobuf:"bytes,2,opt,name=host_allow_messages,json=hostAllowMessages,proto3" json:"host_allow_messages"`
+}
+
+func (m *MsgSetHostAllowMessages) Reset()         { *m = MsgSetHostAllowMessages{} }
+func (m *MsgSetHostAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHostAllowMessages) ProtoMessage()    {}
+func (*MsgSetHostAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f087790e59c806, []int{6}
+}
+func (m *MsgSetHostAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHostAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHostAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHostAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHostAllowMessages.Merge(m, src)
+}
+func (m *MsgSetHostAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHostAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHostAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHostAllowMessages proto.InternalMessageInfo
+
+func (m *MsgSetHostAllowMessages) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetHostAllowMessages) GetHostAllowMessages() HostAllowMessages {
+	if m != nil {
+		return m.HostAllowMessages
+	}
+	return HostAllowMessages{}
+}
+
+// MsgSetHostAllowMessagesResponse defines the response structure for executing
+// a MsgSetHostAllowMessages message.
+type MsgSetHostAllowMessagesResponse struct {
+}
+
+func (m *MsgSetHostAllowMessagesResponse) Reset()         { *m = MsgSetHostAllowMessagesResponse{} }
+func (m *MsgSetHostAllowMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetHostAllowMessagesResponse) ProtoMessage()    {}
+func (*MsgSetHostAllowMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f087790e59c806, []int{7}
+}
+func (m *MsgSetHostAllowMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetHostAllowMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetHostAllowMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetHostAllowMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetHostAllowMessagesResponse.Merge(m, src)
+}
+func (m *MsgSetHostAllowMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetHostAllowMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetHostAllowMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetHostAllowMessagesResponse proto.InternalMessageInfo
+
+// MsgRemoveHostAllowMessages removes the cached allow_messages param of the ICA
+// host module of the chain on the other side of a connection, so messages of
+// MsgSubmitTx are no longer checked for the connection.
+type MsgRemoveHostAllowMessages struct {
+	// Authority is the address of the governance account.
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *MsgRemoveHostAllowMessages) Reset()         { *m = MsgRemoveHostAllowMessages{} }
+func (m *MsgRemoveHostAllowMessages) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHostAllowMessages) ProtoMessage()    {}
+func (*MsgRemoveHostAllowMessages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f087790e59c806, []int{8}
+}
+func (m *MsgRemoveHostAllowMessages) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHostAllowMessages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHostAllowMessages.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveHostAllowMessages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHostAllowMessages.Merge(m, src)
+}
+func (m *MsgRemoveHostAllowMessages) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHostAllowMessages) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHostAllowMessages.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveHostAllowMessages proto.InternalMessageInfo
+
+func (m *MsgRemoveHostAllowMessages) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveHostAllowMessages) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// MsgRemoveHostAllowMessagesResponse defines the response structure for
+// executing a MsgRemoveHostAllowMessages message.
+type MsgRemoveHostAllowMessagesResponse struct {
+}
+
+func (m *MsgRemoveHostAllowMessagesResponse) Reset()         { *m = MsgRemoveHostAllowMessagesResponse{} }
+func (m *MsgRemoveHostAllowMessagesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveHostAllowMessagesResponse) ProtoMessage()    {}
+func (*MsgRemoveHostAllowMessagesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f087790e59c806, []int{9}
+}
+func (m *MsgRemoveHostAllowMessagesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveHostAllowMessagesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveHostAllowMessagesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveHostAllowMessagesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveHostAllowMessagesResponse.Merge(m, src)
+}
+func (m *MsgRemoveHostAllowMessagesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveHostAllowMessagesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveHostAllowMessagesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveHostAllowMessagesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "neutron.interchaintxs.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "neutron.interchaintxs.v1.MsgRegisterInterchainAccountResponse")
@@ -329,68 +545,86 @@ func init() {
 	proto.RegisterType((*MsgSubmitTxResponse)(nil), "neutron.interchaintxs.v1.MsgSubmitTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.interchaintxs.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.interchaintxs.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetHostAllowMessages)(nil), "neutron.interchaintxs.v1.MsgSetHostAllowMessages")
+	proto.RegisterType((*MsgSetHostAllowMessagesResponse)(nil), "neutron.interchaintxs.v1.MsgSetHostAllowMessagesResponse")
+	proto.RegisterType((*MsgRemoveHostAllowMessages)(nil), "neutron.interchaintxs.v1.MsgRemoveHostAllowMessages")
+	proto.RegisterType((*MsgRemoveHostAllowMessagesResponse)(nil), "neutron.interchaintxs.v1.MsgRemoveHostAllowMessagesResponse")
 }
 
 func init() { proto.RegisterFile("neutron/interchaintxs/v1/tx.proto", fileDescriptor_50f087790e59c806) }
 
 var fileDescriptor_50f087790e59c806 = []byte{
-	// 890 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x31, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0xc6, 0xbe, 0xe4, 0x3c, 0x0e, 0x20, 0xf6, 0x72, 0xca, 0xda, 0x4a, 0x6c, 0x67, 0xe1,
-	0x24, 0x13, 0x29, 0xb3, 0xb1, 0x91, 0x22, 0x14, 0x09, 0xa4, 0xf8, 0xa4, 0x93, 0x5c, 0x44, 0x9c,
-	0xf6, 0x8e, 0x86, 0xc6, 0x9a, 0xdd, 0x1d, 0xaf, 0x47, 0x64, 0x67, 0xcc, 0xcc, 0xac, 0x15, 0x77,
-	0x88, 0x0a, 0x51, 0xd1, 0x50, 0xd0, 0x5d, 0x89, 0x28, 0x50, 0x0a, 0x7e, 0x00, 0xe5, 0x95, 0x27,
-	0x2a, 0xaa, 0x80, 0x92, 0x22, 0xd4, 0x57, 0x50, 0xa3, 0xd9, 0x9d, 0x71, 0x6c, 0x2b, 0xb6, 0x22,
-	0x1a, 0x7b, 0xde, 0x7b, 0xdf, 0xbc, 0xf7, 0xe6, 0x7d, 0xef, 0xbd, 0x05, 0x7b, 0x14, 0xa7, 0x92,
-	0x33, 0xea, 0x11, 0x2a, 0x31, 0x0f, 0x87, 0x88, 0x50, 0x79, 0x2e, 0xbc, 0x71, 0xdb, 0x93, 0xe7,
-	0x70, 0xc4, 0x99, 0x64, 0xb6, 0xa3, 0x21, 0x70, 0x0e, 0x02, 0xc7, 0xed, 0xda, 0xfb, 0x28, 0x21,
-	0x94, 0x79, 0xd9, 0x6f, 0x0e, 0xae, 0xd5, 0x43, 0x26, 0x12, 0x26, 0xbc, 0x00, 0x09, 0xec, 0x8d,
-	0xdb, 0x01, 0x96, 0xa8, 0xed, 0x85, 0x8c, 0x50, 0x6d, 0xdf, 0xd6, 0xf6, 0x44, 0xc4, 0x2a, 0x48,
-	0x22, 0x62, 0x6d, 0xa8, 0xe6, 0x86, 0x7e, 0x26, 0x79, 0xb9, 0xa0, 0x4d, 0x5b, 0x31, 0x8b, 0x59,
-	0xae, 0x57, 0x27, 0xad, 0xdd, 0x89, 0x19, 0x8b, 0xcf, 0xb0, 0x87, 0x46, 0xc4, 0x43, 0x94, 0x32,
-	0x89, 0x24, 0x61, 0xd4, 0xdc, 0x79, 0x3c, 0x63, 0x1d, 0x4a, 0x39, 0x32, 0x51, 0xb4, 0x3a, 0x93,
-	0x82, 0x74, 0xe0, 0x21, 0x3a, 0xd1, 0xa6, 0x3d, 0x12, 0x84, 0x5e, 0xc8, 0x38, 0xf6, 0xc2, 0x21,
-	0xa2, 0x14, 0x9f, 0xa9, 0xfc, 0xf4, 0x51, 0x43, 0x76, 0x4d, 0xb1, 0x06, 0x18, 0x73, 0x3c, 0x48,
-	0x69, 0x84, 0xb9, 0x3a, 0x6b, 0xf3, 0x93, 0xa5, 0xb5, 0x1c, 0x21, 0x8e, 0x12, 0x9d, 0x9a, 0xfb,
-	0x53, 0x11, 0xec, 0x9c, 0x8a, 0xd8, 0xc7, 0x31, 0x11, 0x12, 0xf3, 0xde, 0x14, 0x7c, 0x12, 0x86,
-	0x2c, 0xa5, 0xd2, 0xde, 0x03, 0x9b, 0x03, 0xce, 0x92, 0x3e, 0x8a, 0x22, 0x8e, 0x85, 0x70, 0xac,
-	0xa6, 0xd5, 0x2a, 0xfb, 0x15, 0xa5, 0x3b, 0xc9, 0x55, 0xf6, 0xa7, 0xe0, 0x9d, 0x90, 0x51, 0x8a,
-	0x43, 0xf5, 0xe6, 0x3e, 0x89, 0x9c, 0x35, 0x85, 0xe9, 0x3a, 0x6f, 0x2f, 0x1b, 0x5b, 0x13, 0x94,
-	0x9c, 0x1d, 0xbb, 0x73, 0x66, 0xd7, 0xdf, 0xbc, 0x95, 0x7b, 0x91, 0xfd, 0x12, 0x3c, 0xbe, 0xcd,
-	0xb1, 0x8f, 0xf2, 0xb8, 0xca, 0x4d, 0x31, 0x73, 0xd3, 0x7c, 0x7b, 0xd9, 0xd8, 0xc9, 0xdd, 0xdc,
-	0x09, 0x73, 0xfd, 0x47, 0x64, 0x31, 0xeb, 0x5e, 0x64, 0x53, 0xb0, 0xc9, 0xf5, 0xa3, 0xfa, 0x03,
-	0x8c, 0x9d, 0x52, 0xb3, 0xd8, 0xaa, 0x74, 0xaa, 0x50, 0x93, 0xa9, 0x5a, 0x02, 0xea, 0x96, 0x80,
-	0x4f, 0x19, 0xa1, 0xdd, 0xc3, 0xd7, 0x97, 0x8d, 0xc2, 0x2f, 0x7f, 0x35, 0x5a, 0x31, 0x91, 0xc3,
-	0x34, 0x80, 0x21, 0x4b, 0x34, 0xf3, 0xfa, 0xef, 0x40, 0x44, 0x5f, 0x79, 0x72, 0x32, 0xc2, 0x22,
-	0xbb, 0x20, 0xfc, 0x8a, 0x09, 0xf0, 0x0c, 0x63, 0xfb, 0x08, 0x3c, 0x64, 0x3c, 0xc2, 0x9c, 0xd0,
-	0xd8, 0x79, 0xd0, 0xb4, 0x5a, 0xef, 0x76, 0x6a, 0x90, 0x04, 0x21, 0x54, 0x24, 0x42, 0xc3, 0xdc,
-	0xb8, 0x0d, 0x3f, 0x57, 0x20, 0x7f, 0x8a, 0x3d, 0xae, 0x7e, 0xf7, 0xaa, 0x51, 0xf8, 0xe7, 0x55,
-	0xa3, 0xf0, 0xed, 0xcd, 0xc5, 0xfe, 0x5c, 0xa9, 0xdd, 0x08, 0x7c, 0xb8, 0x8a, 0x1a, 0x1f, 0x8b,
-	0x11, 0xa3, 0x02, 0xdb, 0xbb, 0x00, 0xe8, 0x00, 0xaa, 0x6a, 0x39, 0x41, 0x65, 0xad, 0xe9, 0x45,
-	0xf6, 0x36, 0xd8, 0x18, 0x31, 0x2e, 0xa7, 0xc4, 0xf8, 0xeb, 0x4a, 0xec, 0x45, 0xc7, 0x25, 0x15,
-	0xda, 0xfd, 0x75, 0x0d, 0x54, 0x4e, 0x45, 0xfc, 0x22, 0x0d, 0x12, 0x22, 0x5f, 0x9e, 0xdf, 0x87,
-	0xf0, 0xce, 0x32, 0xc6, 0x72, 0xff, 0x77, 0xf2, 0xf1, 0xc1, 0x62, 0x93, 0x64, 0xec, 0x2e, 0xb4,
-	0x42, 0x0b, 0x94, 0x12, 0x11, 0x0b, 0x4d, 0xd6, 0x16, 0xcc, 0x07, 0x04, 0x9a, 0x01, 0x81, 0x27,
-	0x74, 0xe2, 0x67, 0x08, 0xdb, 0x06, 0xa5, 0x04, 0x27, 0x2c, 0x2b, 0x75, 0xd9, 0xcf, 0xce, 0xb6,
-	0x03, 0x36, 0x24, 0x49, 0x30, 0x4b, 0xa5, 0xb3, 0xde, 0xb4, 0x5a, 0x25, 0xdf, 0x88, 0xf6, 0x21,
-	0x28, 0xaa, 0x1e, 0xd8, 0x68, 0x5a, 0xad, 0x4a, 0xc7, 0x81, 0x66, 0x87, 0xcc, 0x4c, 0x0e, 0x7c,
-	0x86, 0x71, 0xb7, 0xa4, 0x5a, 0xc0, 0x57, 0xd0, 0x55, 0xb4, 0x3c, 0x07, 0x8f, 0x66, 0xea, 0x35,
-	0x65, 0xa1, 0x01, 0x2a, 0x02, 0x7f, 0x9d, 0x62, 0x1a, 0x62, 0x43, 0x43, 0xc9, 0x07, 0x46, 0xd5,
-	0x8b, 0x54, 0x7a, 0x9a, 0x14, 0x5d, 0x27, 0x23, 0xba, 0xbf, 0x5b, 0xe0, 0xbd, 0x53, 0x11, 0x7f,
-	0x31, 0x8a, 0x90, 0xc4, 0xcf, 0xb3, 0xf1, 0xb4, 0x8f, 0x40, 0x19, 0xa5, 0x72, 0xc8, 0x38, 0x91,
-	0x93, 0x9c, 0x83, 0xae, 0xf3, 0xc7, 0x6f, 0x07, 0x5b, 0xba, 0x7f, 0x35, 0x15, 0x2f, 0xa4, 0x6a,
-	0x22, 0xff, 0x16, 0x6a, 0x3f, 0x05, 0xeb, 0xf9, 0x80, 0x67, 0x41, 0x2a, 0x9d, 0x26, 0x5c, 0xb6,
-	0x31, 0x61, 0x1e, 0xa9, 0x5b, 0x56, 0xaf, 0xfe, 0xf9, 0xe6, 0x62, 0xdf, 0xf2, 0xf5, 0xd5, 0xe3,
-	0x43, 0xf5, 0xea, 0x5b, 0xa7, 0xdf, 0xdf, 0x5c, 0xec, 0xef, 0xce, 0xef, 0x91, 0x85, 0x74, 0xdd,
-	0x2a, 0xd8, 0x5e, 0x50, 0x99, 0xc2, 0x74, 0xfe, 0x5d, 0x03, 0xc5, 0x53, 0x11, 0xdb, 0x3f, 0x5a,
-	0xa0, 0xba, 0x7c, 0xcf, 0x1c, 0x2d, 0xcf, 0x73, 0xd5, 0x10, 0xd4, 0x3e, 0xfb, 0x7f, 0xf7, 0x4c,
-	0x76, 0x6e, 0xc1, 0x0e, 0xc0, 0xc3, 0x69, 0xf3, 0x3f, 0x59, 0xe9, 0xcd, 0xc0, 0x6a, 0x07, 0xf7,
-	0x82, 0xcd, 0xc4, 0x38, 0x03, 0x9b, 0x73, 0xec, 0x7e, 0xb4, 0xd2, 0xc1, 0x2c, 0xb4, 0xd6, 0xbe,
-	0x37, 0xd4, 0xc4, 0xab, 0x3d, 0xf8, 0x46, 0xb1, 0xd9, 0xf5, 0x5f, 0x5f, 0xd5, 0xad, 0x37, 0x57,
-	0x75, 0xeb, 0xef, 0xab, 0xba, 0xf5, 0xc3, 0x75, 0xbd, 0xf0, 0xe6, 0xba, 0x5e, 0xf8, 0xf3, 0xba,
-	0x5e, 0xf8, 0xf2, 0x93, 0x99, 0x1d, 0xa7, 0xbd, 0x1f, 0x30, 0x1e, 0x9b, 0xb3, 0x37, 0x6e, 0xb7,
-	0xbd, 0xf3, 0x85, 0x2f, 0x47, 0xb6, 0xf9, 0x82, 0xf5, 0x6c, 0x16, 0x3f, 0xfe, 0x2f, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0x19, 0xc9, 0x47, 0xab, 0x07, 0x00, 0x00,
+	// 1115 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xbd, 0x6f, 0x23, 0x45,
+	0x14, 0xf7, 0x5e, 0x7c, 0xf9, 0x18, 0x87, 0x3b, 0xdd, 0x26, 0xa7, 0xac, 0xad, 0xc4, 0x76, 0xf6,
+	0x2e, 0xc2, 0x04, 0x65, 0x37, 0x36, 0x28, 0x3a, 0xcc, 0x87, 0x14, 0x9f, 0x74, 0xc2, 0x45, 0x74,
+	0x68, 0x72, 0x34, 0x34, 0xd6, 0x78, 0x77, 0xbc, 0x5e, 0xe1, 0x9d, 0x31, 0x3b, 0x63, 0x93, 0x50,
+	0x21, 0x44, 0x81, 0x90, 0x0e, 0xd1, 0xd0, 0x5f, 0x89, 0xa8, 0x52, 0x20, 0x0a, 0x2a, 0xca, 0x2b,
+	0x4f, 0x34, 0x50, 0x05, 0x94, 0x14, 0xa1, 0x3e, 0xfe, 0x01, 0x34, 0xb3, 0xb3, 0x8e, 0xbf, 0xd6,
+	0x84, 0x88, 0x26, 0x99, 0x79, 0xef, 0x37, 0x6f, 0xde, 0xfb, 0xfd, 0xde, 0x3c, 0x2f, 0xd8, 0x24,
+	0xb8, 0xc7, 0x43, 0x4a, 0x6c, 0x9f, 0x70, 0x1c, 0x3a, 0x6d, 0xe4, 0x13, 0x7e, 0xc4, 0xec, 0x7e,
+	0xd9, 0xe6, 0x47, 0x56, 0x37, 0xa4, 0x9c, 0xea, 0x86, 0x82, 0x58, 0x23, 0x10, 0xab, 0x5f, 0xce,
+	0xdd, 0x41, 0x81, 0x4f, 0xa8, 0x2d, 0xff, 0x46, 0xe0, 0x5c, 0xde, 0xa1, 0x2c, 0xa0, 0xcc, 0x6e,
+	0x22, 0x86, 0xed, 0x7e, 0xb9, 0x89, 0x39, 0x2a, 0xdb, 0x0e, 0xf5, 0x89, 0xf2, 0xaf, 0x29, 0x7f,
+	0xc0, 0x3c, 0x71, 0x49, 0xc0, 0x3c, 0xe5, 0xc8, 0x46, 0x8e, 0x86, 0xdc, 0xd9, 0xd1, 0x46, 0xb9,
+	0x56, 0x3d, 0xea, 0xd1, 0xc8, 0x2e, 0x56, 0xca, 0xba, 0xee, 0x51, 0xea, 0x75, 0xb0, 0x8d, 0xba,
+	0xbe, 0x8d, 0x08, 0xa1, 0x1c, 0x71, 0x9f, 0x92, 0xf8, 0xcc, 0xdd, 0x21, 0x6f, 0x9b, 0xf3, 0x6e,
+	0x7c, 0x8b, 0x32, 0xcb, 0x5d, 0xb3, 0xd7, 0xb2, 0x11, 0x39, 0x56, 0xae, 0x4d, 0xbf, 0xe9, 0xd8,
+	0x0e, 0x0d, 0xb1, 0xed, 0xb4, 0x11, 0x21, 0xb8, 0x23, 0xf2, 0x53, 0x4b, 0x05, 0xd9, 0x88, 0xc9,
+	0x6a, 0x61, 0x1c, 0xe2, 0x56, 0x8f, 0xb8, 0x38, 0x14, 0x6b, 0xe5, 0xae, 0x24, 0x72, 0xd9, 0xa6,
+	0x8c, 0x37, 0x50, 0xa7, 0x43, 0x3f, 0x6d, 0x04, 0x98, 0x31, 0xe4, 0xe1, 0x38, 0xcf, 0xad, 0xc4,
+	0x33, 0x5d, 0x14, 0xa2, 0x40, 0xc1, 0xcc, 0x9f, 0xe7, 0xc0, 0xfa, 0x01, 0xf3, 0x20, 0xf6, 0x7c,
+	0xc6, 0x71, 0x58, 0x1f, 0x80, 0xf7, 0x1d, 0x87, 0xf6, 0x08, 0xd7, 0x37, 0xc1, 0x72, 0x2b, 0xa4,
+	0x41, 0x03, 0xb9, 0x6e, 0x88, 0x19, 0x33, 0xb4, 0xa2, 0x56, 0x5a, 0x82, 0x19, 0x61, 0xdb, 0x8f,
+	0x4c, 0xfa, 0xbb, 0xe0, 0x15, 0x87, 0x12, 0x82, 0x1d, 0xc1, 0x53, 0xc3, 0x77, 0x8d, 0x1b, 0x02,
+	0x53, 0x33, 0x5e, 0x9e, 0x16, 0x56, 0x8f, 0x51, 0xd0, 0xa9, 0x9a, 0x23, 0x6e, 0x13, 0x2e, 0x5f,
+	0xee, 0xeb, 0xae, 0xfe, 0x04, 0xdc, 0xbd, 0xcc, 0xb1, 0x81, 0xa2, 0x7b, 0x45, 0x98, 0x39, 0x19,
+	0xa6, 0xf8, 0xf2, 0xb4, 0xb0, 0x1e, 0x85, 0x99, 0x0a, 0x33, 0xe1, 0x8a, 0x3f, 0x9e, 0x75, 0xdd,
+	0xd5, 0x09, 0x58, 0x0e, 0x55, 0x51, 0x8d, 0x16, 0xc6, 0x46, 0xba, 0x38, 0x57, 0xca, 0x54, 0xb2,
+	0x96, 0x6a, 0x00, 0xd1, 0x46, 0x96, 0x6a, 0x23, 0xeb, 0x21, 0xf5, 0x49, 0x6d, 0xf7, 0xf9, 0x69,
+	0x21, 0xf5, 0xc3, 0x1f, 0x85, 0x92, 0xe7, 0xf3, 0x76, 0xaf, 0x69, 0x39, 0x34, 0x50, 0xdd, 0xa2,
+	0xfe, 0xed, 0x30, 0xf7, 0x63, 0x9b, 0x1f, 0x77, 0x31, 0x93, 0x07, 0x18, 0xcc, 0xc4, 0x17, 0x3c,
+	0xc2, 0x58, 0xdf, 0x03, 0x8b, 0x34, 0x74, 0x71, 0xe8, 0x13, 0xcf, 0xb8, 0x59, 0xd4, 0x4a, 0xb7,
+	0x2a, 0x39, 0xcb, 0x6f, 0x3a, 0x96, 0x10, 0xde, 0x8a, 0xd5, 0xee, 0x97, 0xad, 0xc7, 0x02, 0x04,
+	0x07, 0x58, 0xbd, 0x00, 0x32, 0xa8, 0xc7, 0x69, 0x23, 0xc4, 0xb4, 0x8b, 0x89, 0x31, 0x5f, 0xd4,
+	0x4a, 0x8b, 0x10, 0x08, 0x13, 0x94, 0x96, 0x6a, 0xf6, 0xab, 0x67, 0x85, 0xd4, 0x5f, 0xcf, 0x0a,
+	0xa9, 0x2f, 0x2e, 0x4e, 0xb6, 0x47, 0xb4, 0x30, 0x5d, 0x70, 0x7f, 0x96, 0x76, 0x10, 0xb3, 0x2e,
+	0x25, 0x0c, 0xeb, 0x1b, 0x00, 0xa8, 0x0c, 0x04, 0xad, 0x91, 0x82, 0x4b, 0xca, 0x52, 0x77, 0xf5,
+	0x35, 0xb0, 0xd0, 0xa5, 0x21, 0x1f, 0x28, 0x07, 0xe7, 0xc5, 0xb6, 0xee, 0x56, 0xd3, 0xe2, 0x6a,
+	0xf3, 0xb7, 0x1b, 0x20, 0x73, 0xc0, 0xbc, 0xc3, 0x5e, 0x33, 0xf0, 0xf9, 0x93, 0xa3, 0xab, 0x74,
+	0x44, 0x25, 0x49, 0xd2, 0x28, 0xfe, 0x54, 0xc1, 0xee, 0x8d, 0x77, 0x91, 0x94, 0x7f, 0xac, 0x57,
+	0x4a, 0x20, 0x1d, 0x30, 0x8f, 0x29, 0x35, 0x57, 0xad, 0xe8, 0xd5, 0x59, 0xf1, 0xab, 0xb3, 0xf6,
+	0xc9, 0x31, 0x94, 0x08, 0x5d, 0x07, 0xe9, 0x00, 0x07, 0x54, 0x6a, 0xb1, 0x04, 0xe5, 0x5a, 0x37,
+	0xc0, 0x02, 0xf7, 0x03, 0x4c, 0x7b, 0x5c, 0xf2, 0x9c, 0x86, 0xf1, 0x56, 0xdf, 0x05, 0x73, 0xa2,
+	0x49, 0x16, 0x8a, 0x5a, 0x29, 0x53, 0x31, 0xac, 0x78, 0x30, 0x0d, 0x3d, 0x47, 0xeb, 0x11, 0xc6,
+	0xb5, 0xb4, 0xe8, 0x11, 0x28, 0xa0, 0xfa, 0xab, 0xe0, 0x36, 0xeb, 0x76, 0x7c, 0xde, 0xa0, 0x7d,
+	0x1c, 0x32, 0xff, 0x33, 0xec, 0x1a, 0x8b, 0x52, 0xbb, 0x5b, 0xd2, 0xfc, 0x38, 0xb6, 0xce, 0xd2,
+	0xef, 0x1b, 0x0d, 0xac, 0x0c, 0x31, 0x3b, 0xd0, 0xab, 0x00, 0x32, 0x0c, 0x7f, 0xd2, 0xc3, 0xc4,
+	0xc1, 0xb1, 0x60, 0x69, 0x08, 0x62, 0x53, 0xdd, 0x15, 0x85, 0x28, 0xf9, 0x14, 0xa3, 0xf1, 0x56,
+	0xcf, 0x82, 0xc5, 0x26, 0xe2, 0x4e, 0x3b, 0x26, 0x30, 0x0d, 0x17, 0xe4, 0xbe, 0xee, 0x0a, 0xdd,
+	0x86, 0xa2, 0x46, 0x1c, 0xa6, 0x61, 0xe6, 0x32, 0x2c, 0x33, 0x7f, 0xd1, 0xc0, 0xed, 0x03, 0xe6,
+	0x7d, 0xd8, 0x75, 0x11, 0xc7, 0x1f, 0xc8, 0x39, 0xa1, 0xef, 0x81, 0x25, 0xd4, 0xe3, 0x6d, 0x1a,
+	0xfa, 0xfc, 0x38, 0xd2, 0xba, 0x66, 0xfc, 0xfa, 0xe3, 0xce, 0xaa, 0x7a, 0x48, 0x4a, 0xf2, 0x43,
+	0x2e, 0xba, 0x19, 0x5e, 0x42, 0xf5, 0x87, 0x60, 0x3e, 0x9a, 0x34, 0x32, 0xc5, 0x4c, 0xa5, 0x68,
+	0x25, 0x8d, 0x7b, 0x2b, 0xba, 0xa9, 0xb6, 0x24, 0xd8, 0xfd, 0xfe, 0xe2, 0x64, 0x5b, 0x83, 0xea,
+	0x68, 0x75, 0x57, 0x90, 0x76, 0x19, 0xf4, 0xeb, 0x8b, 0x93, 0xed, 0x8d, 0xd1, 0x81, 0x36, 0x96,
+	0xae, 0x99, 0x05, 0x6b, 0x63, 0xa6, 0x98, 0x56, 0xf3, 0x6f, 0x4d, 0xfa, 0x0e, 0x31, 0x7f, 0x9f,
+	0x32, 0xbe, 0x2f, 0xa6, 0xe6, 0x81, 0x1a, 0x9a, 0xd7, 0xae, 0xb2, 0x05, 0x56, 0xa6, 0xcc, 0x60,
+	0x55, 0xf2, 0xeb, 0xc9, 0x25, 0x4f, 0x64, 0x30, 0x5c, 0xfd, 0x9d, 0xf6, 0xb8, 0xb7, 0xfa, 0x60,
+	0x92, 0x88, 0xad, 0x09, 0x22, 0xa6, 0x55, 0x66, 0x6e, 0x82, 0x42, 0x82, 0x6b, 0x40, 0xcc, 0x4f,
+	0x1a, 0xc8, 0xc9, 0x41, 0x12, 0xd0, 0x3e, 0xfe, 0xff, 0xb8, 0xb9, 0x37, 0xf5, 0x77, 0x61, 0xf4,
+	0x45, 0x57, 0xdf, 0x9e, 0x2c, 0xac, 0x34, 0x51, 0x58, 0x42, 0x66, 0xe6, 0x7d, 0x60, 0x26, 0x7b,
+	0xe3, 0xf2, 0x2a, 0x4f, 0x6f, 0x82, 0xb9, 0x03, 0xe6, 0xe9, 0xdf, 0x69, 0x20, 0x9b, 0xfc, 0x43,
+	0xb7, 0x97, 0x2c, 0xd6, 0xac, 0x21, 0x9b, 0x7b, 0xef, 0x7a, 0xe7, 0x06, 0xe4, 0xa7, 0xf4, 0x26,
+	0x58, 0x1c, 0x0c, 0xd7, 0xad, 0x99, 0xd1, 0x62, 0x58, 0x6e, 0xe7, 0x4a, 0xb0, 0xa1, 0x3b, 0x3a,
+	0x60, 0x79, 0xe4, 0x55, 0xbf, 0x36, 0x33, 0xc0, 0x30, 0x34, 0x57, 0xbe, 0x32, 0x74, 0x30, 0xc0,
+	0xbe, 0xd4, 0xc0, 0xea, 0xd4, 0x67, 0x36, 0x3b, 0xd6, 0xb4, 0x23, 0xb9, 0xb7, 0xfe, 0xf3, 0x91,
+	0x41, 0x1a, 0x4f, 0x35, 0xb0, 0x96, 0xd4, 0xd4, 0x6f, 0xfe, 0x8b, 0x6c, 0x53, 0x4f, 0xe5, 0xde,
+	0xb9, 0xce, 0xa9, 0x38, 0x9f, 0xdc, 0xcd, 0xcf, 0xc5, 0xf3, 0xae, 0xc1, 0xe7, 0x67, 0x79, 0xed,
+	0xc5, 0x59, 0x5e, 0xfb, 0xf3, 0x2c, 0xaf, 0x7d, 0x7b, 0x9e, 0x4f, 0xbd, 0x38, 0xcf, 0xa7, 0x7e,
+	0x3f, 0xcf, 0xa7, 0x3e, 0x7a, 0x30, 0xf4, 0xed, 0xa1, 0x2e, 0xda, 0xa1, 0xa1, 0x17, 0xaf, 0xed,
+	0x7e, 0xb9, 0x6c, 0x1f, 0x8d, 0x7d, 0xd1, 0xc9, 0x2f, 0x92, 0xe6, 0xbc, 0xfc, 0x09, 0x7c, 0xe3,
+	0x9f, 0x01, 0x00, 0x7f, 0x6e, 0x31, 0x40, 0x77, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -408,6 +642,8 @@ type MsgClient interface {
 	RegisterInterchainAccount(ctx context.Context, in *MsgRegisterInterchainAccount, opts ...grpc.CallOption) (*MsgRegisterInterchainAccountResponse, error)
 	SubmitTx(ctx context.Context, in *MsgSubmitTx, opts ...grpc.CallOption) (*MsgSubmitTxResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetHostAllowMessages(ctx context.Context, in *MsgSetHostAllowMessages, opts ...grpc.CallOption) (*MsgSetHostAllowMessagesResponse, error)
+	RemoveHostAllowMessages(ctx context.Context, in *MsgRemoveHostAllowMessages, opts ...grpc.CallOption) (*MsgRemoveHostAllowMessagesResponse, error)
 }
 
 type msgClient struct {
@@ -445,11 +681,31 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetHostAllowMessages(ctx context.Context, in *MsgSetHostAllowMessages, opts ...grpc.CallOption) (*MsgSetHostAllowMessagesResponse, error) {
+	out := new(MsgSetHostAllowMessagesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchaintxs.v1.Msg/SetHostAllowMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveHostAllowMessages(ctx context.Context, in *MsgRemoveHostAllowMessages, opts ...grpc.CallOption) (*MsgRemoveHostAllowMessagesResponse, error) {
+	out := new(MsgRemoveHostAllowMessagesResponse)
+	err := c.cc.Invoke(ctx, "/neutron.interchaintxs.v1.Msg/RemoveHostAllowMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	RegisterInterchainAccount(context.Context, *MsgRegisterInterchainAccount) (*MsgRegisterInterchainAccountResponse, error)
 	SubmitTx(context.Context, *MsgSubmitTx) (*MsgSubmitTxResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetHostAllowMessages(context.Context, *MsgSetHostAllowMessages) (*MsgSetHostAllowMessagesResponse, error)
+	RemoveHostAllowMessages(context.Context, *MsgRemoveHostAllowMessages) (*MsgRemoveHostAllowMessagesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -465,6 +721,12 @@ func (*UnimplementedMsgServer) SubmitTx(ctx context.Context, req *MsgSubmitTx) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetHostAllowMessages(ctx context.Context, req *MsgSetHostAllowMessages) (*MsgSetHostAllowMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetHostAllowMessages not implemented")
+}
+func (*UnimplementedMsgServer) RemoveHostAllowMessages(ctx context.Context, req *MsgRemoveHostAllowMessages) (*MsgRemoveHostAllowMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveHostAllowMessages not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -524,6 +786,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetHostAllowMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetHostAllowMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetHostAllowMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchaintxs.v1.Msg/SetHostAllowMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetHostAllowMessages(ctx, req.(*MsgSetHostAllowMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveHostAllowMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveHostAllowMessages)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveHostAllowMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.interchaintxs.v1.Msg/RemoveHostAllowMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveHostAllowMessages(ctx, req.(*MsgRemoveHostAllowMessages))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.interchaintxs.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -540,6 +839,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetHostAllowMessages",
+			Handler:    _Msg_SetHostAllowMessages_Handler,
+		},
+		{
+			MethodName: "RemoveHostAllowMessages",
+			Handler:    _Msg_RemoveHostAllowMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/interchaintxs/v1/tx.proto",
@@ -565,6 +872,16 @@ func (m *MsgRegisterInterchainAccount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.AutoReopen {
+		i--
+		if m.AutoReopen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Ordering != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.Ordering))
 		i--
@@ -665,6 +982,16 @@ func (m *MsgSubmitTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SplitOversized {
+		i--
+		if m.SplitOversized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -745,6 +1072,29 @@ func (m *MsgSubmitTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SequenceIds) > 0 {
+		dAtA3 := make([]byte, len(m.SequenceIds)*10)
+		var j2 int
+		for _, num := range m.SequenceIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Channel) > 0 {
 		i -= len(m.Channel)
 		copy(dAtA[i:], m.Channel)
@@ -823,66 +1173,192 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetHostAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterInterchainAccount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetHostAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHostAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FromAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InterchainAccountId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RegisterFee) > 0 {
-		for _, e := range m.RegisterFee {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.HostAllowMessages.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.Ordering != 0 {
-		n += 1 + sovTx(uint64(m.Ordering))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetHostAllowMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTx) Size() (n int) {
-	if m == nil {
+func (m *MsgSetHostAllowMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetHostAllowMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHostAllowMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHostAllowMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHostAllowMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveHostAllowMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveHostAllowMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveHostAllowMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterInterchainAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InterchainAccountId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RegisterFee) > 0 {
+		for _, e := range m.RegisterFee {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovTx(uint64(m.Ordering))
+	}
+	if m.AutoReopen {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTx) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -914,6 +1390,9 @@ func (m *MsgSubmitTx) Size() (n int) {
 	}
 	l = m.Fee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.SplitOversized {
+		n += 2
+	}
 	return n
 }
 
@@ -930,6 +1409,16 @@ func (m *MsgSubmitTxResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.BatchId != 0 {
+		n += 1 + sovTx(uint64(m.BatchId))
+	}
+	if len(m.SequenceIds) > 0 {
+		l = 0
+		for _, e := range m.SequenceIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
 	return n
 }
 
@@ -957,6 +1446,56 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetHostAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.HostAllowMessages.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetHostAllowMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveHostAllowMessages) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveHostAllowMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1141,6 +1680,26 @@ func (m *MsgRegisterInterchainAccount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoReopen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoReopen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1519,6 +2078,26 @@ func (m *MsgSubmitTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitOversized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SplitOversized = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1620,6 +2199,101 @@ func (m *MsgSubmitTxResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SequenceIds = append(m.SequenceIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.SequenceIds) == 0 {
+					m.SequenceIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SequenceIds = append(m.SequenceIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1806,6 +2480,335 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetHostAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHostAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHostAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAllowMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HostAllowMessages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetHostAllowMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetHostAllowMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetHostAllowMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveHostAllowMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveHostAllowMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveHostAllowMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveHostAllowMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveHostAllowMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveHostAllowMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
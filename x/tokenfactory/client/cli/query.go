@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
 		GetCmdBeforeSendHook(),
+		GetCmdDenomMintLimits(),
 	)
 
 	return cmd
@@ -160,3 +161,39 @@ func GetCmdBeforeSendHook() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomMintLimits returns the max supply and the mint schedule for a queried denom
+func GetCmdDenomMintLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-limits [denom] [flags]",
+		Short: "Get the max supply, the mint schedule and the mintable amount for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			creator, subdenom, err := types.DeconstructDenom(denom)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DenomMintLimits(cmd.Context(), &types.QueryDenomMintLimitsRequest{
+				Creator:  creator,
+				Subdenom: subdenom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
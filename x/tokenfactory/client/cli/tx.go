@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	"cosmossdk.io/math"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/cobra"
 
@@ -35,6 +38,7 @@ func GetTxCmd() *cobra.Command {
 		NewChangeAdminCmd(),
 		NewSetBeforeSendHook(),
 		NewSetDenomMetadataCmd(),
+		NewSetMintLimitsCmd(),
 	)
 
 	return cmd
@@ -278,3 +282,93 @@ func NewSetBeforeSendHook() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+const (
+	FlagMaxSupply    = "max-supply"
+	FlagMintSchedule = "mint-schedule"
+)
+
+// NewSetMintLimitsCmd broadcast MsgSetMintLimits
+func NewSetMintLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-mint-limits [denom] [flags]",
+		Short: "Sets the max supply and the mint schedule for a factory-created denom. Must have admin authority to do so.",
+		Long: `Sets the max supply and the mint schedule for a factory-created denom. Must have admin authority to do so.
+The max supply can only be lowered once set, and the mint schedule can be set only once.
+The mint schedule is a comma-separated list of amount@unlock_time entries with RFC3339 unlock times, e.g.
+--mint-schedule 1000000@2027-01-01T00:00:00Z,1000000@2028-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var maxSupply *math.Int
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if maxSupplyStr != "" {
+				amount, ok := math.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid max supply: %s", maxSupplyStr)
+				}
+				maxSupply = &amount
+			}
+
+			mintScheduleStr, err := cmd.Flags().GetString(FlagMintSchedule)
+			if err != nil {
+				return err
+			}
+			mintSchedule, err := parseMintSchedule(mintScheduleStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintLimits(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+				mintSchedule,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMaxSupply, "", "Max supply of the denom")
+	cmd.Flags().String(FlagMintSchedule, "", "Mint schedule of the denom as comma-separated amount@unlock_time entries")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseMintSchedule parses comma-separated amount@unlock_time entries
+func parseMintSchedule(schedule string) ([]types.MintScheduleEntry, error) {
+	if schedule == "" {
+		return nil, nil
+	}
+
+	var entries []types.MintScheduleEntry
+	for _, entry := range strings.Split(schedule, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "@")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid mint schedule entry %q, expected amount@unlock_time", entry)
+		}
+		amount, ok := math.NewIntFromString(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid mint schedule amount: %s", parts[0])
+		}
+		unlockTime, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid mint schedule unlock time: %w", err)
+		}
+		entries = append(entries, types.MintScheduleEntry{UnlockTime: unlockTime, Amount: amount})
+	}
+	return entries, nil
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/proto"
 
@@ -47,3 +48,31 @@ func (k Keeper) setAdmin(ctx sdk.Context, denom, admin string) error {
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// setMintLimits lowers or sets the max supply of the denom and sets its mint schedule if it isn't set yet
+func (k Keeper) setMintLimits(ctx sdk.Context, denom string, maxSupply *math.Int, mintSchedule []types.MintScheduleEntry) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if maxSupply != nil {
+		if metadata.MaxSupply != nil && maxSupply.GT(*metadata.MaxSupply) {
+			return types.ErrInvalidMintLimits.Wrapf("max supply can only be lowered, current max supply: %s", metadata.MaxSupply)
+		}
+		supply := k.bankKeeper.GetSupply(ctx, denom)
+		if maxSupply.LT(supply.Amount) {
+			return types.ErrInvalidMintLimits.Wrapf("max supply can't be lower than the current supply: %s", supply.Amount)
+		}
+		metadata.MaxSupply = maxSupply
+	}
+
+	if len(mintSchedule) > 0 {
+		if len(metadata.MintSchedule) > 0 {
+			return types.ErrInvalidMintLimits.Wrap("mint schedule is already set")
+		}
+		metadata.MintSchedule = mintSchedule
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
@@ -8,6 +8,18 @@ import (
 	"github.com/neutron-org/neutron/v11/x/tokenfactory/types"
 )
 
+// MintTo mints the denom to the address within the mint limits of the denom. It's used by x/coinfactory to
+// convert the migrated denoms back to the tokenfactory ones.
+func (k Keeper) MintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
+	return k.mintTo(ctx, amount, mintTo)
+}
+
+// BurnFrom burns the denom from the address. It's used by x/coinfactory to convert the migrated denoms
+// to the coinfactory ones.
+func (k Keeper) BurnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
+	return k.burnFrom(ctx, amount, burnFrom)
+}
+
 func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -28,6 +40,11 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return status.Errorf(codes.Internal, "minting to IBC escrow accounts is forbidden")
 	}
 
+	err = k.checkMintLimits(ctx, amount)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -38,6 +55,28 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		sdk.NewCoins(amount))
 }
 
+// checkMintLimits ensures that minting the amount keeps the supply within the max supply and the mint schedule of the denom
+func (k Keeper) checkMintLimits(ctx sdk.Context, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	newSupply := k.bankKeeper.GetSupply(ctx, amount.Denom).Amount.Add(amount.Amount)
+	if metadata.MaxSupply != nil && newSupply.GT(*metadata.MaxSupply) {
+		return types.ErrMaxSupplyExceeded.Wrapf("max supply: %s, supply after minting: %s", metadata.MaxSupply, newSupply)
+	}
+
+	if len(metadata.MintSchedule) > 0 {
+		unlocked := metadata.UnlockedSupply(ctx.BlockTime())
+		if newSupply.GT(unlocked) {
+			return types.ErrMintScheduleExceeded.Wrapf("unlocked supply: %s, supply after minting: %s", unlocked, newSupply)
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
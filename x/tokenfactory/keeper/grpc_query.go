@@ -57,3 +57,22 @@ func (k Keeper) FullDenom(_ context.Context, req *types.QueryFullDenomRequest) (
 
 	return &types.QueryFullDenomResponse{FullDenom: fullDenom}, nil
 }
+
+func (k Keeper) DenomMintLimits(ctx context.Context, req *types.QueryDenomMintLimitsRequest) (*types.QueryDenomMintLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom := fmt.Sprintf("factory/%s/%s", req.GetCreator(), req.GetSubdenom())
+	authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	supply := k.bankKeeper.GetSupply(sdkCtx, denom)
+
+	return &types.QueryDenomMintLimitsResponse{
+		MaxSupply:    authorityMetadata.MaxSupply,
+		MintSchedule: authorityMetadata.MintSchedule,
+		Supply:       supply,
+		Mintable:     authorityMetadata.MintableAmount(supply.Amount, sdkCtx.BlockTime()),
+	}, nil
+}
@@ -22,7 +22,9 @@ type (
 		accountKeeper  types.AccountKeeper
 		bankKeeper     types.BankKeeper
 		contractKeeper types.ContractKeeper
-		authority      string
+		// coinfactoryKeeper is set after the x/coinfactory keeper is created
+		coinfactoryKeeper types.CoinfactoryKeeper
+		authority         string
 	}
 )
 
@@ -88,6 +90,11 @@ func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
 	k.contractKeeper = contractKeeper
 }
 
+// SetCoinfactoryKeeper sets the coinfactory keeper which blocks the direct mints of the migrated denoms.
+func (k *Keeper) SetCoinfactoryKeeper(coinfactoryKeeper types.CoinfactoryKeeper) {
+	k.coinfactoryKeeper = coinfactoryKeeper
+}
+
 // CreateModuleAccount creates a module account with minting and burning capabilities
 // This account isn't intended to store any coins,
 // it purely mints and burns them on behalf of the admin of respective denoms,
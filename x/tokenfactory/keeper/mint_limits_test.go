@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v11/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestSetMintLimits() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	admin := suite.TestAccs[0].String()
+
+	_, err := suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	maxSupply := math.NewInt(1000)
+	// only the admin can set the limits
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(suite.TestAccs[1].String(), suite.defaultDenom, &maxSupply, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// the max supply can't be lower than the current supply
+	belowSupply := math.NewInt(99)
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, &belowSupply, nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, &maxSupply, nil))
+	suite.Require().NoError(err)
+
+	// the max supply can only be lowered
+	higher := math.NewInt(1001)
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, &higher, nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+
+	lower := math.NewInt(500)
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, &lower, nil))
+	suite.Require().NoError(err)
+
+	// the mint schedule can be set only once
+	schedule := []types.MintScheduleEntry{{UnlockTime: ctx.BlockTime(), Amount: math.NewInt(200)}}
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, nil, schedule))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, nil, schedule))
+	suite.Require().ErrorIs(err, types.ErrInvalidMintLimits)
+
+	metadata, err := suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lower, *metadata.MaxSupply)
+	suite.Require().Len(metadata.MintSchedule, 1)
+	suite.Require().Equal(math.NewInt(200), metadata.MintSchedule[0].Amount)
+
+	// the limits are kept when the admin changes
+	_, err = suite.msgServer.ChangeAdmin(ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	metadata, err = suite.GetNeutronZoneApp(suite.ChainA).TokenFactoryKeeper.GetAuthorityMetadata(ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lower, *metadata.MaxSupply)
+	suite.Require().Len(metadata.MintSchedule, 1)
+}
+
+func (suite *KeeperTestSuite) TestMintLimits() {
+	suite.Setup()
+	suite.CreateDefaultDenom(suite.ChainA.GetContext())
+	ctx := suite.ChainA.GetContext()
+	admin := suite.TestAccs[0].String()
+	creator, subdenom, err := types.DeconstructDenom(suite.defaultDenom)
+	suite.Require().NoError(err)
+
+	// the minting isn't limited by default
+	res, err := suite.queryClient.DenomMintLimits(ctx, &types.QueryDenomMintLimitsRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.MaxSupply)
+	suite.Require().Nil(res.Mintable)
+
+	maxSupply := math.NewInt(1000)
+	schedule := []types.MintScheduleEntry{
+		{UnlockTime: ctx.BlockTime(), Amount: math.NewInt(300)},
+		{UnlockTime: ctx.BlockTime().Add(time.Hour), Amount: math.NewInt(1000)},
+	}
+	_, err = suite.msgServer.SetMintLimits(ctx, types.NewMsgSetMintLimits(admin, suite.defaultDenom, &maxSupply, schedule))
+	suite.Require().NoError(err)
+
+	// only the first entry of the schedule is unlocked
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 301)))
+	suite.Require().ErrorIs(err, types.ErrMintScheduleExceeded)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 300)))
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.DenomMintLimits(ctx, &types.QueryDenomMintLimitsRequest{Creator: creator, Subdenom: subdenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal(maxSupply, *res.MaxSupply)
+	suite.Require().Len(res.MintSchedule, len(schedule))
+	for i := range schedule {
+		suite.Require().True(schedule[i].Equal(res.MintSchedule[i]))
+	}
+	suite.Require().Equal(sdk.NewInt64Coin(suite.defaultDenom, 300), res.Supply)
+	suite.Require().Equal(math.ZeroInt(), *res.Mintable)
+
+	// the whole schedule is unlocked, but the max supply still applies
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 701)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 700)))
+	suite.Require().NoError(err)
+
+	// the burnt tokens can be minted again
+	_, err = suite.msgServer.Burn(ctx, types.NewMsgBurn(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(ctx, types.NewMsgMint(admin, sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+}
@@ -69,6 +69,11 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, types.ErrUnauthorized
 	}
 
+	// the combined supply of a migrated denom and its successor is limited by the successor
+	if server.coinfactoryKeeper != nil && server.coinfactoryKeeper.IsMigratedTokenfactoryDenom(ctx, msg.Amount.Denom) {
+		return nil, types.ErrDenomMigrated.Wrapf("denom: %s", msg.Amount.Denom)
+	}
+
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
@@ -271,6 +276,41 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
 
+func (server msgServer) SetMintLimits(goCtx context.Context, msg *types.MsgSetMintLimits) (*types.MsgSetMintLimitsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetMintLimits")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.setMintLimits(ctx, msg.Denom, msg.MaxSupply, msg.MintSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeDenom, msg.Denom)}
+	if msg.MaxSupply != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()))
+	}
+	if len(msg.MintSchedule) > 0 {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMintSchedule, types.FormatMintSchedule(msg.MintSchedule)))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.TypeMsgSetMintLimits, attributes...),
+	})
+
+	return &types.MsgSetMintLimitsResponse{}, nil
+}
+
 // UpdateParams updates the module parameters
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
@@ -839,6 +840,88 @@ func TestMsgSetBeforeSendHookValidate(t *testing.T) {
 	}
 }
 
+func TestMsgSetMintLimitsValidate(t *testing.T) {
+	k, ctx := testkeeper.TokenFactoryKeeper(t, nil, nil, nil)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	maxSupply := math.NewInt(1000)
+	zero := math.ZeroInt()
+	unlockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgSetMintLimits
+		expectedErr error
+	}{
+		{
+			"empty sender",
+			types.MsgSetMintLimits{
+				Sender:    "",
+				Denom:     denom,
+				MaxSupply: &maxSupply,
+			},
+			sdkerrors.ErrInvalidAddress,
+		},
+		{
+			"incorrect denom prefix",
+			types.MsgSetMintLimits{
+				Sender:    testutil.TestOwnerAddress,
+				Denom:     "bitcoin/factory/sun",
+				MaxSupply: &maxSupply,
+			},
+			types.ErrInvalidDenom,
+		},
+		{
+			"no limits",
+			types.MsgSetMintLimits{
+				Sender: testutil.TestOwnerAddress,
+				Denom:  denom,
+			},
+			types.ErrInvalidMintLimits,
+		},
+		{
+			"zero max supply",
+			types.MsgSetMintLimits{
+				Sender:    testutil.TestOwnerAddress,
+				Denom:     denom,
+				MaxSupply: &zero,
+			},
+			types.ErrInvalidMintLimits,
+		},
+		{
+			"zero mint schedule amount",
+			types.MsgSetMintLimits{
+				Sender: testutil.TestOwnerAddress,
+				Denom:  denom,
+				MintSchedule: []types.MintScheduleEntry{
+					{UnlockTime: unlockTime, Amount: zero},
+				},
+			},
+			types.ErrInvalidMintLimits,
+		},
+		{
+			"unordered mint schedule",
+			types.MsgSetMintLimits{
+				Sender: testutil.TestOwnerAddress,
+				Denom:  denom,
+				MintSchedule: []types.MintScheduleEntry{
+					{UnlockTime: unlockTime, Amount: maxSupply},
+					{UnlockTime: unlockTime, Amount: maxSupply},
+				},
+			},
+			types.ErrInvalidMintLimits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.SetMintLimits(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
 func TestMsgUpdateParamsValidate(t *testing.T) {
 	k, ctx := testkeeper.TokenFactoryKeeper(t, nil, nil, nil)
 
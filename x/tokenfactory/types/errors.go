@@ -21,4 +21,8 @@ var (
 	ErrTrackBeforeSendOutOfGas      = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
 	ErrInvalidHookContractAddress   = errorsmod.Register(ModuleName, 13, "invalid hook contract address")
 	ErrBeforeSendHookNotWhitelisted = errorsmod.Register(ModuleName, 14, "beforeSendHook is not whitelisted")
+	ErrMaxSupplyExceeded            = errorsmod.Register(ModuleName, 15, "minting exceeds the max supply of the denom")
+	ErrMintScheduleExceeded         = errorsmod.Register(ModuleName, 16, "minting exceeds the supply unlocked by the mint schedule of the denom")
+	ErrInvalidMintLimits            = errorsmod.Register(ModuleName, 17, "invalid mint limits")
+	ErrDenomMigrated                = errorsmod.Register(ModuleName, 18, "the denom is migrated to coinfactory and is minted only by the conversions")
 )
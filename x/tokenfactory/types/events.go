@@ -14,4 +14,6 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeMaxSupply             = "max_supply"
+	AttributeMintSchedule          = "mint_schedule"
 )
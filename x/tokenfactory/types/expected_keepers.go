@@ -14,6 +14,7 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 
 	HasSupply(ctx context.Context, denom string) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -35,6 +36,12 @@ type BankHooks interface {
 	BlockBeforeSend(ctx context.Context, from, to sdk.AccAddress, amount sdk.Coins) error // Must be before any send is executed
 }
 
+// CoinfactoryKeeper tells whether the denom is migrated to x/coinfactory. The supply of the migrated denoms
+// is limited by their coinfactory successors, so they are minted only by the conversions.
+type CoinfactoryKeeper interface {
+	IsMigratedTokenfactoryDenom(ctx context.Context, denom string) bool
+}
+
 type ContractKeeper interface {
 	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 	GetContractInfo(ctx context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
@@ -44,6 +44,10 @@ func (gs GenesisState) Validate() error {
 			}
 		}
 
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid mint limits (%s)", err)
+		}
+
 		if _, err := sdk.AccAddressFromBech32(denom.HookContractAddress); denom.HookContractAddress != "" && err != nil {
 			return errorsmod.Wrapf(ErrInvalidHookContractAddress, "Invalid hook contract address (%s)", err)
 		}
@@ -17,6 +17,7 @@ const (
 	TypeMsgChangeAdmin       = "change_admin"
 	TypeMsgSetDenomMetadata  = "set_denom_metadata"
 	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgSetMintLimits     = "set_mint_limits"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -317,3 +318,48 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetMintLimits{}
+
+// NewMsgSetMintLimits creates a message to set the max supply and the mint schedule of a denom
+func NewMsgSetMintLimits(sender, denom string, maxSupply *math.Int, mintSchedule []MintScheduleEntry) *MsgSetMintLimits {
+	return &MsgSetMintLimits{
+		Sender:       sender,
+		Denom:        denom,
+		MaxSupply:    maxSupply,
+		MintSchedule: mintSchedule,
+	}
+}
+
+func (m MsgSetMintLimits) Route() string { return RouterKey }
+func (m MsgSetMintLimits) Type() string  { return TypeMsgSetMintLimits }
+func (m MsgSetMintLimits) Validate() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.MaxSupply == nil && len(m.MintSchedule) == 0 {
+		return errorsmod.Wrap(ErrInvalidMintLimits, "either max supply or mint schedule must be set")
+	}
+
+	if m.MaxSupply != nil && !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMintLimits, "max supply must be positive, got %s", m.MaxSupply)
+	}
+
+	return ValidateMintSchedule(m.MintSchedule)
+}
+
+func (m MsgSetMintLimits) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&m)
+}
+
+func (m MsgSetMintLimits) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -504,6 +507,119 @@ func (m *QueryFullDenomResponse) GetFullDenom() string {
 	return ""
 }
 
+// QueryDenomMintLimitsRequest defines the request structure for the
+// DenomMintLimits gRPC query.
+type QueryDenomMintLimitsRequest struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	Subdenom string `protobuf:"bytes,2,opt,name=subdenom,proto3" json:"subdenom,omitempty" yaml:"subdenom"`
+}
+
+func (m *QueryDenomMintLimitsRequest) Reset()         { *m = QueryDenomMintLimitsRequest{} }
+func (m *QueryDenomMintLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitsRequest) ProtoMessage()    {}
+func (*QueryDenomMintLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{10}
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitsRequest.Merge(m, src)
+}
+func (m *QueryDenomMintLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitsRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomMintLimitsRequest) GetSubdenom() string {
+	if m != nil {
+		return m.Subdenom
+	}
+	return ""
+}
+
+// QueryDenomMintLimitsResponse defines the response structure for the
+// DenomMintLimits gRPC query.
+type QueryDenomMintLimitsResponse struct {
+	// max_supply is unset if the supply isn't capped
+	MaxSupply    *cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty" yaml:"max_supply"`
+	MintSchedule []MintScheduleEntry    `protobuf:"bytes,2,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule" yaml:"mint_schedule"`
+	Supply       types.Coin             `protobuf:"bytes,3,opt,name=supply,proto3" json:"supply" yaml:"supply"`
+	// mintable is the amount that can be minted at the current block time,
+	// unset if the minting isn't limited
+	Mintable *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=mintable,proto3,customtype=cosmossdk.io/math.Int" json:"mintable,omitempty" yaml:"mintable"`
+}
+
+func (m *QueryDenomMintLimitsResponse) Reset()         { *m = QueryDenomMintLimitsResponse{} }
+func (m *QueryDenomMintLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMintLimitsResponse) ProtoMessage()    {}
+func (*QueryDenomMintLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{11}
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMintLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMintLimitsResponse.Merge(m, src)
+}
+func (m *QueryDenomMintLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMintLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMintLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMintLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomMintLimitsResponse) GetMintSchedule() []MintScheduleEntry {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
+func (m *QueryDenomMintLimitsResponse) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsResponse")
@@ -515,6 +631,8 @@ func init() {
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
 	proto.RegisterType((*QueryFullDenomRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryFullDenomRequest")
 	proto.RegisterType((*QueryFullDenomResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryFullDenomResponse")
+	proto.RegisterType((*QueryDenomMintLimitsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMintLimitsRequest")
+	proto.RegisterType((*QueryDenomMintLimitsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMintLimitsResponse")
 }
 
 func init() {
@@ -522,55 +640,70 @@ func init() {
 }
 
 var fileDescriptor_6f22013ad0f72e3f = []byte{
-	// 758 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x4f, 0x13, 0x4f,
-	0x18, 0xee, 0xf2, 0xfb, 0x59, 0xe9, 0x28, 0x9a, 0x0e, 0x7f, 0x82, 0x0d, 0xb6, 0x32, 0x1a, 0x83,
-	0x09, 0x76, 0x2c, 0x34, 0x31, 0x01, 0x89, 0x50, 0x0c, 0x31, 0x51, 0x88, 0xac, 0xc4, 0x03, 0x31,
-	0x69, 0xa6, 0xdd, 0x69, 0x69, 0xe8, 0xee, 0x94, 0xd9, 0x59, 0x62, 0x43, 0xb8, 0x60, 0xe2, 0xd9,
-	0xc4, 0xa3, 0xdf, 0xc1, 0xef, 0xe0, 0x8d, 0x83, 0x07, 0x12, 0x2e, 0x9e, 0x36, 0x06, 0xfc, 0x04,
-	0x3d, 0x78, 0xf1, 0x62, 0x3a, 0x33, 0x4b, 0x81, 0x96, 0x4d, 0x81, 0x84, 0xdb, 0x76, 0xde, 0xe7,
-	0x7d, 0xde, 0xe7, 0x99, 0x77, 0xde, 0x37, 0x05, 0x63, 0xcc, 0xb5, 0x99, 0x5b, 0x71, 0xb1, 0x60,
-	0xeb, 0xd4, 0x29, 0x91, 0xa2, 0x60, 0xbc, 0x8e, 0x37, 0x33, 0x05, 0x2a, 0x48, 0x06, 0x6f, 0x78,
-	0x94, 0xd7, 0xd3, 0x35, 0xce, 0x04, 0x83, 0x23, 0x1a, 0x99, 0x3e, 0x8e, 0x4c, 0x6b, 0x64, 0x62,
-	0xa0, 0xcc, 0xca, 0x4c, 0x02, 0x71, 0xf3, 0x4b, 0xe5, 0x24, 0x46, 0xca, 0x8c, 0x95, 0xab, 0x14,
-	0x93, 0x5a, 0x05, 0x13, 0xc7, 0x61, 0x82, 0x88, 0x0a, 0x73, 0x5c, 0x1d, 0x1d, 0xed, 0x58, 0xbb,
-	0x46, 0x38, 0xb1, 0x03, 0x48, 0x36, 0x54, 0x1e, 0xf1, 0xc4, 0x1a, 0xe3, 0x15, 0x51, 0x5f, 0xa4,
-	0x82, 0x58, 0x44, 0x10, 0x95, 0x85, 0x06, 0x00, 0x5c, 0x6e, 0x2a, 0x7f, 0x23, 0xa9, 0x4c, 0xba,
-	0xe1, 0x51, 0x57, 0xa0, 0x65, 0xd0, 0x7f, 0xe2, 0xd4, 0xad, 0x31, 0xc7, 0xa5, 0x70, 0x0a, 0x44,
-	0x55, 0xc9, 0x61, 0xe3, 0x9e, 0x31, 0x76, 0x63, 0x62, 0x24, 0xdd, 0xd1, 0xa8, 0xca, 0xca, 0xfd,
-	0xbf, 0xeb, 0xa7, 0x22, 0xa6, 0xce, 0x40, 0x1f, 0x0d, 0x80, 0x24, 0xe7, 0x0b, 0xea, 0x30, 0x7b,
-	0xee, 0xb4, 0x1c, 0x5d, 0x19, 0x8e, 0x83, 0xeb, 0x45, 0x4e, 0x89, 0x60, 0x5c, 0xd6, 0x88, 0xe5,
-	0x60, 0xc3, 0x4f, 0xdd, 0xaa, 0x13, 0xbb, 0x3a, 0x85, 0x74, 0x00, 0x99, 0x01, 0x04, 0x62, 0xd0,
-	0xeb, 0x7a, 0x05, 0xab, 0xc9, 0x38, 0xdc, 0x23, 0xe1, 0xfd, 0x0d, 0x3f, 0x75, 0x5b, 0xc1, 0x83,
-	0x08, 0x32, 0x8f, 0x40, 0xe8, 0x9b, 0x01, 0xee, 0x87, 0xaa, 0xd0, 0x4e, 0x3f, 0x19, 0x00, 0x1e,
-	0x5d, 0x59, 0xde, 0xd6, 0x61, 0x6d, 0x3b, 0x9b, 0x0e, 0xeb, 0x6f, 0xba, 0x33, 0x75, 0x6e, 0xb4,
-	0x79, 0x1d, 0x0d, 0x3f, 0x75, 0x47, 0xa9, 0x6b, 0x67, 0x47, 0x66, 0xbc, 0xad, 0x4b, 0x68, 0x11,
-	0xdc, 0x6d, 0xe9, 0x75, 0x17, 0x38, 0xb3, 0xe7, 0x95, 0xf7, 0x0b, 0x5d, 0x18, 0x7a, 0x05, 0x92,
-	0x67, 0xd1, 0x69, 0xe7, 0x8f, 0x40, 0x54, 0x5e, 0x55, 0xb3, 0xc7, 0xff, 0x8d, 0xc5, 0x72, 0xf1,
-	0x86, 0x9f, 0xea, 0x53, 0x74, 0xea, 0x1c, 0x99, 0x1a, 0x80, 0x76, 0x0c, 0x30, 0x2a, 0xd9, 0x72,
-	0xb4, 0xc4, 0x38, 0x7d, 0x4b, 0x1d, 0xeb, 0x25, 0x63, 0xeb, 0x73, 0x96, 0xc5, 0xa9, 0xeb, 0x5e,
-	0x51, 0x47, 0x8b, 0xfa, 0x59, 0x9d, 0xa1, 0x41, 0xbb, 0x9a, 0x01, 0x7d, 0x45, 0xe6, 0x08, 0x4e,
-	0x8a, 0x22, 0x4f, 0x2c, 0x2b, 0x90, 0x32, 0xdc, 0xf0, 0x53, 0x03, 0x5a, 0xca, 0xf1, 0x30, 0x32,
-	0x6f, 0x06, 0xbf, 0x9b, 0x4c, 0x68, 0x13, 0x0c, 0xca, 0x22, 0x0b, 0x5e, 0xb5, 0x2a, 0xaf, 0xee,
-	0x8a, 0xcc, 0x2d, 0x81, 0xa1, 0xd3, 0x75, 0xb5, 0xa1, 0x2c, 0x00, 0x25, 0xaf, 0x5a, 0xcd, 0x2b,
-	0x32, 0x55, 0x7b, 0xb0, 0xe1, 0xa7, 0xe2, 0x8a, 0xac, 0x15, 0x43, 0x66, 0xac, 0x14, 0x64, 0x4f,
-	0x7c, 0xef, 0x05, 0xd7, 0x24, 0x21, 0xfc, 0x6a, 0x80, 0xa8, 0x9a, 0x53, 0xf8, 0x24, 0xfc, 0x39,
-	0xb7, 0xaf, 0x87, 0x44, 0xe6, 0x1c, 0x19, 0x4a, 0x2f, 0x1a, 0xdf, 0xd9, 0xff, 0xfd, 0xa5, 0xe7,
-	0x21, 0x7c, 0x80, 0x43, 0xd7, 0x94, 0x5a, 0x16, 0xf0, 0xaf, 0x01, 0x86, 0x3a, 0x8f, 0x11, 0x9c,
-	0xed, 0xa2, 0x76, 0xe8, 0x8a, 0x49, 0xcc, 0x5d, 0x82, 0x41, 0xbb, 0x79, 0x2f, 0xdd, 0xbc, 0x83,
-	0x2b, 0xe1, 0x6e, 0xd4, 0x9c, 0xe0, 0xe0, 0x78, 0x4b, 0xbf, 0x83, 0x6d, 0xbc, 0x15, 0x74, 0x78,
-	0x1b, 0xb7, 0xef, 0x01, 0xb8, 0x6f, 0x80, 0x78, 0xdb, 0x80, 0xc2, 0xe9, 0x6e, 0x65, 0x77, 0xd8,
-	0x12, 0x89, 0x67, 0x17, 0x4b, 0xd6, 0x76, 0xe7, 0xa5, 0xdd, 0x19, 0x38, 0xdd, 0x8d, 0xdd, 0x7c,
-	0x89, 0x33, 0x3b, 0xaf, 0xad, 0xb6, 0x3c, 0xc3, 0x3f, 0x06, 0x18, 0xec, 0x38, 0xa4, 0xf0, 0x79,
-	0x17, 0xe2, 0xc2, 0x56, 0x4c, 0x62, 0xf6, 0xe2, 0x04, 0xda, 0xe1, 0xaa, 0x74, 0xb8, 0x02, 0xcd,
-	0xcb, 0x37, 0xb4, 0x20, 0x0b, 0xe5, 0x5d, 0xea, 0x58, 0xf9, 0x35, 0xc6, 0xd6, 0xe1, 0x0f, 0x03,
-	0xc4, 0x8e, 0x06, 0x18, 0x4e, 0x76, 0xa1, 0xf5, 0xf4, 0x9a, 0x49, 0x64, 0xcf, 0x97, 0xa4, 0x4d,
-	0xad, 0x48, 0x53, 0x4b, 0xf0, 0xf5, 0xe5, 0x4d, 0xb5, 0xf6, 0x49, 0x6e, 0x79, 0xf7, 0x20, 0x69,
-	0xec, 0x1d, 0x24, 0x8d, 0x5f, 0x07, 0x49, 0xe3, 0xf3, 0x61, 0x32, 0xb2, 0x77, 0x98, 0x8c, 0xfc,
-	0x3c, 0x4c, 0x46, 0x56, 0x9f, 0x96, 0x2b, 0x62, 0xcd, 0x2b, 0xa4, 0x8b, 0xcc, 0xc6, 0x0e, 0xf5,
-	0x04, 0x67, 0xce, 0x63, 0xc6, 0xcb, 0xc1, 0x37, 0xde, 0xcc, 0x64, 0xf0, 0x87, 0x93, 0x1a, 0x44,
-	0xbd, 0x46, 0xdd, 0x42, 0x54, 0xfe, 0x17, 0x99, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x71, 0x2b,
-	0xf9, 0xb7, 0x62, 0x09, 0x00, 0x00,
+	// 996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0xc5, 0xd4, 0xd3, 0x86, 0x28, 0xd3, 0xb8, 0x72, 0x8c, 0xf1, 0x36, 0x03, 0x42,
+	0x41, 0x6a, 0x77, 0x71, 0x1a, 0x09, 0x91, 0x52, 0x48, 0x1c, 0xa8, 0x8a, 0x68, 0x0a, 0xd9, 0x04,
+	0x0e, 0x11, 0xd2, 0x6a, 0xbc, 0x3b, 0xb6, 0x57, 0xd9, 0xdd, 0x71, 0x77, 0x67, 0xa3, 0x58, 0xa5,
+	0x97, 0x22, 0x71, 0x46, 0xe2, 0x84, 0x38, 0x72, 0xe7, 0xc4, 0x8f, 0xc8, 0x81, 0x43, 0x45, 0x2f,
+	0x88, 0xc3, 0x82, 0x12, 0x7e, 0x81, 0x0f, 0x5c, 0xb8, 0xa0, 0x9d, 0x99, 0xb5, 0x13, 0xdb, 0x2c,
+	0x6e, 0x2c, 0xe5, 0xe6, 0x9d, 0xf7, 0xbe, 0xef, 0x7d, 0xdf, 0x9b, 0xdd, 0xf7, 0x0c, 0x96, 0x69,
+	0xe8, 0xd1, 0xd0, 0x09, 0x75, 0x46, 0xf7, 0x89, 0xdf, 0xc0, 0x16, 0xa3, 0x41, 0x47, 0x3f, 0xa8,
+	0xd6, 0x09, 0xc3, 0x55, 0xfd, 0x51, 0x44, 0x82, 0x8e, 0xd6, 0x0e, 0x28, 0xa3, 0xb0, 0x2c, 0x33,
+	0xb5, 0xd3, 0x99, 0x9a, 0xcc, 0x2c, 0x55, 0x2c, 0x1e, 0xd6, 0xeb, 0x38, 0x24, 0x3d, 0xb8, 0x45,
+	0x1d, 0x5f, 0xa0, 0x4b, 0x8b, 0x22, 0x6e, 0xf2, 0x27, 0x5d, 0x3c, 0xc8, 0xd0, 0x42, 0x93, 0x36,
+	0xa9, 0x38, 0x4f, 0x7e, 0xc9, 0xd3, 0x72, 0x93, 0xd2, 0xa6, 0x4b, 0x74, 0xdc, 0x76, 0x74, 0xec,
+	0xfb, 0x94, 0x61, 0xe6, 0x50, 0x3f, 0xc5, 0x2c, 0x8d, 0x94, 0xdd, 0xc6, 0x01, 0xf6, 0xd2, 0x94,
+	0xd5, 0x4c, 0x67, 0x38, 0x62, 0x2d, 0x1a, 0x38, 0xac, 0xb3, 0x45, 0x18, 0xb6, 0x31, 0xc3, 0x02,
+	0x85, 0x16, 0x00, 0xdc, 0x4e, 0x4c, 0x7f, 0xc6, 0xa9, 0x0c, 0xf2, 0x28, 0x22, 0x21, 0x43, 0xdb,
+	0xe0, 0xda, 0x99, 0xd3, 0xb0, 0x4d, 0xfd, 0x90, 0xc0, 0x35, 0x90, 0x13, 0x25, 0x8b, 0xca, 0x0d,
+	0x65, 0xf9, 0xca, 0x4a, 0x59, 0x1b, 0xd9, 0x23, 0x81, 0xaa, 0x5d, 0x3a, 0x8a, 0xd5, 0x29, 0x43,
+	0x22, 0xd0, 0xd7, 0x0a, 0x40, 0x9c, 0xf3, 0x43, 0xe2, 0x53, 0x6f, 0x63, 0x50, 0x8e, 0xac, 0x0c,
+	0x6f, 0x82, 0x97, 0xad, 0x80, 0x60, 0x46, 0x03, 0x5e, 0x23, 0x5f, 0x83, 0xdd, 0x58, 0x7d, 0xa5,
+	0x83, 0x3d, 0x77, 0x0d, 0xc9, 0x00, 0x32, 0xd2, 0x14, 0xa8, 0x83, 0xcb, 0x61, 0x54, 0xb7, 0x13,
+	0xc6, 0xe2, 0x34, 0x4f, 0xbf, 0xd6, 0x8d, 0xd5, 0x39, 0x91, 0x9e, 0x46, 0x90, 0xd1, 0x4b, 0x42,
+	0x3f, 0x29, 0xe0, 0xf5, 0x4c, 0x15, 0xd2, 0xe9, 0x37, 0x0a, 0x80, 0xbd, 0x96, 0x99, 0x9e, 0x0c,
+	0x4b, 0xdb, 0xab, 0x5a, 0xd6, 0xab, 0xa1, 0x8d, 0xa6, 0xae, 0x2d, 0x25, 0xed, 0xe8, 0xc6, 0xea,
+	0xa2, 0x50, 0x37, 0xcc, 0x8e, 0x8c, 0xf9, 0xa1, 0x5b, 0x42, 0x5b, 0xe0, 0xb5, 0xbe, 0xde, 0xf0,
+	0x5e, 0x40, 0xbd, 0x4d, 0xe1, 0xfd, 0x5c, 0x0d, 0x43, 0x9f, 0x80, 0xca, 0x7f, 0xd1, 0x49, 0xe7,
+	0x6f, 0x81, 0x1c, 0x6f, 0x55, 0x72, 0xc7, 0x33, 0xcb, 0xf9, 0xda, 0x7c, 0x37, 0x56, 0x67, 0x05,
+	0x9d, 0x38, 0x47, 0x86, 0x4c, 0x40, 0x4f, 0x15, 0xb0, 0xc4, 0xd9, 0x6a, 0xa4, 0x41, 0x03, 0xb2,
+	0x43, 0x7c, 0xfb, 0x3e, 0xa5, 0xfb, 0x1b, 0xb6, 0x1d, 0x90, 0x30, 0xbc, 0xa0, 0x1b, 0xb5, 0x00,
+	0xca, 0xd2, 0x20, 0x5d, 0xdd, 0x05, 0xb3, 0x16, 0xf5, 0x59, 0x80, 0x2d, 0x66, 0x62, 0xdb, 0x4e,
+	0xa5, 0x14, 0xbb, 0xb1, 0xba, 0x20, 0xa5, 0x9c, 0x0e, 0x23, 0xe3, 0x6a, 0xfa, 0x9c, 0x30, 0xa1,
+	0x03, 0x50, 0xe0, 0x45, 0xee, 0x45, 0xae, 0xcb, 0x5b, 0x77, 0x41, 0xe6, 0x1e, 0x82, 0xeb, 0x83,
+	0x75, 0xa5, 0xa1, 0x55, 0x00, 0x1a, 0x91, 0xeb, 0x9a, 0x82, 0x4c, 0xd4, 0x2e, 0x74, 0x63, 0x75,
+	0x5e, 0x90, 0xf5, 0x63, 0xc8, 0xc8, 0x37, 0x52, 0x34, 0xfa, 0x0a, 0xbc, 0xda, 0xbf, 0xfe, 0x2d,
+	0xc7, 0x67, 0x0f, 0x1c, 0xcf, 0x61, 0x17, 0x75, 0x55, 0xdf, 0xcf, 0x80, 0xf2, 0xe8, 0xf2, 0xd2,
+	0x94, 0x09, 0x80, 0x87, 0x0f, 0xcd, 0x30, 0x6a, 0xb7, 0xdd, 0x8e, 0x94, 0xb0, 0x7e, 0x14, 0xab,
+	0xca, 0xef, 0xb1, 0x5a, 0x10, 0x33, 0x34, 0xb4, 0xf7, 0x35, 0x87, 0xea, 0x1e, 0x66, 0x2d, 0xed,
+	0x63, 0x9f, 0xf5, 0x1d, 0xf7, 0x81, 0xe8, 0xd7, 0x9f, 0x6f, 0x01, 0x91, 0x9d, 0xa4, 0x18, 0x79,
+	0x0f, 0x1f, 0xee, 0xf0, 0x08, 0x0c, 0xc0, 0xac, 0xe7, 0xf8, 0xcc, 0x0c, 0xad, 0x16, 0xb1, 0x23,
+	0x97, 0x14, 0xa7, 0x6f, 0xcc, 0x2c, 0x5f, 0x59, 0xd1, 0xb3, 0x3f, 0xe8, 0x44, 0xe9, 0x8e, 0x44,
+	0x7c, 0xe4, 0xb3, 0xa0, 0x53, 0x2b, 0xcb, 0x6f, 0x59, 0xbe, 0x3b, 0x67, 0x38, 0x91, 0x71, 0xd5,
+	0x3b, 0x05, 0x80, 0xf7, 0x41, 0x4e, 0x1a, 0x9a, 0xe1, 0xd3, 0x63, 0x51, 0x93, 0xda, 0x92, 0xd5,
+	0xd1, 0xab, 0xb1, 0x49, 0x1d, 0xbf, 0x56, 0x90, 0xb4, 0xb3, 0x69, 0x0f, 0xb9, 0x1d, 0x43, 0xe2,
+	0xe1, 0x1e, 0xb8, 0x9c, 0x30, 0xe3, 0xba, 0x4b, 0x8a, 0x97, 0x78, 0x73, 0xde, 0xff, 0xbf, 0xe6,
+	0xcc, 0xf5, 0x05, 0x26, 0xb0, 0xc1, 0xd6, 0xf4, 0xf8, 0x56, 0x7e, 0x04, 0xe0, 0x25, 0x7e, 0x37,
+	0xf0, 0x07, 0x05, 0xe4, 0xc4, 0x04, 0x87, 0x6f, 0x67, 0xf7, 0x65, 0x78, 0x71, 0x94, 0xaa, 0x2f,
+	0x80, 0x10, 0x97, 0x8e, 0x6e, 0x3e, 0x7d, 0xfe, 0xd7, 0x77, 0xd3, 0x6f, 0xc2, 0x37, 0xf4, 0xcc,
+	0x05, 0x26, 0xd6, 0x08, 0xfc, 0x47, 0x01, 0xd7, 0x47, 0x0f, 0x58, 0xb8, 0x3e, 0x46, 0xed, 0xcc,
+	0xe5, 0x53, 0xda, 0x98, 0x80, 0x41, 0xba, 0xf9, 0x92, 0xbb, 0xf9, 0x02, 0xee, 0x66, 0xbb, 0x11,
+	0x13, 0x54, 0x4f, 0x8f, 0x1f, 0xcb, 0x6f, 0xea, 0x89, 0xfe, 0x38, 0xfd, 0x5a, 0x9e, 0xe8, 0xc3,
+	0x1b, 0x02, 0x3e, 0x57, 0xc0, 0xfc, 0xd0, 0xe8, 0x86, 0x77, 0xc6, 0x95, 0x3d, 0x62, 0x7f, 0x94,
+	0xde, 0x3b, 0x1f, 0x58, 0xda, 0xdd, 0xe4, 0x76, 0xef, 0xc2, 0x3b, 0xe3, 0xd8, 0x35, 0x1b, 0x01,
+	0xf5, 0x4c, 0x69, 0xb5, 0xef, 0x19, 0xfe, 0xad, 0x80, 0xc2, 0xc8, 0xf1, 0x0d, 0x3f, 0x18, 0x43,
+	0x5c, 0xd6, 0xf2, 0x29, 0xad, 0x9f, 0x9f, 0x40, 0x3a, 0xdc, 0xe3, 0x0e, 0x77, 0xa1, 0x31, 0xf9,
+	0x85, 0xd6, 0x79, 0x21, 0x33, 0x24, 0xbe, 0x6d, 0xb6, 0x28, 0xdd, 0x87, 0xbf, 0x28, 0x20, 0xdf,
+	0x1b, 0xed, 0xf0, 0xf6, 0x18, 0x5a, 0x07, 0x17, 0x50, 0x69, 0xf5, 0xc5, 0x40, 0xd2, 0xd4, 0x2e,
+	0x37, 0xf5, 0x10, 0x3e, 0x98, 0xdc, 0x54, 0x7f, 0xd3, 0xc0, 0x3f, 0x14, 0x30, 0x37, 0x30, 0xda,
+	0xe1, 0xbb, 0xe3, 0xbe, 0x5e, 0x43, 0xdb, 0xa8, 0xb4, 0x76, 0x1e, 0xa8, 0x34, 0xf8, 0x39, 0x37,
+	0xf8, 0x29, 0xdc, 0x9a, 0xdc, 0x20, 0x1f, 0xee, 0x2e, 0xa7, 0xaf, 0x6d, 0x1f, 0x1d, 0x57, 0x94,
+	0x67, 0xc7, 0x15, 0xe5, 0xcf, 0xe3, 0x8a, 0xf2, 0xed, 0x49, 0x65, 0xea, 0xd9, 0x49, 0x65, 0xea,
+	0xb7, 0x93, 0xca, 0xd4, 0xde, 0x3b, 0x4d, 0x87, 0xb5, 0xa2, 0xba, 0x66, 0x51, 0x4f, 0xf7, 0x49,
+	0xc4, 0x02, 0xea, 0xdf, 0xa2, 0x41, 0x33, 0xfd, 0xad, 0x1f, 0x54, 0xab, 0xfa, 0xe1, 0x59, 0x11,
+	0xac, 0xd3, 0x26, 0x61, 0x3d, 0xc7, 0xff, 0x87, 0xdf, 0xfe, 0x77, 0x00, 0xa6, 0xd8, 0xa4, 0x51,
+	0x99, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -600,6 +733,9 @@ type QueryClient interface {
 	// FullDenom defines a gRPC query method for getting full denom name
 	// from the creator and subdenom strings.
 	FullDenom(ctx context.Context, in *QueryFullDenomRequest, opts ...grpc.CallOption) (*QueryFullDenomResponse, error)
+	// DenomMintLimits defines a gRPC query method for fetching the max supply
+	// and the mint schedule of a denom along with its current supply.
+	DenomMintLimits(ctx context.Context, in *QueryDenomMintLimitsRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitsResponse, error)
 }
 
 type queryClient struct {
@@ -655,6 +791,15 @@ func (c *queryClient) FullDenom(ctx context.Context, in *QueryFullDenomRequest,
 	return out, nil
 }
 
+func (c *queryClient) DenomMintLimits(ctx context.Context, in *QueryDenomMintLimitsRequest, opts ...grpc.CallOption) (*QueryDenomMintLimitsResponse, error) {
+	out := new(QueryDenomMintLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomMintLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -672,6 +817,9 @@ type QueryServer interface {
 	// FullDenom defines a gRPC query method for getting full denom name
 	// from the creator and subdenom strings.
 	FullDenom(context.Context, *QueryFullDenomRequest) (*QueryFullDenomResponse, error)
+	// DenomMintLimits defines a gRPC query method for fetching the max supply
+	// and the mint schedule of a denom along with its current supply.
+	DenomMintLimits(context.Context, *QueryDenomMintLimitsRequest) (*QueryDenomMintLimitsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -693,6 +841,9 @@ func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req
 func (*UnimplementedQueryServer) FullDenom(ctx context.Context, req *QueryFullDenomRequest) (*QueryFullDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FullDenom not implemented")
 }
+func (*UnimplementedQueryServer) DenomMintLimits(ctx context.Context, req *QueryDenomMintLimitsRequest) (*QueryDenomMintLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMintLimits not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -788,6 +939,25 @@ func _Query_FullDenom_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomMintLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMintLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMintLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomMintLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMintLimits(ctx, req.(*QueryDenomMintLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -812,6 +982,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FullDenom",
 			Handler:    _Query_FullDenom_Handler,
 		},
+		{
+			MethodName: "DenomMintLimits",
+			Handler:    _Query_DenomMintLimits_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/query.proto",
@@ -1139,6 +1313,114 @@ func (m *QueryFullDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomMintLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Subdenom) > 0 {
+		i -= len(m.Subdenom)
+		copy(dAtA[i:], m.Subdenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subdenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMintLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMintLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMintLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mintable != nil {
+		{
+			size := m.Mintable.Size()
+			i -= size
+			if _, err := m.Mintable.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1286,6 +1568,48 @@ func (m *QueryFullDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomMintLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Subdenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMintLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Mintable != nil {
+		l = m.Mintable.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2178,6 +2502,309 @@ func (m *QueryFullDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomMintLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subdenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subdenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomMintLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomMintLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, MintScheduleEntry{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Mintable = &v
+			if err := m.Mintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
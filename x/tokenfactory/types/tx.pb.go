@@ -5,11 +5,12 @@ package types
 
 import (
 	context "context"
+	_ "cosmossdk.io/api/amino"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
-	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -733,6 +734,110 @@ func (m *MsgForceTransferResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgForceTransferResponse proto.InternalMessageInfo
 
+// MsgSetMintLimits is the sdk.Msg type for allowing an admin account to commit
+// to the max supply and the mint schedule of a denom. The max supply can only
+// be lowered and the mint schedule can be set only once.
+type MsgSetMintLimits struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// max_supply is left unchanged if unset
+	MaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty" yaml:"max_supply"`
+	// mint_schedule is left unchanged if empty
+	MintSchedule []MintScheduleEntry `protobuf:"bytes,4,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule" yaml:"mint_schedule"`
+}
+
+func (m *MsgSetMintLimits) Reset()         { *m = MsgSetMintLimits{} }
+func (m *MsgSetMintLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintLimits) ProtoMessage()    {}
+func (*MsgSetMintLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{14}
+}
+func (m *MsgSetMintLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintLimits.Merge(m, src)
+}
+func (m *MsgSetMintLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintLimits proto.InternalMessageInfo
+
+func (m *MsgSetMintLimits) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetMintLimits) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetMintLimits) GetMintSchedule() []MintScheduleEntry {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
+// MsgSetMintLimitsResponse defines the response structure for an executed
+// MsgSetMintLimits message.
+type MsgSetMintLimitsResponse struct {
+}
+
+func (m *MsgSetMintLimitsResponse) Reset()         { *m = MsgSetMintLimitsResponse{} }
+func (m *MsgSetMintLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMintLimitsResponse) ProtoMessage()    {}
+func (*MsgSetMintLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_283b6c9a90a846b4, []int{15}
+}
+func (m *MsgSetMintLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMintLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMintLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMintLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMintLimitsResponse.Merge(m, src)
+}
+func (m *MsgSetMintLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMintLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMintLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMintLimitsResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the MsgUpdateParams request type.
 //
 // Since: 0.47
@@ -749,7 +854,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_283b6c9a90a846b4, []int{14}
+	return fileDescriptor_283b6c9a90a846b4, []int{16}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +908,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_283b6c9a90a846b4, []int{15}
+	return fileDescriptor_283b6c9a90a846b4, []int{17}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -847,6 +952,8 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgForceTransfer)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransfer")
 	proto.RegisterType((*MsgForceTransferResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgForceTransferResponse")
+	proto.RegisterType((*MsgSetMintLimits)(nil), "osmosis.tokenfactory.v1beta1.MsgSetMintLimits")
+	proto.RegisterType((*MsgSetMintLimitsResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgSetMintLimitsResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "osmosis.tokenfactory.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.MsgUpdateParamsResponse")
 }
@@ -856,73 +963,83 @@ func init() {
 }
 
 var fileDescriptor_283b6c9a90a846b4 = []byte{
-	// 1054 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xf6, 0x47, 0x9a, 0x4c, 0x12, 0x92, 0x6c, 0x4c, 0xe3, 0x2c, 0xae, 0xb7, 0x2c, 0x14,
-	0xb5, 0x11, 0xde, 0x95, 0x53, 0x68, 0xc1, 0x12, 0x82, 0xba, 0x28, 0xea, 0x01, 0x4b, 0xb0, 0x09,
-	0x17, 0x54, 0xc9, 0x5a, 0xdb, 0xe3, 0xcd, 0xca, 0xec, 0x8c, 0x99, 0x19, 0x27, 0xcd, 0xad, 0x82,
-	0x1b, 0x27, 0xfe, 0x03, 0xc4, 0x05, 0x71, 0xcc, 0x81, 0xff, 0x80, 0x4b, 0xb9, 0x55, 0x9c, 0x38,
-	0xad, 0x50, 0x72, 0xc8, 0x81, 0x13, 0xfe, 0x0b, 0xd0, 0xfc, 0xd8, 0xb5, 0x77, 0xbd, 0xc2, 0xf6,
-	0x01, 0xf5, 0xd2, 0x7a, 0xe7, 0x7d, 0xdf, 0xdb, 0xf7, 0x7d, 0xf3, 0xe6, 0xcd, 0x06, 0xdc, 0xc1,
-	0x34, 0xc4, 0x34, 0xa0, 0x0e, 0xc3, 0x3d, 0x88, 0xba, 0x5e, 0x9b, 0x61, 0x72, 0xea, 0x1c, 0x57,
-	0x5b, 0x90, 0x79, 0x55, 0x87, 0x3d, 0xb3, 0xfb, 0x04, 0x33, 0xac, 0x97, 0x14, 0xcc, 0x1e, 0x87,
-	0xd9, 0x0a, 0x66, 0x6c, 0x7a, 0x61, 0x80, 0xb0, 0x23, 0xfe, 0x95, 0x04, 0xa3, 0xdc, 0x16, 0x0c,
-	0xa7, 0xe5, 0xa1, 0x5e, 0x92, 0x8e, 0x3f, 0x4c, 0xc4, 0x29, 0x4c, 0xe2, 0x6d, 0x1c, 0x20, 0x15,
-	0xdf, 0x56, 0xf1, 0x90, 0xfa, 0xce, 0x71, 0x95, 0xff, 0xa7, 0x02, 0x3b, 0x32, 0xd0, 0x14, 0x4f,
-	0x8e, 0x7c, 0x50, 0xa1, 0x82, 0x8f, 0x7d, 0x2c, 0xd7, 0xf9, 0x2f, 0xb5, 0xfa, 0x66, 0xae, 0xc2,
-	0xbe, 0x47, 0xbc, 0x50, 0x11, 0xad, 0x1f, 0x35, 0xf0, 0x5a, 0x83, 0xfa, 0x8f, 0x09, 0xf4, 0x18,
-	0xfc, 0x14, 0x22, 0x1c, 0xea, 0xf7, 0xc0, 0x22, 0x85, 0xa8, 0x03, 0x49, 0x51, 0xbb, 0xad, 0xdd,
-	0x5d, 0xae, 0x6f, 0x0e, 0x23, 0x73, 0xed, 0xd4, 0x0b, 0xbf, 0xae, 0x59, 0x72, 0xdd, 0x72, 0x15,
-	0x40, 0x77, 0xc0, 0x12, 0x1d, 0xb4, 0x3a, 0x9c, 0x56, 0xbc, 0x22, 0xc0, 0x5b, 0xc3, 0xc8, 0x5c,
-	0x57, 0x60, 0x15, 0xb1, 0xdc, 0x04, 0x54, 0xab, 0x7e, 0x7b, 0x79, 0xb6, 0xab, 0xd8, 0xdf, 0x5f,
-	0x9e, 0xed, 0xe6, 0x57, 0xd8, 0x16, 0xd5, 0x54, 0x24, 0xfb, 0x29, 0xb8, 0x99, 0x2e, 0xd0, 0x85,
-	0xb4, 0x8f, 0x11, 0x85, 0x7a, 0x1d, 0xac, 0x23, 0x78, 0xd2, 0x14, 0xd4, 0xa6, 0x2c, 0x42, 0x56,
-	0x6c, 0x0c, 0x23, 0xf3, 0xa6, 0x2c, 0x22, 0x03, 0xb0, 0xdc, 0x35, 0x04, 0x4f, 0x0e, 0xf9, 0x82,
-	0xc8, 0x65, 0xfd, 0xad, 0x81, 0x1b, 0x0d, 0xea, 0x37, 0x02, 0xc4, 0xe6, 0x11, 0xfe, 0x04, 0x2c,
-	0x7a, 0x21, 0x1e, 0x20, 0x26, 0x64, 0xaf, 0xec, 0xed, 0xd8, 0x6a, 0x3b, 0xf8, 0xa6, 0xc6, 0xcd,
-	0x61, 0x3f, 0xc6, 0x01, 0xaa, 0xbf, 0xfe, 0x22, 0x32, 0x17, 0x46, 0x99, 0x24, 0xcd, 0x72, 0x15,
-	0x5f, 0xff, 0x04, 0xac, 0x85, 0x01, 0x62, 0x87, 0xf8, 0x51, 0xa7, 0x43, 0x20, 0xa5, 0xc5, 0xab,
-	0x59, 0x09, 0x3c, 0xdc, 0x64, 0xb8, 0xe9, 0x49, 0x80, 0xe5, 0xa6, 0x09, 0xb5, 0x7b, 0x19, 0x4f,
-	0x77, 0x72, 0x3d, 0xe5, 0x1c, 0x6b, 0x13, 0xac, 0x2b, 0xb1, 0xb1, 0x89, 0xd6, 0x3f, 0xd2, 0x80,
-	0xfa, 0x80, 0xa0, 0x57, 0x63, 0xc0, 0x3e, 0x58, 0x6f, 0x0d, 0x08, 0xda, 0x27, 0x38, 0x4c, 0x5b,
-	0x50, 0x1a, 0x46, 0x66, 0x51, 0x72, 0x38, 0xa0, 0xd9, 0x25, 0x38, 0x1c, 0x99, 0x90, 0x25, 0xcd,
-	0x68, 0x03, 0x67, 0x29, 0x1b, 0xb8, 0xe4, 0xc4, 0x86, 0xdf, 0xd5, 0x39, 0x38, 0xf2, 0x90, 0x0f,
-	0x1f, 0x75, 0xc2, 0x60, 0x2e, 0x37, 0xde, 0x01, 0xd7, 0xc7, 0x0f, 0xc1, 0xc6, 0x30, 0x32, 0x57,
-	0x25, 0x52, 0x75, 0x9d, 0x0c, 0xeb, 0x55, 0xb0, 0xcc, 0x1b, 0xd2, 0xe3, 0xf9, 0x95, 0xca, 0xc2,
-	0x30, 0x32, 0x37, 0x46, 0xbd, 0x2a, 0x42, 0x96, 0xbb, 0x84, 0xe0, 0x89, 0xa8, 0x62, 0xd6, 0x13,
-	0x23, 0xea, 0xae, 0x48, 0x76, 0x51, 0x9e, 0x98, 0x91, 0x94, 0x44, 0xe5, 0xb9, 0x06, 0x0a, 0x0d,
-	0xea, 0x1f, 0x40, 0x56, 0x87, 0x5d, 0x4c, 0xe0, 0x01, 0x44, 0x9d, 0x27, 0x18, 0xf7, 0xfe, 0x0f,
-	0xad, 0x1f, 0x81, 0xb5, 0x36, 0x46, 0x8c, 0x78, 0x6d, 0x26, 0x76, 0x4d, 0xe9, 0x2d, 0x0e, 0x23,
-	0xb3, 0x20, 0xf1, 0xa9, 0xb0, 0xe5, 0xae, 0xc6, 0xcf, 0x7c, 0x47, 0x6b, 0x1f, 0x64, 0x74, 0xdf,
-	0xcd, 0xd5, 0x4d, 0x21, 0xab, 0xb4, 0x84, 0x14, 0x8e, 0xac, 0x1c, 0x61, 0xdc, 0xb3, 0xca, 0xa0,
-	0x94, 0xa7, 0x31, 0x31, 0xe1, 0x27, 0x0d, 0x6c, 0x49, 0x80, 0x18, 0x01, 0x0d, 0xc8, 0xbc, 0x8e,
-	0xc7, 0xbc, 0x79, 0x3c, 0x70, 0xc1, 0x52, 0xa8, 0x68, 0xaa, 0xff, 0x6f, 0x8d, 0xfa, 0x1f, 0xf5,
-	0x92, 0xfe, 0x8f, 0x73, 0xd7, 0xb7, 0xd5, 0x19, 0x50, 0xa3, 0x31, 0x26, 0x5b, 0x6e, 0x92, 0xa7,
-	0xb6, 0x32, 0x26, 0xd8, 0xba, 0x05, 0xde, 0xc8, 0x29, 0x31, 0x91, 0x10, 0x5d, 0x01, 0x1b, 0x0d,
-	0xea, 0xef, 0x63, 0xd2, 0x86, 0x87, 0xc4, 0x43, 0xb4, 0x0b, 0xc9, 0xab, 0x39, 0xbd, 0x2e, 0xd8,
-	0x62, 0xaa, 0x80, 0xc9, 0x13, 0x7c, 0x7b, 0x18, 0x99, 0x25, 0xc9, 0x8b, 0x41, 0x99, 0x53, 0x9c,
-	0x47, 0xd6, 0x3f, 0x03, 0x9b, 0xf1, 0xf2, 0x68, 0x2c, 0x5e, 0x13, 0x19, 0xcb, 0xc3, 0xc8, 0x34,
-	0x32, 0x19, 0xc7, 0x47, 0xe3, 0x24, 0xb1, 0x76, 0x3f, 0xd3, 0x48, 0x6f, 0xe5, 0x36, 0x52, 0x97,
-	0x5b, 0x59, 0x89, 0xd9, 0x96, 0x01, 0x8a, 0x59, 0x7f, 0x13, 0xf3, 0x7f, 0xd3, 0xc4, 0xf8, 0xf8,
-	0xb2, 0xdf, 0xf1, 0x18, 0xfc, 0x5c, 0x5c, 0xa6, 0xfa, 0x03, 0xb0, 0xec, 0x0d, 0xd8, 0x11, 0x26,
-	0x01, 0x3b, 0x55, 0xf6, 0x17, 0xff, 0xf8, 0xb5, 0x52, 0x50, 0xb6, 0xaa, 0x5a, 0x0e, 0x18, 0x09,
-	0x90, 0xef, 0x8e, 0xa0, 0xfa, 0xc7, 0x60, 0x51, 0x5e, 0xc7, 0x6a, 0x23, 0x4a, 0x76, 0xee, 0xd7,
-	0x86, 0x7c, 0x4b, 0x7d, 0x99, 0xef, 0xc5, 0x2f, 0x97, 0x67, 0xbb, 0x9a, 0xab, 0x68, 0xb5, 0x87,
-	0x5c, 0xdd, 0x28, 0x21, 0x17, 0xf8, 0x76, 0xae, 0xc0, 0x4c, 0xc5, 0xd6, 0x0e, 0xd8, 0xce, 0x2c,
-	0xc5, 0x02, 0xf7, 0x7e, 0xbe, 0x01, 0xae, 0x36, 0xa8, 0xaf, 0x7f, 0x03, 0x56, 0xc6, 0xbf, 0x0b,
-	0xde, 0xb5, 0xff, 0xeb, 0x4b, 0xc8, 0x4e, 0x5f, 0xd2, 0xc6, 0x7b, 0xf3, 0xa0, 0x93, 0x2b, 0xfd,
-	0x29, 0xb8, 0x26, 0xae, 0xe2, 0x3b, 0x53, 0xd9, 0x1c, 0x66, 0x54, 0x66, 0x82, 0x8d, 0x67, 0x17,
-	0xf7, 0xdc, 0xf4, 0xec, 0x1c, 0x36, 0x43, 0xf6, 0xf1, 0x2b, 0x44, 0xd8, 0x35, 0x76, 0x7d, 0xcc,
-	0x60, 0xd7, 0x08, 0x3d, 0x8b, 0x5d, 0x93, 0xf3, 0x5c, 0x7f, 0xae, 0x81, 0x8d, 0x89, 0x39, 0x56,
-	0x9d, 0x9a, 0x2a, 0x4b, 0x31, 0x3e, 0x9c, 0x9b, 0x92, 0x94, 0xf0, 0x9d, 0x06, 0x36, 0x27, 0xef,
-	0x93, 0xbd, 0x59, 0x12, 0xa6, 0x39, 0x46, 0x6d, 0x7e, 0x4e, 0x52, 0xc5, 0x09, 0x58, 0x4b, 0x0f,
-	0x43, 0x7b, 0x6a, 0xb2, 0x14, 0xde, 0x78, 0x30, 0x1f, 0x3e, 0x79, 0x31, 0x03, 0xab, 0xa9, 0x41,
-	0x30, 0xbd, 0x67, 0xc6, 0xe1, 0xc6, 0xfb, 0x73, 0xc1, 0xe3, 0xb7, 0x1a, 0xd7, 0x9f, 0xf3, 0x21,
-	0x50, 0xff, 0xe2, 0xc5, 0x79, 0x59, 0x7b, 0x79, 0x5e, 0xd6, 0xfe, 0x3a, 0x2f, 0x6b, 0x3f, 0x5c,
-	0x94, 0x17, 0x5e, 0x5e, 0x94, 0x17, 0xfe, 0xbc, 0x28, 0x2f, 0x7c, 0xf5, 0xd0, 0x0f, 0xd8, 0xd1,
-	0xa0, 0x65, 0xb7, 0x71, 0xe8, 0x20, 0x38, 0x60, 0x04, 0xa3, 0x0a, 0x26, 0x7e, 0xfc, 0xdb, 0x39,
-	0xae, 0x56, 0x9d, 0x67, 0xe9, 0x01, 0xc1, 0x4e, 0xfb, 0x90, 0xb6, 0x16, 0xc5, 0x9f, 0x05, 0xf7,
-	0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x22, 0xcd, 0x4f, 0x1d, 0x0d, 0x00, 0x00,
+	// 1214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xb1, 0x6f, 0xdb, 0xc6,
+	0x17, 0x36, 0x63, 0xc7, 0x3f, 0xfb, 0x6c, 0xff, 0x6c, 0xc9, 0x4a, 0x2c, 0xb3, 0x8a, 0xe8, 0xb2,
+	0x4d, 0xe1, 0x18, 0x15, 0x09, 0x39, 0xae, 0xd3, 0x0a, 0x28, 0x9a, 0x28, 0xad, 0x91, 0x00, 0x11,
+	0xd0, 0x52, 0xee, 0x52, 0x04, 0x10, 0x28, 0xe9, 0x4c, 0x11, 0x32, 0xef, 0x54, 0xde, 0xc9, 0xb6,
+	0xb6, 0xa0, 0xdd, 0x3a, 0xf5, 0x3f, 0x08, 0xba, 0x75, 0xf4, 0x90, 0xff, 0xa0, 0x8b, 0xbb, 0x05,
+	0x99, 0x8a, 0x0e, 0x44, 0x61, 0x0f, 0x1e, 0xba, 0xb4, 0xfa, 0x0b, 0x8a, 0xe3, 0x1d, 0x29, 0x92,
+	0x56, 0x22, 0x69, 0x08, 0xb2, 0x24, 0x22, 0xdf, 0xf7, 0x3d, 0xbe, 0xef, 0xbb, 0x77, 0xef, 0xce,
+	0xe0, 0x36, 0x26, 0x0e, 0x26, 0x36, 0xd1, 0x29, 0x6e, 0x43, 0x74, 0x60, 0x36, 0x28, 0x76, 0x7b,
+	0xfa, 0x51, 0xb1, 0x0e, 0xa9, 0x59, 0xd4, 0xe9, 0x89, 0xd6, 0x71, 0x31, 0xc5, 0xe9, 0x9c, 0x80,
+	0x69, 0x51, 0x98, 0x26, 0x60, 0x72, 0xca, 0x74, 0x6c, 0x84, 0x75, 0xff, 0x5f, 0x4e, 0x90, 0xf3,
+	0x0d, 0x9f, 0xa1, 0xd7, 0x4d, 0xd4, 0x0e, 0xd3, 0xb1, 0x87, 0x2b, 0x71, 0x02, 0xc3, 0x78, 0x03,
+	0xdb, 0x48, 0xc4, 0xd7, 0x44, 0xdc, 0x21, 0x96, 0x7e, 0x54, 0x64, 0xff, 0x89, 0xc0, 0x3a, 0x0f,
+	0xd4, 0xfc, 0x27, 0x9d, 0x3f, 0x88, 0x50, 0xc6, 0xc2, 0x16, 0xe6, 0xef, 0xd9, 0x2f, 0xf1, 0xf6,
+	0xfd, 0xa1, 0x0a, 0x3b, 0xa6, 0x6b, 0x3a, 0x01, 0x71, 0xe7, 0x8d, 0x26, 0x98, 0x5d, 0xda, 0xc2,
+	0xae, 0x4d, 0x7b, 0x15, 0x48, 0xcd, 0xa6, 0x49, 0x4d, 0xce, 0x52, 0x9f, 0x4b, 0xe0, 0xff, 0x15,
+	0x62, 0x3d, 0x74, 0xa1, 0x49, 0xe1, 0x97, 0x10, 0x61, 0x27, 0x7d, 0x07, 0xcc, 0x12, 0x88, 0x9a,
+	0xd0, 0xcd, 0x4a, 0x1b, 0xd2, 0xe6, 0x7c, 0x39, 0xd5, 0xf7, 0x94, 0xa5, 0x9e, 0xe9, 0x1c, 0x96,
+	0x54, 0xfe, 0x5e, 0x35, 0x04, 0x20, 0xad, 0x83, 0x39, 0xd2, 0xad, 0x37, 0x19, 0x2d, 0x7b, 0xcd,
+	0x07, 0xaf, 0xf6, 0x3d, 0x65, 0x59, 0x80, 0x45, 0x44, 0x35, 0x42, 0x50, 0xa9, 0xf8, 0xc3, 0xe5,
+	0xe9, 0x96, 0x60, 0xff, 0x74, 0x79, 0xba, 0x35, 0x5c, 0x57, 0xc3, 0xaf, 0xa6, 0xc0, 0xd9, 0x4f,
+	0xc1, 0xcd, 0x78, 0x81, 0x06, 0x24, 0x1d, 0x8c, 0x08, 0x4c, 0x97, 0xc1, 0x32, 0x82, 0xc7, 0x35,
+	0x9f, 0x5a, 0xe3, 0x45, 0xf0, 0x8a, 0xe5, 0xbe, 0xa7, 0xdc, 0xe4, 0x45, 0x24, 0x00, 0xaa, 0xb1,
+	0x84, 0xe0, 0xf1, 0x3e, 0x7b, 0xe1, 0xe7, 0x52, 0xff, 0x96, 0xc0, 0xff, 0x2a, 0xc4, 0xaa, 0xd8,
+	0x88, 0x4e, 0x22, 0xfc, 0x11, 0x98, 0x35, 0x1d, 0xdc, 0x45, 0xd4, 0x97, 0xbd, 0xb0, 0xbd, 0xae,
+	0x89, 0x45, 0x64, 0xad, 0x10, 0xb4, 0x94, 0xf6, 0x10, 0xdb, 0xa8, 0x7c, 0xe3, 0xcc, 0x53, 0xa6,
+	0x06, 0x99, 0x38, 0x4d, 0x35, 0x04, 0x3f, 0x7d, 0x1f, 0x2c, 0x39, 0x36, 0xa2, 0xfb, 0xf8, 0x41,
+	0xb3, 0xe9, 0x42, 0x42, 0xb2, 0xd3, 0x49, 0x09, 0x2c, 0x5c, 0xa3, 0xb8, 0x66, 0x72, 0x80, 0x6a,
+	0xc4, 0x09, 0xa5, 0x3b, 0x09, 0x4f, 0xd7, 0x87, 0x7a, 0xca, 0x38, 0x6a, 0x0a, 0x2c, 0x0b, 0xb1,
+	0x81, 0x89, 0xea, 0xbf, 0xdc, 0x80, 0x72, 0xd7, 0x45, 0xef, 0xc6, 0x80, 0x3d, 0xb0, 0x5c, 0xef,
+	0xba, 0x68, 0xcf, 0xc5, 0x4e, 0xdc, 0x82, 0x5c, 0xdf, 0x53, 0xb2, 0x9c, 0xc3, 0x00, 0xb5, 0x03,
+	0x17, 0x3b, 0x03, 0x13, 0x92, 0xa4, 0x31, 0x6d, 0x60, 0x2c, 0x61, 0x03, 0x93, 0x1c, 0xda, 0xf0,
+	0xbb, 0xd8, 0x07, 0x2d, 0x13, 0x59, 0xf0, 0x41, 0xd3, 0xb1, 0x27, 0x72, 0xe3, 0x23, 0x70, 0x3d,
+	0xba, 0x09, 0x56, 0xfa, 0x9e, 0xb2, 0xc8, 0x91, 0xa2, 0xeb, 0x78, 0x38, 0x5d, 0x04, 0xf3, 0xac,
+	0x21, 0x4d, 0x96, 0x5f, 0xa8, 0xcc, 0xf4, 0x3d, 0x65, 0x65, 0xd0, 0xab, 0x7e, 0x48, 0x35, 0xe6,
+	0x10, 0x3c, 0xf6, 0xab, 0x18, 0x77, 0xc7, 0xf8, 0x75, 0x17, 0x38, 0x3b, 0xcb, 0x77, 0xcc, 0x40,
+	0x4a, 0xa8, 0xf2, 0x5c, 0x02, 0x99, 0x0a, 0xb1, 0xaa, 0x90, 0x96, 0xe1, 0x01, 0x76, 0x61, 0x15,
+	0xa2, 0xe6, 0x23, 0x8c, 0xdb, 0x6f, 0x43, 0xeb, 0xe7, 0x60, 0xa9, 0x81, 0x11, 0x75, 0xcd, 0x06,
+	0xf5, 0x57, 0x4d, 0xe8, 0xcd, 0xf6, 0x3d, 0x25, 0xc3, 0xf1, 0xb1, 0xb0, 0x6a, 0x2c, 0x06, 0xcf,
+	0x6c, 0x45, 0x4b, 0x9f, 0x26, 0x74, 0x6f, 0x0e, 0xd5, 0x4d, 0x20, 0x2d, 0xd4, 0x7d, 0x29, 0x0c,
+	0x59, 0x68, 0x61, 0xdc, 0x56, 0xf3, 0x20, 0x37, 0x4c, 0x63, 0x68, 0xc2, 0x2f, 0x12, 0x58, 0xe5,
+	0x00, 0x7f, 0x04, 0x04, 0x03, 0x71, 0x12, 0x0f, 0x0c, 0x30, 0xe7, 0x08, 0x9a, 0xe8, 0xff, 0x5b,
+	0x83, 0xfe, 0x47, 0xed, 0xb0, 0xff, 0x83, 0xdc, 0xe5, 0x35, 0xb1, 0x07, 0xc4, 0x68, 0x0c, 0xc8,
+	0xaa, 0x11, 0xe6, 0x29, 0x2d, 0x44, 0x04, 0xab, 0xb7, 0xc0, 0x7b, 0x43, 0x4a, 0x0c, 0x25, 0x78,
+	0xd7, 0xc0, 0x4a, 0x85, 0x58, 0x7b, 0xd8, 0x6d, 0xc0, 0x7d, 0xd7, 0x44, 0xe4, 0x00, 0xba, 0xef,
+	0x66, 0xf7, 0x1a, 0x60, 0x95, 0x8a, 0x02, 0xae, 0xee, 0xe0, 0x8d, 0xbe, 0xa7, 0xe4, 0x38, 0x2f,
+	0x00, 0x25, 0x76, 0xf1, 0x30, 0x72, 0xfa, 0x09, 0x48, 0x05, 0xaf, 0x07, 0x63, 0x71, 0xc6, 0xcf,
+	0x98, 0xef, 0x7b, 0x8a, 0x9c, 0xc8, 0x18, 0x1d, 0x8d, 0x57, 0x89, 0xa5, 0xbb, 0x89, 0x46, 0xfa,
+	0x60, 0x68, 0x23, 0x1d, 0x30, 0x2b, 0x0b, 0x01, 0x5b, 0x95, 0x41, 0x36, 0xe9, 0x6f, 0x68, 0xfe,
+	0x3f, 0xdc, 0xfc, 0x2a, 0xa4, 0x6c, 0x90, 0x3e, 0xb1, 0x1d, 0x9b, 0x92, 0xb7, 0xb1, 0x81, 0x6a,
+	0x00, 0x38, 0xe6, 0x49, 0x8d, 0x74, 0x3b, 0x9d, 0xc3, 0x9e, 0x70, 0xf4, 0xfe, 0x99, 0xa7, 0x48,
+	0x7f, 0x7a, 0xca, 0x0d, 0xbe, 0x5e, 0xa4, 0xd9, 0xd6, 0x6c, 0xac, 0x3b, 0x26, 0x6d, 0x69, 0x8f,
+	0x11, 0xed, 0x7b, 0x4a, 0x4a, 0x34, 0x58, 0x48, 0x54, 0x5f, 0xbd, 0x28, 0x00, 0xb1, 0xba, 0x8f,
+	0x11, 0x35, 0xe6, 0x1d, 0xf3, 0xa4, 0xea, 0x47, 0xd2, 0x2e, 0x3f, 0x7a, 0x6a, 0xa4, 0xd1, 0x82,
+	0xcd, 0xee, 0x21, 0xcc, 0xce, 0x6c, 0x4c, 0x6f, 0x2e, 0x6c, 0xeb, 0xda, 0x9b, 0xee, 0x49, 0x1a,
+	0x13, 0x5d, 0x15, 0x8c, 0xaf, 0x10, 0x75, 0x7b, 0xe5, 0x9c, 0x68, 0x91, 0x4c, 0xe4, 0xbc, 0x0a,
+	0x72, 0xaa, 0xc6, 0xa2, 0x13, 0x21, 0x94, 0x76, 0x12, 0xab, 0xf1, 0xe1, 0x6b, 0xb7, 0x35, 0xa3,
+	0x15, 0x0e, 0x7d, 0x77, 0xc5, 0x72, 0xc4, 0x1c, 0x0f, 0x97, 0xe3, 0x37, 0xc9, 0x9f, 0xe6, 0xdf,
+	0x76, 0x9a, 0x26, 0x85, 0x5f, 0xfb, 0x37, 0xa2, 0xf4, 0x2e, 0x98, 0x0f, 0x2f, 0x3c, 0x62, 0x41,
+	0xb2, 0xaf, 0x5e, 0x14, 0x32, 0xc2, 0x07, 0xd1, 0x1a, 0x55, 0xea, 0xda, 0xc8, 0x32, 0x06, 0xd0,
+	0xf4, 0x17, 0x60, 0x96, 0xdf, 0xa9, 0xc4, 0xbe, 0xc8, 0x0d, 0xb7, 0x82, 0x7f, 0xa5, 0x3c, 0xcf,
+	0x74, 0xff, 0x7a, 0x79, 0xba, 0x25, 0x19, 0x82, 0x56, 0xba, 0xc7, 0xe4, 0x0d, 0x12, 0xbe, 0x5e,
+	0x61, 0xa2, 0x62, 0x75, 0x1d, 0xac, 0x25, 0x5e, 0x05, 0x02, 0xb7, 0x9f, 0xcf, 0x81, 0xe9, 0x0a,
+	0xb1, 0xd2, 0xdf, 0x83, 0x85, 0xe8, 0x35, 0xed, 0xe3, 0x11, 0xcb, 0x14, 0xbb, 0x33, 0xc9, 0x3b,
+	0x93, 0xa0, 0xc3, 0x1b, 0xd6, 0x53, 0x30, 0xe3, 0xdf, 0x8c, 0x6e, 0x8f, 0x64, 0x33, 0x98, 0x5c,
+	0x18, 0x0b, 0x16, 0xcd, 0xee, 0x5f, 0x3b, 0x46, 0x67, 0x67, 0x30, 0xb9, 0x30, 0x16, 0x2c, 0xcc,
+	0xce, 0xec, 0x8a, 0x9c, 0xe6, 0x63, 0xd8, 0x35, 0x40, 0xcb, 0x3b, 0x93, 0xa0, 0xc3, 0x4f, 0x3e,
+	0x93, 0xc0, 0xca, 0x95, 0x63, 0xa5, 0x38, 0x32, 0x55, 0x92, 0x22, 0x7f, 0x36, 0x31, 0x25, 0x2c,
+	0xe1, 0x47, 0x09, 0xa4, 0xae, 0x1e, 0xef, 0xdb, 0xe3, 0x24, 0x8c, 0x73, 0xe4, 0xd2, 0xe4, 0x9c,
+	0xb0, 0x8a, 0x63, 0xb0, 0x14, 0x3f, 0x9b, 0xb4, 0x91, 0xc9, 0x62, 0x78, 0x79, 0x77, 0x32, 0x7c,
+	0xf4, 0xc3, 0xf1, 0xb9, 0xac, 0x8d, 0xa3, 0x62, 0x80, 0x97, 0x77, 0x27, 0xc3, 0x87, 0x1f, 0xa6,
+	0x60, 0x31, 0x36, 0x81, 0x46, 0x37, 0x6b, 0x14, 0x2e, 0x7f, 0x32, 0x11, 0x3c, 0xf8, 0xaa, 0x7c,
+	0xfd, 0x19, 0x9b, 0x3e, 0xe5, 0x6f, 0xce, 0xce, 0xf3, 0xd2, 0xcb, 0xf3, 0xbc, 0xf4, 0xd7, 0x79,
+	0x5e, 0xfa, 0xf9, 0x22, 0x3f, 0xf5, 0xf2, 0x22, 0x3f, 0xf5, 0xc7, 0x45, 0x7e, 0xea, 0xbb, 0x7b,
+	0x96, 0x4d, 0x5b, 0xdd, 0xba, 0xd6, 0xc0, 0x8e, 0x8e, 0x60, 0x97, 0xba, 0x18, 0x15, 0xb0, 0x6b,
+	0x05, 0xbf, 0xf5, 0xa3, 0x62, 0x51, 0x3f, 0x89, 0x4f, 0x26, 0xda, 0xeb, 0x40, 0x52, 0x9f, 0xf5,
+	0xff, 0x3c, 0xbc, 0xfb, 0xdf, 0x00, 0x18, 0x55, 0x17, 0xe4, 0x5b, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -944,6 +1061,7 @@ type MsgClient interface {
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(ctx context.Context, in *MsgSetBeforeSendHook, opts ...grpc.CallOption) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(ctx context.Context, in *MsgForceTransfer, opts ...grpc.CallOption) (*MsgForceTransferResponse, error)
+	SetMintLimits(ctx context.Context, in *MsgSetMintLimits, opts ...grpc.CallOption) (*MsgSetMintLimitsResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
@@ -1018,6 +1136,15 @@ func (c *msgClient) ForceTransfer(ctx context.Context, in *MsgForceTransfer, opt
 	return out, nil
 }
 
+func (c *msgClient) SetMintLimits(ctx context.Context, in *MsgSetMintLimits, opts ...grpc.CallOption) (*MsgSetMintLimitsResponse, error) {
+	out := new(MsgSetMintLimitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/SetMintLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -1036,6 +1163,7 @@ type MsgServer interface {
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	SetBeforeSendHook(context.Context, *MsgSetBeforeSendHook) (*MsgSetBeforeSendHookResponse, error)
 	ForceTransfer(context.Context, *MsgForceTransfer) (*MsgForceTransferResponse, error)
+	SetMintLimits(context.Context, *MsgSetMintLimits) (*MsgSetMintLimitsResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
@@ -1064,6 +1192,9 @@ func (*UnimplementedMsgServer) SetBeforeSendHook(ctx context.Context, req *MsgSe
 func (*UnimplementedMsgServer) ForceTransfer(ctx context.Context, req *MsgForceTransfer) (*MsgForceTransferResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceTransfer not implemented")
 }
+func (*UnimplementedMsgServer) SetMintLimits(ctx context.Context, req *MsgSetMintLimits) (*MsgSetMintLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMintLimits not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1198,6 +1329,24 @@ func _Msg_ForceTransfer_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMintLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMintLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMintLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Msg/SetMintLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMintLimits(ctx, req.(*MsgSetMintLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -1216,6 +1365,7 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1248,6 +1398,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForceTransfer",
 			Handler:    _Msg_ForceTransfer_Handler,
 		},
+		{
+			MethodName: "SetMintLimits",
+			Handler:    _Msg_SetMintLimits_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1738,6 +1892,92 @@ func (m *MsgForceTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetMintLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMintLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMintLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMintLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2014,6 +2254,42 @@ func (m *MsgForceTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetMintLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetMintLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3420,6 +3696,240 @@ func (m *MsgForceTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetMintLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, MintScheduleEntry{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMintLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMintLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMintLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
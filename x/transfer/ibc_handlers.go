@@ -8,6 +8,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	"github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
+	contractmanagertypes "github.com/neutron-org/neutron/v11/x/contractmanager/types"
 	feetypes "github.com/neutron-org/neutron/v11/x/feerefunder/types"
 	"github.com/neutron-org/neutron/v11/x/interchaintxs/types"
 )
@@ -76,3 +77,53 @@ func (im IBCModule) HandleTimeout(ctx sdk.Context, packet channeltypes.Packet, r
 
 	return nil
 }
+
+// HandleTransferReceived notifies the contract that received the ICS-20 transfer via a sudo call
+// if the contract has enabled the notifications. Failures of the sudo call don't affect the
+// acknowledgement of the packet.
+func (im IBCModule) HandleTransferReceived(ctx sdk.Context, channelVersion string, packet channeltypes.Packet) {
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return
+	}
+
+	receiverAddress, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return
+	}
+	if !im.wrappedKeeper.IsTransferReceivedCallbackEnabled(ctx, receiverAddress) || !im.sudoKeeper.HasContractInfo(ctx, receiverAddress) {
+		return
+	}
+
+	// the same way the original OnRecvPacket does, unwind the denom if the tokens return to Neutron
+	// and prefix it with the destination hop otherwise
+	denom := data.Token.Denom
+	if denom.HasPrefix(packet.SourcePort, packet.SourceChannel) {
+		denom.Trace = denom.Trace[1:]
+	} else {
+		denom.Trace = append([]transfertypes.Hop{transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)}, denom.Trace...)
+	}
+
+	msg, err := keeper.PrepareTransferReceivedCallbackMessage(contractmanagertypes.TransferReceivedDetails{
+		PortID:        packet.DestinationPort,
+		ChannelID:     packet.DestinationChannel,
+		SourcePort:    packet.SourcePort,
+		SourceChannel: packet.SourceChannel,
+		Sequence:      packet.Sequence,
+		Sender:        data.Sender,
+		Receiver:      data.Receiver,
+		Denom:         denom.IBCDenom(),
+		DenomTrace:    denom.Path(),
+		Amount:        data.Token.Amount,
+		Memo:          data.Memo,
+	})
+	if err != nil {
+		im.keeper.Logger(ctx).Error("HandleTransferReceived: failed to marshal transfer received message", "error", err)
+		return
+	}
+
+	_, err = im.sudoKeeper.Sudo(ctx, receiverAddress, msg)
+	if err != nil {
+		im.keeper.Logger(ctx).Debug("HandleTransferReceived: failed to Sudo contract on transfer received", "error", err)
+	}
+}
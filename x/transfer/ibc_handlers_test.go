@@ -7,6 +7,7 @@ import (
 	types2 "cosmossdk.io/store/types"
 
 	"github.com/neutron-org/neutron/v11/x/contractmanager/keeper"
+	contractmanagertypes "github.com/neutron-org/neutron/v11/x/contractmanager/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -183,3 +184,89 @@ func TestHandleTimeout(t *testing.T) {
 	err = txModule.HandleTimeout(ctx, p, relayerAddress)
 	require.NoError(t, err)
 }
+
+func TestHandleTransferReceived(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	feeKeeper := mock_types.NewMockFeeRefunderKeeper(ctrl)
+	chanKeeper := mock_types.NewMockChannelKeeper(ctrl)
+	authKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	tokenfactoryKeeper := mock_types.NewMockTokenfactoryKeeper(ctrl)
+	CoinfactoryKeeper := mock_types.NewMockTokenfactoryKeeper(ctrl)
+	// required to initialize keeper
+	authKeeper.EXPECT().GetModuleAddress(transfertypes.ModuleName).Return([]byte("address"))
+	txKeeper, infCtx, _ := testkeeper.TransferKeeper(t, wmKeeper, feeKeeper, chanKeeper, authKeeper)
+	txModule := transfer.NewIBCModule(*txKeeper, wmKeeper, tokenfactoryKeeper, CoinfactoryKeeper)
+	ctx := infCtx.WithGasMeter(types2.NewGasMeter(1_000_000_000_000))
+	contractAddress := sdk.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+
+	p := channeltypes.Packet{
+		Sequence:           100,
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-9",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+	token := transfertypes.FungibleTokenPacketData{
+		Denom:    "uatom",
+		Amount:   "1000",
+		Sender:   TestCosmosAddress,
+		Receiver: testutil.TestOwnerAddress,
+		Memo:     "memo",
+	}
+	tokenBz, err := ictxtypes.ModuleCdc.MarshalJSON(&token)
+	require.NoError(t, err)
+	p.Data = tokenBz
+
+	// notifications are not enabled
+	txModule.HandleTransferReceived(ctx, transfertypes.V1, p)
+
+	require.NoError(t, txKeeper.SetTransferReceivedCallbackEnabled(ctx, contractAddress, true))
+
+	// the contract has been removed
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(false)
+	txModule.HandleTransferReceived(ctx, transfertypes.V1, p)
+
+	// a voucher is received
+	msg, err := keeper.PrepareTransferReceivedCallbackMessage(contractmanagertypes.TransferReceivedDetails{
+		PortID:        "transfer",
+		ChannelID:     "channel-0",
+		SourcePort:    "transfer",
+		SourceChannel: "channel-9",
+		Sequence:      100,
+		Sender:        TestCosmosAddress,
+		Receiver:      testutil.TestOwnerAddress,
+		Denom:         transfertypes.NewDenom("uatom", transfertypes.NewHop("transfer", "channel-0")).IBCDenom(),
+		DenomTrace:    "transfer/channel-0/uatom",
+		Amount:        "1000",
+		Memo:          "memo",
+	})
+	require.NoError(t, err)
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, msg).Return(nil, fmt.Errorf("SudoTransferReceived error"))
+	txModule.HandleTransferReceived(ctx, transfertypes.V1, p)
+
+	// a native token returns to Neutron
+	token.Denom = "transfer/channel-9/untrn"
+	tokenBz, err = ictxtypes.ModuleCdc.MarshalJSON(&token)
+	require.NoError(t, err)
+	p.Data = tokenBz
+	msg, err = keeper.PrepareTransferReceivedCallbackMessage(contractmanagertypes.TransferReceivedDetails{
+		PortID:        "transfer",
+		ChannelID:     "channel-0",
+		SourcePort:    "transfer",
+		SourceChannel: "channel-9",
+		Sequence:      100,
+		Sender:        TestCosmosAddress,
+		Receiver:      testutil.TestOwnerAddress,
+		Denom:         "untrn",
+		DenomTrace:    "untrn",
+		Amount:        "1000",
+		Memo:          "memo",
+	})
+	require.NoError(t, err)
+	wmKeeper.EXPECT().HasContractInfo(ctx, contractAddress).Return(true)
+	wmKeeper.EXPECT().Sudo(ctx, contractAddress, msg).Return(nil, nil)
+	txModule.HandleTransferReceived(ctx, transfertypes.V1, p)
+}
@@ -21,6 +21,7 @@ import (
 // KeeperTransferWrapper is a wrapper for original ibc keeper to override response for "Transfer" method
 type KeeperTransferWrapper struct {
 	keeper.Keeper
+	storeService  store.KVStoreService
 	channelKeeper wrappedtypes.ChannelKeeper
 	FeeKeeper     wrappedtypes.FeeRefunderKeeper
 	SudoKeeper    wrappedtypes.WasmKeeper
@@ -41,6 +42,15 @@ func (k KeeperTransferWrapper) Transfer(goCtx context.Context, msg *wrappedtypes
 		return nil, errors.Wrap(err, "failed to validate MsgTransfer")
 	}
 
+	// if the sender is a contract, lock fees.
+	// Because contracts are required to pay fees for the acknowledgements and timeouts
+	return k.transfer(ctx, msg, isContract)
+}
+
+// transfer sends the packet of the validated msg and locks msg.Fee for it if lockFees is set.
+func (k KeeperTransferWrapper) transfer(ctx sdk.Context, msg *wrappedtypes.MsgTransfer, lockFees bool) (*wrappedtypes.MsgTransferResponse, error) {
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
 	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, msg.SourcePort, msg.SourceChannel)
 	if !found {
 		return nil, errors.Wrapf(
@@ -49,16 +59,14 @@ func (k KeeperTransferWrapper) Transfer(goCtx context.Context, msg *wrappedtypes
 		)
 	}
 
-	// if the sender is a contract, lock fees.
-	// Because contracts are required to pay fees for the acknowledgements and timeouts
-	if isContract {
+	if lockFees {
 		if err := k.FeeKeeper.LockFees(ctx, senderAddr, feetypes.NewPacketID(msg.SourcePort, msg.SourceChannel, sequence), msg.Fee); err != nil {
 			return nil, errors.Wrapf(err, "failed to lock fees to pay for transfer msg: %v", msg)
 		}
 	}
 
 	transferMsg := types.NewMsgTransfer(msg.SourcePort, msg.SourceChannel, msg.Token, msg.Sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo)
-	if _, err := k.Keeper.Transfer(goCtx, transferMsg); err != nil {
+	if _, err := k.Keeper.Transfer(ctx, transferMsg); err != nil {
 		return nil, err
 	}
 
@@ -68,6 +76,37 @@ func (k KeeperTransferWrapper) Transfer(goCtx context.Context, msg *wrappedtypes
 	}, nil
 }
 
+// BatchTransfer sends each transfer of the batch as a separate packet. Either all the packets are
+// sent or none of them. If the sender is a contract, the fee of the batch is locked once, for the
+// first packet of the batch: the relayer of that packet gets the whole fee, while the rest of the
+// packets are sent without fees.
+func (k KeeperTransferWrapper) BatchTransfer(goCtx context.Context, msg *wrappedtypes.MsgBatchTransfer) (*wrappedtypes.MsgBatchTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBatchTransfer")
+	}
+
+	isContract := k.SudoKeeper.HasContractInfo(ctx, sdk.MustAccAddressFromBech32(msg.Sender))
+
+	resp := &wrappedtypes.MsgBatchTransferResponse{
+		Transfers: make([]wrappedtypes.MsgTransferResponse, 0, len(msg.Transfers)),
+	}
+	for i, transferMsg := range msg.GetMsgTransfers() {
+		if err := transferMsg.Validate(isContract); err != nil {
+			return nil, errors.Wrapf(err, "failed to validate transfer #%d of the batch", i)
+		}
+
+		transferResp, err := k.transfer(ctx, transferMsg, isContract && i == 0)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to send transfer #%d of the batch", i)
+		}
+		resp.Transfers = append(resp.Transfers, *transferResp)
+	}
+
+	return resp, nil
+}
+
 func (k KeeperTransferWrapper) UpdateParams(goCtx context.Context, msg *wrappedtypes.MsgUpdateParams) (*wrappedtypes.MsgUpdateParamsResponse, error) {
 	newMsg := &types.MsgUpdateParams{
 		Signer: msg.Signer,
@@ -95,6 +134,7 @@ func NewKeeper(
 	authority string,
 ) KeeperTransferWrapper {
 	return KeeperTransferWrapper{
+		storeService:  store,
 		channelKeeper: channelKeeper,
 		Keeper: keeper.NewKeeper(
 			cdc,
@@ -562,3 +562,127 @@ func TestMsgTransferValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSetTransferReceivedCallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	authKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	wmKeeper := mock_types.NewMockWasmKeeper(ctrl)
+	// required to initialize keeper
+	authKeeper.EXPECT().GetModuleAddress(transfertypes.ModuleName).Return([]byte("address"))
+	k, ctx, _ := keeper.TransferKeeper(t, wmKeeper, nil, nil, authKeeper)
+
+	contract := sdktypes.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+
+	_, err := k.SetTransferReceivedCallback(ctx, &types.MsgSetTransferReceivedCallback{Sender: "nonbech32", Enabled: true})
+	require.ErrorIs(t, err, errors.ErrInvalidAddress)
+
+	wmKeeper.EXPECT().HasContractInfo(ctx, contract).Return(false)
+	_, err = k.SetTransferReceivedCallback(ctx, &types.MsgSetTransferReceivedCallback{Sender: contract.String(), Enabled: true})
+	require.ErrorIs(t, err, errors.ErrInvalidRequest)
+	require.False(t, k.IsTransferReceivedCallbackEnabled(ctx, contract))
+
+	wmKeeper.EXPECT().HasContractInfo(ctx, contract).Return(true).Times(2)
+	_, err = k.SetTransferReceivedCallback(ctx, &types.MsgSetTransferReceivedCallback{Sender: contract.String(), Enabled: true})
+	require.NoError(t, err)
+	require.True(t, k.IsTransferReceivedCallbackEnabled(ctx, contract))
+	require.False(t, k.IsTransferReceivedCallbackEnabled(ctx, sdktypes.MustAccAddressFromBech32(TestAddress)))
+
+	_, err = k.SetTransferReceivedCallback(ctx, &types.MsgSetTransferReceivedCallback{Sender: contract.String(), Enabled: false})
+	require.NoError(t, err)
+	require.False(t, k.IsTransferReceivedCallbackEnabled(ctx, contract))
+	require.Equal(t, sdktypes.NewEvent(
+		types.EventTypeSetTransferReceivedCallback,
+		sdktypes.NewAttribute(types.AttributeKeyContract, contract.String()),
+		sdktypes.NewAttribute(types.AttributeKeyEnabled, "false"),
+	), ctx.EventManager().Events()[len(ctx.EventManager().Events())-1])
+}
+
+func (suite KeeperTestSuite) TestBatchTransfer() { //nolint:govet // it's a test so it's okay to copy locks
+	suite.ConfigureTransferChannel()
+
+	msgSrv := suite.GetNeutronZoneApp(suite.ChainA).TransferKeeper
+	bankKeeper := suite.GetNeutronZoneApp(suite.ChainA).BankKeeper
+
+	fee := feetypes.Fee{
+		RecvFee:    nil,
+		AckFee:     sdktypes.NewCoins(sdktypes.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+		TimeoutFee: sdktypes.NewCoins(sdktypes.NewCoin(params.DefaultDenom, math.NewInt(1000))),
+	}
+	item := types.BatchTransferItem{
+		SourcePort:    suite.TransferPath.EndpointA.ChannelConfig.PortID,
+		SourceChannel: suite.TransferPath.EndpointA.ChannelID,
+		Token:         sdktypes.NewCoin(params.DefaultDenom, math.NewInt(1000)),
+		Receiver:      TestAddress,
+		TimeoutHeight: clienttypes.Height{
+			RevisionNumber: 10,
+			RevisionHeight: 10000,
+		},
+	}
+
+	ctx := suite.ChainA.GetContext()
+	resp, err := msgSrv.BatchTransfer(ctx, &types.MsgBatchTransfer{Sender: testutil.TestOwnerAddress, Fee: fee})
+	suite.Nil(resp)
+	suite.ErrorContains(err, "no transfers in the batch")
+
+	testOwner := sdktypes.MustAccAddressFromBech32(testutil.TestOwnerAddress)
+	codeID := suite.StoreTestCode(ctx, testOwner, reflectContractPath)
+	contractAddress := suite.InstantiateTestContract(ctx, testOwner, codeID)
+	suite.Require().NotEmpty(contractAddress)
+	suite.TopUpWallet(ctx, suite.ChainA.SenderAccounts[0].SenderAccount.GetAddress(), contractAddress)
+
+	// the second transfer fails, so the whole message fails and its state changes are discarded
+	ctx, _ = suite.ChainA.GetContext().CacheContext()
+	invalidItem := item
+	invalidItem.SourceChannel = "channel-100"
+	resp, err = msgSrv.BatchTransfer(ctx, &types.MsgBatchTransfer{
+		Sender:    contractAddress.String(),
+		Transfers: []types.BatchTransferItem{item, invalidItem},
+		Fee:       fee,
+	})
+	suite.Nil(resp)
+	suite.ErrorContains(err, "failed to send transfer #1 of the batch")
+
+	ctx = suite.ChainA.GetContext()
+	balanceBefore := bankKeeper.GetBalance(ctx, contractAddress, params.DefaultDenom)
+	resp, err = msgSrv.BatchTransfer(ctx, &types.MsgBatchTransfer{
+		Sender:    contractAddress.String(),
+		Transfers: []types.BatchTransferItem{item, item},
+		Fee:       fee,
+	})
+	suite.NoError(err)
+	suite.Equal(&types.MsgBatchTransferResponse{Transfers: []types.MsgTransferResponse{
+		{SequenceId: 1, Channel: suite.TransferPath.EndpointA.ChannelID},
+		{SequenceId: 2, Channel: suite.TransferPath.EndpointA.ChannelID},
+	}}, resp)
+	// two transfers and the fee locked once for the whole batch
+	suite.Equal(balanceBefore.Amount.Sub(math.NewInt(2*1000+2000)), bankKeeper.GetBalance(ctx, contractAddress, params.DefaultDenom).Amount)
+
+	// the fee is attributed to the first packet of the batch only
+	feeKeeper := suite.GetNeutronZoneApp(suite.ChainA).FeeKeeper
+	feeInfo, found := feeKeeper.GetFeeInfo(ctx, feetypes.NewPacketID(item.SourcePort, item.SourceChannel, 1))
+	suite.True(found)
+	suite.Equal(fee, feeInfo.Fee)
+	_, found = feeKeeper.GetFeeInfo(ctx, feetypes.NewPacketID(item.SourcePort, item.SourceChannel, 2))
+	suite.False(found)
+}
+
+func TestMsgBatchTransferValidate(t *testing.T) {
+	item := types.BatchTransferItem{SourcePort: "transfer", SourceChannel: "channel-0"}
+
+	msg := types.MsgBatchTransfer{Sender: "nonbech32", Transfers: []types.BatchTransferItem{item}}
+	require.ErrorIs(t, msg.Validate(), errors.ErrInvalidAddress)
+
+	msg = types.MsgBatchTransfer{Sender: testutil.TestOwnerAddress}
+	require.ErrorIs(t, msg.Validate(), errors.ErrInvalidRequest)
+
+	msg.Transfers = make([]types.BatchTransferItem, types.MaxBatchTransfers+1)
+	require.ErrorIs(t, msg.Validate(), errors.ErrInvalidRequest)
+
+	msg.Transfers = []types.BatchTransferItem{item, item}
+	require.NoError(t, msg.Validate())
+	for _, transfer := range msg.GetMsgTransfers() {
+		require.Equal(t, testutil.TestOwnerAddress, transfer.Sender)
+		require.Equal(t, "channel-0", transfer.SourceChannel)
+	}
+}
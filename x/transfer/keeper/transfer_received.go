@@ -0,0 +1,57 @@
+package transfer
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	wrappedtypes "github.com/neutron-org/neutron/v11/x/transfer/types"
+)
+
+// SetTransferReceivedCallback enables or disables the `transfer_received` sudo notifications for
+// the contract sending the message.
+func (k KeeperTransferWrapper) SetTransferReceivedCallback(goCtx context.Context, msg *wrappedtypes.MsgSetTransferReceivedCallback) (*wrappedtypes.MsgSetTransferReceivedCallbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetTransferReceivedCallback")
+	}
+
+	contract := sdk.MustAccAddressFromBech32(msg.Sender)
+	if !k.SudoKeeper.HasContractInfo(ctx, contract) {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not a contract address", msg.Sender)
+	}
+
+	if err := k.SetTransferReceivedCallbackEnabled(ctx, contract, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		wrappedtypes.EventTypeSetTransferReceivedCallback,
+		sdk.NewAttribute(wrappedtypes.AttributeKeyContract, msg.Sender),
+		sdk.NewAttribute(wrappedtypes.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+	))
+
+	return &wrappedtypes.MsgSetTransferReceivedCallbackResponse{}, nil
+}
+
+// SetTransferReceivedCallbackEnabled stores whether the contract is notified about the ICS-20
+// transfers it receives.
+func (k KeeperTransferWrapper) SetTransferReceivedCallbackEnabled(ctx context.Context, contract sdk.AccAddress, enabled bool) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if enabled {
+		return store.Set(wrappedtypes.GetTransferReceivedCallbackKey(contract), []byte{1})
+	}
+
+	return store.Delete(wrappedtypes.GetTransferReceivedCallbackKey(contract))
+}
+
+// IsTransferReceivedCallbackEnabled returns true if the contract has enabled the
+// `transfer_received` sudo notifications.
+func (k KeeperTransferWrapper) IsTransferReceivedCallbackEnabled(ctx context.Context, contract sdk.AccAddress) bool {
+	has, err := k.storeService.OpenKVStore(ctx).Has(wrappedtypes.GetTransferReceivedCallbackKey(contract))
+	return err == nil && has
+}
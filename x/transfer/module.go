@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 
 	wrapkeeper "github.com/neutron-org/neutron/v11/x/transfer/keeper"
 	neutrontypes "github.com/neutron-org/neutron/v11/x/transfer/types"
@@ -49,6 +50,24 @@ func NewIBCModule(k wrapkeeper.KeeperTransferWrapper, sudoKeeper neutrontypes.Wa
 	}
 }
 
+// OnRecvPacket implements the IBCModule interface.
+// Wrapper struct shadows(overrides) the OnRecvPacket method to notify the receiving contract about the transfer.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	ack := im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	im.HandleTransferReceived(ctx, channelVersion, packet)
+
+	return ack
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface.
 // Wrapper struct shadows(overrides) the OnAcknowledgementPacket method to achieve the package's purpose.
 func (im IBCModule) OnAcknowledgementPacket(
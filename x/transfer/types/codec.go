@@ -14,11 +14,15 @@ var (
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgTransfer{}, "/neutron.transfer.v1.Transfer", nil)
+	cdc.RegisterConcrete(&MsgBatchTransfer{}, "/neutron.transfer.v1.BatchTransfer", nil)
+	cdc.RegisterConcrete(&MsgSetTransferReceivedCallback{}, "/neutron.transfer.v1.SetTransferReceivedCallback", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgTransfer{},
+		&MsgBatchTransfer{},
+		&MsgSetTransferReceivedCallback{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
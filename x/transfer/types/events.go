@@ -0,0 +1,9 @@
+package types
+
+// Transfer module event types
+const (
+	EventTypeSetTransferReceivedCallback = "set_transfer_received_callback"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyEnabled  = "enabled"
+)
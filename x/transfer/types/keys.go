@@ -0,0 +1,16 @@
+package types
+
+// The keys are stored in the store of the original ibc-go transfer module. The prefixes are picked
+// far away from the ones used by ibc-go to avoid collisions.
+const (
+	prefixTransferReceivedCallbackKey = iota + 0xa0
+)
+
+// TransferReceivedCallbackKey is the prefix of the addresses of the contracts that have enabled
+// the `transfer_received` sudo notifications.
+var TransferReceivedCallbackKey = []byte{prefixTransferReceivedCallbackKey}
+
+// GetTransferReceivedCallbackKey returns the store key of the contract's notifications switch.
+func GetTransferReceivedCallbackKey(contract []byte) []byte {
+	return append(append([]byte{}, TransferReceivedCallbackKey...), contract...)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	"google.golang.org/grpc"
 
@@ -27,6 +29,64 @@ func (msg *MsgTransfer) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{fromAddress}
 }
 
+// MaxBatchTransfers is the maximum number of transfers in a single MsgBatchTransfer.
+const MaxBatchTransfers = 32
+
+func (msg *MsgBatchTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse sender address: %s", msg.Sender)
+	}
+
+	if len(msg.Transfers) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "no transfers in the batch")
+	}
+
+	if len(msg.Transfers) > MaxBatchTransfers {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "too many transfers in the batch: %d > %d", len(msg.Transfers), MaxBatchTransfers)
+	}
+
+	return nil
+}
+
+func (msg *MsgBatchTransfer) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{fromAddress}
+}
+
+// GetMsgTransfers returns the transfers of the batch as MsgTransfer messages with the fee of the batch.
+// BatchTransfer locks the fee only for the first of them.
+func (msg *MsgBatchTransfer) GetMsgTransfers() []*MsgTransfer {
+	transfers := make([]*MsgTransfer, 0, len(msg.Transfers))
+	for _, item := range msg.Transfers {
+		transfers = append(transfers, &MsgTransfer{
+			SourcePort:       item.SourcePort,
+			SourceChannel:    item.SourceChannel,
+			Token:            item.Token,
+			Sender:           msg.Sender,
+			Receiver:         item.Receiver,
+			TimeoutHeight:    item.TimeoutHeight,
+			TimeoutTimestamp: item.TimeoutTimestamp,
+			Memo:             item.Memo,
+			Fee:              msg.Fee,
+		})
+	}
+
+	return transfers
+}
+
+func (msg *MsgSetTransferReceivedCallback) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "failed to parse sender address: %s", msg.Sender)
+	}
+
+	return nil
+}
+
+func (msg *MsgSetTransferReceivedCallback) GetSigners() []sdk.AccAddress {
+	fromAddress, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{fromAddress}
+}
+
 // MsgOrigTransferHandler - 1) helps to bind `/neutron.transfer.Msg/Transfer` as a handler for `ibc.applications.transfer.v1.MsgTransfer`
 // 2) converts `ibc.applications.transfer.v1.MsgTransfer` into `neutron.transfer.MsgTransfer` before processing.
 //
@@ -143,6 +143,148 @@ func (m *MsgTransferResponse) GetChannel() string {
 	return ""
 }
 
+// MsgBatchTransfer sends several ICS-20 transfers in a single message. Every transfer is sent as a
+// separate packet, and the fee is locked once for the first packet of the batch.
+type MsgBatchTransfer struct {
+	// the sender address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the transfers to be sent
+	Transfers []BatchTransferItem `protobuf:"bytes,2,rep,name=transfers,proto3" json:"transfers"`
+	// the fee locked once for the whole batch
+	Fee types2.Fee `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MsgBatchTransfer) Reset()         { *m = MsgBatchTransfer{} }
+func (m *MsgBatchTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchTransfer) ProtoMessage()    {}
+func (*MsgBatchTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c44193c4a9c18e30, []int{2}
+}
+func (m *MsgBatchTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchTransfer.Merge(m, src)
+}
+func (m *MsgBatchTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchTransfer proto.InternalMessageInfo
+
+// BatchTransferItem is a single transfer of MsgBatchTransfer.
+type BatchTransferItem struct {
+	// the port on which the packet will be sent
+	SourcePort string `protobuf:"bytes,1,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	// the channel by which the packet will be sent
+	SourceChannel string `protobuf:"bytes,2,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// the tokens to be transferred
+	Token types.Coin `protobuf:"bytes,3,opt,name=token,proto3" json:"token"`
+	// the recipient address on the destination chain
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// Timeout height relative to the current block height.
+	// The timeout is disabled when set to 0.
+	TimeoutHeight types1.Height `protobuf:"bytes,5,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height"`
+	// Timeout timestamp in absolute nanoseconds since unix epoch.
+	// The timeout is disabled when set to 0.
+	TimeoutTimestamp uint64 `protobuf:"varint,6,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Memo             string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (m *BatchTransferItem) Reset()         { *m = BatchTransferItem{} }
+func (m *BatchTransferItem) String() string { return proto.CompactTextString(m) }
+func (*BatchTransferItem) ProtoMessage()    {}
+func (*BatchTransferItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c44193c4a9c18e30, []int{3}
+}
+func (m *BatchTransferItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchTransferItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchTransferItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchTransferItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchTransferItem.Merge(m, src)
+}
+func (m *BatchTransferItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchTransferItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchTransferItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchTransferItem proto.InternalMessageInfo
+
+// MsgBatchTransferResponse is the response type for MsgBatchTransfer.
+type MsgBatchTransferResponse struct {
+	// the sequences and channels of the sent packets in the order of the transfers
+	Transfers []MsgTransferResponse `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+}
+
+func (m *MsgBatchTransferResponse) Reset()         { *m = MsgBatchTransferResponse{} }
+func (m *MsgBatchTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchTransferResponse) ProtoMessage()    {}
+func (*MsgBatchTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c44193c4a9c18e30, []int{4}
+}
+func (m *MsgBatchTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchTransferResponse.Merge(m, src)
+}
+func (m *MsgBatchTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchTransferResponse proto.InternalMessageInfo
+
+func (m *MsgBatchTransferResponse) GetTransfers() []MsgTransferResponse {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 type MsgUpdateParams struct {
 	// signer address
@@ -157,7 +299,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c44193c4a9c18e30, []int{2}
+	return fileDescriptor_c44193c4a9c18e30, []int{5}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -195,7 +337,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c44193c4a9c18e30, []int{3}
+	return fileDescriptor_c44193c4a9c18e30, []int{6}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,60 +366,160 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetTransferReceivedCallback enables or disables the `transfer_received` sudo notifications
+// about the ICS-20 transfers received by the contract.
+type MsgSetTransferReceivedCallback struct {
+	// the contract address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// whether the contract wants to be notified about the received transfers
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetTransferReceivedCallback) Reset()         { *m = MsgSetTransferReceivedCallback{} }
+func (m *MsgSetTransferReceivedCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferReceivedCallback) ProtoMessage()    {}
+func (*MsgSetTransferReceivedCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c44193c4a9c18e30, []int{7}
+}
+func (m *MsgSetTransferReceivedCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferReceivedCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferReceivedCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferReceivedCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferReceivedCallback.Merge(m, src)
+}
+func (m *MsgSetTransferReceivedCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferReceivedCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferReceivedCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferReceivedCallback proto.InternalMessageInfo
+
+// MsgSetTransferReceivedCallbackResponse defines the response structure for executing a
+// MsgSetTransferReceivedCallback message.
+type MsgSetTransferReceivedCallbackResponse struct {
+}
+
+func (m *MsgSetTransferReceivedCallbackResponse) Reset() {
+	*m = MsgSetTransferReceivedCallbackResponse{}
+}
+func (m *MsgSetTransferReceivedCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferReceivedCallbackResponse) ProtoMessage()    {}
+func (*MsgSetTransferReceivedCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c44193c4a9c18e30, []int{8}
+}
+func (m *MsgSetTransferReceivedCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferReceivedCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferReceivedCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferReceivedCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferReceivedCallbackResponse.Merge(m, src)
+}
+func (m *MsgSetTransferReceivedCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferReceivedCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferReceivedCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferReceivedCallbackResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "neutron.transfer.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "neutron.transfer.MsgTransferResponse")
+	proto.RegisterType((*MsgBatchTransfer)(nil), "neutron.transfer.MsgBatchTransfer")
+	proto.RegisterType((*BatchTransferItem)(nil), "neutron.transfer.BatchTransferItem")
+	proto.RegisterType((*MsgBatchTransferResponse)(nil), "neutron.transfer.MsgBatchTransferResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.transfer.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.transfer.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetTransferReceivedCallback)(nil), "neutron.transfer.MsgSetTransferReceivedCallback")
+	proto.RegisterType((*MsgSetTransferReceivedCallbackResponse)(nil), "neutron.transfer.MsgSetTransferReceivedCallbackResponse")
 }
 
 func init() { proto.RegisterFile("neutron/transfer/v1/tx.proto", fileDescriptor_c44193c4a9c18e30) }
 
 var fileDescriptor_c44193c4a9c18e30 = []byte{
-	// 685 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcf, 0x4e, 0xdb, 0x4c,
-	0x10, 0x8f, 0xbf, 0x84, 0x00, 0x9b, 0x8f, 0x3f, 0xdf, 0xf2, 0x15, 0x4c, 0x04, 0x36, 0xb5, 0x5a,
-	0x89, 0x52, 0x75, 0xdd, 0x80, 0xaa, 0x4a, 0x9c, 0xaa, 0x20, 0x55, 0xe5, 0x10, 0x29, 0xb2, 0xe8,
-	0xa5, 0xaa, 0x44, 0x1d, 0x67, 0xe2, 0x58, 0x8d, 0x77, 0xdd, 0xdd, 0x4d, 0x04, 0x97, 0xaa, 0xea,
-	0x89, 0x63, 0x1f, 0x81, 0x47, 0xe0, 0x01, 0xfa, 0x00, 0x1c, 0x39, 0xf6, 0x14, 0x55, 0x70, 0xa0,
-	0x67, 0xae, 0xbd, 0x54, 0x5e, 0xaf, 0x43, 0x82, 0xaa, 0xf6, 0x94, 0x99, 0xf9, 0xfd, 0x66, 0xc6,
-	0xf3, 0x9b, 0xd9, 0xa0, 0x35, 0x0a, 0x7d, 0xc9, 0x19, 0x75, 0x25, 0xf7, 0xa9, 0xe8, 0x00, 0x77,
-	0x07, 0x35, 0x57, 0x1e, 0x91, 0x84, 0x33, 0xc9, 0xf0, 0xa2, 0x46, 0x49, 0x8e, 0x56, 0xad, 0x80,
-	0x89, 0x98, 0x09, 0xb7, 0xe5, 0x0b, 0x70, 0x07, 0xb5, 0x16, 0x48, 0xbf, 0xe6, 0x06, 0x2c, 0xa2,
-	0x59, 0x46, 0x75, 0x45, 0xe3, 0xb1, 0x08, 0xd3, 0x4a, 0xb1, 0x08, 0x35, 0xf0, 0x7f, 0xc8, 0x42,
-	0xa6, 0x4c, 0x37, 0xb5, 0x74, 0xf4, 0x71, 0xd4, 0x0a, 0x5c, 0x3f, 0x49, 0x7a, 0x51, 0xe0, 0xcb,
-	0x88, 0x51, 0x31, 0xf9, 0x1d, 0xda, 0xd6, 0x64, 0x3b, 0x25, 0x07, 0x8c, 0x83, 0x1b, 0xf4, 0x22,
-	0xa0, 0x32, 0xa5, 0x64, 0x96, 0x26, 0xac, 0xe7, 0xc3, 0x74, 0x00, 0x38, 0x74, 0xfa, 0xb4, 0x0d,
-	0x3c, 0xb5, 0x33, 0xd8, 0xf9, 0x59, 0x44, 0x95, 0x86, 0x08, 0x0f, 0x74, 0x55, 0xfc, 0x1c, 0x55,
-	0x04, 0xeb, 0xf3, 0x00, 0x0e, 0x13, 0xc6, 0xa5, 0x69, 0x6c, 0x18, 0x9b, 0xb3, 0xf5, 0xe5, 0x9b,
-	0xa1, 0x8d, 0x8f, 0xfd, 0xb8, 0xb7, 0xeb, 0x8c, 0x81, 0x8e, 0x87, 0x32, 0xaf, 0xc9, 0xb8, 0xc4,
-	0x2f, 0xd0, 0xbc, 0xc6, 0x82, 0xae, 0x4f, 0x29, 0xf4, 0xcc, 0x7f, 0x54, 0xee, 0xea, 0xcd, 0xd0,
-	0xbe, 0x37, 0x91, 0xab, 0x71, 0xc7, 0x9b, 0xcb, 0x02, 0x7b, 0x99, 0x8f, 0x9f, 0xa1, 0x29, 0xc9,
-	0xde, 0x03, 0x35, 0x8b, 0x1b, 0xc6, 0x66, 0x65, 0x7b, 0x95, 0x64, 0xb2, 0x91, 0x54, 0x56, 0xa2,
-	0x65, 0x25, 0x7b, 0x2c, 0xa2, 0xf5, 0xd2, 0xf9, 0xd0, 0x2e, 0x78, 0x19, 0x1b, 0x2f, 0xa3, 0xb2,
-	0x80, 0x74, 0x2a, 0xb3, 0x94, 0x36, 0xf4, 0xb4, 0x87, 0xab, 0x68, 0x86, 0x43, 0x00, 0xd1, 0x00,
-	0xb8, 0x39, 0xa5, 0x90, 0x91, 0x8f, 0xdf, 0xa1, 0x79, 0x19, 0xc5, 0xc0, 0xfa, 0xf2, 0xb0, 0x0b,
-	0x51, 0xd8, 0x95, 0x66, 0x59, 0xf5, 0xac, 0x92, 0xa8, 0x15, 0x90, 0x54, 0x4e, 0xa2, 0x45, 0x1c,
-	0xd4, 0xc8, 0x2b, 0xc5, 0xa8, 0xaf, 0xa7, 0x4d, 0x6f, 0x87, 0x99, 0xcc, 0x77, 0xbc, 0x39, 0x1d,
-	0xc8, 0xd8, 0x78, 0x1f, 0xfd, 0x97, 0x33, 0xd2, 0x5f, 0x21, 0xfd, 0x38, 0x31, 0xa7, 0x37, 0x8c,
-	0xcd, 0x52, 0x7d, 0xed, 0x66, 0x68, 0x9b, 0x93, 0x45, 0x46, 0x14, 0xc7, 0x5b, 0xd4, 0xb1, 0x83,
-	0x3c, 0x84, 0x31, 0x2a, 0xc5, 0x10, 0x33, 0x73, 0x46, 0x0d, 0xa1, 0x6c, 0xfc, 0x14, 0x15, 0x3b,
-	0x00, 0xe6, 0xac, 0xfa, 0x6a, 0x93, 0xe4, 0x27, 0x39, 0xb6, 0x63, 0xf2, 0x12, 0x40, 0x0b, 0x95,
-	0x52, 0x77, 0x97, 0x4e, 0x4e, 0xed, 0xc2, 0x8f, 0x53, 0xbb, 0xf0, 0xf9, 0xfa, 0x6c, 0x4b, 0x6b,
-	0xe4, 0x34, 0xd1, 0xd2, 0xd8, 0xf2, 0x3d, 0x10, 0x09, 0xa3, 0x02, 0xb0, 0x8d, 0x2a, 0x02, 0x3e,
-	0xf4, 0x81, 0x06, 0x70, 0x18, 0xb5, 0xd5, 0x11, 0x94, 0x3c, 0x94, 0x87, 0xf6, 0xdb, 0xd8, 0x44,
-	0xd3, 0x13, 0x5b, 0xf6, 0x72, 0xd7, 0xf9, 0x88, 0x16, 0x1a, 0x22, 0x7c, 0x9d, 0xb4, 0x7d, 0x09,
-	0x4d, 0x9f, 0xfb, 0xb1, 0x50, 0x0b, 0x8a, 0x42, 0x0a, 0x3c, 0xbb, 0x26, 0x4f, 0x7b, 0xb8, 0x8e,
-	0xca, 0x89, 0x62, 0xa8, 0x1a, 0x95, 0xed, 0x07, 0x4a, 0xfc, 0xf1, 0xc3, 0x1f, 0x3d, 0xb1, 0x74,
-	0x0d, 0x59, 0x35, 0x3d, 0x92, 0xce, 0xdc, 0x5d, 0x38, 0x19, 0x4d, 0xa4, 0x8a, 0x3a, 0xab, 0x68,
-	0xe5, 0x4e, 0xff, 0x7c, 0xaa, 0xed, 0xaf, 0x06, 0x2a, 0x36, 0x44, 0x88, 0x9b, 0x68, 0x66, 0x74,
-	0xee, 0xeb, 0xe4, 0xee, 0x6b, 0x26, 0x63, 0x82, 0x54, 0x1f, 0xfe, 0x11, 0x1e, 0xe9, 0xf5, 0x16,
-	0xfd, 0x3b, 0x31, 0xf1, 0xfd, 0xdf, 0xa6, 0x8d, 0x53, 0xaa, 0x8f, 0xfe, 0x4a, 0xc9, 0xab, 0x57,
-	0xa7, 0x3e, 0x5d, 0x9f, 0x6d, 0x19, 0xf5, 0xc6, 0xf9, 0xa5, 0x65, 0x5c, 0x5c, 0x5a, 0xc6, 0xf7,
-	0x4b, 0xcb, 0xf8, 0x72, 0x65, 0x15, 0x2e, 0xae, 0xac, 0xc2, 0xb7, 0x2b, 0xab, 0xf0, 0x66, 0x27,
-	0x8c, 0x64, 0xb7, 0xdf, 0x22, 0x01, 0x8b, 0x5d, 0x5d, 0xf5, 0x09, 0xe3, 0x61, 0x6e, 0xbb, 0x83,
-	0x5a, 0xcd, 0x3d, 0xba, 0xfd, 0x13, 0x91, 0xc7, 0x09, 0x88, 0x56, 0x59, 0xbd, 0xff, 0x9d, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x25, 0xf8, 0xc1, 0xe3, 0xed, 0x04, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x31, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0x59, 0x96, 0x4f, 0x75, 0xe2, 0x5c, 0xda, 0x84, 0x66, 0x63, 0xd2, 0x65, 0x9b,
+	0x42, 0x75, 0xd0, 0x63, 0xe4, 0xa0, 0x68, 0xe1, 0xa9, 0x90, 0x81, 0xa6, 0x1e, 0x04, 0x18, 0x6c,
+	0xba, 0x14, 0x05, 0x5c, 0x92, 0x7a, 0xa6, 0x88, 0x88, 0x3c, 0x96, 0x77, 0x12, 0x92, 0xa5, 0x28,
+	0x3a, 0xa5, 0x5b, 0x7f, 0x42, 0xd6, 0x6e, 0x99, 0x3b, 0x76, 0xca, 0x98, 0xb1, 0x93, 0x51, 0xd8,
+	0x43, 0x3a, 0x7b, 0xed, 0x52, 0xf0, 0x78, 0xa4, 0x48, 0x49, 0x96, 0x5b, 0x34, 0x93, 0xef, 0xdd,
+	0xfb, 0xde, 0x7b, 0x77, 0xdf, 0xf7, 0x1d, 0x2d, 0x74, 0x27, 0x82, 0x31, 0x4f, 0x68, 0x64, 0xf1,
+	0xc4, 0x89, 0xd8, 0x09, 0x24, 0xd6, 0xa4, 0x6b, 0xf1, 0x27, 0x24, 0x4e, 0x28, 0xa7, 0x78, 0x53,
+	0x66, 0x49, 0x9e, 0xd5, 0x74, 0x8f, 0xb2, 0x90, 0x32, 0xcb, 0x75, 0x18, 0x58, 0x93, 0xae, 0x0b,
+	0xdc, 0xe9, 0x5a, 0x1e, 0x0d, 0xa2, 0xac, 0x42, 0xbb, 0x2d, 0xf3, 0x21, 0xf3, 0xd3, 0x4e, 0x21,
+	0xf3, 0x65, 0xe2, 0x6d, 0x9f, 0xfa, 0x54, 0x2c, 0xad, 0x74, 0x25, 0x77, 0xef, 0x05, 0xae, 0x67,
+	0x39, 0x71, 0x3c, 0x0a, 0x3c, 0x87, 0x07, 0x34, 0x62, 0xd5, 0x73, 0xc8, 0xb5, 0x04, 0x1b, 0x29,
+	0xd8, 0xa3, 0x09, 0x58, 0xde, 0x28, 0x80, 0x88, 0xa7, 0x90, 0x6c, 0x25, 0x01, 0xdb, 0xf9, 0x65,
+	0x4e, 0x00, 0x12, 0x38, 0x19, 0x47, 0x03, 0x48, 0xd2, 0x75, 0x96, 0x36, 0xff, 0xae, 0xa3, 0x76,
+	0x9f, 0xf9, 0x8f, 0x64, 0x57, 0xfc, 0x29, 0x6a, 0x33, 0x3a, 0x4e, 0x3c, 0x38, 0x8e, 0x69, 0xc2,
+	0x55, 0x65, 0x47, 0xe9, 0xac, 0xf7, 0x6e, 0x5d, 0x9c, 0x1a, 0xf8, 0xa9, 0x13, 0x8e, 0xf6, 0xcd,
+	0x52, 0xd2, 0xb4, 0x51, 0x16, 0x1d, 0xd1, 0x84, 0xe3, 0xcf, 0xd1, 0x35, 0x99, 0xf3, 0x86, 0x4e,
+	0x14, 0xc1, 0x48, 0x5d, 0x11, 0xb5, 0x5b, 0x17, 0xa7, 0xc6, 0x3b, 0x95, 0x5a, 0x99, 0x37, 0xed,
+	0x8d, 0x6c, 0xe3, 0x20, 0x8b, 0xf1, 0x27, 0x68, 0x95, 0xd3, 0xc7, 0x10, 0xa9, 0xf5, 0x1d, 0xa5,
+	0xd3, 0xde, 0xdb, 0x22, 0x19, 0x6d, 0x24, 0xa5, 0x95, 0x48, 0x5a, 0xc9, 0x01, 0x0d, 0xa2, 0x5e,
+	0xe3, 0xe5, 0xa9, 0x51, 0xb3, 0x33, 0x34, 0xbe, 0x85, 0x9a, 0x0c, 0xd2, 0x5b, 0xa9, 0x8d, 0x74,
+	0xa0, 0x2d, 0x23, 0xac, 0xa1, 0x56, 0x02, 0x1e, 0x04, 0x13, 0x48, 0xd4, 0x55, 0x91, 0x29, 0x62,
+	0xfc, 0x1d, 0xba, 0xc6, 0x83, 0x10, 0xe8, 0x98, 0x1f, 0x0f, 0x21, 0xf0, 0x87, 0x5c, 0x6d, 0x8a,
+	0x99, 0x1a, 0x09, 0x5c, 0x8f, 0xa4, 0x74, 0x12, 0x49, 0xe2, 0xa4, 0x4b, 0xbe, 0x14, 0x88, 0xde,
+	0x76, 0x3a, 0x74, 0x7a, 0x99, 0x6a, 0xbd, 0x69, 0x6f, 0xc8, 0x8d, 0x0c, 0x8d, 0x0f, 0xd1, 0x8d,
+	0x1c, 0x91, 0xfe, 0x65, 0xdc, 0x09, 0x63, 0x75, 0x6d, 0x47, 0xe9, 0x34, 0x7a, 0x77, 0x2e, 0x4e,
+	0x0d, 0xb5, 0xda, 0xa4, 0x80, 0x98, 0xf6, 0xa6, 0xdc, 0x7b, 0x94, 0x6f, 0x61, 0x8c, 0x1a, 0x21,
+	0x84, 0x54, 0x6d, 0x89, 0x4b, 0x88, 0x35, 0xbe, 0x8f, 0xea, 0x27, 0x00, 0xea, 0xba, 0x38, 0xb5,
+	0x4a, 0x72, 0x4b, 0x96, 0x34, 0x26, 0x5f, 0x00, 0x48, 0xa2, 0x52, 0xe8, 0xfe, 0xcd, 0x67, 0xcf,
+	0x8d, 0xda, 0x5f, 0xcf, 0x8d, 0xda, 0x4f, 0xaf, 0x5f, 0xec, 0x4a, 0x8e, 0xcc, 0x23, 0x74, 0xb3,
+	0x24, 0xbe, 0x0d, 0x2c, 0xa6, 0x11, 0x03, 0x6c, 0xa0, 0x36, 0x83, 0xef, 0xc7, 0x10, 0x79, 0x70,
+	0x1c, 0x0c, 0x84, 0x09, 0x1a, 0x36, 0xca, 0xb7, 0x0e, 0x07, 0x58, 0x45, 0x6b, 0x15, 0x95, 0xed,
+	0x3c, 0x34, 0x7f, 0x53, 0xd0, 0x66, 0x9f, 0xf9, 0x3d, 0x87, 0x7b, 0xc3, 0xc2, 0x54, 0x53, 0x89,
+	0x94, 0x8a, 0x44, 0x0f, 0xd1, 0x7a, 0x6e, 0x67, 0xa6, 0xae, 0xec, 0xd4, 0x3b, 0xed, 0xbd, 0xf7,
+	0xc9, 0xec, 0xf3, 0x22, 0x95, 0x5e, 0x87, 0x1c, 0x42, 0x79, 0xad, 0x69, 0x6d, 0x4e, 0x47, 0xfd,
+	0x7f, 0xd2, 0xf1, 0xfb, 0x0a, 0xba, 0x31, 0x37, 0x4d, 0xb0, 0x31, 0xfb, 0x24, 0x2a, 0xd6, 0xbf,
+	0xbb, 0xd8, 0xfa, 0x6f, 0xc8, 0xdf, 0x65, 0x1f, 0x37, 0x66, 0x7c, 0xfc, 0x70, 0xce, 0xc7, 0xab,
+	0x57, 0xfa, 0x38, 0x6b, 0x3e, 0x63, 0xd7, 0x7b, 0x8b, 0xec, 0xda, 0x14, 0xba, 0x5f, 0x6e, 0xc8,
+	0xb5, 0xa9, 0x21, 0xf7, 0x5b, 0x39, 0x9f, 0x26, 0x20, 0x75, 0xd6, 0x00, 0x85, 0xb1, 0x0e, 0xcb,
+	0x82, 0x2b, 0x42, 0xf0, 0xbb, 0xf3, 0x82, 0x2f, 0xb0, 0xe4, 0x9c, 0xe4, 0xe6, 0x0f, 0xe8, 0x7a,
+	0x9f, 0xf9, 0x5f, 0xc7, 0x03, 0x87, 0xc3, 0x91, 0x93, 0x38, 0x21, 0x13, 0x36, 0x0b, 0xfc, 0xa8,
+	0x64, 0x33, 0x11, 0xe1, 0x1e, 0x6a, 0xc6, 0x02, 0x21, 0x74, 0x69, 0xef, 0x7d, 0x20, 0xd8, 0x29,
+	0x7f, 0x61, 0xa7, 0xb3, 0x27, 0x5d, 0x92, 0x75, 0x93, 0x13, 0x65, 0xe5, 0xfe, 0xf5, 0x67, 0x85,
+	0x57, 0x44, 0x53, 0x73, 0x0b, 0xdd, 0x9e, 0x99, 0x9f, 0x9f, 0xd5, 0xf4, 0x90, 0xde, 0x67, 0xfe,
+	0x57, 0xc0, 0xa7, 0xb7, 0x10, 0x7a, 0x0d, 0x0e, 0x9c, 0xd1, 0xc8, 0x75, 0xbc, 0xc7, 0x97, 0x3e,
+	0x08, 0x15, 0xad, 0x41, 0xe4, 0xb8, 0x23, 0x18, 0x88, 0xa3, 0xb6, 0xec, 0x3c, 0x2c, 0xcf, 0xcf,
+	0xbc, 0xda, 0x41, 0x1f, 0x2e, 0x1f, 0x92, 0x1f, 0x67, 0xef, 0xd7, 0x3a, 0xaa, 0xf7, 0x99, 0x8f,
+	0x8f, 0x50, 0xab, 0x78, 0x91, 0xdb, 0x4b, 0x59, 0xd7, 0xfe, 0x9d, 0x28, 0xf8, 0x5b, 0xf4, 0x56,
+	0x45, 0x80, 0xf7, 0x16, 0x96, 0x95, 0x21, 0xda, 0x47, 0x57, 0x42, 0x8a, 0xee, 0xc7, 0x68, 0xa3,
+	0xfa, 0x19, 0x31, 0x17, 0xd6, 0x56, 0x30, 0xda, 0xee, 0xd5, 0x98, 0x62, 0xc0, 0xcf, 0x0a, 0x7a,
+	0x77, 0x99, 0x4a, 0xf7, 0x17, 0xf6, 0x5a, 0x52, 0xa1, 0x7d, 0xf6, 0x5f, 0x2b, 0xf2, 0xb3, 0x68,
+	0xab, 0x3f, 0xbe, 0x7e, 0xb1, 0xab, 0xf4, 0xfa, 0x2f, 0xcf, 0x74, 0xe5, 0xd5, 0x99, 0xae, 0xfc,
+	0x79, 0xa6, 0x2b, 0xbf, 0x9c, 0xeb, 0xb5, 0x57, 0xe7, 0x7a, 0xed, 0x8f, 0x73, 0xbd, 0xf6, 0xcd,
+	0x03, 0x3f, 0xe0, 0xc3, 0xb1, 0x4b, 0x3c, 0x1a, 0x5a, 0x72, 0xc8, 0xc7, 0x34, 0xf1, 0xf3, 0xb5,
+	0x35, 0xe9, 0x76, 0xad, 0x27, 0xd3, 0x5f, 0x0a, 0xfc, 0x69, 0x0c, 0xcc, 0x6d, 0x8a, 0x7f, 0xf2,
+	0x0f, 0xfe, 0x19, 0x00, 0xbf, 0x26, 0xe9, 0xd8, 0xd2, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -296,6 +538,10 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// BatchTransfer defines a rpc handler method for MsgBatchTransfer.
+	BatchTransfer(ctx context.Context, in *MsgBatchTransfer, opts ...grpc.CallOption) (*MsgBatchTransferResponse, error)
+	// SetTransferReceivedCallback defines a rpc handler for MsgSetTransferReceivedCallback.
+	SetTransferReceivedCallback(ctx context.Context, in *MsgSetTransferReceivedCallback, opts ...grpc.CallOption) (*MsgSetTransferReceivedCallbackResponse, error)
 }
 
 type msgClient struct {
@@ -324,12 +570,34 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) BatchTransfer(ctx context.Context, in *MsgBatchTransfer, opts ...grpc.CallOption) (*MsgBatchTransferResponse, error) {
+	out := new(MsgBatchTransferResponse)
+	err := c.cc.Invoke(ctx, "/neutron.transfer.Msg/BatchTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTransferReceivedCallback(ctx context.Context, in *MsgSetTransferReceivedCallback, opts ...grpc.CallOption) (*MsgSetTransferReceivedCallbackResponse, error) {
+	out := new(MsgSetTransferReceivedCallbackResponse)
+	err := c.cc.Invoke(ctx, "/neutron.transfer.Msg/SetTransferReceivedCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// BatchTransfer defines a rpc handler method for MsgBatchTransfer.
+	BatchTransfer(context.Context, *MsgBatchTransfer) (*MsgBatchTransferResponse, error)
+	// SetTransferReceivedCallback defines a rpc handler for MsgSetTransferReceivedCallback.
+	SetTransferReceivedCallback(context.Context, *MsgSetTransferReceivedCallback) (*MsgSetTransferReceivedCallbackResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -342,6 +610,12 @@ func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) BatchTransfer(ctx context.Context, req *MsgBatchTransfer) (*MsgBatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTransfer not implemented")
+}
+func (*UnimplementedMsgServer) SetTransferReceivedCallback(ctx context.Context, req *MsgSetTransferReceivedCallback) (*MsgSetTransferReceivedCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferReceivedCallback not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -383,6 +657,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_BatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.transfer.Msg/BatchTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchTransfer(ctx, req.(*MsgBatchTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTransferReceivedCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferReceivedCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferReceivedCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.transfer.Msg/SetTransferReceivedCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferReceivedCallback(ctx, req.(*MsgSetTransferReceivedCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "neutron.transfer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -395,6 +706,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "BatchTransfer",
+			Handler:    _Msg_BatchTransfer_Handler,
+		},
+		{
+			MethodName: "SetTransferReceivedCallback",
+			Handler:    _Msg_SetTransferReceivedCallback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "neutron/transfer/v1/tx.proto",
@@ -528,7 +847,7 @@ func (m *MsgTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgBatchTransfer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -538,18 +857,18 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBatchTransfer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBatchTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -557,18 +876,32 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	dAtA[i] = 0x1a
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *BatchTransferItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -578,57 +911,273 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BatchTransferItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BatchTransferItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = len(m.SourcePort)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.SourceChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.TimeoutHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.Token.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = m.TimeoutHeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.TimeoutTimestamp != 0 {
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourcePort) > 0 {
+		i -= len(m.SourcePort)
+		copy(dAtA[i:], m.SourcePort)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourcePort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferReceivedCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferReceivedCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferReceivedCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferReceivedCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferReceivedCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferReceivedCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
 		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
 	}
 	l = len(m.Memo)
@@ -656,6 +1205,74 @@ func (m *MsgTransferResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgBatchTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *BatchTransferItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourcePort)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimeoutHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -666,27 +1283,630 @@ func (m *MsgUpdateParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTransferReceivedCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetTransferReceivedCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourcePort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeoutHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceId", wireType)
+			}
+			m.SequenceId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, BatchTransferItem{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
+func (m *BatchTransferItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -709,10 +1929,10 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchTransferItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchTransferItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -813,38 +2033,6 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
@@ -876,7 +2064,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
@@ -909,7 +2097,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
 			}
@@ -928,7 +2116,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 8:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 			}
@@ -960,9 +2148,59 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -989,7 +2227,8 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, MsgTransferResponse{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1014,7 +2253,7 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1037,17 +2276,17 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.SequenceId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1057,16 +2296,29 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SequenceId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1076,23 +2328,24 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1115,7 +2368,7 @@ func (m *MsgTransferResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1138,15 +2391,65 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTransferReceivedCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTransferReceivedCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTransferReceivedCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1174,13 +2477,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1190,25 +2493,12 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1230,7 +2520,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTransferReceivedCallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1253,10 +2543,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTransferReceivedCallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTransferReceivedCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: